@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build !nobuilder
+
+package main
+
+import "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+
+// builderComponents returns the providers for the payload scheduler, which
+// builds payloads ahead of time for upcoming proposals. The local builder
+// itself is always included since block proposals depend on it. Build with
+// the nobuilder tag to leave the scheduler out. It is kept in validatoronly
+// builds, as validators are the nodes that propose.
+func builderComponents() []any {
+	return []any{
+		components.ProvidePayloadScheduler[
+			*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+			*BeaconStateMarshallable, *Deposit, *ExecutionPayload,
+			*ExecutionPayloadHeader, *KVStore, *Logger,
+		],
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build !nonodeapi && !validatoronly
+
+package main
+
+import "github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+
+// nodeAPIComponents returns the providers for the beacon node API server, its
+// handlers and the event feed and journal backing the events endpoints. Build
+// with the nonodeapi or validatoronly tag to leave them out.
+func nodeAPIComponents() []any {
+	return []any{
+		components.ProvideEventFeed,
+		components.ProvideEventJournal[*Logger],
+		components.ProvideEventPublisher[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+			*BlobSidecar, *BlobSidecars, *Deposit, *ExecutionPayload, *Logger,
+		],
+		components.ProvideNodeAPIServer[*Logger, NodeAPIContext],
+		components.ProvideSyncDetail[
+			*BeaconState, *ExecutionPayload, *ExecutionPayloadHeader, *Logger,
+			*StorageBackend,
+		],
+		components.ProvideNodeAPIEngineFactory,
+		components.ProvideNodeAPIBackend[
+			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
+			*BeaconBlockHeader, *BlockStore, *BeaconState,
+			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
+			*ExecutionPayloadHeader, *KVStore, *CometBFTService, *StorageBackend,
+		],
+		components.ProvideNodeAPIHandlers[
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*BlobSidecar, *BlobSidecars, *ExecutionPayloadHeader, *KVStore,
+			NodeAPIContext,
+		],
+		components.ProvideNodeAPIAdminHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIBeaconHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
+		components.ProvideNodeAPIConfigHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIDebugHandler[
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
+		],
+		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
+		components.ProvideNodeAPIKeymanagerHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPIProofHandler[
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*BlobSidecar, *BlobSidecars, *ExecutionPayloadHeader, *KVStore,
+			NodeAPIContext,
+		],
+		components.ProvideNodeAPISyncHandler[NodeAPIContext],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+	}
+}
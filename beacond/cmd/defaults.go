@@ -41,17 +41,22 @@ func DefaultComponents() []any {
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlobSidecars, *Logger,
 		],
+		components.ProvideAvailabilitySampler,
 		components.ProvideBeaconDepositContract[
 			*Deposit, *ExecutionPayload, *ExecutionPayloadHeader,
 		],
 		components.ProvideBlockStore[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
 		],
-		components.ProvideBlockStoreService[
+		components.ProvideBlsSigner,
+		components.ProvideBlobFeeTracker[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
-			*BlockStore, *Logger,
+			*ExecutionPayload, *Logger,
+		],
+		components.ProvideBlobTxTracker[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+			*ExecutionPayload, *Logger,
 		],
-		components.ProvideBlsSigner,
 		components.ProvideBlobProcessor[
 			*AvailabilityStore, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlobSidecar, *BlobSidecars, *Logger,
@@ -60,6 +65,8 @@ func DefaultComponents() []any {
 		components.ProvideBlobVerifier[
 			*BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
 		],
+		components.ProvideBrake[*Logger],
+		components.ProvideCacheBudget,
 		components.ProvideChainService[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
@@ -67,8 +74,12 @@ func DefaultComponents() []any {
 			*ExecutionPayload, *ExecutionPayloadHeader, *Genesis,
 			*KVStore, *Logger, *StorageBackend,
 		],
+		components.ProvideChainVerifier[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*StorageBackend,
+		],
 		components.ProvideNode,
-		components.ProvideChainSpec,
+		components.ProvideChainSpec[*Logger],
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		// components.ProvideConsensusEngine[
@@ -79,32 +90,51 @@ func DefaultComponents() []any {
 			*AvailabilityStore, *BeaconBlockBody, *BlobSidecar,
 			*BlobSidecars, *Logger,
 		],
-		components.ProvideDBManager[*AvailabilityStore, *DepositStore, *Logger],
+		components.ProvideDBManager[
+			*AvailabilityStore, *BeaconBlock, *DepositStore, *Logger,
+		],
 		components.ProvideDepositPruner[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*Deposit, *DepositStore, *Logger,
 		],
 		components.ProvideDepositService[
-			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Deposit,
-			*DepositContract, *DepositStore, *ExecutionPayload,
-			*ExecutionPayloadHeader, *Logger,
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*Deposit, *DepositContract, *DepositStore, *ExecutionPayload,
+			*ExecutionPayloadHeader, *Logger, *StorageBackend,
 		],
-		components.ProvideDepositStore[*Deposit],
+		components.ProvideDepositStore[*Deposit, *Logger],
 		components.ProvideDispatcher[
-			*BeaconBlock, *BlobSidecars, *Genesis, *Logger,
+			*BeaconBlock, *BeaconState, *BlobSidecars, *Genesis, *Logger,
 		],
+		components.ProvideDoppelganger[*Logger],
+		components.ProvideEncryptionCipher,
 		components.ProvideEngineClient[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
 		components.ProvideExecutionEngine[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideEmptyPayloadOverride,
+		components.ProvideForkManager[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
+		],
+		components.ProvideGraffiti,
+		components.ProvideHotKeysTracer,
 		components.ProvideJWTSecret,
+		components.ProvideKafkaSink,
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 		],
+		components.ProvideMaintenance[
+			*BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
+		],
+		components.ProvidePerformanceTracker[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
+		],
+		components.ProvidePostgresSink,
 		components.ProvideReportingService[*Logger],
+		components.ProvideReqRespReactor[*BlockStore, *Logger],
 		components.ProvideCometBFTService[*Logger],
 		components.ProvideServiceRegistry[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
@@ -114,9 +144,14 @@ func DefaultComponents() []any {
 			*Genesis, *KVStore, *Logger,
 			NodeAPIContext,
 		],
+		components.ProvideSinkService[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Deposit,
+			*Logger,
+		],
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 		],
+		components.ProvideSlotControl,
 		components.ProvideStateProcessor[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BeaconState, *BeaconStateMarshallable, *Deposit, *ExecutionPayload,
@@ -129,7 +164,9 @@ func DefaultComponents() []any {
 		],
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTimelinessTracker[*Logger],
 		components.ProvideTrustedSetup,
+		components.ProvideUpgradeManager,
 		components.ProvideValidatorService[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
@@ -137,39 +174,19 @@ func DefaultComponents() []any {
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 			*StorageBackend,
 		],
+		components.ProvideWitnessExporter[
+			*BeaconState, *BeaconStateMarshallable,
+		],
 		// TODO Hacks
 		components.ProvideKVStoreService,
 		components.ProvideKVStoreKey,
 	}
-	c = append(c,
-		components.ProvideNodeAPIServer[*Logger, NodeAPIContext],
-		components.ProvideNodeAPIEngine,
-		components.ProvideNodeAPIBackend[
-			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
-			*BeaconBlockHeader, *BlockStore, *BeaconState,
-			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, *StorageBackend,
-		],
-	)
-
-	c = append(c,
-		components.ProvideNodeAPIHandlers[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
-		],
-		components.ProvideNodeAPIBeaconHandler[
-			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
-		],
-		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
-		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
-		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
-		components.ProvideNodeAPIProofHandler[
-			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
-		],
-	)
-
+	// Optional subsystems, each of which can be compiled out with a build
+	// tag. See the components_*.go files in this package.
+	c = append(c, archiveComponents()...)
+	c = append(c, builderComponents()...)
+	c = append(c, nodeAPIComponents()...)
+	// Failure injection, which is compiled in with the chaos tag only.
+	c = append(c, chaosComponents()...)
 	return c
 }
@@ -65,13 +65,17 @@ func run() error {
 				clicomponents.DefaultClientComponents(),
 				// TODO: remove these, and eventually pull cfg and chainspec
 				// from built node
-				nodecomponents.ProvideChainSpec,
+				nodecomponents.ProvideChainSpec[*Logger],
 			),
 		),
 		// Set the NodeBuilderFunc to the NodeBuilder Build.
 		clibuilder.WithNodeBuilderFunc[
 			Node, *ExecutionPayload, *Logger,
 		](nb.Build),
+		// Set the GraphFunc to the NodeBuilder Graph.
+		clibuilder.WithGraphFunc[Node, *ExecutionPayload, *Logger](
+			nb.Graph,
+		),
 	)
 
 	cmd, err := cb.Build()
@@ -23,6 +23,7 @@ package main
 import (
 	"cosmossdk.io/core/appmodule/v2"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/forks"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -40,6 +41,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/performance"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
@@ -146,6 +148,9 @@ type (
 		engineprimitives.Withdrawals,
 	]
 
+	// ForkManager is a type alias for the fork manager.
+	ForkManager = forks.Manager[*BeaconBlock]
+
 	// IndexDB is a type alias for the range DB.
 	IndexDB = filedb.RangeDB
 
@@ -172,14 +177,30 @@ type (
 	// NodeAPIEngine is a type alias for the node API engine.
 	NodeAPIEngine = echo.Engine
 
+	// NodeAPIEngineFactory is a type alias for the node API engine factory.
+	NodeAPIEngineFactory = echo.EngineFactory
+
 	// NodeAPIServer is a type alias for the node API server.
 	NodeAPIServer = server.Server[NodeAPIContext]
 
+	// PayloadScheduler is a type alias for the payload build scheduler.
+	PayloadScheduler = payloadbuilder.Scheduler[
+		*BeaconState,
+		*ExecutionPayload,
+		*ExecutionPayloadHeader,
+		*PayloadAttributes,
+		PayloadID,
+		*Withdrawal,
+	]
+
+	// PerformanceTracker is a type alias for the performance tracker.
+	PerformanceTracker = performance.Tracker[*BeaconBlock]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
 	// SidecarFactory is a type alias for the sidecar factory.
-	SidecarFactory = dablob.SidecarFactory[
+	SidecarFactory = dablob.BlobSidecarFactory[
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
@@ -37,6 +37,16 @@ ifeq (boltdb,$(findstring boltdb,$(COSMOS_BUILD_OPTIONS)))
   build_tags += boltdb
 endif
 
+# optional subsystems that can be compiled out of beacond
+ifeq (validatoronly,$(findstring validatoronly,$(COSMOS_BUILD_OPTIONS)))
+  build_tags += validatoronly
+endif
+
+# failure injection for resilience testing, never to be used in production
+ifeq (chaos,$(findstring chaos,$(COSMOS_BUILD_OPTIONS)))
+  build_tags += chaos
+endif
+
 # always include pebble
 build_tags += pebbledb
 
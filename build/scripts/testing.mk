@@ -267,6 +267,27 @@ test-unit-bench: ## run golang unit benchmarks
 	@go list -f '{{.Dir}}/...' -m | xargs \
 		go test -bench=. -run=^$ -benchmem
 
+HTR_BENCH_PKGS = ./mod/primitives/pkg/crypto/sha256/... \
+	./mod/consensus-types/pkg/types/...
+HTR_BENCH_DIR = bench
+HTR_BENCH_BASELINE ?= $(HTR_BENCH_DIR)/htr-baseline.txt
+
+test-unit-bench-htr: ## run hash tree root benchmarks and compare them against the baseline
+	@mkdir -p $(HTR_BENCH_DIR)
+	@go test -run=^$$ -bench=. -benchmem -count=6 $(HTR_BENCH_PKGS) \
+		| tee $(HTR_BENCH_DIR)/htr.txt
+	@if [ -f $(HTR_BENCH_BASELINE) ]; then \
+		go run golang.org/x/perf/cmd/benchstat@latest \
+			$(HTR_BENCH_BASELINE) $(HTR_BENCH_DIR)/htr.txt; \
+	else \
+		echo "No baseline at $(HTR_BENCH_BASELINE), record one with make test-unit-bench-htr-baseline"; \
+	fi
+
+test-unit-bench-htr-baseline: ## record the hash tree root benchmark baseline
+	@mkdir -p $(HTR_BENCH_DIR)
+	@go test -run=^$$ -bench=. -benchmem -count=6 $(HTR_BENCH_PKGS) \
+		| tee $(HTR_BENCH_BASELINE)
+
 # On MacOS, if there is a linking issue on the fuzz tests, 
 # use the old linker with flags -ldflags=-extldflags=-Wl,-ld_classic
 test-unit-fuzz: ## run fuzz tests
@@ -276,6 +297,9 @@ test-unit-fuzz: ## run fuzz tests
 	go test ./mod/payload/pkg/cache/... -fuzz=FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME}
 	go test -fuzz=FuzzHashTreeRoot ./mod/primitives/pkg/merkle -fuzztime=${MEDIUM_FUZZ_TIME}
 
+test-byzantine: ## run byzantine fault injection tests against the middleware
+	go test ./testing/byzantine/... -v
+
 test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
 
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ticker
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Clock maps wall-clock time to slots and epochs, counting slots of a fixed
+// duration from the genesis time.
+type Clock struct {
+	// genesis is the start of slot zero.
+	genesis time.Time
+	// slotDuration is the duration of a slot.
+	slotDuration time.Duration
+	// slotsPerEpoch is the number of slots in an epoch.
+	slotsPerEpoch uint64
+	// now returns the current time, overridable in tests.
+	now func() time.Time
+}
+
+// NewClock creates a new slot clock.
+func NewClock(
+	genesis time.Time,
+	slotDuration time.Duration,
+	slotsPerEpoch uint64,
+) (*Clock, error) {
+	if slotDuration <= 0 {
+		return nil, ErrInvalidSlotDuration
+	}
+	if slotsPerEpoch == 0 {
+		return nil, ErrInvalidSlotsPerEpoch
+	}
+	return &Clock{
+		genesis:       genesis,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+		now:           time.Now,
+	}, nil
+}
+
+// SlotDuration returns the duration of a slot.
+func (c *Clock) SlotDuration() time.Duration {
+	return c.slotDuration
+}
+
+// CurrentSlot returns the slot of the current time.
+func (c *Clock) CurrentSlot() math.Slot {
+	return c.SlotAt(c.now())
+}
+
+// SlotAt returns the slot of the given time. Times before genesis belong to
+// slot zero.
+func (c *Clock) SlotAt(t time.Time) math.Slot {
+	if t.Before(c.genesis) {
+		return 0
+	}
+	//#nosec:G115 // the elapsed time is positive.
+	return math.Slot(t.Sub(c.genesis) / c.slotDuration)
+}
+
+// SlotStart returns the time at which the given slot starts.
+func (c *Clock) SlotStart(slot math.Slot) time.Time {
+	//#nosec:G115 // slots fit in an int64 for any realistic duration.
+	return c.genesis.Add(time.Duration(slot) * c.slotDuration)
+}
+
+// EpochOf returns the epoch of the given slot.
+func (c *Clock) EpochOf(slot math.Slot) math.Epoch {
+	return math.Epoch(slot.Unwrap() / c.slotsPerEpoch)
+}
+
+// IsEpochStart returns true if the given slot is the first of its epoch.
+func (c *Clock) IsEpochStart(slot math.Slot) bool {
+	return slot.Unwrap()%c.slotsPerEpoch == 0
+}
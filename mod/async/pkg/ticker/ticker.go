@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Schedule describes when a subscription ticks.
+type Schedule struct {
+	// Offset is how far into the slot the tick fires.
+	Offset time.Duration
+	// EpochOnly restricts the ticks to the first slot of each epoch.
+	EpochOnly bool
+}
+
+// StartOfSlot ticks at the start of every slot.
+func StartOfSlot() Schedule {
+	return Schedule{}
+}
+
+// IntoSlot ticks the given duration into every slot.
+func IntoSlot(offset time.Duration) Schedule {
+	return Schedule{Offset: offset}
+}
+
+// StartOfEpoch ticks at the start of the first slot of every epoch.
+func StartOfEpoch() Schedule {
+	return Schedule{EpochOnly: true}
+}
+
+// Tick is delivered to subscribers when their schedule fires.
+type Tick struct {
+	// Slot is the slot the tick belongs to.
+	Slot math.Slot
+	// Epoch is the epoch of the slot.
+	Epoch math.Epoch
+	// Time is the time the tick was scheduled for.
+	Time time.Time
+}
+
+// Ticker delivers ticks derived from a slot clock. Services subscribe to the
+// point of the slot they act on, rather than running timers of their own.
+type Ticker struct {
+	clock *Clock
+}
+
+// New creates a new ticker on top of the given clock.
+func New(clock *Clock) *Ticker {
+	return &Ticker{clock: clock}
+}
+
+// Clock returns the slot clock of the ticker.
+func (t *Ticker) Clock() *Clock {
+	return t.clock
+}
+
+// Subscribe returns a channel receiving a tick each time the schedule fires,
+// starting with the next one. Like time.Ticker, ticks are dropped rather than
+// queued for a slow receiver. The channel is closed once ctx is done.
+func (t *Ticker) Subscribe(
+	ctx context.Context,
+	schedule Schedule,
+) (<-chan Tick, error) {
+	if schedule.Offset < 0 || schedule.Offset >= t.clock.slotDuration {
+		return nil, ErrInvalidOffset
+	}
+	ch := make(chan Tick, 1)
+	go t.run(ctx, schedule, ch)
+	return ch, nil
+}
+
+// run delivers the ticks of the schedule to ch until ctx is done.
+func (t *Ticker) run(
+	ctx context.Context,
+	schedule Schedule,
+	ch chan<- Tick,
+) {
+	defer close(ch)
+
+	slot := t.next(t.clock.now(), schedule)
+	at := t.clock.SlotStart(slot).Add(schedule.Offset)
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		select {
+		case ch <- Tick{Slot: slot, Epoch: t.clock.EpochOf(slot), Time: at}:
+		default:
+		}
+
+		// Skip the slots missed while the process was suspended.
+		slot = t.next(t.clock.now(), schedule)
+		at = t.clock.SlotStart(slot).Add(schedule.Offset)
+		timer.Reset(time.Until(at))
+	}
+}
+
+// next returns the first slot at or after now whose tick for the schedule is
+// still ahead.
+func (t *Ticker) next(now time.Time, schedule Schedule) math.Slot {
+	slot := t.clock.SlotAt(now)
+	if !t.clock.SlotStart(slot).Add(schedule.Offset).After(now) {
+		slot++
+	}
+	if schedule.EpochOnly && !t.clock.IsEpochStart(slot) {
+		slot += math.Slot(
+			t.clock.slotsPerEpoch - slot.Unwrap()%t.clock.slotsPerEpoch,
+		)
+	}
+	return slot
+}
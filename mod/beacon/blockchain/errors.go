@@ -27,4 +27,7 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable indicates that the required data is not available.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrInvalidAncestor indicates that the execution payload of a block is,
+	// or descends from, a payload known to be invalid.
+	ErrInvalidAncestor = errors.New("execution payload has invalid ancestor")
 )
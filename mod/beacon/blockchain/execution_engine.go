@@ -22,12 +22,15 @@ package blockchain
 
 import (
 	"context"
-	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 )
 
-// sendPostBlockFCU sends a forkchoice update to the execution client.
+// sendPostBlockFCU sends a forkchoice update to the execution client. When
+// building non-optimistically, it then hands a copy of the post-state to the
+// payload builder, which follows up with a forkchoice update with
+// attributes for the next slot.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
 ]) sendPostBlockFCU(
@@ -44,52 +47,29 @@ func (s *Service[
 		return
 	}
 
-	if !s.shouldBuildOptimisticPayloads() && s.localBuilder.Enabled() {
-		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
-	}
-}
-
-// sendNextFCUWithAttributes sends a forkchoice update to the execution
-// client with attributes.
-func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT,
-	_, _, ExecutionPayloadHeaderT, _, _,
-]) sendNextFCUWithAttributes(
-	ctx context.Context,
-	st BeaconStateT,
-	blk BeaconBlockT,
-	lph ExecutionPayloadHeaderT,
-) {
-	var err error
-	stCopy := st.Copy()
-	if _, err = s.stateProcessor.ProcessSlots(
-		stCopy, blk.GetSlot()+1,
-	); err != nil {
-		s.logger.Error(
-			"failed to process slots in non-optimistic payload",
-			"error", err,
-		)
-		return
+	// The copy must be taken before returning, as the state is committed
+	// once the block is finalized.
+	var (
+		stCopy BeaconStateT
+		build  = !s.shouldBuildOptimisticPayloads() && s.localBuilder.Enabled()
+	)
+	if build {
+		stCopy = st.Copy()
 	}
 
-	prevBlockRoot := blk.HashTreeRoot()
-	if _, err = s.localBuilder.RequestPayloadAsync(
-		ctx,
-		stCopy,
-		blk.GetSlot()+1,
-		s.calculateNextTimestamp(blk),
-		prevBlockRoot,
-		lph.GetBlockHash(),
-		lph.GetParentHash(),
-	); err != nil {
-		s.logger.Error(
-			"failed to send forkchoice update with attributes in non-optimistic payload",
-			"error",
-			err,
-		)
-	}
+	go func() {
+		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
+		if !build {
+			return
+		}
+		if pubErr := s.dispatcher.Publish(
+			async.NewEvent(ctx, async.BeaconStateFinalized, stCopy),
+		); pubErr != nil {
+			s.logger.Error(
+				"failed to publish finalized beacon state", "error", pubErr,
+			)
+		}
+	}()
 }
 
 // sendNextFCUWithoutAttributes sends a forkchoice update to the
@@ -121,18 +101,3 @@ func (s *Service[
 		)
 	}
 }
-
-// calculateNextTimestamp calculates the next timestamp for an execution
-// payload.
-//
-// TODO: This is hood and needs to be improved.
-func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _,
-]) calculateNextTimestamp(blk BeaconBlockT) uint64 {
-	//#nosec:G701 // not an issue in practice.
-	return max(
-		uint64(time.Now().Unix()+
-			int64(s.chainSpec.TargetSecondsPerEth1Block())),
-		uint64(blk.GetBody().GetExecutionPayload().GetTimestamp()+1),
-	)
-}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// defaultInvalidPayloadsLimit is the number of invalid execution block
+// hashes remembered by the blockchain service.
+const defaultInvalidPayloadsLimit = 1024
+
+// invalidPayloads is a bounded set of execution block hashes known to be
+// invalid. Once the set is full, the oldest hash is evicted.
+type invalidPayloads struct {
+	mu     sync.RWMutex
+	limit  int
+	hashes map[common.ExecutionHash]struct{}
+	order  []common.ExecutionHash
+}
+
+// newInvalidPayloads creates a new invalidPayloads set holding at most
+// limit hashes.
+func newInvalidPayloads(limit int) *invalidPayloads {
+	return &invalidPayloads{
+		limit:  limit,
+		hashes: make(map[common.ExecutionHash]struct{}, limit),
+		order:  make([]common.ExecutionHash, 0, limit),
+	}
+}
+
+// add marks the given hash as invalid.
+func (p *invalidPayloads) add(hash common.ExecutionHash) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.hashes[hash]; ok {
+		return
+	}
+	if len(p.order) >= p.limit {
+		delete(p.hashes, p.order[0])
+		p.order = p.order[1:]
+	}
+	p.hashes[hash] = struct{}{}
+	p.order = append(p.order, hash)
+}
+
+// contains returns true if the given hash is known to be invalid.
+func (p *invalidPayloads) contains(hash common.ExecutionHash) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.hashes[hash]
+	return ok
+}
@@ -116,3 +116,37 @@ func (cm *chainMetrics) measureStateRootVerificationTime(start time.Time) {
 		"beacon_kit.blockchain.state_root_verification_duration", start,
 	)
 }
+
+// markInvalidAncestorRejected increments the counter for the number of
+// blocks rejected because their payload descends from an invalid payload.
+func (cm *chainMetrics) markInvalidAncestorRejected(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.invalid_ancestor_rejected",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// measureBlockLateness measures how long after the start of its slot a block
+// of the given proposer arrived, and counts it if it is late.
+func (cm *chainMetrics) measureBlockLateness(
+	proposer math.ValidatorIndex,
+	slotStart, arrival time.Time,
+	late bool,
+) {
+	// The start is shifted by the time elapsed since the arrival, so that
+	// the duration measured ends at the arrival rather than now.
+	cm.sink.MeasureSince(
+		"beacon_kit.blockchain.block_lateness",
+		slotStart.Add(time.Since(arrival)),
+		"proposer",
+		proposer.Base10(),
+	)
+	if late {
+		cm.sink.IncrementCounter(
+			"beacon_kit.blockchain.late_block",
+			"proposer",
+			proposer.Base10(),
+		)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -39,6 +40,7 @@ func (s *Service[
 	return s.stateProcessor.InitializePreminedBeaconStateFromEth1(
 		s.storageBackend.StateFromContext(ctx),
 		genesisData.GetDeposits(),
+		genesisData.GetValidators(),
 		genesisData.GetExecutionPayloadHeader(),
 		genesisData.GetForkVersion(),
 	)
@@ -62,11 +64,20 @@ func (s *Service[
 	// ends up not being valid later, the node will simply AppHash,
 	// which is completely fine. This means we were syncing from a
 	// bad peer, and we would likely AppHash anyways.
-	st := s.storageBackend.StateFromContext(ctx)
-	valUpdates, err := s.executeStateTransition(ctx, st, blk)
+	stateCtx, exportWitness := s.startWitness(ctx, blk.GetSlot())
+	st := s.storageBackend.StateFromContext(stateCtx)
+	valUpdates, deposits, err := s.executeStateTransition(ctx, st, blk)
 	if err != nil {
 		return nil, err
 	}
+	if exportWitness != nil {
+		if err = exportWitness(); err != nil {
+			s.logger.Error(
+				"Failed to export block witness",
+				"slot", blk.GetSlot().Base10(), "error", err,
+			)
+		}
+	}
 
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
@@ -90,42 +101,78 @@ func (s *Service[
 		return nil, err
 	}
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	// Announce the deposits applied by the block, so that their inclusion
+	// can be followed without polling the validators.
+	for _, deposit := range deposits {
+		if err = s.dispatcher.Publish(
+			async.NewEvent(ctx, async.DepositProcessed, deposit),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	s.sendPostBlockFCU(ctx, st, blk)
 
 	return valUpdates.CanonicalSort(), nil
 }
 
-// executeStateTransition runs the stf.
+// startWitness returns the context the state of the block at slot must be
+// read through for its witness to be recorded, and the function exporting
+// the witness. Without a witness exporter, it returns ctx and nil.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) startWitness(
+	ctx context.Context,
+	slot math.Slot,
+) (context.Context, func() error) {
+	if s.witnessExporter == nil {
+		return ctx, nil
+	}
+
+	stateCtx, export, err := s.witnessExporter.Start(
+		ctx, s.storageBackend.StateFromContext(ctx), slot,
+	)
+	if err != nil {
+		s.logger.Error(
+			"Failed to record block witness",
+			"slot", slot.Base10(), "error", err,
+		)
+		return ctx, nil
+	}
+	return stateCtx, export
+}
+
+// executeStateTransition runs the stf, returning the validator updates and
+// the deposits applied by the block.
 func (s *Service[
 	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
 ]) executeStateTransition(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-) (transition.ValidatorUpdates, error) {
+) (
+	transition.ValidatorUpdates, []*transition.ProcessedDeposit, error,
+) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
-	valUpdates, err := s.stateProcessor.Transition(
-		&transition.Context{
-			Context:          ctx,
-			OptimisticEngine: true,
-			// When we are NOT synced to the tip, process proposal
-			// does NOT get called and thus we must ensure that
-			// NewPayload is called to get the execution
-			// client the payload.
-			//
-			// When we are synced to the tip, we can skip the
-			// NewPayload call since we already gave our execution client
-			// the payload in process proposal.
-			//
-			// In both cases the payload was already accepted by a majority
-			// of validators in their process proposal call and thus
-			// the "verification aspect" of this NewPayload call is
-			// actually irrelevant at this point.
-			SkipPayloadVerification: false,
-		},
-		st,
-		blk,
-	)
-	return valUpdates, err
+	tctx := &transition.Context{
+		Context:          ctx,
+		OptimisticEngine: true,
+		// When we are NOT synced to the tip, process proposal
+		// does NOT get called and thus we must ensure that
+		// NewPayload is called to get the execution
+		// client the payload.
+		//
+		// When we are synced to the tip, we can skip the
+		// NewPayload call since we already gave our execution client
+		// the payload in process proposal.
+		//
+		// In both cases the payload was already accepted by a majority
+		// of validators in their process proposal call and thus
+		// the "verification aspect" of this NewPayload call is
+		// actually irrelevant at this point.
+		SkipPayloadVerification: false,
+	}
+	valUpdates, err := s.stateProcessor.Transition(tctx, st, blk)
+	return valUpdates, tctx.ProcessedDeposits, err
 }
@@ -26,6 +26,9 @@ import (
 
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -37,6 +40,8 @@ func (s *Service[
 	ctx context.Context,
 	blk BeaconBlockT,
 ) error {
+	arrival := time.Now()
+
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.storageBackend.StateFromContext(ctx)
 
@@ -56,25 +61,41 @@ func (s *Service[
 
 	s.logger.Info(
 		"Received incoming beacon block",
-		"state_root", blk.GetStateRoot(), "slot", blk.GetSlot(),
+		correlation.LogFields(
+			ctx, "state_root", blk.GetStateRoot(), "slot", blk.GetSlot(),
+		)...,
 	)
+	s.observeTimeliness(blk, arrival)
+
+	// Reject the block outright if its payload is known to be invalid or to
+	// build on an invalid payload, as the execution client would refuse it.
+	if err := s.verifyPayloadAncestry(blk); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			correlation.LogFields(
+				ctx, "state_root", blk.GetStateRoot(), "reason", err,
+			)...,
+		)
+		return err
+	}
 
 	// We purposefully make a copy of the BeaconState in orer
 	// to avoid modifying the underlying state, for the event in which
 	// we have to rebuild a payload for this slot again, if we do not agree
-	// with the incoming block.
+	// with the incoming block. The copy is a copy-on-write overlay, so a
+	// rejected candidate transition is discarded without any rollback.
 	postState := preState.Copy()
 
 	// Verify the state root of the incoming block.
 	if err := s.verifyStateRoot(
 		ctx, postState, blk,
 	); err != nil {
+		s.recordInvalidPayload(blk, err)
 		s.logger.Error(
 			"Rejecting incoming beacon block ❌ ",
-			"state_root",
-			blk.GetStateRoot(),
-			"reason",
-			err,
+			correlation.LogFields(
+				ctx, "state_root", blk.GetStateRoot(), "reason", err,
+			)...,
 		)
 
 		if s.shouldBuildOptimisticPayloads() {
@@ -84,10 +105,14 @@ func (s *Service[
 		return err
 	}
 
+	s.brake.ObservePayload(
+		blk.GetSlot(),
+		blk.GetBody().GetExecutionPayload().GetBlockHash(),
+		true,
+	)
 	s.logger.Info(
 		"State root verification succeeded - accepting incoming beacon block",
-		"state_root",
-		blk.GetStateRoot(),
+		correlation.LogFields(ctx, "state_root", blk.GetStateRoot())...,
 	)
 
 	if s.shouldBuildOptimisticPayloads() {
@@ -107,6 +132,11 @@ func (s *Service[
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
+
+	// Bound the execution of the payload by the time consensus gives us to
+	// vote on the block.
+	ctx, cancel := budget.WithPhase(ctx, budget.StateTransition)
+	defer cancel()
 	if _, err := s.stateProcessor.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
@@ -132,6 +162,52 @@ func (s *Service[
 	return nil
 }
 
+// verifyPayloadAncestry returns ErrInvalidAncestor if the execution payload
+// of the block, or its parent, is known to be invalid. A payload building
+// on an invalid parent is itself remembered as invalid.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) verifyPayloadAncestry(blk BeaconBlockT) error {
+	payload := blk.GetBody().GetExecutionPayload()
+	blockHash, parentHash := payload.GetBlockHash(), payload.GetParentHash()
+	switch {
+	case s.invalidPayloads.contains(blockHash):
+	case s.invalidPayloads.contains(parentHash):
+		s.invalidPayloads.add(blockHash)
+	default:
+		return nil
+	}
+	s.metrics.markInvalidAncestorRejected(blk.GetSlot())
+	return errors.Wrapf(
+		ErrInvalidAncestor,
+		"block_hash=%s parent_hash=%s", blockHash, parentHash,
+	)
+}
+
+// recordInvalidPayload remembers the execution payload of the block as
+// invalid if the execution client reported it so. When the latest valid
+// hash reported is not the payload's parent, the parent is invalid too.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) recordInvalidPayload(blk BeaconBlockT, err error) {
+	var invalidErr *engineerrors.InvalidPayloadError
+	if !errors.As(err, &invalidErr) {
+		return
+	}
+	payload := blk.GetBody().GetExecutionPayload()
+	s.invalidPayloads.add(invalidErr.BlockHash)
+	s.brake.ObservePayload(blk.GetSlot(), invalidErr.BlockHash, false)
+	// A zero hash means the execution client could not determine the latest
+	// valid ancestor, which says nothing about the parent.
+	lvh := invalidErr.LatestValidHash
+	if lvh == nil || *lvh == (common.ExecutionHash{}) {
+		return
+	}
+	if parentHash := payload.GetParentHash(); *lvh != parentHash {
+		s.invalidPayloads.add(parentHash)
+	}
+}
+
 // shouldBuildOptimisticPayloads returns true if optimistic
 // payload builds are enabled.
 func (s *Service[
@@ -23,11 +23,14 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -79,6 +82,19 @@ type Service[
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// invalidPayloads holds the execution block hashes known to be invalid,
+	// so that their descendants are rejected without calling newPayload.
+	invalidPayloads *invalidPayloads
+	// witnessExporter, if set, exports the witness of every finalized block.
+	witnessExporter WitnessExporter[BeaconStateT]
+	// timeliness records the lateness of the incoming blocks.
+	timeliness TimelinessTracker
+	// brake is told the verdict of the execution client on every payload.
+	brake ProposalBrake
+	// slotStart is the time, in unix nanoseconds, the latest block was
+	// finalized at, which starts the slot of the next one. It is zero until
+	// a block is finalized.
+	slotStart atomic.Int64
 
 	// subFinalBlkReceived is a channel holding FinalBeaconBlockReceived events.
 	subFinalBlkReceived chan async.Event[BeaconBlockT]
@@ -122,6 +138,9 @@ func NewService[
 	],
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	witnessExporter WitnessExporter[BeaconStateT],
+	timeliness TimelinessTracker,
+	brake ProposalBrake,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
@@ -145,6 +164,12 @@ func NewService[
 		subFinalBlkReceived:     make(chan async.Event[BeaconBlockT]),
 		subBlockReceived:        make(chan async.Event[BeaconBlockT]),
 		subGenDataReceived:      make(chan async.Event[GenesisT]),
+		invalidPayloads: newInvalidPayloads(
+			defaultInvalidPayloadsLimit,
+		),
+		witnessExporter: witnessExporter,
+		timeliness:      timeliness,
+		brake:           brake,
 	}
 }
 
@@ -294,8 +319,10 @@ func (s *Service[
 	valUpdates, finalizeErr = s.ProcessBeaconBlock(msg.Context(), msg.Data())
 	if finalizeErr != nil {
 		s.logger.Error("Failed to process verified beacon block",
-			"error", finalizeErr,
+			correlation.LogFields(msg.Context(), "error", finalizeErr)...,
 		)
+	} else {
+		s.startSlot(time.Now())
 	}
 
 	// Emit the event containing the validator updates.
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "time"
+
+// startSlot records that the slot of the next block started at now.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) startSlot(now time.Time) {
+	s.slotStart.Store(now.UnixNano())
+}
+
+// observeTimeliness records how long after the start of its slot the block
+// arrived. Blocks arriving before any block is finalized by this node, such as
+// while it catches up, have no slot start to be measured against.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) observeTimeliness(blk BeaconBlockT, arrival time.Time) {
+	start := s.slotStart.Load()
+	if start == 0 {
+		return
+	}
+	slotStart := time.Unix(0, start)
+	lateness := max(arrival.Sub(slotStart), 0)
+	late := s.timeliness.Observe(
+		blk.GetSlot(), blk.GetProposerIndex(), lateness,
+	)
+	s.metrics.measureBlockLateness(
+		blk.GetProposerIndex(), slotStart, arrival, late,
+	)
+}
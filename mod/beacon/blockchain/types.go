@@ -48,6 +48,8 @@ type BeaconBlock[BeaconBlockBodyT any] interface {
 	constraints.Nillable
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetStateRoot returns the state root of the beacon block.
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
@@ -111,6 +113,8 @@ type Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 	GetForkVersion() common.Version
 	// GetDeposits returns the deposits.
 	GetDeposits() []DepositT
+	// GetValidators returns the customized genesis validators.
+	GetValidators() []*transition.GenesisValidator
 	// GetExecutionPayloadHeader returns the execution payload header.
 	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
 }
@@ -137,6 +141,43 @@ type LocalBuilder[BeaconStateT any] interface {
 	) error
 }
 
+// WitnessExporter exports the witness of the state accessed by the
+// transition of a block.
+type WitnessExporter[BeaconStateT any] interface {
+	// Start returns a context recording the accesses to the state made
+	// through it and a function exporting the witness of the block at slot
+	// once its transition has succeeded.
+	Start(
+		ctx context.Context,
+		preState BeaconStateT,
+		slot math.Slot,
+	) (context.Context, func() error, error)
+}
+
+// ProposalBrake stops the node from proposing once the execution client
+// reported its own payloads as invalid too many times in a row.
+type ProposalBrake interface {
+	// ObservePayload records whether the execution client reported the
+	// payload with the given block hash as valid.
+	ObservePayload(
+		slot math.Slot,
+		blockHash common.ExecutionHash,
+		valid bool,
+	)
+}
+
+// TimelinessTracker records how long after the start of their slot the
+// blocks of every proposer arrive.
+type TimelinessTracker interface {
+	// Observe records the lateness of the block proposed by the given
+	// validator for the given slot, and returns true if it is late.
+	Observe(
+		slot math.Slot,
+		proposer math.ValidatorIndex,
+		lateness time.Duration,
+	) bool
+}
+
 type PayloadAttributes interface {
 	IsNil() bool
 	Version() uint32
@@ -184,6 +225,7 @@ type StateProcessor[
 	InitializePreminedBeaconStateFromEth1(
 		BeaconStateT,
 		[]DepositT,
+		[]*transition.GenesisValidator,
 		ExecutionPayloadHeaderT,
 		common.Version,
 	) (transition.ValidatorUpdates, error)
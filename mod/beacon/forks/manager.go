@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forks
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Manager coordinates the activation of the forks scheduled by the chain
+// spec. Once the last block before a fork is finalized, it publishes a
+// ForkActivated event holding the upcoming fork, so that services can switch
+// their behavior before the first block of the fork is built or processed.
+type Manager[BeaconBlockT BeaconBlock] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec holds the fork schedule.
+	chainSpec common.ChainSpec
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewManager creates a new fork manager.
+func NewManager[BeaconBlockT BeaconBlock](
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+) *Manager[BeaconBlockT] {
+	return &Manager[BeaconBlockT]{
+		logger:                logger,
+		chainSpec:             chainSpec,
+		dispatcher:            dispatcher,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// Name returns the name of the service.
+func (m *Manager[_]) Name() string {
+	return "fork-manager"
+}
+
+// Start logs the fork schedule, subscribes the manager to
+// BeaconBlockFinalized events and starts its event loop.
+func (m *Manager[_]) Start(ctx context.Context) error {
+	for _, fork := range m.chainSpec.ForkSchedule() {
+		m.logger.Info(
+			"Scheduled fork",
+			"version", version.FromUint32[common.Version](fork.Version),
+			"epoch", fork.Epoch,
+			"max_blobs_per_block", fork.MaxBlobsPerBlock,
+			"max_deposits_per_block", fork.MaxDepositsPerBlock,
+			"max_withdrawal_requests_per_block",
+			fork.MaxWithdrawalRequestsPerBlock,
+		)
+	}
+
+	if err := m.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, m.subFinalizedBlkEvents,
+	); err != nil {
+		return err
+	}
+
+	go m.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the fork manager.
+func (m *Manager[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-m.subFinalizedBlkEvents:
+			m.onFinalizeBlock(event)
+		}
+	}
+}
+
+// onFinalizeBlock publishes a ForkActivated event if the slot following the
+// finalized block belongs to a new fork.
+func (m *Manager[BeaconBlockT]) onFinalizeBlock(
+	event async.Event[BeaconBlockT],
+) {
+	slot := event.Data().GetSlot()
+	next, activated := m.forkActivatedAt(slot + 1)
+	if !activated {
+		return
+	}
+
+	m.logger.Info(
+		"Activating fork at next slot 🍴",
+		"version", version.FromUint32[common.Version](next.Version),
+		"slot", slot+1,
+		"epoch", next.Epoch,
+	)
+	if err := m.dispatcher.Publish(
+		async.NewEvent(event.Context(), async.ForkActivated, next),
+	); err != nil {
+		m.logger.Error(
+			"failed to publish fork activation", "slot", slot+1, "error", err,
+		)
+	}
+}
+
+// forkActivatedAt returns the fork active at the given slot, and whether it
+// differs from the fork active at the previous slot.
+func (m *Manager[_]) forkActivatedAt(
+	slot math.Slot,
+) (chain.Fork[math.Epoch], bool) {
+	fork := m.chainSpec.ActiveForkForSlot(slot)
+	if slot == 0 {
+		return fork, false
+	}
+	return fork, m.chainSpec.ActiveForkForSlot(slot-1).Version != fork.Version
+}
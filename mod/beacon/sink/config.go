@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import "time"
+
+const (
+	// defaultBufferSize is the default number of finalized blocks queued for
+	// the sinks.
+	defaultBufferSize = 64
+	// defaultKafkaTimeout is the default timeout of a request to the Kafka
+	// REST proxy.
+	defaultKafkaTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the built-in sinks.
+type Config struct {
+	// BufferSize is the number of finalized blocks queued for the sinks.
+	// Blocks finalized while the queue is full are not delivered.
+	BufferSize int `mapstructure:"buffer-size"`
+	// Postgres is the configuration of the PostgreSQL sink.
+	Postgres PostgresConfig `mapstructure:"postgres"`
+	// Kafka is the configuration of the Kafka sink.
+	Kafka KafkaConfig `mapstructure:"kafka"`
+}
+
+// PostgresConfig is the configuration of the PostgreSQL sink.
+type PostgresConfig struct {
+	// Enabled determines if the sink is enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// DSN is the connection string of the database.
+	DSN string `mapstructure:"dsn"`
+}
+
+// KafkaConfig is the configuration of the Kafka sink, which publishes
+// through a Kafka REST proxy.
+type KafkaConfig struct {
+	// Enabled determines if the sink is enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// ProxyURL is the URL of the Kafka REST proxy.
+	ProxyURL string `mapstructure:"proxy-url"`
+	// Topic is the topic the records are published to.
+	Topic string `mapstructure:"topic"`
+	// Timeout is the timeout of a request to the proxy.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// DefaultConfig returns the default configuration of the sinks, with all
+// built-in sinks disabled.
+func DefaultConfig() Config {
+	return Config{
+		BufferSize: defaultBufferSize,
+		Kafka: KafkaConfig{
+			Topic:   "beacon-kit",
+			Timeout: defaultKafkaTimeout,
+		},
+	}
+}
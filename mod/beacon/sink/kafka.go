@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+// kafkaContentType is the content type of JSON records for the v2 API of the
+// Kafka REST proxy.
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaSink publishes the finalized data to a Kafka topic through a Kafka
+// REST proxy. Records are keyed by their kind and slot, so that a topic
+// partitioned by key keeps them in order.
+type KafkaSink struct {
+	client   *http.Client
+	endpoint string
+}
+
+// NewKafkaSink returns a sink publishing to the topic of the config.
+func NewKafkaSink(cfg KafkaConfig) (*KafkaSink, error) {
+	endpoint, err := url.JoinPath(cfg.ProxyURL, "topics", cfg.Topic)
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSink{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: endpoint,
+	}, nil
+}
+
+// kafkaRecords is the body of a produce request to the Kafka REST proxy.
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaRecord is a record of a produce request.
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+// Name returns the name of the sink.
+func (*KafkaSink) Name() string {
+	return "kafka"
+}
+
+// OnBlockFinalized publishes the block.
+func (k *KafkaSink) OnBlockFinalized(ctx context.Context, blk Block) error {
+	return k.publish(ctx, fmt.Sprintf("block:%d", blk.Slot), blk)
+}
+
+// OnStateCommitted publishes the state.
+func (k *KafkaSink) OnStateCommitted(ctx context.Context, st State) error {
+	return k.publish(ctx, fmt.Sprintf("state:%d", st.Slot), st)
+}
+
+// OnDepositIncluded publishes the deposit.
+func (k *KafkaSink) OnDepositIncluded(ctx context.Context, dep Deposit) error {
+	return k.publish(ctx, fmt.Sprintf("deposit:%d", dep.Index), dep)
+}
+
+// publish produces a single record to the topic.
+func (k *KafkaSink) publish(ctx context.Context, key string, value any) error {
+	body, err := json.Marshal(kafkaRecords{
+		Records: []kafkaRecord{{Key: key, Value: value}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, k.endpoint, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	if _, err = io.Copy(io.Discard, resp.Body); err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(
+			ErrPublishFailed, "proxy responded with %s", resp.Status,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import (
+	"context"
+	"database/sql"
+)
+
+// postgresSchema creates the tables of the PostgreSQL sink.
+const postgresSchema = `
+CREATE TABLE IF NOT EXISTS beacon_blocks (
+	slot           BIGINT PRIMARY KEY,
+	root           BYTEA  NOT NULL,
+	parent_root    BYTEA  NOT NULL,
+	state_root     BYTEA  NOT NULL,
+	proposer_index BIGINT NOT NULL,
+	ssz            BYTEA  NOT NULL
+);
+CREATE TABLE IF NOT EXISTS beacon_states (
+	slot       BIGINT PRIMARY KEY,
+	state_root BYTEA  NOT NULL
+);
+CREATE TABLE IF NOT EXISTS beacon_deposits (
+	deposit_index          BIGINT PRIMARY KEY,
+	slot                   BIGINT NOT NULL,
+	pubkey                 BYTEA  NOT NULL,
+	withdrawal_credentials BYTEA  NOT NULL,
+	amount                 BIGINT NOT NULL
+);`
+
+// PostgresSink writes the finalized data to a PostgreSQL database. Rows that
+// already exist, e.g. when a block is replayed after a restart, are kept.
+type PostgresSink struct {
+	db *sql.DB
+}
+
+// NewPostgresSink returns a sink writing to db, creating its tables if they
+// do not exist. The caller is responsible for registering the driver.
+func NewPostgresSink(
+	ctx context.Context,
+	db *sql.DB,
+) (*PostgresSink, error) {
+	if _, err := db.ExecContext(ctx, postgresSchema); err != nil {
+		return nil, err
+	}
+	return &PostgresSink{db: db}, nil
+}
+
+// Name returns the name of the sink.
+func (*PostgresSink) Name() string {
+	return "postgres"
+}
+
+// OnBlockFinalized inserts the block into beacon_blocks.
+func (p *PostgresSink) OnBlockFinalized(
+	ctx context.Context,
+	blk Block,
+) error {
+	//#nosec:G115 // slots and indices fit in a BIGINT.
+	_, err := p.db.ExecContext(ctx, `
+INSERT INTO beacon_blocks
+	(slot, root, parent_root, state_root, proposer_index, ssz)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (slot) DO NOTHING`,
+		int64(blk.Slot), blk.Root[:], blk.ParentRoot[:], blk.StateRoot[:],
+		int64(blk.ProposerIndex), []byte(blk.SSZ),
+	)
+	return err
+}
+
+// OnStateCommitted inserts the state into beacon_states.
+func (p *PostgresSink) OnStateCommitted(
+	ctx context.Context,
+	st State,
+) error {
+	//#nosec:G115 // slots fit in a BIGINT.
+	_, err := p.db.ExecContext(ctx, `
+INSERT INTO beacon_states (slot, state_root)
+VALUES ($1, $2)
+ON CONFLICT (slot) DO NOTHING`,
+		int64(st.Slot), st.StateRoot[:],
+	)
+	return err
+}
+
+// OnDepositIncluded inserts the deposit into beacon_deposits.
+func (p *PostgresSink) OnDepositIncluded(
+	ctx context.Context,
+	dep Deposit,
+) error {
+	//#nosec:G115 // indices, slots and amounts fit in a BIGINT.
+	_, err := p.db.ExecContext(ctx, `
+INSERT INTO beacon_deposits
+	(deposit_index, slot, pubkey, withdrawal_credentials, amount)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (deposit_index) DO NOTHING`,
+		int64(dep.Index), int64(dep.Slot), dep.Pubkey[:],
+		dep.WithdrawalCredentials[:], int64(dep.Amount),
+	)
+	return err
+}
+
+// Close closes the database.
+func (p *PostgresSink) Close() error {
+	return p.db.Close()
+}
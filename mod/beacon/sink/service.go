@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Service feeds the finalized blocks, the committed states and the included
+// deposits to the registered sinks. Delivery is best effort: a failing sink
+// is logged and skipped, and never holds up finalization.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT],
+	DepositT BeaconDeposit[WithdrawalCredentialsT],
+	WithdrawalCredentialsT ~[32]byte,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// sinks are the sinks fed by the service.
+	sinks []Sink
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewService creates a new sink service feeding the given sinks.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT],
+	DepositT BeaconDeposit[WithdrawalCredentialsT],
+	WithdrawalCredentialsT ~[32]byte,
+](
+	config Config,
+	logger log.Logger,
+	dispatcher asynctypes.EventDispatcher,
+	sinks ...Sink,
+) *Service[BeaconBlockT, BeaconBlockBodyT, DepositT, WithdrawalCredentialsT] {
+	return &Service[
+		BeaconBlockT, BeaconBlockBodyT, DepositT, WithdrawalCredentialsT,
+	]{
+		logger:     logger,
+		dispatcher: dispatcher,
+		sinks:      sinks,
+		subFinalizedBlkEvents: make(
+			chan async.Event[BeaconBlockT], max(config.BufferSize, 1),
+		),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[_, _, _, _]) Name() string {
+	return "sink-service"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events if any sink is
+// registered.
+func (s *Service[_, _, _, _]) Start(ctx context.Context) error {
+	if len(s.sinks) == 0 {
+		return nil
+	}
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		return err
+	}
+
+	names := make([]string, len(s.sinks))
+	for i, snk := range s.sinks {
+		names[i] = snk.Name()
+	}
+	s.logger.Info("Feeding finalized blocks to sinks", "sinks", names)
+
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the service.
+func (s *Service[_, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			if event.Error() != nil {
+				continue
+			}
+			s.onBlockFinalized(ctx, event.Data())
+		}
+	}
+}
+
+// onBlockFinalized feeds a finalized block, its post state and its deposits
+// to each sink.
+func (s *Service[BeaconBlockT, _, _, _]) onBlockFinalized(
+	ctx context.Context,
+	blk BeaconBlockT,
+) {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		s.logger.Error(
+			"Failed to encode block for sinks",
+			"slot", blk.GetSlot().Base10(), "error", err,
+		)
+		return
+	}
+
+	var (
+		slot  = blk.GetSlot()
+		block = Block{
+			Slot:          slot,
+			Root:          blk.HashTreeRoot(),
+			ParentRoot:    blk.GetParentBlockRoot(),
+			StateRoot:     blk.GetStateRoot(),
+			ProposerIndex: blk.GetProposerIndex(),
+			SSZ:           bz,
+		}
+		state    = State{Slot: slot, StateRoot: blk.GetStateRoot()}
+		deposits = blk.GetBody().GetDeposits()
+	)
+	for _, snk := range s.sinks {
+		if err = s.deliver(ctx, snk, block, state, deposits); err != nil {
+			s.logger.Error(
+				"Failed to feed sink",
+				"sink", snk.Name(), "slot", slot.Base10(), "error", err,
+			)
+		}
+	}
+}
+
+// deliver feeds the records of a finalized block to a sink, stopping at the
+// first error.
+func (s *Service[_, _, DepositT, _]) deliver(
+	ctx context.Context,
+	snk Sink,
+	block Block,
+	state State,
+	deposits []DepositT,
+) error {
+	if err := snk.OnBlockFinalized(ctx, block); err != nil {
+		return err
+	}
+	if err := snk.OnStateCommitted(ctx, state); err != nil {
+		return err
+	}
+	for _, dep := range deposits {
+		if err := snk.OnDepositIncluded(ctx, Deposit{
+			Slot:   block.Slot,
+			Index:  dep.GetIndex(),
+			Pubkey: dep.GetPubkey(),
+			WithdrawalCredentials: common.Bytes32(
+				dep.GetWithdrawalCredentials(),
+			),
+			Amount: dep.GetAmount(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
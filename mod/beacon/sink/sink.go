@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Sink receives the data of the chain as it is finalized, letting external
+// indexers be fed without scraping the node API. Sinks are called from a
+// single goroutine, in slot order, and must not retain the records.
+type Sink interface {
+	// Name returns the name of the sink, used in logs.
+	Name() string
+	// OnBlockFinalized is called with each finalized block.
+	OnBlockFinalized(ctx context.Context, blk Block) error
+	// OnStateCommitted is called with the state committed after each
+	// finalized block.
+	OnStateCommitted(ctx context.Context, st State) error
+	// OnDepositIncluded is called with each deposit included in a finalized
+	// block.
+	OnDepositIncluded(ctx context.Context, dep Deposit) error
+}
+
+// Registration provides a Sink to the node through depinject. Any number of
+// registrations can be provided, and the sink service feeds all of them. A
+// registration with a nil Sink is ignored, so that providers can disable
+// their sink through configuration.
+type Registration struct {
+	Sink Sink
+}
+
+// IsManyPerContainerType implements depinject.ManyPerContainerType.
+func (Registration) IsManyPerContainerType() {}
+
+// Block is a finalized beacon block.
+type Block struct {
+	// Slot is the slot of the block.
+	Slot math.Slot `json:"slot"`
+	// Root is the hash tree root of the block.
+	Root common.Root `json:"root"`
+	// ParentRoot is the root of the parent block.
+	ParentRoot common.Root `json:"parent_root"`
+	// StateRoot is the root of the post state of the block.
+	StateRoot common.Root `json:"state_root"`
+	// ProposerIndex is the index of the proposer of the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// SSZ is the SSZ encoding of the block.
+	SSZ bytes.Bytes `json:"ssz"`
+}
+
+// State is a committed beacon state.
+type State struct {
+	// Slot is the slot of the state.
+	Slot math.Slot `json:"slot"`
+	// StateRoot is the hash tree root of the state.
+	StateRoot common.Root `json:"state_root"`
+}
+
+// Deposit is a deposit included in a finalized block.
+type Deposit struct {
+	// Slot is the slot of the block including the deposit.
+	Slot math.Slot `json:"slot"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// WithdrawalCredentials are the withdrawal credentials of the
+	// validator.
+	WithdrawalCredentials common.Bytes32 `json:"withdrawal_credentials"`
+	// Amount is the amount of the deposit.
+	Amount math.Gwei `json:"amount"`
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sink
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface of the finalized blocks fed to the sinks.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// HashTreeRoot returns the hash tree root of the block.
+	HashTreeRoot() common.Root
+	// GetParentBlockRoot returns the root of the parent block.
+	GetParentBlockRoot() common.Root
+	// GetStateRoot returns the root of the post state of the block.
+	GetStateRoot() common.Root
+	// GetProposerIndex returns the index of the proposer of the block.
+	GetProposerIndex() math.ValidatorIndex
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+	// MarshalSSZ returns the SSZ encoding of the block.
+	MarshalSSZ() ([]byte, error)
+}
+
+// BeaconBlockBody is the interface of the body of the finalized blocks.
+type BeaconBlockBody[DepositT any] interface {
+	// GetDeposits returns the deposits included in the block.
+	GetDeposits() []DepositT
+}
+
+// BeaconDeposit is the interface of the deposits included in the finalized
+// blocks.
+type BeaconDeposit[WithdrawalCredentialsT ~[32]byte] interface {
+	// GetIndex returns the index of the deposit in the deposit contract.
+	GetIndex() math.U64
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetWithdrawalCredentials returns the withdrawal credentials.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// GetAmount returns the amount of the deposit.
+	GetAmount() math.Gwei
+}
@@ -26,8 +26,10 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -121,11 +123,22 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Remember the payload as our own, so that the execution client
+	// rejecting it counts towards halting our proposals.
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return blk, sidecars, err
+	}
+	s.brake.track(header.GetBlockHash())
+
 	s.logger.Info(
 		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
-		"state_root", blk.GetStateRoot(),
-		"duration", time.Since(startTime).String(),
+		correlation.LogFields(
+			ctx,
+			"slot", slotData.GetSlot().Base10(),
+			"state_root", blk.GetStateRoot(),
+			"duration", time.Since(startTime).String(),
+		)...,
 	)
 
 	return blk, sidecars, nil
@@ -155,6 +168,17 @@ func (s *Service[
 		return blk, err
 	}
 
+	// Skip building if the chain expects another validator to propose.
+	expected, ok, err := s.stateProcessor.ExpectedProposer(st, requestedSlot)
+	if err != nil {
+		return blk, err
+	} else if ok && expected != proposerIndex {
+		return blk, errors.Wrapf(
+			ErrNotExpectedProposer, "expected: %d, got: %d",
+			expected, proposerIndex,
+		)
+	}
+
 	return blk.NewWithVersion(
 		requestedSlot,
 		proposerIndex,
@@ -198,6 +222,11 @@ func (s *Service[
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	// Fetching the payload, including the synchronous fallback, must leave
+	// enough of the propose timeout to assemble and gossip the block.
+	ctx, cancel := budget.WithPhase(ctx, budget.PayloadFetch)
+	defer cancel()
+
 	//
 	// TODO: Add external block builders to this flow.
 	//
@@ -253,7 +282,7 @@ func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
 	_, _, SlotDataT,
 ]) buildBlockBody(
-	_ context.Context,
+	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
@@ -283,10 +312,10 @@ func (s *Service[
 		return ErrNilDepositIndexStart
 	}
 
-	// Dequeue deposits from the state.
+	// Dequeue deposits from the state, up to the limit of the active fork.
+	fork := s.chainSpec.ActiveForkForSlot(blk.GetSlot())
 	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+		depositIndex, fork.MaxDepositsPerBlock,
 	)
 	if err != nil {
 		return err
@@ -304,19 +333,14 @@ func (s *Service[
 	))
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
-	graffiti, err := bytes.ToBytes32(sizedGraffiti)
+	graffiti, err := s.graffiti.Render(ctx, s.signer.PublicKey())
 	if err != nil {
 		return fmt.Errorf("failed processing graffiti: %w", err)
 	}
 	body.SetGraffiti(graffiti)
 
-	// Get the epoch to find the active fork version.
-	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
-	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
-		epoch,
-	)
-	if activeForkVersion >= version.DenebPlus {
+	// Only include the operations introduced by the active fork.
+	if fork.BeaconOperations {
 		// Set the attestations on the block body.
 		body.SetAttestations(slotData.GetAttestationData())
 
@@ -341,8 +365,9 @@ func (s *Service[
 	if err != nil {
 		s.logger.Error(
 			"failed to compute state root while building block ❗️ ",
-			"slot", blk.GetSlot().Base10(),
-			"error", err,
+			correlation.LogFields(
+				ctx, "slot", blk.GetSlot().Base10(), "error", err,
+			)...,
 		)
 		return err
 	}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ownPayloadsLimit is the number of payloads built by the node that are
+// remembered, enough to cover the rounds of a few heights.
+const ownPayloadsLimit = 16
+
+// Brake stops the node from proposing once the execution client reported
+// the payloads built by the node as invalid too many times in a row, which
+// points at a misconfigured node rather than at a faulty block. The node
+// keeps following the chain while the brake is engaged, and releases it on
+// restart.
+type Brake struct {
+	// threshold is the number of consecutive invalid payloads engaging the
+	// brake, zero disables the brake.
+	threshold uint64
+	// logger is used to raise the alert when the brake engages.
+	logger log.Logger
+	// metrics is a metrics collector.
+	metrics *validatorMetrics
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// own holds the block hashes of the latest payloads built by the node.
+	own []common.ExecutionHash
+	// invalid is the number of consecutive payloads of the node reported
+	// as invalid.
+	invalid uint64
+	// engaged is whether the node stopped proposing.
+	engaged bool
+}
+
+// NewBrake creates a brake engaging after threshold consecutive payloads of
+// the node are reported as invalid by the execution client.
+func NewBrake(
+	threshold uint64,
+	logger log.Logger,
+	ts TelemetrySink,
+) *Brake {
+	return &Brake{
+		threshold: threshold,
+		logger:    logger,
+		metrics:   newValidatorMetrics(ts),
+		own:       make([]common.ExecutionHash, 0, ownPayloadsLimit),
+	}
+}
+
+// Engaged returns whether the node stopped proposing.
+func (b *Brake) Engaged() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.engaged
+}
+
+// ObservePayload records the verdict of the execution client on the payload
+// with the given block hash. Payloads not built by the node are ignored.
+func (b *Brake) ObservePayload(
+	slot math.Slot,
+	blockHash common.ExecutionHash,
+	valid bool,
+) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	idx := slices.Index(b.own, blockHash)
+	if idx < 0 {
+		return
+	}
+	b.own = slices.Delete(b.own, idx, idx+1)
+
+	if valid {
+		b.invalid = 0
+		return
+	}
+	b.invalid++
+	b.metrics.markOwnPayloadInvalid(slot)
+	b.logger.Warn(
+		"Execution client reported our own payload as invalid",
+		"slot", slot.Base10(),
+		"block_hash", blockHash,
+		"consecutive", b.invalid,
+	)
+	if b.engaged || b.threshold == 0 || b.invalid < b.threshold {
+		return
+	}
+	b.engaged = true
+	b.metrics.markProposalsHalted(slot)
+	b.logger.Error(
+		"CRITICAL: halting block proposals after repeated invalid payloads, "+
+			"check the execution client and restart the node 🛑",
+		"slot", slot.Base10(),
+		"consecutive", b.invalid,
+	)
+}
+
+// track remembers the payload with the given block hash as built by the
+// node.
+func (b *Brake) track(blockHash common.ExecutionHash) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if slices.Contains(b.own, blockHash) {
+		return
+	}
+	if len(b.own) == ownPayloadsLimit {
+		b.own = slices.Delete(b.own, 0, 1)
+	}
+	b.own = append(b.own, blockHash)
+}
@@ -27,24 +27,63 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultHaltAfterInvalidPayloads is the default number of consecutive
+	// payloads of the node reported as invalid that halts its proposals.
+	defaultHaltAfterInvalidPayloads = 3
+
+	// defaultDoppelgangerEpochs is the default number of epochs watched for
+	// doppelgangers before proposing.
+	defaultDoppelgangerEpochs = 0
 )
 
 // Config is the validator configuration.
 //
 //nolint:lll // struct tags.
 type Config struct {
-	// Graffiti is the string that will be included in the
-	// graffiti field of the beacon block.
+	// Graffiti is the template of the string that will be included in the
+	// graffiti field of the beacon block. See GraffitiVars for the variables
+	// it may refer to.
 	Graffiti string `mapstructure:"graffiti"`
 
+	// GraffitiByKey overrides Graffiti for individual validator keys. It is
+	// keyed by the 0x prefixed public key of the validator.
+	GraffitiByKey map[string]string `mapstructure:"graffiti-by-key"`
+
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// HaltAfterInvalidPayloads is the number of consecutive payloads built
+	// by the node that the execution client must report as invalid for the
+	// node to stop proposing. Zero never stops the node from proposing.
+	HaltAfterInvalidPayloads uint64 `mapstructure:"halt-after-invalid-payloads"`
+
+	// DoppelgangerEpochs is the number of epochs the node watches the chain
+	// for blocks of its validator proposed elsewhere after a restart, before
+	// it proposes. Zero disables the check.
+	DoppelgangerEpochs uint64 `mapstructure:"doppelganger-epochs"`
+
+	// DevnetControls enables the controls over the slots the node builds
+	// blocks for, through the admin API and DevnetSkipSlots. It must only be
+	// enabled on devnets.
+	DevnetControls bool `mapstructure:"devnet-controls"`
+
+	// DevnetStartPaused starts the node with block production paused. It is
+	// ignored unless DevnetControls is enabled.
+	DevnetStartPaused bool `mapstructure:"devnet-start-paused"`
+
+	// DevnetSkipSlots are slots the node never builds a block for. They are
+	// ignored unless DevnetControls is enabled.
+	DevnetSkipSlots []uint64 `mapstructure:"devnet-skip-slots"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
+		GraffitiByKey:                 make(map[string]string),
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		HaltAfterInvalidPayloads:      defaultHaltAfterInvalidPayloads,
+		DoppelgangerEpochs:            defaultDoppelgangerEpochs,
 	}
 }
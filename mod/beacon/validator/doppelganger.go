@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Doppelganger keeps the node from proposing after a restart until it has
+// watched the chain for a number of epochs, so that a validator key also
+// running on another host is caught before both sign. The observation starts
+// at the first slot the node is asked to propose for, since the blocks
+// finalized while catching up may have been proposed by the node itself
+// before the restart. The node does not propose while observing, so a block
+// of its validator finalized in the meantime comes from a doppelganger, and
+// the node then stops proposing until it is restarted.
+type Doppelganger struct {
+	// epochs is the number of epochs observed, zero disables the check.
+	epochs uint64
+	// chainSpec is used to convert epochs to slots.
+	chainSpec common.ChainSpec
+	// logger is used to raise the alert when a doppelganger is detected.
+	logger log.Logger
+	// metrics is a metrics collector.
+	metrics *validatorMetrics
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// started is true once the observation has started.
+	started bool
+	// first is the first slot observed and until the first slot the node
+	// may propose for, once the observation has started.
+	first, until math.Slot
+	// detected is whether a doppelganger was detected.
+	detected bool
+}
+
+// NewDoppelganger creates a doppelganger check observing the given number
+// of epochs before the node proposes.
+func NewDoppelganger(
+	epochs uint64,
+	chainSpec common.ChainSpec,
+	logger log.Logger,
+	ts TelemetrySink,
+) *Doppelganger {
+	return &Doppelganger{
+		epochs:    epochs,
+		chainSpec: chainSpec,
+		logger:    logger,
+		metrics:   newValidatorMetrics(ts),
+	}
+}
+
+// Enabled returns whether the check is enabled.
+func (d *Doppelganger) Enabled() bool {
+	return d.epochs > 0
+}
+
+// Detected returns whether a doppelganger was detected.
+func (d *Doppelganger) Detected() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.detected
+}
+
+// watching returns whether a block finalized at the given slot is within
+// the observation.
+func (d *Doppelganger) watching(slot math.Slot) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.started && !d.detected && slot >= d.first && slot < d.until
+}
+
+// observeBlock records a block finalized at the given slot, own being
+// whether it was proposed by the validator of the node.
+func (d *Doppelganger) observeBlock(slot math.Slot, own bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !own || !d.started || d.detected ||
+		slot < d.first || slot >= d.until {
+		return
+	}
+	d.detected = true
+	d.metrics.markDoppelgangerDetected(slot)
+	d.logger.Error(
+		"CRITICAL: a block of our validator was proposed by another node, "+
+			"the validator key is in use elsewhere, stop one of the nodes "+
+			"and restart this one 🛑",
+		"slot", slot.Base10(),
+	)
+}
+
+// allow reports whether the node may propose for the given slot, starting
+// the observation at the first slot it is called for.
+func (d *Doppelganger) allow(slot math.Slot) bool {
+	if d.epochs == 0 {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.started {
+		d.started = true
+		d.first = slot
+		d.until = slot + math.Slot(d.epochs*d.chainSpec.SlotsPerEpoch())
+		d.logger.Info(
+			"Watching the chain for doppelgangers before proposing",
+			"from_slot", d.first.Base10(),
+			"until_slot", d.until.Base10(),
+		)
+	}
+	return slot >= d.until
+}
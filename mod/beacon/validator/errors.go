@@ -37,4 +37,36 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrInvalidGraffitiKey is an error for when a key of the graffiti by
+	// key configuration is not a valid public key.
+	ErrInvalidGraffitiKey = errors.New("invalid graffiti key")
+
+	// ErrNotExpectedProposer is an error for when the chain expects another
+	// validator to propose the block.
+	ErrNotExpectedProposer = errors.New("not the expected proposer")
+
+	// ErrMaintenanceMode is an error for when the node is asked to propose
+	// while in maintenance.
+	ErrMaintenanceMode = errors.New("node is in maintenance")
+
+	// ErrProposalsHalted is an error for when the node is asked to propose
+	// after its proposals were halted by the brake.
+	ErrProposalsHalted = errors.New(
+		"proposals halted after repeated invalid payloads",
+	)
+
+	// ErrDoppelgangerDetected is an error for when the node is asked to
+	// propose after a block of its validator was proposed by another node.
+	ErrDoppelgangerDetected = errors.New(
+		"validator key in use by another node",
+	)
+
+	// ErrWatchingDoppelgangers is an error for when the node is asked to
+	// propose while watching the chain for doppelgangers after a restart.
+	ErrWatchingDoppelgangers = errors.New("watching for doppelgangers")
+
+	// ErrSlotSkipped is an error for when the node is asked to propose for
+	// a slot its devnet slot controls skip.
+	ErrSlotSkipped = errors.New("slot skipped by the devnet slot controls")
 )
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// GraffitiVars are the variables a graffiti template may refer to, e.g.
+// "{{.Moniker}} {{.ELClient}}".
+type GraffitiVars struct {
+	// Version is the version of the beacon node.
+	Version string
+	// ELClient is the client code and version of the execution client.
+	ELClient string
+	// Moniker is the CometBFT moniker of the node.
+	Moniker string
+}
+
+// Graffiti resolves the graffiti of the blocks proposed by a validator key.
+// Keys without a graffiti of their own use the graffiti of the node. The
+// templates can be updated at runtime.
+type Graffiti struct {
+	// elClient is queried for the ELClient variable.
+	elClient ExecutionClient
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// vars are the variables the templates are executed with.
+	vars GraffitiVars
+	// node is the graffiti of the node.
+	node *graffitiTemplate
+	// byKey holds the graffiti of individual validator keys.
+	byKey map[crypto.BLSPubkey]*graffitiTemplate
+}
+
+// graffitiTemplate is a parsed graffiti template along with its source.
+type graffitiTemplate struct {
+	source string
+	tmpl   *template.Template
+}
+
+// NewGraffiti creates the graffiti of the node from the given config.
+func NewGraffiti(
+	cfg *Config,
+	version string,
+	moniker string,
+	elClient ExecutionClient,
+) (*Graffiti, error) {
+	node, err := parseGraffiti(cfg.Graffiti)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &Graffiti{
+		elClient: elClient,
+		vars: GraffitiVars{
+			Version: version,
+			Moniker: moniker,
+		},
+		node:  node,
+		byKey: make(map[crypto.BLSPubkey]*graffitiTemplate),
+	}
+	for key, graffiti := range cfg.GraffitiByKey {
+		var pubkey crypto.BLSPubkey
+		if err = pubkey.UnmarshalText([]byte(key)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidGraffitiKey, "%s", key)
+		}
+		if err = g.SetTemplate(pubkey, graffiti); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Template returns the graffiti template used for the given key.
+func (g *Graffiti) Template(pubkey crypto.BLSPubkey) string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if tmpl, ok := g.byKey[pubkey]; ok {
+		return tmpl.source
+	}
+	return g.node.source
+}
+
+// SetTemplate sets the graffiti template of the given key.
+func (g *Graffiti) SetTemplate(pubkey crypto.BLSPubkey, graffiti string) error {
+	tmpl, err := parseGraffiti(graffiti)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.byKey[pubkey] = tmpl
+	return nil
+}
+
+// DeleteTemplate removes the graffiti template of the given key, which then
+// falls back to the graffiti of the node.
+func (g *Graffiti) DeleteTemplate(pubkey crypto.BLSPubkey) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.byKey, pubkey)
+}
+
+// Render executes the graffiti template of the given key. The result is
+// truncated to the size of the graffiti field.
+func (g *Graffiti) Render(
+	ctx context.Context,
+	pubkey crypto.BLSPubkey,
+) (common.Bytes32, error) {
+	g.resolveELClient(ctx)
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	tmpl, ok := g.byKey[pubkey]
+	if !ok {
+		tmpl = g.node
+	}
+
+	var sb strings.Builder
+	if err := tmpl.tmpl.Execute(&sb, g.vars); err != nil {
+		return common.Bytes32{}, err
+	}
+	rendered := []byte(sb.String())
+	if len(rendered) > bytes.B32Size {
+		rendered = rendered[:bytes.B32Size]
+	}
+	return bytes.ToBytes32(bytes.ExtendToSize(rendered, bytes.B32Size))
+}
+
+// resolveELClient fills in the ELClient variable the first time the execution
+// client reports its version.
+func (g *Graffiti) resolveELClient(ctx context.Context) {
+	g.mu.RLock()
+	resolved := g.vars.ELClient != ""
+	g.mu.RUnlock()
+	if resolved || g.elClient == nil {
+		return
+	}
+
+	versions, err := g.elClient.GetClientVersionV1(ctx)
+	if err != nil || len(versions) == 0 {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.vars.ELClient = versions[0].Code + " " + versions[0].Version
+}
+
+// parseGraffiti parses a graffiti template, making sure it only refers to
+// known variables.
+func parseGraffiti(graffiti string) (*graffitiTemplate, error) {
+	tmpl, err := template.New("graffiti").Parse(graffiti)
+	if err != nil {
+		return nil, err
+	}
+	if err = tmpl.Execute(io.Discard, GraffitiVars{}); err != nil {
+		return nil, err
+	}
+	return &graffitiTemplate{source: graffiti, tmpl: tmpl}, nil
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Pausable is a component whose work is suspended while the node is in
+// maintenance, such as the local payload builder.
+type Pausable interface {
+	// Pause suspends the work of the component.
+	Pause()
+	// Resume resumes the work of the component.
+	Resume()
+}
+
+// Maintenance is the maintenance mode of the node. While in maintenance the
+// node keeps following the chain, but neither proposes blocks nor builds
+// payloads, so that it can be taken out of rotation without interrupting a
+// proposal.
+type Maintenance struct {
+	// pausables are paused while in maintenance.
+	pausables []Pausable
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// since is when maintenance was entered, zero when not in maintenance.
+	since time.Time
+	// proposals is the number of proposals being built.
+	proposals int
+	// idle is closed while no proposal is being built.
+	idle chan struct{}
+}
+
+// NewMaintenance creates the maintenance mode of the node, pausing the given
+// components while in maintenance.
+func NewMaintenance(pausables ...Pausable) *Maintenance {
+	idle := make(chan struct{})
+	close(idle)
+	return &Maintenance{
+		pausables: pausables,
+		idle:      idle,
+	}
+}
+
+// Enable puts the node in maintenance. Proposals already being built are
+// left to complete.
+func (m *Maintenance) Enable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.since.IsZero() {
+		return
+	}
+	m.since = time.Now()
+	for _, p := range m.pausables {
+		p.Pause()
+	}
+}
+
+// Disable takes the node out of maintenance.
+func (m *Maintenance) Disable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.since.IsZero() {
+		return
+	}
+	m.since = time.Time{}
+	for _, p := range m.pausables {
+		p.Resume()
+	}
+}
+
+// Enabled returns whether the node is in maintenance.
+func (m *Maintenance) Enabled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return !m.since.IsZero()
+}
+
+// Since returns when the node entered maintenance, or the zero time if it
+// is not in maintenance.
+func (m *Maintenance) Since() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.since
+}
+
+// Proposing returns whether a proposal is being built.
+func (m *Maintenance) Proposing() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.proposals > 0
+}
+
+// Drain puts the node in maintenance and waits until the proposals being
+// built are complete, or ctx is done.
+func (m *Maintenance) Drain(ctx context.Context) error {
+	m.Enable()
+
+	m.mu.Lock()
+	idle := m.idle
+	m.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// beginProposal records that a proposal is being built, returning false if
+// the node is in maintenance and must not propose.
+func (m *Maintenance) beginProposal() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.since.IsZero() {
+		return false
+	}
+	if m.proposals == 0 {
+		m.idle = make(chan struct{})
+	}
+	m.proposals++
+	return true
+}
+
+// endProposal records that a proposal is complete.
+func (m *Maintenance) endProposal() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.proposals--
+	if m.proposals == 0 {
+		close(m.idle)
+	}
+}
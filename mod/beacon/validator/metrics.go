@@ -74,3 +74,27 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+func (cm *validatorMetrics) markOwnPayloadInvalid(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.own_payload_invalid",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+func (cm *validatorMetrics) markProposalsHalted(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposals_halted",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+func (cm *validatorMetrics) markDoppelgangerDetected(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.doppelganger_detected",
+		"slot",
+		slot.Base10(),
+	)
+}
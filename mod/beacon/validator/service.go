@@ -57,6 +57,18 @@ type Service[
 	chainSpec common.ChainSpec
 	// signer is used to retrieve the public key of this node.
 	signer crypto.BLSSigner
+	// graffiti resolves the graffiti of the blocks proposed by this node.
+	graffiti *Graffiti
+	// maintenance stops the node from proposing while in maintenance.
+	maintenance *Maintenance
+	// brake stops the node from proposing after repeated invalid payloads.
+	brake *Brake
+	// doppelganger stops the node from proposing while it watches for, or
+	// after it detected, its validator key in use elsewhere.
+	doppelganger *Doppelganger
+	// slotControl stops the node from proposing for the slots skipped on
+	// devnets.
+	slotControl *SlotControl
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT]
 	// sb is the beacon state backend.
@@ -82,6 +94,9 @@ type Service[
 	metrics *validatorMetrics
 	// subNewSlot is a channel to hold NewSlot events.
 	subNewSlot chan async.Event[SlotDataT]
+	// subFinalizedBlkEvents is a channel to hold BeaconBlockFinalized
+	// events, watched for doppelgangers.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
 }
 
 // NewService creates a new validator service.
@@ -113,6 +128,11 @@ func NewService[
 		ExecutionPayloadHeaderT,
 	],
 	signer crypto.BLSSigner,
+	graffiti *Graffiti,
+	maintenance *Maintenance,
+	brake *Brake,
+	doppelganger *Doppelganger,
+	slotControl *SlotControl,
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
@@ -134,6 +154,11 @@ func NewService[
 		sb:                    sb,
 		chainSpec:             chainSpec,
 		signer:                signer,
+		graffiti:              graffiti,
+		maintenance:           maintenance,
+		brake:                 brake,
+		doppelganger:          doppelganger,
+		slotControl:           slotControl,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
@@ -141,6 +166,7 @@ func NewService[
 		metrics:               newValidatorMetrics(ts),
 		dispatcher:            dispatcher,
 		subNewSlot:            make(chan async.Event[SlotDataT]),
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
 	}
 }
 
@@ -163,6 +189,14 @@ func (s *Service[
 	if err != nil {
 		return err
 	}
+	// subscribe to BeaconBlockFinalized events to watch for doppelgangers
+	if s.doppelganger.Enabled() {
+		if err = s.dispatcher.Subscribe(
+			async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+		); err != nil {
+			return err
+		}
+	}
 	// start the event loop to listen and handle events.
 	go s.eventLoop(ctx)
 	return nil
@@ -178,6 +212,8 @@ func (s *Service[_, _, _, _, _, _, _, _, _, _, _, _, _]) eventLoop(
 			return
 		case event := <-s.subNewSlot:
 			s.handleNewSlot(event)
+		case event := <-s.subFinalizedBlkEvents:
+			s.handleFinalizedBlock(event)
 		}
 	}
 }
@@ -193,10 +229,26 @@ func (s *Service[
 		sidecars BlobSidecarsT
 		err      error
 	)
-	// build the block and sidecars for the requested slot data
-	blk, sidecars, err = s.buildBlockAndSidecars(
-		req.Context(), req.Data(),
-	)
+	// build the block and sidecars for the requested slot data, unless the
+	// node is in maintenance, its proposals were halted, it is watching for
+	// doppelgangers or the slot is skipped.
+	switch {
+	case s.brake.Engaged():
+		err = ErrProposalsHalted
+	case s.doppelganger.Detected():
+		err = ErrDoppelgangerDetected
+	case !s.doppelganger.allow(req.Data().GetSlot()):
+		err = ErrWatchingDoppelgangers
+	case !s.slotControl.allow(req.Data().GetSlot()):
+		err = ErrSlotSkipped
+	case s.maintenance.beginProposal():
+		blk, sidecars, err = s.buildBlockAndSidecars(
+			req.Context(), req.Data(),
+		)
+		s.maintenance.endProposal()
+	default:
+		err = ErrMaintenanceMode
+	}
 	if err != nil {
 		s.logger.Error("failed to build block", "err", err)
 	}
@@ -215,3 +267,22 @@ func (s *Service[
 		s.logger.Error("failed to dispatch built sidecars", "err", err)
 	}
 }
+
+// handleFinalizedBlock tells the doppelganger check whether the finalized
+// block was proposed by the validator of the node.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _,
+]) handleFinalizedBlock(req async.Event[BeaconBlockT]) {
+	blk := req.Data()
+	if !s.doppelganger.watching(blk.GetSlot()) {
+		return
+	}
+	index, err := s.sb.StateFromContext(req.Context()).
+		ValidatorIndexByPubkey(s.signer.PublicKey())
+	if err != nil {
+		// The node has no validator in the state, so none of the blocks
+		// can be its own.
+		return
+	}
+	s.doppelganger.observeBlock(blk.GetSlot(), blk.GetProposerIndex() == index)
+}
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SlotControl decides which slots the node builds a beacon block for, so
+// that slot and epoch scenarios can be constructed on devnets. The node
+// proposes no beacon block for the slots it skips: consensus moves on with
+// an empty proposal, and the next beacon block processes the skipped slots.
+// The time between blocks is still set by the consensus timeouts.
+type SlotControl struct {
+	// enabled is whether the controls apply at all.
+	enabled bool
+	// skipSlots are the slots configured to be skipped.
+	skipSlots map[math.Slot]struct{}
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// paused is whether block production is paused.
+	paused bool
+	// produce is the window of slots blocks are built for while paused.
+	produce slotWindow
+	// skip is the window of slots skipped.
+	skip slotWindow
+}
+
+// NewSlotControl creates the slot controls of the node, skipping the given
+// slots and starting with block production paused if requested. The
+// controls do nothing unless enabled.
+func NewSlotControl(
+	enabled bool,
+	paused bool,
+	skipSlots []uint64,
+) *SlotControl {
+	c := &SlotControl{
+		enabled:   enabled,
+		skipSlots: make(map[math.Slot]struct{}, len(skipSlots)),
+		paused:    enabled && paused,
+	}
+	for _, slot := range skipSlots {
+		c.skipSlots[math.Slot(slot)] = struct{}{}
+	}
+	return c
+}
+
+// Enabled returns whether the controls are enabled.
+func (c *SlotControl) Enabled() bool {
+	return c.enabled
+}
+
+// Pause pauses block production until Resume is called.
+func (c *SlotControl) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused = true
+	c.produce.set(0)
+}
+
+// Resume resumes block production.
+func (c *SlotControl) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused = false
+	c.produce.set(0)
+}
+
+// Produce builds blocks for the next given number of slots, back to back,
+// and pauses block production after them.
+func (c *SlotControl) Produce(blocks uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused = true
+	c.produce.set(blocks)
+}
+
+// Skip skips the next given number of slots, replacing the slots left to
+// skip. Zero stops skipping slots.
+func (c *SlotControl) Skip(slots uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.skip.set(slots)
+}
+
+// Paused returns whether block production is paused.
+func (c *SlotControl) Paused() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.paused
+}
+
+// RemainingBlocks returns the number of blocks left to build before block
+// production pauses again.
+func (c *SlotControl) RemainingBlocks() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.produce.remaining()
+}
+
+// RemainingSkips returns the number of slots left to skip.
+func (c *SlotControl) RemainingSkips() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.skip.remaining()
+}
+
+// allow reports whether the node builds a block for the given slot.
+func (c *SlotControl) allow(slot math.Slot) bool {
+	if !c.enabled {
+		return true
+	}
+	if _, ok := c.skipSlots[slot]; ok {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.skip.apply(slot) {
+		return false
+	}
+	return !c.paused || c.produce.apply(slot)
+}
+
+// slotWindow is a window of consecutive slots, starting at the first slot
+// it is applied to after being set. Slots are applied to it again when a
+// proposal is retried in a later round, so it is tracked by slot rather
+// than by a counter.
+type slotWindow struct {
+	// pending is the number of slots set, until the window starts.
+	pending uint64
+	// started is true once the window has started.
+	started bool
+	// first and last are the first and last slots of the window, once it
+	// has started.
+	first, last math.Slot
+	// current is the latest slot applied since the window started.
+	current math.Slot
+}
+
+// set replaces the window with one of the given number of slots.
+func (w *slotWindow) set(slots uint64) {
+	*w = slotWindow{pending: slots}
+}
+
+// remaining returns the number of slots of the window not yet passed,
+// including the current one.
+func (w *slotWindow) remaining() uint64 {
+	if !w.started {
+		return w.pending
+	} else if w.current > w.last {
+		return 0
+	}
+	return (w.last - w.current).Unwrap() + 1
+}
+
+// apply reports whether the given slot falls within the window.
+func (w *slotWindow) apply(slot math.Slot) bool {
+	if !w.started {
+		if w.pending == 0 {
+			return false
+		}
+		w.started = true
+		w.first = slot
+		w.last = slot + math.Slot(w.pending) - 1
+		w.current = slot
+	}
+	w.current = max(w.current, slot)
+	return slot >= w.first && slot <= w.last
+}
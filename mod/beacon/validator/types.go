@@ -48,6 +48,9 @@ type BeaconBlock[
 	) (T, error)
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the index of the proposer of the beacon
+	// block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block.
@@ -136,6 +139,14 @@ type Eth1Data[T any] interface {
 	) T
 }
 
+// ExecutionClient identifies the execution client paired with the node.
+type ExecutionClient interface {
+	// GetClientVersionV1 returns the version of the execution client.
+	GetClientVersionV1(
+		ctx context.Context,
+	) ([]engineprimitives.ClientVersionV1, error)
+}
+
 // ExecutionPayloadHeader represents the execution payload header interface.
 type ExecutionPayloadHeader interface {
 	// GetTimestamp returns the timestamp of the execution payload header.
@@ -209,6 +220,11 @@ type StateProcessor[
 		st BeaconStateT,
 		blk BeaconBlockT,
 	) (transition.ValidatorUpdates, error)
+	// ExpectedProposer returns the validator expected to propose at the
+	// given slot, if the chain restricts who may propose.
+	ExpectedProposer(
+		st BeaconStateT, slot math.Slot,
+	) (math.ValidatorIndex, bool, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -35,7 +35,9 @@ type Spec[
 	MinDepositAmount() uint64
 
 	// MaxEffectiveBalance returns the maximum balance counted in rewards
-	// calculations in Gwei.
+	// calculations in Gwei at genesis. It is also the effective balance a
+	// validator must reach to be activated; later forks may raise the limit
+	// for activated compounding validators through Fork.MaxEffectiveBalance.
 	MaxEffectiveBalance() uint64
 
 	// EjectionBalance returns the balance below which a validator is ejected.
@@ -45,6 +47,20 @@ type Spec[
 	// calculations.
 	EffectiveBalanceIncrement() uint64
 
+	// HysteresisQuotient returns the quotient dividing the effective balance
+	// increment into the hysteresis increment.
+	HysteresisQuotient() uint64
+
+	// HysteresisDownwardMultiplier returns the number of hysteresis
+	// increments a balance must drop below the effective balance before the
+	// effective balance is lowered.
+	HysteresisDownwardMultiplier() uint64
+
+	// HysteresisUpwardMultiplier returns the number of hysteresis increments
+	// a balance must rise above the effective balance before the effective
+	// balance is raised.
+	HysteresisUpwardMultiplier() uint64
+
 	// Time parameters constants.
 
 	// SlotsPerEpoch returns the number of slots in an epoch.
@@ -57,6 +73,35 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// MaxSeedLookahead returns the number of epochs after which activations
+	// and exits take effect.
+	MaxSeedLookahead() uint64
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between
+	// the exit of a validator and it becoming withdrawable.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// Validator cycle.
+
+	// MinPerEpochChurnLimit returns the minimum per-epoch churn limit.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the quotient used to scale the churn limit
+	// with the active validator set.
+	ChurnLimitQuotient() uint64
+
+	// MaxPerEpochActivationChurnLimit returns the maximum number of
+	// activations per epoch.
+	MaxPerEpochActivationChurnLimit() uint64
+
+	// ProposerSelection returns the rule that decides which validator is
+	// expected to propose a block.
+	ProposerSelection() string
+
+	// DepositAdmission returns the restrictions on the deposits that may
+	// create a validator.
+	DepositAdmission() DepositAdmission[DomainTypeT]
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -165,6 +210,16 @@ type Spec[
 
 	// Helpers for ChainSpecData
 
+	// ForkSchedule returns the forks of the chain, ordered by activation
+	// epoch.
+	ForkSchedule() []Fork[EpochT]
+
+	// ActiveForkForSlot returns the fork that is active at a given slot.
+	ActiveForkForSlot(slot SlotT) Fork[EpochT]
+
+	// ActiveForkForEpoch returns the fork that is active at a given epoch.
+	ActiveForkForEpoch(epoch EpochT) Fork[EpochT]
+
 	// ActiveForkVersionForSlot returns the active fork version for a given
 	// slot.
 	ActiveForkVersionForSlot(slot SlotT) uint32
@@ -173,6 +228,18 @@ type Spec[
 	// epoch.
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 
+	// ValidatorChurnLimit returns the number of validators that may exit
+	// per epoch, given the number of active validators.
+	ValidatorChurnLimit(activeValidators uint64) uint64
+
+	// ActivationChurnLimit returns the number of validators that may be
+	// activated per epoch, given the number of active validators.
+	ActivationChurnLimit(activeValidators uint64) uint64
+
+	// ActivationExitEpoch returns the epoch at which an activation or exit
+	// initiated in the given epoch takes effect.
+	ActivationExitEpoch(epoch EpochT) EpochT
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
 
@@ -196,6 +263,8 @@ type chainSpec[
 ] struct {
 	// Data contains the actual chain-specific parameter values.
 	Data SpecData[DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT]
+	// forks is the fork schedule derived from Data.
+	forks []Fork[EpochT]
 }
 
 // NewChainSpec creates a new instance of a ChainSpec with the provided data.
@@ -213,7 +282,8 @@ func NewChainSpec[
 	return &chainSpec[
 		DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
 	]{
-		Data: data,
+		Data:  data,
+		forks: newForkSchedule(data),
 	}
 }
 
@@ -245,6 +315,27 @@ func (c chainSpec[
 	return c.Data.EffectiveBalanceIncrement
 }
 
+// HysteresisQuotient returns the hysteresis quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisQuotient() uint64 {
+	return c.Data.HysteresisQuotient
+}
+
+// HysteresisDownwardMultiplier returns the hysteresis downward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisDownwardMultiplier() uint64 {
+	return c.Data.HysteresisDownwardMultiplier
+}
+
+// HysteresisUpwardMultiplier returns the hysteresis upward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisUpwardMultiplier() uint64 {
+	return c.Data.HysteresisUpwardMultiplier
+}
+
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -267,6 +358,63 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// MaxSeedLookahead returns the number of epochs after which activations and
+// exits take effect.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxSeedLookahead() uint64 {
+	return c.Data.MaxSeedLookahead
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs between the
+// exit of a validator and it becoming withdrawable.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// MinPerEpochChurnLimit returns the minimum per-epoch churn limit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the churn limit quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// MaxPerEpochActivationChurnLimit returns the maximum number of activations
+// per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPerEpochActivationChurnLimit() uint64 {
+	return c.Data.MaxPerEpochActivationChurnLimit
+}
+
+// ProposerSelection returns the proposer selection rule, defaulting to
+// ProposerSelectionConsensus when none is set.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ProposerSelection() string {
+	if c.Data.ProposerSelection == "" {
+		return ProposerSelectionConsensus
+	}
+	return c.Data.ProposerSelection
+}
+
+// DepositAdmission returns the restrictions on the deposits that may create a
+// validator.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DepositAdmission() DepositAdmission[DomainTypeT] {
+	return c.Data.DepositAdmission
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -20,6 +20,11 @@
 
 package chain
 
+// ProposerSelectionConsensus accepts the proposer chosen by CometBFT, which
+// rotates proposers by voting power. It is the default, and the only rule
+// supported, as CometBFT alone decides who proposes at each height.
+const ProposerSelectionConsensus = "cometbft"
+
 // SpecData is the underlying data structure for chain-specific parameters.
 //
 //nolint:lll // struct tags may create long lines.
@@ -43,6 +48,18 @@ type SpecData[
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
 
+	// Effective balance hysteresis.
+	//
+	// HysteresisQuotient divides the effective balance increment into the
+	// hysteresis increment.
+	HysteresisQuotient uint64 `mapstructure:"hysteresis-quotient"`
+	// HysteresisDownwardMultiplier is the number of hysteresis increments a
+	// balance must fall below the effective balance before it is lowered.
+	HysteresisDownwardMultiplier uint64 `mapstructure:"hysteresis-downward-multiplier"`
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance must rise above the effective balance before it is raised.
+	HysteresisUpwardMultiplier uint64 `mapstructure:"hysteresis-upward-multiplier"`
+
 	// Time parameters constants.
 	//
 	// SlotsPerEpoch is the number of slots per epoch.
@@ -52,6 +69,29 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// MaxSeedLookahead is the number of epochs between an epoch and the
+	// epoch at which activations and exits initiated in it take effect.
+	MaxSeedLookahead uint64 `mapstructure:"max-seed-lookahead"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs an exited
+	// validator must wait before it becomes withdrawable.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+
+	// Validator cycle.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that may
+	// enter or leave the active set in a single epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient scales the churn limit with the size of the
+	// active validator set.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// MaxPerEpochActivationChurnLimit caps the number of validators that may
+	// be activated in a single epoch.
+	MaxPerEpochActivationChurnLimit uint64 `mapstructure:"max-per-epoch-activation-churn-limit"`
+	// ProposerSelection is the rule that decides which validator is expected
+	// to propose a block. Only ProposerSelectionConsensus is supported.
+	ProposerSelection string `mapstructure:"proposer-selection"`
+	// DepositAdmission restricts the deposits that may create a validator.
+	DepositAdmission DepositAdmission[DomainTypeT] `mapstructure:"deposit-admission"`
 
 	// Signature domains.
 	//
@@ -81,6 +121,10 @@ type SpecData[
 	// MaxDepositsPerBlock specifies the maximum number of deposit operations
 	// allowed per block.
 	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
+	// MaxWithdrawalRequestsPerBlock specifies the maximum number of
+	// withdrawal requests, through which validators exit from the execution
+	// layer, allowed per block.
+	MaxWithdrawalRequestsPerBlock uint64 `mapstructure:"max-withdrawal-requests-per-block"`
 	// DepositEth1ChainID is the chain ID of the execution client.
 	DepositEth1ChainID uint64 `mapstructure:"deposit-eth1-chain-id"`
 	// Eth1FollowDistance is the distance between the eth1 chain and the beacon
@@ -93,8 +137,12 @@ type SpecData[
 	//
 	// DenebPlus is the epoch at which the Deneb+ fork is activated.
 	DenebPlusForkEpoch EpochT `mapstructure:"deneb-plus-fork-epoch"`
+	// DenebPlusForkParams are the parameters changed by the Deneb+ fork.
+	DenebPlusForkParams ForkParams `mapstructure:"deneb-plus-fork-params"`
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// ElectraForkParams are the parameters changed by the Electra fork.
+	ElectraForkParams ForkParams `mapstructure:"electra-fork-params"`
 
 	// State list lengths
 	//
@@ -147,3 +195,21 @@ type SpecData[
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
 }
+
+// DepositAdmission restricts the deposits that may create a validator, so
+// that permissioned chains can control validator entry. The zero value admits
+// every deposit with a valid signature.
+type DepositAdmission[DomainTypeT ~[4]byte] struct {
+	// Pubkeys, if not empty, are the hex encoded public keys allowed to
+	// become validators.
+	Pubkeys []string `mapstructure:"pubkeys"`
+	// WithdrawalCredentials, if not empty, are the hex encoded withdrawal
+	// credentials new validators must use.
+	WithdrawalCredentials []string `mapstructure:"withdrawal-credentials"`
+	// MinAmount is the minimum amount in Gwei of a deposit creating a
+	// validator.
+	MinAmount uint64 `mapstructure:"min-amount"`
+	// DomainType, if not zero, replaces DomainTypeDeposit when verifying the
+	// signature of a deposit creating a validator.
+	DomainType DomainTypeT `mapstructure:"domain-type"`
+}
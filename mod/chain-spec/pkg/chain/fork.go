@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// Fork is a scheduled upgrade of the chain, along with the parameters that
+// are in effect from its activation epoch until the next fork.
+type Fork[EpochT ~uint64] struct {
+	// Version is the fork version.
+	Version uint32
+	// Epoch is the epoch at which the fork is activated.
+	Epoch EpochT
+	// MaxBlobsPerBlock is the maximum number of blobs allowed per block.
+	MaxBlobsPerBlock uint64
+	// MaxDepositsPerBlock is the maximum number of deposits allowed per
+	// block.
+	MaxDepositsPerBlock uint64
+	// MaxWithdrawalRequestsPerBlock is the maximum number of withdrawal
+	// requests allowed per block.
+	MaxWithdrawalRequestsPerBlock uint64
+	// MaxEffectiveBalance is the maximum effective balance an activated
+	// validator with compounding withdrawal credentials may grow to, once
+	// they are enabled.
+	MaxEffectiveBalance uint64
+	// BeaconOperations reports whether blocks carry attestation data and
+	// slashing info.
+	BeaconOperations bool
+	// DataColumns reports whether blob data is distributed as erasure-coded
+	// data column sidecars rather than blob sidecars.
+	DataColumns bool
+	// RegistryUpdates reports whether validators are activated and exited
+	// through the registry updates, with effective balances following
+	// balances once per epoch. Before, every validator in the registry is
+	// part of the validator set.
+	RegistryUpdates bool
+	// CompoundingCredentials reports whether validators with compounding
+	// withdrawal credentials may grow to MaxEffectiveBalance. Before, every
+	// validator is capped at the maximum effective balance of the chain spec.
+	CompoundingCredentials bool
+}
+
+// ForkParams are the chain parameters that change when a fork is activated.
+// A zero value keeps the parameter of the previous fork.
+type ForkParams struct {
+	// MaxBlobsPerBlock is the maximum number of blobs allowed per block.
+	MaxBlobsPerBlock uint64 `mapstructure:"max-blobs-per-block"`
+	// MaxDepositsPerBlock is the maximum number of deposits allowed per
+	// block.
+	MaxDepositsPerBlock uint64 `mapstructure:"max-deposits-per-block"`
+	// MaxWithdrawalRequestsPerBlock is the maximum number of withdrawal
+	// requests allowed per block.
+	MaxWithdrawalRequestsPerBlock uint64 `mapstructure:"max-withdrawal-requests-per-block"`
+	// MaxEffectiveBalance is the maximum effective balance an activated
+	// validator with compounding withdrawal credentials may grow to. It may
+	// only be raised by a fork, as in EIP-7251.
+	MaxEffectiveBalance uint64 `mapstructure:"max-effective-balance"`
+}
+
+// apply returns the fork activated at the given epoch with the given version,
+// inheriting every parameter that is not overridden by params from the fork.
+func (f Fork[EpochT]) apply(
+	forkVersion uint32, epoch EpochT, params ForkParams,
+) Fork[EpochT] {
+	f.Version = forkVersion
+	f.Epoch = epoch
+	if params.MaxBlobsPerBlock != 0 {
+		f.MaxBlobsPerBlock = params.MaxBlobsPerBlock
+	}
+	if params.MaxDepositsPerBlock != 0 {
+		f.MaxDepositsPerBlock = params.MaxDepositsPerBlock
+	}
+	if params.MaxWithdrawalRequestsPerBlock != 0 {
+		f.MaxWithdrawalRequestsPerBlock = params.MaxWithdrawalRequestsPerBlock
+	}
+	if params.MaxEffectiveBalance > f.MaxEffectiveBalance {
+		f.MaxEffectiveBalance = params.MaxEffectiveBalance
+	}
+	f.BeaconOperations = forkVersion >= version.DenebPlus
+	f.DataColumns = forkVersion >= version.Electra
+	f.RegistryUpdates = forkVersion >= version.DenebPlus
+	f.CompoundingCredentials = forkVersion >= version.Electra
+	return f
+}
+
+// newForkSchedule builds the fork schedule of the given spec data, ordered by
+// activation epoch. A fork that is not activated before a later fork is
+// superseded by it and dropped from the schedule.
+func newForkSchedule[
+	DomainTypeT ~[4]byte,
+	EpochT ~uint64,
+	ExecutionAddressT ~[20]byte,
+	SlotT ~uint64,
+	CometBFTConfigT any,
+](
+	data SpecData[
+		DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+	],
+) []Fork[EpochT] {
+	genesis := Fork[EpochT]{}.apply(version.Deneb, 0, ForkParams{
+		MaxBlobsPerBlock:              data.MaxBlobsPerBlock,
+		MaxDepositsPerBlock:           data.MaxDepositsPerBlock,
+		MaxWithdrawalRequestsPerBlock: data.MaxWithdrawalRequestsPerBlock,
+		MaxEffectiveBalance:           data.MaxEffectiveBalance,
+	})
+	denebPlus := genesis.apply(
+		version.DenebPlus, data.DenebPlusForkEpoch, data.DenebPlusForkParams,
+	)
+	electra := denebPlus.apply(
+		version.Electra, data.ElectraForkEpoch, data.ElectraForkParams,
+	)
+
+	// Walk the forks backwards, keeping only those that are activated before
+	// every later fork.
+	forks := []Fork[EpochT]{genesis, denebPlus, electra}
+	schedule := make([]Fork[EpochT], 0, len(forks))
+	next := ^EpochT(0)
+	for i := len(forks) - 1; i >= 0; i-- {
+		if forks[i].Epoch < next {
+			schedule = append(schedule, forks[i])
+			next = forks[i].Epoch
+		}
+	}
+	slices.Reverse(schedule)
+	return schedule
+}
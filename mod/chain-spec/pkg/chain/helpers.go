@@ -21,9 +21,40 @@
 package chain
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"slices"
 )
 
+// ForkSchedule returns the forks of the chain, ordered by activation epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ForkSchedule() []Fork[EpochT] {
+	return slices.Clone(c.forks)
+}
+
+// ActiveForkForSlot returns the fork that is active at a given slot.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ActiveForkForSlot(
+	slot SlotT,
+) Fork[EpochT] {
+	return c.ActiveForkForEpoch(c.SlotToEpoch(slot))
+}
+
+// ActiveForkForEpoch returns the fork that is active at a given epoch, which
+// is the last fork of the schedule activated at or before the epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ActiveForkForEpoch(
+	epoch EpochT,
+) Fork[EpochT] {
+	for i := len(c.forks) - 1; i > 0; i-- {
+		if epoch >= c.forks[i].Epoch {
+			return c.forks[i]
+		}
+	}
+	return c.forks[0]
+}
+
 // ActiveForkVersionForSlot returns the active fork version for a given slot.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -39,13 +70,47 @@ func (c chainSpec[
 ]) ActiveForkVersionForEpoch(
 	epoch EpochT,
 ) uint32 {
-	if epoch >= c.Data.ElectraForkEpoch {
-		return version.Electra
-	} else if epoch >= c.Data.DenebPlusForkEpoch {
-		return version.DenebPlus
+	return c.ActiveForkForEpoch(epoch).Version
+}
+
+// ValidatorChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ValidatorChurnLimit(activeValidators uint64) uint64 {
+	if c.Data.ChurnLimitQuotient == 0 {
+		return c.Data.MinPerEpochChurnLimit
 	}
+	return max(
+		c.Data.MinPerEpochChurnLimit,
+		activeValidators/c.Data.ChurnLimitQuotient,
+	)
+}
+
+// ActivationChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#new-get_validator_activation_churn_limit
+//
+//nolint:lll
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ActivationChurnLimit(activeValidators uint64) uint64 {
+	return min(
+		c.Data.MaxPerEpochActivationChurnLimit,
+		c.ValidatorChurnLimit(activeValidators),
+	)
+}
 
-	return version.Deneb
+// ActivationExitEpoch as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ActivationExitEpoch(epoch EpochT) EpochT {
+	//#nosec:G701 // realistically fine in practice.
+	return epoch + 1 + EpochT(c.Data.MaxSeedLookahead)
 }
 
 // SlotToEpoch converts a slot to an epoch.
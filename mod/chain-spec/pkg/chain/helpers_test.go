@@ -46,6 +46,10 @@ var spec = chain.NewChainSpec(
 		ElectraForkEpoch:                 10,
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
+		MaxSeedLookahead:                 4,
+		MinPerEpochChurnLimit:            4,
+		ChurnLimitQuotient:               65536,
+		MaxPerEpochActivationChurnLimit:  8,
 	},
 )
 
@@ -155,3 +159,172 @@ func TestWithinDAPeriod(t *testing.T) {
 		})
 	}
 }
+
+// TestActiveForkForEpoch tests that fork parameters are inherited and
+// overridden across the fork schedule.
+func TestActiveForkForEpoch(t *testing.T) {
+	forkSpec := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			SlotsPerEpoch:                 32,
+			MaxBlobsPerBlock:              6,
+			MaxDepositsPerBlock:           16,
+			MaxWithdrawalRequestsPerBlock: 16,
+			MaxEffectiveBalance:           32e9,
+			DenebPlusForkEpoch:            5,
+			DenebPlusForkParams: chain.ForkParams{
+				MaxEffectiveBalance:           16e9,
+				MaxWithdrawalRequestsPerBlock: 4,
+			},
+			ElectraForkEpoch: 10,
+			ElectraForkParams: chain.ForkParams{
+				MaxBlobsPerBlock:    9,
+				MaxEffectiveBalance: 2048e9,
+			},
+		},
+	)
+
+	genesis := forkSpec.ActiveForkForEpoch(4)
+	require.Equal(t, version.Deneb, genesis.Version)
+	require.Equal(t, uint64(6), genesis.MaxBlobsPerBlock)
+	require.False(t, genesis.BeaconOperations)
+	require.False(t, genesis.RegistryUpdates)
+	require.Equal(t, uint64(16), genesis.MaxWithdrawalRequestsPerBlock)
+
+	denebPlus := forkSpec.ActiveForkForEpoch(5)
+	require.Equal(t, version.DenebPlus, denebPlus.Version)
+	require.Equal(t, uint64(6), denebPlus.MaxBlobsPerBlock)
+	require.True(t, denebPlus.BeaconOperations)
+	require.True(t, denebPlus.RegistryUpdates)
+	require.False(t, denebPlus.CompoundingCredentials)
+	require.False(t, denebPlus.DataColumns)
+	// The maximum effective balance can only be raised by a fork.
+	require.Equal(t, uint64(32e9), denebPlus.MaxEffectiveBalance)
+
+	electra := forkSpec.ActiveForkForSlot(320)
+	require.Equal(t, version.Electra, electra.Version)
+	require.Equal(t, epoch(10), electra.Epoch)
+	require.Equal(t, uint64(9), electra.MaxBlobsPerBlock)
+	require.Equal(t, uint64(16), electra.MaxDepositsPerBlock)
+	require.Equal(t, uint64(4), electra.MaxWithdrawalRequestsPerBlock)
+	require.Equal(t, uint64(2048e9), electra.MaxEffectiveBalance)
+	require.True(t, electra.DataColumns)
+	require.True(t, electra.CompoundingCredentials)
+}
+
+// TestForkSchedule tests that superseded forks are dropped from the schedule.
+func TestForkSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		denebPlus epoch
+		electra   epoch
+		expected  []uint32
+	}{
+		{
+			name:      "All Forks Scheduled",
+			denebPlus: 9,
+			electra:   10,
+			expected: []uint32{
+				version.Deneb, version.DenebPlus, version.Electra,
+			},
+		},
+		{
+			name:      "Deneb+ At Genesis",
+			denebPlus: 0,
+			electra:   10,
+			expected:  []uint32{version.DenebPlus, version.Electra},
+		},
+		{
+			name:      "Electra Supersedes Deneb+",
+			denebPlus: 10,
+			electra:   10,
+			expected:  []uint32{version.Deneb, version.Electra},
+		},
+		{
+			name:      "Electra At Genesis",
+			denebPlus: 10,
+			electra:   0,
+			expected:  []uint32{version.Electra},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forkSpec := chain.NewChainSpec(
+				chain.SpecData[
+					domainType, epoch, executionAddress, slot, cometBFTConfig,
+				]{
+					DenebPlusForkEpoch: tt.denebPlus,
+					ElectraForkEpoch:   tt.electra,
+				},
+			)
+			versions := make([]uint32, 0)
+			for _, fork := range forkSpec.ForkSchedule() {
+				versions = append(versions, fork.Version)
+			}
+			require.Equal(t, tt.expected, versions)
+		})
+	}
+}
+
+// TestActivationChurnLimit tests the ValidatorChurnLimit and
+// ActivationChurnLimit methods.
+func TestActivationChurnLimit(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name               string
+		activeValidators   uint64
+		expectedChurn      uint64
+		expectedActivation uint64
+	}{
+		{
+			name:               "Minimum churn",
+			activeValidators:   100,
+			expectedChurn:      4,
+			expectedActivation: 4,
+		},
+		{
+			name:               "Scaled churn",
+			activeValidators:   6 * 65536,
+			expectedChurn:      6,
+			expectedActivation: 6,
+		},
+		{
+			name:               "Capped activation churn",
+			activeValidators:   20 * 65536,
+			expectedChurn:      20,
+			expectedActivation: 8,
+		},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedChurn,
+				spec.ValidatorChurnLimit(tt.activeValidators))
+			require.Equal(t, tt.expectedActivation,
+				spec.ActivationChurnLimit(tt.activeValidators))
+		})
+	}
+}
+
+// TestActivationExitEpoch tests the ActivationExitEpoch method.
+func TestActivationExitEpoch(t *testing.T) {
+	require.Equal(t, epoch(5), spec.ActivationExitEpoch(0))
+	require.Equal(t, epoch(15), spec.ActivationExitEpoch(10))
+}
+
+// TestProposerSelection tests the ProposerSelection method.
+func TestProposerSelection(t *testing.T) {
+	require.Equal(t, chain.ProposerSelectionConsensus, spec.ProposerSelection())
+
+	configured := chain.NewChainSpec(
+		chain.SpecData[
+			domainType, epoch, executionAddress, slot, cometBFTConfig,
+		]{
+			ProposerSelection: "effective-balance",
+		},
+	)
+	require.Equal(t, "effective-balance", configured.ProposerSelection())
+}
@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/depinject"
 	cmdlib "github.com/berachain/beacon-kit/mod/cli/pkg/commands"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/components"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -53,6 +54,8 @@ type CLIBuilder[
 	// eventually called by the cosmos-sdk.
 	// TODO: CLI should not know about the AppCreator
 	nodeBuilderFunc servertypes.AppCreator[T, LoggerT]
+	// graphFunc resolves the dependency graph of the node components.
+	graphFunc components.GraphFunc
 }
 
 // New returns a new CLIBuilder with the given options.
@@ -114,6 +117,7 @@ func (cb *CLIBuilder[
 		&cometbft.Service[LoggerT]{},
 		cb.nodeBuilderFunc,
 		chainSpec,
+		cb.graphFunc,
 	)
 
 	return rootCmd, nil
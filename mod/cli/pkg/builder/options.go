@@ -21,6 +21,7 @@
 package builder
 
 import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/components"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
@@ -79,3 +80,17 @@ func WithNodeBuilderFunc[
 		cb.nodeBuilderFunc = nodeBuilderFunc
 	}
 }
+
+// WithGraphFunc sets the function resolving the dependency graph of the node
+// components for the CLIBuilder.
+func WithGraphFunc[
+	T types.Node,
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	graphFunc components.GraphFunc,
+) Opt[T, ExecutionPayloadT, LoggerT] {
+	return func(cb *CLIBuilder[T, ExecutionPayloadT, LoggerT]) {
+		cb.graphFunc = graphFunc
+	}
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/graph"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// FlagDOT is the flag to print the graph in the DOT language.
+const FlagDOT = "dot"
+
+// GraphFunc resolves the dependency graph of the node components.
+type GraphFunc func() (*graph.Graph, error)
+
+// Commands creates a new command for inspecting the node components.
+func Commands(graphFn GraphFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "components",
+		Short:                      "node components subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewGraphCommand(graphFn))
+
+	return cmd
+}
+
+// NewGraphCommand creates a new command for printing the dependency graph of
+// the node components.
+func NewGraphCommand(graphFn GraphFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Prints the dependency graph of the node components",
+		Long: `This command resolves the dependency graph of the node components
+without building the node. It prints the types each component provides and
+consumes, along with the components providing them, and fails if a type is
+missing a provider or has more than one.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dot, err := cmd.Flags().GetBool(FlagDOT)
+			if err != nil {
+				return err
+			}
+
+			g, err := graphFn()
+			if err != nil {
+				return err
+			}
+
+			if dot {
+				err = g.WriteDOT(cmd.OutOrStdout())
+			} else {
+				err = g.WriteText(cmd.OutOrStdout())
+			}
+			if err != nil {
+				return err
+			}
+			return g.Err()
+		},
+	}
+
+	cmd.Flags().Bool(FlagDOT, false, "Print the graph in the DOT language")
+	return cmd
+}
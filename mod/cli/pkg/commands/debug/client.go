@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// stateFieldRoots is the per-field root breakdown of a beacon state, as
+// served by the `/bkit/v1/debug/states/{state_id}/field_roots` endpoint.
+type stateFieldRoots struct {
+	Slot      math.Slot   `json:"slot"`
+	StateRoot common.Root `json:"state_root"`
+	Fields    []struct {
+		Index int         `json:"index"`
+		Name  string      `json:"name"`
+		Root  common.Root `json:"root"`
+	} `json:"fields"`
+}
+
+// fetchStateFieldRoots requests the per-field root breakdown of the state
+// with the given ID from the Beacon API at url.
+func fetchStateFieldRoots(
+	ctx context.Context, client *http.Client, url, stateID string,
+) (*stateFieldRoots, error) {
+	path := strings.TrimSuffix(url, "/") +
+		"/bkit/v1/debug/states/" + stateID + "/field_roots"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(
+			ErrUnexpectedStatus, "%s: %d", path, res.StatusCode,
+		)
+	}
+
+	var out struct {
+		Data stateFieldRoots `json:"data"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out.Data, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagNodeAPI is the address of the Beacon API of the beacon node.
+	FlagNodeAPI = "node-api"
+	// FlagCompareTo is the address of the Beacon API of a second beacon node
+	// to compare the state against.
+	FlagCompareTo = "compare-to"
+
+	// requestTimeout bounds the requests to the beacon nodes, hashing a
+	// large state taking a while.
+	requestTimeout = time.Minute
+)
+
+// Commands creates a new command for debugging a beacon node.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "debug",
+		Short:                      "Debugging subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewStateRootsCommand(),
+	)
+
+	return cmd
+}
+
+// NewStateRootsCommand creates a new command printing the hash tree root of
+// each top-level field of a beacon state.
+func NewStateRootsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-roots [state_id]",
+		Short: "Prints the root of each field of a beacon state",
+		Long: `Prints the hash tree root of each top-level field of the beacon
+state with the given ID ("head", "genesis", a slot or a state root). With
+--compare-to, the state of a second node is fetched as well and the fields
+whose roots differ are marked, localizing a state root mismatch.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateID := "head"
+			if len(args) == 1 {
+				stateID = args[0]
+			}
+			var (
+				httpClient = &http.Client{Timeout: requestTimeout}
+				url, _     = cmd.Flags().GetString(FlagNodeAPI)
+				other, _   = cmd.Flags().GetString(FlagCompareTo)
+			)
+
+			local, err := fetchStateFieldRoots(
+				cmd.Context(), httpClient, url, stateID,
+			)
+			if err != nil {
+				return err
+			}
+			if other == "" {
+				printStateRoots(cmd, local)
+				return nil
+			}
+
+			remote, err := fetchStateFieldRoots(
+				cmd.Context(), httpClient, other, stateID,
+			)
+			if err != nil {
+				return err
+			}
+			return compareStateRoots(cmd, local, remote)
+		},
+	}
+	cmd.Flags().String(
+		FlagNodeAPI, "http://localhost:3500",
+		"Address of the Beacon API of the beacon node",
+	)
+	cmd.Flags().String(
+		FlagCompareTo, "",
+		"Address of the Beacon API of a beacon node to compare against",
+	)
+	return cmd
+}
+
+// printStateRoots prints the per-field roots of a beacon state.
+func printStateRoots(cmd *cobra.Command, st *stateFieldRoots) {
+	cmd.Printf("slot:       %s\n", st.Slot.Base10())
+	cmd.Printf("state_root: %s\n", st.StateRoot)
+	for _, field := range st.Fields {
+		cmd.Printf("%2d %-32s %s\n", field.Index, field.Name, field.Root)
+	}
+}
+
+// compareStateRoots prints the per-field roots of the beacon states of two
+// nodes, marking the fields on which they diverge.
+func compareStateRoots(
+	cmd *cobra.Command, local, remote *stateFieldRoots,
+) error {
+	if len(local.Fields) != len(remote.Fields) {
+		return ErrFieldCountMismatch
+	}
+
+	cmd.Printf(
+		"slot:       %s / %s\n", local.Slot.Base10(), remote.Slot.Base10(),
+	)
+	cmd.Printf("state_root: %s / %s\n", local.StateRoot, remote.StateRoot)
+	diverged := 0
+	for i, field := range local.Fields {
+		if field.Root == remote.Fields[i].Root {
+			cmd.Printf("   %2d %-32s %s\n", field.Index, field.Name, field.Root)
+			continue
+		}
+		diverged++
+		cmd.Printf(
+			"!! %2d %-32s %s / %s\n",
+			field.Index, field.Name, field.Root, remote.Fields[i].Root,
+		)
+	}
+	cmd.Printf("%d of %d fields diverge\n", diverged, len(local.Fields))
+	return nil
+}
@@ -39,6 +39,11 @@ var (
 	ErrDepositReceiptEmpty = errors.New(
 		"deposit receipt is nil")
 
+	// ErrDepositTransactionFailed is returned when the deposit transaction
+	// is mined but reverted.
+	ErrDepositTransactionFailed = errors.New(
+		"deposit transaction failed")
+
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
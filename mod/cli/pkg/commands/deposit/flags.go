@@ -29,6 +29,26 @@ const (
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// amount is the flag for the deposit amount in Gwei.
+	amount = "amount"
+
+	// withdrawalAddress is the flag for the execution address credited with
+	// the withdrawals of the validator.
+	withdrawalAddress = "withdrawal-address"
+
+	// forkVersion is the flag for the fork version the deposit is signed
+	// for.
+	forkVersion = "fork-version"
+
+	// genesisValidatorsRoot is the flag for the genesis validators root.
+	genesisValidatorsRoot = "genesis-validators-root"
+
+	// broadcast is the flag for submitting the deposit transaction.
+	broadcast = "broadcast"
+
+	// jsonOutput is the flag for printing the deposit as JSON.
+	jsonOutput = "json"
 )
 
 const (
@@ -47,6 +67,28 @@ const (
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultAmount is the default value for the amount flag.
+	defaultAmount = "32000000000"
+
+	// defaultWithdrawalAddress is the default value for the
+	// withdrawalAddress flag.
+	defaultWithdrawalAddress = ""
+
+	// defaultForkVersion is the default value for the forkVersion flag,
+	// which stands for the genesis fork version.
+	defaultForkVersion = ""
+
+	// defaultGenesisValidatorsRoot is the default value for the
+	// genesisValidatorsRoot flag.
+	defaultGenesisValidatorsRoot = "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000000"
+
+	// defaultBroadcast is the default value for the broadcast flag.
+	defaultBroadcast = false
+
+	// defaultJSONOutput is the default value for the jsonOutput flag.
+	defaultJSONOutput = false
 )
 
 const (
@@ -62,4 +104,25 @@ const (
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// amountMsg is the usage description for the amount flag.
+	amountMsg = "amount to deposit, in Gwei"
+
+	// withdrawalAddressMsg is the usage description for the
+	// withdrawalAddress flag.
+	withdrawalAddressMsg = "execution address credited with the withdrawals"
+
+	// forkVersionMsg is the usage description for the forkVersion flag.
+	forkVersionMsg = "fork version to sign for, defaults to the genesis one"
+
+	// genesisValidatorsRootMsg is the usage description for the
+	// genesisValidatorsRoot flag.
+	genesisValidatorsRootMsg = "genesis validators root to sign for"
+
+	// broadcastMsg is the usage description for the broadcast flag.
+	broadcastMsg = `submit the deposit transaction to the execution client.
+	Requires the private-key flag.`
+
+	// jsonOutputMsg is the usage description for the jsonOutput flag.
+	jsonOutputMsg = "print the deposit as JSON"
 )
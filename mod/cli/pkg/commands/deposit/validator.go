@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
+	gethcrypto "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/crypto"
+	depositcontract "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
+	gethrpc "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/rpc"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/client"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/spf13/cobra"
+)
+
+// depositData is the signed deposit of a validator. It is what the JSON
+// output prints, so that a deposit signed on an air-gapped machine can be
+// submitted from another one.
+type depositData struct {
+	Pubkey                crypto.BLSPubkey            `json:"pubkey"`
+	WithdrawalCredentials types.WithdrawalCredentials `json:"withdrawal_credentials"`
+	Amount                math.Gwei                   `json:"amount"`
+	Signature             crypto.BLSSignature         `json:"signature"`
+	DepositDataRoot       common.Root                 `json:"deposit_data_root"`
+	ForkVersion           common.Version              `json:"fork_version"`
+	TxHash                *common.ExecutionHash       `json:"tx_hash,omitempty"`
+}
+
+// ValidatorCommands creates a new command for validator related actions.
+func ValidatorCommands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "validator",
+		Short:                      "validator subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewDeposit(chainSpec))
+
+	return cmd
+}
+
+// NewDeposit creates a new command to sign, and optionally submit, the
+// deposit of a validator.
+func NewDeposit(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Signs and optionally submits a validator deposit",
+		Long: `Signs the deposit of the node validator key, or of the key
+given with --validator-private-key, crediting withdrawals to
+--withdrawal-address, and verifies it against the deposit domain of the
+chain spec. The deposit data root is printed along with the deposit.
+
+With --broadcast, the deposit transaction is sent to the deposit contract
+through the RPC of the execution client configured for the node, paid for
+by --private-key, and the command waits for it to be mined. With --json,
+the deposit is printed as JSON, e.g. to carry it off an air-gapped machine.`,
+		Args: cobra.NoArgs,
+		RunE: depositCmd(chainSpec),
+	}
+
+	cmd.Flags().String(amount, defaultAmount, amountMsg)
+	cmd.Flags().String(
+		withdrawalAddress, defaultWithdrawalAddress, withdrawalAddressMsg,
+	)
+	cmd.Flags().String(forkVersion, defaultForkVersion, forkVersionMsg)
+	cmd.Flags().String(
+		genesisValidatorsRoot, defaultGenesisValidatorsRoot,
+		genesisValidatorsRootMsg,
+	)
+	cmd.Flags().Bool(broadcast, defaultBroadcast, broadcastMsg)
+	cmd.Flags().Bool(jsonOutput, defaultJSONOutput, jsonOutputMsg)
+	cmd.Flags().String(privateKey, defaultPrivateKey, privateKeyMsg)
+	cmd.Flags().BoolP(
+		overrideNodeKey, overrideNodeKeyShorthand,
+		defaultOverrideNodeKey, overrideNodeKeyMsg,
+	)
+	cmd.Flags().
+		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+
+	return cmd
+}
+
+// depositCmd returns a command that signs and optionally submits a deposit.
+func depositCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		data, err := signDeposit(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+
+		shouldBroadcast, err := cmd.Flags().GetBool(broadcast)
+		if err != nil {
+			return err
+		}
+		if shouldBroadcast {
+			var txHash common.ExecutionHash
+			if txHash, err = submitDeposit(cmd, chainSpec, data); err != nil {
+				return err
+			}
+			data.TxHash = &txHash
+		}
+
+		asJSON, err := cmd.Flags().GetBool(jsonOutput)
+		if err != nil {
+			return err
+		}
+		if asJSON {
+			var bz []byte
+			if bz, err = json.MarshalIndent(data, "", "  "); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		}
+
+		logger := log.NewLogger(os.Stdout)
+		logger.Info(
+			"Deposit Data",
+			"pubkey", data.Pubkey.String(),
+			"withdrawal credentials", data.WithdrawalCredentials.String(),
+			"amount", data.Amount,
+			"signature", data.Signature.String(),
+			"deposit data root", data.DepositDataRoot.String(),
+		)
+		if data.TxHash != nil {
+			logger.Info("Deposit submitted", "tx hash", data.TxHash.Hex())
+		}
+		return nil
+	}
+}
+
+// signDeposit builds and signs the deposit described by the flags of the
+// command, and verifies it against the deposit domain of the chain spec.
+func signDeposit(
+	cmd *cobra.Command, chainSpec common.ChainSpec,
+) (*depositData, error) {
+	blsSigner, err := getBLSSigner(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	amountStr, err := cmd.Flags().GetString(amount)
+	if err != nil {
+		return nil, err
+	}
+	depositAmount, err := parser.ConvertAmount(amountStr)
+	if err != nil {
+		return nil, err
+	}
+
+	addressStr, err := cmd.Flags().GetString(withdrawalAddress)
+	if err != nil {
+		return nil, err
+	}
+	address, err := parser.ConvertWithdrawalAddress(addressStr)
+	if err != nil {
+		return nil, err
+	}
+
+	versionStr, err := cmd.Flags().GetString(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	currentVersion := version.FromUint32[common.Version](version.Deneb)
+	if versionStr != "" {
+		if currentVersion, err = parser.ConvertVersion(versionStr); err != nil {
+			return nil, err
+		}
+	}
+
+	rootStr, err := cmd.Flags().GetString(genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(rootStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create, sign and verify the deposit message.
+	forkData := types.NewForkData(currentVersion, genesisValidatorRoot)
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData,
+		chainSpec.DomainTypeDeposit(),
+		blsSigner,
+		types.NewCredentialsFromExecutionAddress(address),
+		depositAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = depositMsg.VerifyCreateValidator(
+		forkData,
+		signature,
+		chainSpec.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
+	root, err := depositDataRoot(depositMsg, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &depositData{
+		Pubkey:                depositMsg.Pubkey,
+		WithdrawalCredentials: depositMsg.Credentials,
+		Amount:                depositMsg.Amount,
+		Signature:             signature,
+		DepositDataRoot:       root,
+		ForkVersion:           currentVersion,
+	}, nil
+}
+
+// depositDataRoot returns the hash tree root of the DepositData of the
+// consensus specs, which unlike a Deposit carries no index.
+func depositDataRoot(
+	msg *types.DepositMessage, signature crypto.BLSSignature,
+) (common.Root, error) {
+	hh := fastssz.DefaultHasherPool.Get()
+	defer fastssz.DefaultHasherPool.Put(hh)
+
+	indx := hh.Index()
+	hh.PutBytes(msg.Pubkey[:])
+	hh.PutBytes(msg.Credentials[:])
+	hh.PutUint64(msg.Amount.Unwrap())
+	hh.PutBytes(signature[:])
+	hh.Merkleize(indx)
+
+	root, err := hh.HashRoot()
+	return common.Root(root), err
+}
+
+// submitDeposit sends the deposit transaction to the deposit contract
+// through the RPC of the execution client and waits for it to be mined.
+func submitDeposit(
+	cmd *cobra.Command, chainSpec common.ChainSpec, data *depositData,
+) (common.ExecutionHash, error) {
+	payerKey, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	} else if payerKey == "" {
+		return common.ExecutionHash{}, ErrPrivateKeyRequired
+	}
+	key, err := gethcrypto.HexToECDSA(strings.TrimPrefix(payerKey, "0x"))
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+
+	cfg, err := config.ReadConfigFromAppOpts(client.GetViperFromCmd(cmd))
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	jwtSecret, err := components.LoadJWTFromFile(cfg.Engine.JWTSecretPath)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	rpcClient, err := gethrpc.DialOptions(
+		ctx, cfg.Engine.RPCDialURL.String(),
+		gethrpc.WithHTTPAuth(jwtAuth(jwtSecret)),
+	)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	defer rpcClient.Close()
+	ethClient := ethclient.NewClient(rpcClient)
+
+	contract, err := depositcontract.NewBeaconDepositContract(
+		gethprimitives.ExecutionAddress(chainSpec.DepositContractAddress()),
+		ethClient,
+	)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(
+		key, new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+	)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+	opts.Context = ctx
+	// The deposit contract takes the deposited amount as the value of the
+	// transaction.
+	opts.Value = data.Amount.ToWei().ToBig()
+
+	tx, err := contract.Deposit(
+		opts,
+		data.Pubkey[:],
+		data.WithdrawalCredentials[:],
+		data.Amount.Unwrap(),
+		data.Signature[:],
+	)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	}
+
+	receipt, err := bind.WaitMined(ctx, ethClient, tx)
+	if err != nil {
+		return common.ExecutionHash{}, err
+	} else if receipt == nil {
+		return common.ExecutionHash{}, ErrDepositReceiptEmpty
+	} else if receipt.Status != gethprimitives.ReceiptStatusSuccessful {
+		return common.ExecutionHash{}, ErrDepositTransactionFailed
+	}
+	return common.ExecutionHash(tx.Hash()), nil
+}
+
+// jwtAuth authenticates the requests made to the execution client with a
+// token signed by the engine API JWT secret.
+func jwtAuth(secret *jwt.Secret) gethrpc.HTTPAuth {
+	return func(header http.Header) error {
+		token, err := secret.BuildSignedToken()
+		if err != nil {
+			return err
+		}
+		header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
@@ -34,7 +34,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -61,68 +60,11 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				)
 			}
 
-			var (
-				depositAmountString string
-				depositAmount       math.Gwei
-			)
-
-			// Get the BLS signer.
-			blsSigner, err := components.ProvideBlsSigner(
-				components.BlsSignerInput{
-					AppOpts: context.GetViperFromCmd(cmd),
-				},
-			)
-			if err != nil {
-				return err
-			}
-
-			// Get the deposit amount.
-			depositAmountString, err = cmd.Flags().GetString(depositAmountFlag)
-			if err != nil {
-				return err
-			}
-
-			depositAmount, err = parser.ConvertAmount(depositAmountString)
+			deposit, err := signGenesisDeposit(cmd, cs)
 			if err != nil {
 				return err
 			}
 
-			// TODO: configurable.
-			currentVersion := version.FromUint32[common.Version](
-				version.Deneb,
-			)
-
-			depositMsg, signature, err := types.CreateAndSignDepositMessage(
-				types.NewForkData(currentVersion, common.Root{}),
-				cs.DomainTypeDeposit(),
-				blsSigner,
-				// TODO: configurable.
-				types.NewCredentialsFromExecutionAddress(
-					common.ExecutionAddress{},
-				),
-				depositAmount,
-			)
-			if err != nil {
-				return err
-			}
-
-			// Verify the deposit message.
-			if err = depositMsg.VerifyCreateValidator(
-				types.NewForkData(currentVersion, common.Root{}),
-				signature,
-				cs.DomainTypeDeposit(),
-				signer.BLSSigner{}.VerifySignature,
-			); err != nil {
-				return err
-			}
-
-			deposit := types.Deposit{
-				Pubkey:      depositMsg.Pubkey,
-				Amount:      depositMsg.Amount,
-				Signature:   signature,
-				Credentials: depositMsg.Credentials,
-			}
-
 			//#nosec:G703 // Ignore errors on this line.
 			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
 			if outputDocument == "" {
@@ -133,7 +75,7 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 				}
 			}
 
-			if err = writeDepositToFile(outputDocument, &deposit); err != nil {
+			if err = writeDepositToFile(outputDocument, deposit); err != nil {
 				return errors.Wrap(err, "failed to write signed gen tx")
 			}
 
@@ -147,6 +89,74 @@ func AddGenesisDepositCmd(cs common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
+// signGenesisDeposit signs a deposit of the amount given by the deposit
+// amount flag with the BLS key of the node.
+func signGenesisDeposit(
+	cmd *cobra.Command,
+	cs common.ChainSpec,
+) (*types.Deposit, error) {
+	// Get the BLS signer.
+	blsSigner, err := components.ProvideBlsSigner(
+		components.BlsSignerInput{
+			AppOpts: context.GetViperFromCmd(cmd),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the deposit amount.
+	depositAmountString, err := cmd.Flags().GetString(depositAmountFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	depositAmount, err := parser.ConvertAmount(depositAmountString)
+	if err != nil {
+		return nil, err
+	}
+
+	forkData := genesisForkData()
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData,
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		// TODO: configurable.
+		types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{},
+		),
+		depositAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the deposit message.
+	if err = depositMsg.VerifyCreateValidator(
+		forkData,
+		signature,
+		cs.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+		Credentials: depositMsg.Credentials,
+	}, nil
+}
+
+// genesisForkData returns the fork data genesis deposits are signed over.
+func genesisForkData() *types.ForkData {
+	// TODO: configurable.
+	return types.NewForkData(
+		version.FromUint32[common.Version](version.Deneb), common.Root{},
+	)
+}
+
 func makeOutputFilepath(rootDir, pubkey string) (string, error) {
 	writePath := filepath.Join(rootDir, "config", "premined-deposits")
 	if err := afero.NewOsFs().MkdirAll(writePath, os.ModePerm); err != nil {
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrDuplicatePacket is returned when two genesis packets, or a packet
+	// and a deposit already in the genesis file, share a public key.
+	ErrDuplicatePacket = errors.New("duplicate validator in genesis packets")
+
+	// ErrInvalidPacketAmount is returned when the deposit of a genesis
+	// packet is below the ejection balance or above the maximum effective
+	// balance.
+	ErrInvalidPacketAmount = errors.New("invalid genesis packet amount")
+
+	// ErrMismatchedExecutionBlockHash is returned when a genesis packet was
+	// made for another execution genesis block than the rest.
+	ErrMismatchedExecutionBlockHash = errors.New(
+		"mismatched execution genesis block hash",
+	)
+
+	// ErrUnknownValidator is returned when customizing a validator that has
+	// no deposit in the genesis file.
+	ErrUnknownValidator = errors.New("unknown genesis validator")
+
+	// ErrInvalidValidatorBalance is returned when the balance set for a
+	// genesis validator is not above the ejection balance.
+	ErrInvalidValidatorBalance = errors.New("invalid genesis validator balance")
+)
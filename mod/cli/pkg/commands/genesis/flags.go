@@ -24,4 +24,15 @@ const (
 	depositAmountFlag    = "deposit-amount"
 	defaultDepositAmount = "32000000000" // 32e9
 	depositAmountFlagMsg = "The amount of deposit to be made"
+
+	executionBlockHashFlag    = "execution-block-hash"
+	executionBlockHashFlagMsg = "The hash of the execution genesis block " +
+		"the packet commits to"
+	outputDocumentFlagMsg = "The file to write the genesis packet to"
+
+	balanceFlag    = "balance"
+	balanceFlagMsg = "The initial balance of the validator, in Gwei, in " +
+		"place of the sum of its deposits"
+	activationEpochFlag    = "activation-epoch"
+	activationEpochFlagMsg = "The epoch the validator is activated at"
 )
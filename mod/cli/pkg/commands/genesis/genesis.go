@@ -44,6 +44,9 @@ func Commands(
 	cmd.AddCommand(
 		AddGenesisDepositCmd(cs),
 		CollectGenesisDepositsCmd(),
+		CreatePacketCmd(cs),
+		CollectPacketsCmd(cs),
+		SetGenesisValidatorCmd(cs),
 		AddExecutionPayloadCmd(cs),
 		GetGenesisValidatorRootCmd(cs),
 	)
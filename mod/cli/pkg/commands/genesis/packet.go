@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// packetsDir is the directory, relative to the node home, that genesis
+// packets are written to and collected from by default.
+const packetsDir = "genesis-packets"
+
+// Packet is what an operator contributes to a multi-party genesis: the
+// signed deposit of their validator and the hash of the execution genesis
+// block they agreed to launch with.
+type Packet struct {
+	// Deposit is the signed deposit of the validator.
+	Deposit *types.Deposit `json:"deposit"`
+	// ExecutionBlockHash is the hash of the execution genesis block. It is
+	// zero if the operator did not commit to one.
+	ExecutionBlockHash common.ExecutionHash `json:"execution_block_hash"`
+}
+
+// CreatePacketCmd returns the cobra command an operator runs to produce the
+// genesis packet of their validator.
+func CreatePacketCmd(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-packet",
+		Short: "creates the signed genesis packet of this node's validator",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := context.GetConfigFromCmd(cmd)
+
+			if _, _, err := genutil.InitializeNodeValidatorFiles(
+				config, crypto.CometBLSType,
+			); err != nil {
+				return errors.Wrap(
+					err,
+					"failed to initialize commands validator files",
+				)
+			}
+
+			deposit, err := signGenesisDeposit(cmd, cs)
+			if err != nil {
+				return err
+			}
+
+			packet := &Packet{Deposit: deposit}
+			blockHash, err := cmd.Flags().GetString(executionBlockHashFlag)
+			if err != nil {
+				return err
+			}
+			if blockHash != "" {
+				if err = packet.ExecutionBlockHash.UnmarshalText(
+					[]byte(blockHash),
+				); err != nil {
+					return errors.Wrap(err, "invalid execution block hash")
+				}
+			}
+
+			//#nosec:G703 // Ignore errors on this line.
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				dir := filepath.Join(config.RootDir, "config", packetsDir)
+				err = afero.NewOsFs().MkdirAll(dir, os.ModePerm)
+				if err != nil {
+					return errors.Wrapf(err, "could not create %q", dir)
+				}
+				outputDocument = filepath.Join(
+					dir, fmt.Sprintf("packet-%v.json", deposit.Pubkey),
+				)
+			}
+
+			if err = writePacketToFile(outputDocument, packet); err != nil {
+				return errors.Wrap(err, "failed to write genesis packet")
+			}
+			cmd.Printf("Wrote genesis packet to %s\n", outputDocument)
+			return nil
+		},
+	}
+
+	cmd.Flags().
+		String(depositAmountFlag, defaultDepositAmount, depositAmountFlagMsg)
+	cmd.Flags().String(
+		executionBlockHashFlag, "", executionBlockHashFlagMsg,
+	)
+	cmd.Flags().String(flags.FlagOutputDocument, "", outputDocumentFlagMsg)
+
+	return cmd
+}
+
+// CollectPacketsCmd returns the cobra command a coordinator runs to merge
+// the genesis packets of all operators into the genesis file.
+func CollectPacketsCmd(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-packets [packets-dir]",
+		Short: "validates genesis packets and merges them into genesis",
+		Long: `Validates the genesis packets in the given directory, which
+defaults to config/genesis-packets, and appends their deposits to the genesis
+file. Packets must carry a valid signature, a unique public key, an amount
+between the ejection balance and the maximum effective balance, and commit to
+the same execution genesis block as each other and the genesis file.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+
+			dir := filepath.Join(config.RootDir, "config", packetsDir)
+			if len(args) == 1 {
+				dir = args[0]
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(
+				config.GenesisFile(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+				appGenesis,
+			)
+			if err != nil {
+				return err
+			}
+
+			genesisInfo := &types.Genesis[
+				*types.Deposit,
+				*types.ExecutionPayloadHeader,
+			]{}
+			if err = json.Unmarshal(
+				appGenesisState["beacon"], genesisInfo,
+			); err != nil {
+				return errors.Wrap(err, "failed to unmarshal beacon genesis")
+			}
+
+			packets, err := readPackets(dir)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis packets")
+			}
+
+			var blockHash common.ExecutionHash
+			if header := genesisInfo.ExecutionPayloadHeader; header != nil {
+				blockHash = header.GetBlockHash()
+			}
+			if err = validatePackets(
+				cs, packets, genesisInfo.Deposits, blockHash,
+			); err != nil {
+				return err
+			}
+
+			for _, packet := range packets {
+				//#nosec:G701 // won't realistically overflow.
+				packet.Deposit.Index = uint64(len(genesisInfo.Deposits))
+				genesisInfo.Deposits = append(
+					genesisInfo.Deposits, packet.Deposit,
+				)
+			}
+
+			appGenesisState["beacon"], err = json.Marshal(genesisInfo)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal beacon genesis")
+			}
+
+			if appGenesis.AppState, err = json.MarshalIndent(
+				appGenesisState, "", "  ",
+			); err != nil {
+				return err
+			}
+
+			cmd.Printf("Merged %d genesis packets\n", len(packets))
+			return genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
+		},
+	}
+
+	return cmd
+}
+
+// validatePackets checks the packets against each other and against the
+// deposits and execution block hash already in the genesis file.
+func validatePackets(
+	cs common.ChainSpec,
+	packets []*Packet,
+	existing []*types.Deposit,
+	blockHash common.ExecutionHash,
+) error {
+	seen := make(map[crypto.BLSPubkey]struct{}, len(existing)+len(packets))
+	for _, deposit := range existing {
+		seen[deposit.Pubkey] = struct{}{}
+	}
+
+	minAmount := math.Gwei(cs.EjectionBalance())
+	maxAmount := math.Gwei(cs.MaxEffectiveBalance())
+	for _, packet := range packets {
+		deposit := packet.Deposit
+		if deposit == nil {
+			return errors.New("genesis packet has no deposit")
+		}
+
+		if _, ok := seen[deposit.Pubkey]; ok {
+			return errors.Wrapf(ErrDuplicatePacket, "%s", deposit.Pubkey)
+		}
+		seen[deposit.Pubkey] = struct{}{}
+
+		if deposit.Amount < minAmount || deposit.Amount > maxAmount {
+			return errors.Wrapf(
+				ErrInvalidPacketAmount,
+				"%s deposits %d, want between %d and %d",
+				deposit.Pubkey, deposit.Amount, minAmount, maxAmount,
+			)
+		}
+
+		if err := deposit.VerifySignature(
+			genesisForkData(),
+			cs.DomainTypeDeposit(),
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			return errors.Wrapf(err, "invalid signature of %s", deposit.Pubkey)
+		}
+
+		switch {
+		case packet.ExecutionBlockHash == (common.ExecutionHash{}):
+		case blockHash == (common.ExecutionHash{}):
+			blockHash = packet.ExecutionBlockHash
+		case packet.ExecutionBlockHash != blockHash:
+			return errors.Wrapf(
+				ErrMismatchedExecutionBlockHash,
+				"%s committed to %s, want %s",
+				deposit.Pubkey, packet.ExecutionBlockHash, blockHash,
+			)
+		}
+	}
+	return nil
+}
+
+// readPackets reads the genesis packets in the given directory, in the order
+// of their file names.
+func readPackets(dir string) ([]*Packet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	packets := make([]*Packet, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		var bz []byte
+		bz, err = afero.ReadFile(
+			afero.NewOsFs(), filepath.Join(dir, entry.Name()),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		packet := &Packet{}
+		if err = json.Unmarshal(bz, packet); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", entry.Name())
+		}
+		packets = append(packets, packet)
+	}
+	return packets, nil
+}
+
+func writePacketToFile(outputDocument string, packet *Packet) error {
+	bz, err := json.MarshalIndent(packet, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	//#nosec:G302,G304 // Ignore errors on this line.
+	outputFile, err := afero.NewOsFs().OpenFile(
+		outputDocument,
+		os.O_CREATE|os.O_EXCL|os.O_WRONLY,
+		0o644, //nolint:mnd // file permissions.
+	)
+	if err != nil {
+		return err
+	}
+
+	//#nosec:G307 // Ignore errors on this line.
+	defer outputFile.Close()
+
+	_, err = fmt.Fprintf(outputFile, "%s\n", bz)
+	return err
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+// SetGenesisValidatorCmd returns the cobra command to customize the balance
+// and activation epoch of a validator of the genesis file.
+func SetGenesisValidatorCmd(cs common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator [pubkey]",
+		Short: "sets the balance and activation epoch of a genesis validator",
+		Long: `Sets the balance and activation epoch of a validator whose
+deposit is already in the genesis file. The validator is activated at the given
+epoch whatever its effective balance, and starts with the given balance in
+place of the sum of its deposits, if one is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := context.GetConfigFromCmd(cmd)
+
+			var pubkey crypto.BLSPubkey
+			if err := pubkey.UnmarshalText([]byte(args[0])); err != nil {
+				return errors.Wrap(err, "invalid validator public key")
+			}
+
+			validator, err := genesisValidatorFromFlags(cmd, cs, pubkey)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(
+				config.GenesisFile(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to read genesis doc from file")
+			}
+
+			appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+				appGenesis,
+			)
+			if err != nil {
+				return err
+			}
+
+			genesisInfo := &types.Genesis[
+				*types.Deposit,
+				*types.ExecutionPayloadHeader,
+			]{}
+			if err = json.Unmarshal(
+				appGenesisState["beacon"], genesisInfo,
+			); err != nil {
+				return errors.Wrap(err, "failed to unmarshal beacon genesis")
+			}
+
+			if err = setGenesisValidator(genesisInfo, validator); err != nil {
+				return err
+			}
+
+			appGenesisState["beacon"], err = json.Marshal(genesisInfo)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal beacon genesis")
+			}
+
+			if appGenesis.AppState, err = json.MarshalIndent(
+				appGenesisState, "", "  ",
+			); err != nil {
+				return err
+			}
+
+			return genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
+		},
+	}
+
+	cmd.Flags().String(balanceFlag, "", balanceFlagMsg)
+	cmd.Flags().Uint64(activationEpochFlag, 0, activationEpochFlagMsg)
+
+	return cmd
+}
+
+// genesisValidatorFromFlags returns the customized validator of the given
+// public key described by the flags of the command.
+func genesisValidatorFromFlags(
+	cmd *cobra.Command,
+	cs common.ChainSpec,
+	pubkey crypto.BLSPubkey,
+) (*transition.GenesisValidator, error) {
+	validator := &transition.GenesisValidator{Pubkey: pubkey}
+
+	balance, err := cmd.Flags().GetString(balanceFlag)
+	if err != nil {
+		return nil, err
+	}
+	if balance != "" {
+		if validator.Balance, err = parser.ConvertAmount(balance); err != nil {
+			return nil, err
+		}
+		minBalance := math.Gwei(cs.EjectionBalance())
+		if validator.Balance <= minBalance {
+			return nil, errors.Wrapf(
+				ErrInvalidValidatorBalance,
+				"%s has a balance of %d, want above %d",
+				pubkey, validator.Balance, minBalance,
+			)
+		}
+	}
+
+	activationEpoch, err := cmd.Flags().GetUint64(activationEpochFlag)
+	if err != nil {
+		return nil, err
+	}
+	validator.ActivationEpoch = math.Epoch(activationEpoch)
+	return validator, nil
+}
+
+// setGenesisValidator adds the customized validator to the genesis, or
+// replaces the one of the same public key. The validator must have a deposit
+// in the genesis.
+func setGenesisValidator(
+	genesis *types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader],
+	validator *transition.GenesisValidator,
+) error {
+	var found bool
+	for _, deposit := range genesis.Deposits {
+		if deposit.Pubkey == validator.Pubkey {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.Wrapf(
+			ErrUnknownValidator, "no genesis deposit for %s", validator.Pubkey,
+		)
+	}
+
+	for i, existing := range genesis.Validators {
+		if existing.Pubkey == validator.Pubkey {
+			genesis.Validators[i] = validator
+			return nil
+		}
+	}
+	genesis.Validators = append(genesis.Validators, validator)
+	return nil
+}
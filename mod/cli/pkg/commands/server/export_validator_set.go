@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// NewExportValidatorSetCmd creates a command that exports the active
+// validator set of a committed state.
+func NewExportValidatorSetCmd[
+	T interface {
+		Start(context.Context) error
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	var (
+		slot           int64
+		format, output string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export-validator-set",
+		Short: "Export the active validator set of a committed state",
+		Long: `Export the validators active at a slot, with their balances.
+
+With --format genesis, the validators are written as the deposits of a
+beacon genesis, in place of app_state.beacon.deposits, so that a forked
+network starts with the same validator distribution. The deposits are not
+signed, as the keys of the validators are not available: they are meant for
+networks whose genesis deposits are re-signed, or whose validators are
+replaced, before launch. With --format json, the validators are listed with
+their index, effective balance and balance.
+
+The state of the slot must still be retained by the application store. The
+node must be stopped. The CometBFT node is not started.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if format != cometbft.ValidatorSetFormatGenesis &&
+				format != cometbft.ValidatorSetFormatJSON {
+				return fmt.Errorf(
+					"%w: %q", cometbft.ErrUnsupportedValidatorSetFormat, format,
+				)
+			}
+
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			v := clicontext.GetViperFromCmd(cmd)
+			v.Set(FlagExportValidatorSet, true)
+			v.Set(FlagExportValidatorSetSlot, slot)
+			v.Set(FlagExportValidatorSetFormat, format)
+			v.Set(FlagExportValidatorSetOutput, output)
+
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+
+			err = appCreator(logger, db, nil, cfg, v).Start(cmd.Context())
+			if errors.Is(err, cometbft.ErrValidatorSetExported) {
+				return nil
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().Int64Var(
+		&slot, "slot", 0,
+		"slot to export the validators of, defaults to the latest",
+	)
+	cmd.Flags().StringVar(
+		&format, "format", cometbft.ValidatorSetFormatGenesis,
+		"format of the export, genesis or json",
+	)
+	cmd.Flags().StringVar(
+		&output, "output", "", "file to write to, defaults to stdout",
+	)
+	addStartNodeFlags(cmd, StartCmdOptions[T]{})
+	return cmd
+}
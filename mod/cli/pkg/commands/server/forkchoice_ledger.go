@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ledger"
+	"github.com/spf13/cobra"
+)
+
+// flagLimit is the flag that limits the dump to the most recent entries.
+const flagLimit = "limit"
+
+// NewForkchoiceLedgerCmd creates a command that dumps the forkchoice ledger,
+// the record of the forkchoiceUpdated calls made to the execution client.
+func NewForkchoiceLedgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forkchoice-ledger",
+		Short: "Dump the forkchoiceUpdated calls made to the execution client",
+		Long: `Print the entries of the forkchoice ledger as JSON lines, oldest
+first. Each entry holds the forkchoice state and the hash of the payload
+attributes sent to the execution client, and the status it returned or the
+error of the call. The ledger may be dumped while the node is running.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			l, err := ledger.Open(
+				filepath.Join(cfg.RootDir, "data", ledger.FileName), 0,
+			)
+			if err != nil {
+				return err
+			}
+			defer l.Close()
+
+			entries, err := l.Entries()
+			if err != nil {
+				return err
+			}
+			limit, _ := cmd.Flags().GetUint64(flagLimit)
+			if limit != 0 && uint64(len(entries)) > limit {
+				entries = entries[uint64(len(entries))-limit:]
+			}
+
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			for _, entry := range entries {
+				if err = enc.Encode(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(
+		flagLimit, 0, "print only the most recent entries (0 prints all)",
+	)
+	return cmd
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// flagCodec is the flag that selects the codec blob sidecars are rewritten
+// with.
+const flagCodec = "codec"
+
+// NewRecompressBlobsCmd creates a command that rewrites the blob sidecars on
+// disk with the configured compression codec.
+func NewRecompressBlobsCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recompress-blobs",
+		Short: "Rewrite the blob sidecars on disk with the configured codec",
+		Long: `Rewrite every blob sidecar on disk that is not compressed with
+the codec configured in app.toml, or the codec given by the --codec flag.
+
+Each sidecar is written to a temporary file that replaces the original, so
+the command may run in the background of a running node. Sidecars the node
+writes in the meantime use the codec the node was started with.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			v := clicontext.GetViperFromCmd(cmd)
+
+			name := cast.ToString(v.Get(flags.CompressionCodec))
+			if cmd.Flags().Changed(flagCodec) {
+				name, _ = cmd.Flags().GetString(flagCodec)
+			}
+			codec, err := compression.ParseCodec(name)
+			if err != nil {
+				return err
+			}
+			compressor, err := compression.NewCompressor(codec)
+			if err != nil {
+				return err
+			}
+
+			rootDir := filepath.Join(cfg.RootDir, "data", "blobs")
+			logger.Info(
+				"Recompressing blob sidecars",
+				"dir", rootDir, "codec", codec.String(),
+			)
+			n, err := filedb.NewDB(
+				filedb.WithRootDirectory(rootDir),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(logger),
+				filedb.WithCompressor(compressor),
+			).Recompress(cmd.Context())
+			if err != nil {
+				return err
+			}
+			logger.Info("Recompressed blob sidecars", "rewritten", n)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		flagCodec, "", "codec to rewrite with (none, snappy or zstd)",
+	)
+	return cmd
+}
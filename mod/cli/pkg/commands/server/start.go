@@ -23,6 +23,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
@@ -48,8 +49,47 @@ const (
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
+
+	// FlagABCIRecordPath is the file ABCI exchanges are recorded to.
+	FlagABCIRecordPath = "abci-record-path"
+	// FlagABCIReplayPath is the recording replayed by `abci-replay`. It is
+	// set by the command rather than exposed as a flag.
+	FlagABCIReplayPath = "abci-replay-path"
+	// FlagVerifyChain and the flags following it configure a `verify-chain`
+	// run. They are set by the command rather than exposed on start.
+	FlagVerifyChain      = "verify-chain"
+	FlagVerifyChainFrom  = "verify-chain-from"
+	FlagVerifyChainTo    = "verify-chain-to"
+	FlagVerifyChainPrune = "verify-chain-prune"
+	// FlagAuditRegistry makes the node audit the validator registry. It is
+	// set by `audit-registry` rather than exposed on start.
+	FlagAuditRegistry = "audit-registry"
+	// FlagExportValidatorSet and the flags following it configure an
+	// `export-validator-set` run. They are set by the command rather than
+	// exposed on start.
+	FlagExportValidatorSet       = "export-validator-set"
+	FlagExportValidatorSetSlot   = "export-validator-set-slot"
+	FlagExportValidatorSetFormat = "export-validator-set-format"
+	FlagExportValidatorSetOutput = "export-validator-set-output"
+	// FlagUpgradeName and FlagUpgradeHeight schedule a binary upgrade. The
+	// node halts before processing the upgrade height unless the running
+	// binary implements the upgrade.
+	FlagUpgradeName   = "upgrade-name"
+	FlagUpgradeHeight = "upgrade-height"
+	// FlagReplicaCheckpointDir and FlagReplicaCheckpointInterval make the
+	// node write checkpoints of its application database for read replicas.
+	FlagReplicaCheckpointDir      = "replica-checkpoint-dir"
+	FlagReplicaCheckpointInterval = "replica-checkpoint-interval"
+	// FlagReplicaDir makes the node a read replica serving the checkpoints
+	// of a primary node, looking for a newer one every FlagReplicaRefresh.
+	FlagReplicaDir     = "replica-dir"
+	FlagReplicaRefresh = "replica-refresh"
 )
 
+// defaultReplicaCheckpointInterval is the default number of blocks between
+// two checkpoints for read replicas.
+const defaultReplicaCheckpointInterval = 10
+
 // StartCmdOptions defines options that can be customized in
 // `StartCmdWithOptions`,.
 type StartCmdOptions[
@@ -113,14 +153,20 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 				return err
 			}
 
-			// Open the Database
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			// Open the Database, or the latest checkpoint of the primary
+			// when running as a read replica.
+			var appDB dbm.DB
+			if dir := v.GetString(FlagReplicaDir); dir != "" {
+				appDB, err = db.OpenReplicaDB(dir)
+			} else {
+				appDB, err = db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			}
 			if err != nil {
 				return err
 			}
 
 			// Create the application.
-			return appCreator(logger, db, nil, cfg, v).
+			return appCreator(logger, appDB, nil, cfg, v).
 				Start(cmd.Context())
 		},
 	}
@@ -181,6 +227,41 @@ func addStartNodeFlags[
 			"Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().
 		Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().
+		String(
+			FlagABCIRecordPath,
+			"",
+			"File to record PrepareProposal, ProcessProposal and FinalizeBlock exchanges to (disabled if empty)")
+	cmd.Flags().
+		String(
+			FlagUpgradeName,
+			"",
+			"Name of the scheduled binary upgrade (disabled if empty)")
+	cmd.Flags().
+		Int64(
+			FlagUpgradeHeight,
+			0,
+			"First block height processed by the upgraded binary")
+	cmd.Flags().
+		String(
+			FlagReplicaCheckpointDir,
+			"",
+			"Directory to write application database checkpoints for read replicas to (disabled if empty)")
+	cmd.Flags().
+		Uint64(
+			FlagReplicaCheckpointInterval,
+			defaultReplicaCheckpointInterval,
+			"Number of blocks between two checkpoints for read replicas")
+	cmd.Flags().
+		String(
+			FlagReplicaDir,
+			"",
+			"Checkpoint directory of a primary node to serve as a read replica of, without running CometBFT (disabled if empty)")
+	cmd.Flags().
+		Duration(
+			FlagReplicaRefresh,
+			2*time.Second,
+			"Interval at which a read replica looks for a newer checkpoint")
 
 	// add support for all CometBFT-specific command line options
 	cmtcmd.AddNodeFlags(cmd)
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyChainCmd creates a command that checks the integrity of the
+// stored chain.
+func NewVerifyChainCmd[
+	T interface {
+		Start(context.Context) error
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	var (
+		from, to int64
+		prune    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify-chain",
+		Short: "Verify the integrity of the stored chain",
+		Long: `Walk the CometBFT block store and check, for every block, that:
+
+  - it links to the previous block and carries a commit signed by the
+    validator set of its height;
+  - its beacon block decodes and its parent root matches the previous
+    beacon block;
+  - its state root matches the beacon state stored for its height, for
+    heights the application store still retains.
+
+Every inconsistency is reported. With --prune, the blocks from the first
+corrupt one up to the tip are removed, provided neither the application nor
+CometBFT has applied them yet; corrupt blocks that were already applied must
+be recovered with rollback.
+
+The node must be stopped. The CometBFT node is not started.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			v := clicontext.GetViperFromCmd(cmd)
+			v.Set(FlagVerifyChain, true)
+			v.Set(FlagVerifyChainFrom, from)
+			v.Set(FlagVerifyChainTo, to)
+			v.Set(FlagVerifyChainPrune, prune)
+
+			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			if err != nil {
+				return err
+			}
+
+			err = appCreator(logger, db, nil, cfg, v).Start(cmd.Context())
+			if errors.Is(err, cometbft.ErrChainVerified) {
+				return nil
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().Int64Var(
+		&from, "from", 0, "first height to verify, defaults to the oldest",
+	)
+	cmd.Flags().Int64Var(
+		&to, "to", 0, "last height to verify, defaults to the latest",
+	)
+	cmd.Flags().BoolVar(
+		&prune, "prune", false,
+		"remove corrupt blocks not yet applied from the tip of the store",
+	)
+	addStartNodeFlags(cmd, StartCmdOptions[T]{})
+	return cmd
+}
@@ -21,11 +21,15 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/components"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/store"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/validatorclient"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	cmtcli "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -47,11 +51,24 @@ func DefaultRootCommandSetup[
 	mm *cometbft.Service[LoggerT],
 	appCreator servertypes.AppCreator[T, LoggerT],
 	chainSpec common.ChainSpec,
+	graphFn components.GraphFunc,
 ) {
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `abci-replay`
+		server.NewABCIReplayCmd(appCreator),
+		// `audit-registry`
+		server.NewAuditRegistryCmd(appCreator),
 		// `comet`
 		cmtcli.Commands(appCreator),
+		// `components`
+		components.Commands(graphFn),
+		// `debug`
+		debug.Commands(),
+		// `export-validator-set`
+		server.NewExportValidatorSetCmd(appCreator),
+		// `forkchoice-ledger`
+		server.NewForkchoiceLedgerCmd(),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
@@ -60,6 +77,10 @@ func DefaultRootCommandSetup[
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `migrate-store`
+		store.NewMigrateStoreCmd[LoggerT](),
+		// `recompress-blobs`
+		server.NewRecompressBlobsCmd[LoggerT](),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
@@ -68,6 +89,12 @@ func DefaultRootCommandSetup[
 		}),
 		// `status`
 		cmtcli.StatusCommand(),
+		// `validator`
+		deposit.ValidatorCommands(chainSpec),
+		// `validator-client`
+		validatorclient.NewCommand[LoggerT](chainSpec),
+		// `verify-chain`
+		server.NewVerifyChainCmd(appCreator),
 		// `version`
 		version.NewVersionCommand(),
 	)
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/spf13/cobra"
+)
+
+// ErrUnexpectedSchemaVersion is returned when a store is not at the version
+// the operator expected to migrate from.
+var ErrUnexpectedSchemaVersion = errors.New("unexpected store schema version")
+
+// NewMigrateStoreCmd creates a command that migrates the stores of a stopped
+// node to a version of their schema.
+func NewMigrateStoreCmd[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() *cobra.Command {
+	var (
+		stores     []string
+		from, to   string
+		verifyOnly bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the node stores to a schema version",
+		Long: `Apply the schema migrations of the deposit store and the event journal
+without starting the node, so that large stores can be upgraded ahead of a
+release instead of on its first startup.
+
+Every store is backed up before its first migration and restored from the
+backup if one fails. The version is recorded after every migration that
+completes, so an interrupted run resumes where it stopped. Stores are
+verified once migrated, and --verify-only checks them without migrating.
+
+The beacon state is not migrated here: it is part of the application state,
+and changes to its layout are made at a fork.
+
+The node must be stopped.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd[LoggerT](cmd)
+			homeDir := clicontext.GetConfigFromCmd(cmd).RootDir
+			v := clicontext.GetViperFromCmd(cmd)
+			cfg, err := config.ReadConfigFromAppOpts(v)
+			if err != nil {
+				return err
+			}
+			cipher, err := components.ProvideEncryptionCipher(
+				components.EncryptionCipherInput{AppOpts: v, Config: cfg},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, db := range components.StoreDBs() {
+				if len(stores) > 0 && !slices.Contains(stores, db.Name) {
+					continue
+				}
+				if err = migrateStoreDB(
+					logger, homeDir, cfg.Namespace.Name, db, cipher,
+					from, to, verifyOnly,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(
+		&stores, "store", nil,
+		"stores to migrate (deposits, events), defaults to all",
+	)
+	cmd.Flags().StringVar(
+		&from, "from", "",
+		"version the stores are expected to be at (e.g. v1), not checked "+
+			"by default",
+	)
+	cmd.Flags().StringVar(
+		&to, "to", "", "version to migrate to (e.g. v2), defaults to the latest",
+	)
+	cmd.Flags().BoolVar(
+		&verifyOnly, "verify-only", false,
+		"verify the stores at their current version without migrating",
+	)
+	return cmd
+}
+
+// parseVersion parses a schema version given as "v2" or "2", and reports
+// whether one was given at all.
+func parseVersion(s string) (uint64, bool, error) {
+	if s == "" {
+		return 0, false, nil
+	}
+	version, err := strconv.ParseUint(strings.TrimPrefix(s, "v"), 10, 64)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "invalid schema version %q", s)
+	}
+	return version, true, nil
+}
+
+// migrateStoreDB migrates the store db under homeDir, with its keys under
+// the namespace ns, to version to, or to the latest version if to is empty,
+// and verifies it.
+func migrateStoreDB[LoggerT log.AdvancedLogger[LoggerT]](
+	logger LoggerT,
+	homeDir string,
+	ns string,
+	db components.StoreDB,
+	cipher *encryption.Cipher,
+	from, to string,
+	verifyOnly bool,
+) error {
+	expected, checkFrom, err := parseVersion(from)
+	if err != nil {
+		return err
+	}
+	target, hasTarget, err := parseVersion(to)
+	if err != nil {
+		return err
+	}
+
+	logger = logger.With("store", db.Name)
+	exists, err := db.Exists(homeDir)
+	if err != nil || !exists {
+		if err == nil {
+			logger.Info("Store not found, skipping")
+		}
+		return err
+	}
+
+	kvp, err := db.Open(homeDir, components.StoreDBOptions{
+		Namespace: ns,
+		Cipher:    cipher,
+	})
+	if err != nil {
+		return err
+	}
+	defer kvp.Close()
+
+	migrator, err := manager.NewMigrator(
+		logger, db.Name, db.Dir(homeDir), db.Migrations...,
+	)
+	if err != nil {
+		return err
+	}
+	version, _, err := manager.SchemaVersion(kvp)
+	if err != nil {
+		return err
+	}
+	if checkFrom && version != expected {
+		return errors.Wrapf(
+			ErrUnexpectedSchemaVersion,
+			"store %s is at version %d, expected %d",
+			db.Name, version, expected,
+		)
+	}
+
+	if !verifyOnly {
+		if !hasTarget {
+			target = migrator.LatestVersion()
+		}
+		if err = migrator.MigrateTo(kvp, target); err != nil {
+			return err
+		}
+	}
+	if err = migrator.Verify(kvp); err != nil {
+		return err
+	}
+
+	if version, _, err = manager.SchemaVersion(kvp); err != nil {
+		return err
+	}
+	logger.Info("Store verified", "version", version)
+	return nil
+}
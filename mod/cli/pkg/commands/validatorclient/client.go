@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatorclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// apiClient is a minimal client of the Beacon API of a beacon-kit node,
+// covering the requests the validator client performs.
+type apiClient struct {
+	// url is the base URL of the Beacon API.
+	url string
+	// client is the HTTP client used for the requests.
+	client *http.Client
+}
+
+// newAPIClient creates a new Beacon API client for the node at url.
+func newAPIClient(url string, timeout time.Duration) *apiClient {
+	return &apiClient{
+		url:    strings.TrimSuffix(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the chain the
+// node follows.
+func (c *apiClient) GenesisValidatorsRoot(
+	ctx context.Context,
+) (common.Root, error) {
+	var res struct {
+		Data struct {
+			GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+		} `json:"data"`
+	}
+	err := c.do(ctx, http.MethodGet, "/eth/v1/beacon/genesis", nil, &res)
+	return res.Data.GenesisValidatorsRoot, err
+}
+
+// HeadSlot returns the slot of the head block of the node.
+func (c *apiClient) HeadSlot(ctx context.Context) (math.Slot, error) {
+	var res struct {
+		Data struct {
+			Header struct {
+				Message struct {
+					Slot math.Slot `json:"slot"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	err := c.do(ctx, http.MethodGet, "/eth/v1/beacon/headers/head", nil, &res)
+	return res.Data.Header.Message.Slot, err
+}
+
+// ValidatorIndex returns the index of the validator with the given pubkey in
+// the head state of the node.
+func (c *apiClient) ValidatorIndex(
+	ctx context.Context, pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	var res struct {
+		Data struct {
+			Index uint64 `json:"index,string"`
+		} `json:"data"`
+	}
+	err := c.do(
+		ctx, http.MethodGet,
+		"/eth/v1/beacon/states/head/validators/"+pubkey.String(), nil, &res,
+	)
+	if errors.Is(err, errNotFound) {
+		return 0, errors.Wrap(ErrValidatorNotFound, pubkey.String())
+	}
+	return math.ValidatorIndex(res.Data.Index), err
+}
+
+// IsLive returns whether the validator with the given index performed its
+// duties in the given epoch.
+func (c *apiClient) IsLive(
+	ctx context.Context, epoch math.Epoch, index math.ValidatorIndex,
+) (bool, error) {
+	var res struct {
+		Data []struct {
+			IsLive bool `json:"is_live"`
+		} `json:"data"`
+	}
+	if err := c.do(
+		ctx, http.MethodPost,
+		"/eth/v1/validator/liveness/"+epoch.Base10(),
+		[]string{index.Base10()}, &res,
+	); err != nil {
+		return false, err
+	}
+	return len(res.Data) == 1 && res.Data[0].IsLive, nil
+}
+
+// do performs a request against the Beacon API, encoding body and decoding
+// the response into out.
+func (c *apiClient) do(
+	ctx context.Context, method, path string, body, out any,
+) error {
+	var reqBody io.Reader
+	if body != nil {
+		bz, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(bz)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return errNotFound
+	default:
+		return errors.Wrapf(
+			ErrUnexpectedStatus, "%s %s: %d", method, path, res.StatusCode,
+		)
+	}
+
+	bz, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, out)
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatorclient
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// livenessBackend reports the validators in live as live in every epoch.
+type livenessBackend struct {
+	live map[math.ValidatorIndex]bool
+}
+
+func (b *livenessBackend) AttestationDataAtSlot(
+	math.Slot, uint64,
+) (*types.AttestationData, error) {
+	return nil, nil
+}
+
+func (b *livenessBackend) ValidatorLiveness(
+	_ math.Epoch, indices []math.ValidatorIndex,
+) ([]*types.ValidatorLiveness, error) {
+	liveness := make([]*types.ValidatorLiveness, len(indices))
+	for i, index := range indices {
+		liveness[i] = &types.ValidatorLiveness{
+			Index:  index.Unwrap(),
+			IsLive: b.live[index],
+		}
+	}
+	return liveness, nil
+}
+
+// newTestClient returns a client of a node API serving the validator
+// handlers backed by backend.
+func newTestClient(t *testing.T, backend validator.Backend) *apiClient {
+	t.Helper()
+	var (
+		logger  = noop.NewLogger[log.Logger]()
+		engine  = echo.NewEngineFactory("").NewEngine(server.ProfileConfig{})
+		handler = validator.NewHandler[echo.Context](backend)
+	)
+	handler.RegisterRoutes(logger)
+	engine.RegisterRoutes(handler.RouteSet(), logger)
+
+	e, ok := engine.(*echo.Engine)
+	require.True(t, ok)
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	return newAPIClient(srv.URL, time.Second)
+}
+
+func TestIsLive(t *testing.T) {
+	client := newTestClient(t, &livenessBackend{
+		live: map[math.ValidatorIndex]bool{1: true},
+	})
+
+	live, err := client.IsLive(context.Background(), 3, 1)
+	require.NoError(t, err)
+	require.True(t, live)
+
+	live, err = client.IsLive(context.Background(), 3, 2)
+	require.NoError(t, err)
+	require.False(t, live)
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatorclient
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagNodeAPI is the address of the Beacon API of the beacon node.
+	FlagNodeAPI = "node-api"
+	// FlagSignerAddr is the address the beacon node listens on for its
+	// validator client, i.e. its priv_validator_laddr.
+	FlagSignerAddr = "signer-addr"
+	// FlagPollInterval is the interval at which the duties are checked.
+	FlagPollInterval = "poll-interval"
+
+	// dialTimeout bounds the reads and writes on the signer connection.
+	dialTimeout = 3 * time.Second
+)
+
+// NewCommand creates the `validator-client` command, which runs a validator
+// client against a remote beacon node. The validator client holds the key of
+// the validator and signs the blocks and votes of the node, which connects
+// to it through its priv_validator_laddr, so that the key is kept off the
+// machines running the beacon and execution nodes.
+func NewCommand[
+	LoggerT log.AdvancedLogger[LoggerT],
+](chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-client",
+		Short: "Runs a validator client against a remote beacon node",
+		Long: `Runs a validator client holding the validator key of this home
+directory. The beacon node must set priv_validator_laddr to the signer
+address, and expose its Beacon API to the validator client.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var (
+				cfg    = clicontext.GetConfigFromCmd(cmd)
+				logger = clicontext.GetLoggerFromCmd[LoggerT](cmd).
+					With("service", "validator-client")
+				v = clicontext.GetViperFromCmd(cmd)
+			)
+
+			chainID := v.GetString(flags.FlagChainID)
+			if chainID == "" {
+				return ErrMissingChainID
+			}
+			dialer, err := newDialer(v.GetString(FlagSignerAddr))
+			if err != nil {
+				return err
+			}
+
+			pv := privval.LoadFilePV(
+				cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(),
+			)
+			pubkey, err := pv.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(
+				cmd.Context(), os.Interrupt, syscall.SIGTERM,
+			)
+			defer cancel()
+
+			r := &runner{
+				logger:    logger,
+				chainSpec: chainSpec,
+				api: newAPIClient(
+					v.GetString(FlagNodeAPI), dialTimeout,
+				),
+				server: privval.NewSignerServer(
+					privval.NewSignerDialerEndpoint(
+						servercmtlog.WrapCometLogger(logger), dialer,
+					),
+					chainID,
+					pv,
+				),
+				pubkey:   crypto.BLSPubkey(pubkey.Bytes()),
+				interval: v.GetDuration(FlagPollInterval),
+			}
+			return r.run(ctx)
+		},
+	}
+
+	cmd.Flags().String(
+		FlagNodeAPI, "http://localhost:3500",
+		"Address of the Beacon API of the beacon node",
+	)
+	cmd.Flags().String(
+		FlagSignerAddr, "tcp://localhost:26659",
+		"Address the beacon node listens on for the validator client",
+	)
+	cmd.Flags().Duration(
+		FlagPollInterval, 30*time.Second,
+		"Interval at which the duties of the validator are checked",
+	)
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of the network")
+	return cmd
+}
+
+// newDialer returns the dialer connecting to the signer address of the
+// beacon node.
+func newDialer(addr string) (privval.SocketDialer, error) {
+	protocol, address, ok := strings.Cut(addr, "://")
+	if !ok {
+		return nil, errors.Wrap(ErrInvalidSignerAddr, addr)
+	}
+	switch protocol {
+	case "tcp":
+		return privval.DialTCPFn(
+			address, dialTimeout, ed25519.GenPrivKey(),
+		), nil
+	case "unix":
+		return privval.DialUnixFn(address), nil
+	default:
+		return nil, errors.Wrap(ErrInvalidSignerAddr, addr)
+	}
+}
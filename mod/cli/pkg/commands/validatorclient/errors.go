@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatorclient
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnexpectedStatus is returned when the beacon node answers a request
+	// with a non-200 status code.
+	ErrUnexpectedStatus = errors.New("unexpected beacon node response status")
+
+	// ErrInvalidSignerAddr is returned when the signer address is neither a
+	// tcp:// nor a unix:// address.
+	ErrInvalidSignerAddr = errors.New("invalid signer address")
+
+	// ErrMissingChainID is returned when the chain ID of the network is not
+	// set.
+	ErrMissingChainID = errors.New("chain ID is required")
+
+	// ErrValidatorNotFound is returned when the beacon node does not know the
+	// validator of the validator client.
+	ErrValidatorNotFound = errors.New("validator not found")
+
+	// errNotFound is returned when the beacon node answers a request with a
+	// 404 status code.
+	errNotFound = errors.New("not found")
+)
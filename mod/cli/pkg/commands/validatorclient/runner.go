@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validatorclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// runner runs the validator client: it signs for the beacon node through the
+// signer server and watches the duties of the validator through the Beacon
+// API of the node.
+type runner struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec is used to map slots to epochs.
+	chainSpec common.ChainSpec
+	// api is the client of the Beacon API of the node.
+	api *apiClient
+	// server serves the signing requests of the node.
+	server *privval.SignerServer
+	// pubkey is the public key of the validator.
+	pubkey crypto.BLSPubkey
+	// interval is the interval at which the duties are checked.
+	interval time.Duration
+
+	// index is the index of the validator, once known.
+	index *math.ValidatorIndex
+	// lastEpoch is the last epoch the duties were checked for.
+	lastEpoch math.Epoch
+}
+
+// run waits for the beacon node, then signs on its behalf until ctx is done.
+func (r *runner) run(ctx context.Context) error {
+	root, err := r.waitForNode(ctx)
+	if err != nil {
+		return err
+	}
+	r.logger.Info(
+		"Connected to beacon node",
+		"genesis_validators_root", root,
+		"pubkey", r.pubkey,
+	)
+
+	if err = r.server.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if err = r.server.Stop(); err != nil {
+			r.logger.Error("Failed to stop signer server", "error", err)
+		}
+	}()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.checkDuties(ctx)
+		}
+	}
+}
+
+// waitForNode polls the Beacon API of the node until it answers, returning
+// the genesis validators root of its chain.
+func (r *runner) waitForNode(ctx context.Context) (common.Root, error) {
+	for {
+		root, err := r.api.GenesisValidatorsRoot(ctx)
+		if err == nil {
+			return root, nil
+		}
+		r.logger.Warn("Waiting for beacon node", "reason", err)
+
+		select {
+		case <-ctx.Done():
+			return common.Root{}, ctx.Err()
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// checkDuties reports whether the validator performed its duties in the last
+// complete epoch.
+func (r *runner) checkDuties(ctx context.Context) {
+	if r.index == nil {
+		index, err := r.api.ValidatorIndex(ctx, r.pubkey)
+		if errors.Is(err, ErrValidatorNotFound) {
+			r.logger.Info(
+				"Validator not yet in the registry", "pubkey", r.pubkey,
+			)
+			return
+		} else if err != nil {
+			r.logger.Warn("Failed to look up validator", "reason", err)
+			return
+		}
+		r.index = &index
+	}
+
+	slot, err := r.api.HeadSlot(ctx)
+	if err != nil {
+		r.logger.Warn("Failed to get head slot", "reason", err)
+		return
+	}
+	epoch := r.chainSpec.SlotToEpoch(slot)
+	if epoch == 0 || epoch-1 == r.lastEpoch {
+		return
+	}
+
+	isLive, err := r.api.IsLive(ctx, epoch-1, *r.index)
+	if err != nil {
+		r.logger.Warn("Failed to get validator liveness", "reason", err)
+		return
+	}
+	r.lastEpoch = epoch - 1
+	if isLive {
+		r.logger.Info(
+			"Validator performed its duties",
+			"index", r.index.Base10(), "epoch", r.lastEpoch.Base10(),
+		)
+		return
+	}
+	r.logger.Warn(
+		"Validator did not perform any duty",
+		"index", r.index.Base10(), "epoch", r.lastEpoch.Base10(),
+	)
+}
@@ -35,6 +35,8 @@ const (
 	SuggestedFeeRecipient    = builderRoot + "suggested-fee-recipient"
 	LocalBuilderEnabled      = builderRoot + "local-builder-enabled"
 	LocalBuildPayloadTimeout = builderRoot + "local-build-payload-timeout"
+	ForceBuild               = builderRoot + "force-build"
+	EmptyPayloadSlots        = builderRoot + "empty-payload-slots"
 
 	// Validator Config.
 	validatorRoot = beaconKitRoot + "validator."
@@ -49,11 +51,13 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	ShadowRPCDialURL        = engineRoot + "shadow-rpc-dial-url"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
 	KZGTrustedSetupPath = kzgRoot + "trusted-setup-path"
 	KZGImplementation   = kzgRoot + "implementation"
+	KZGProofWorkers     = kzgRoot + "proof-workers"
 
 	// Logger Config.
 	loggerRoot = beaconKitRoot + "logger."
@@ -68,10 +72,27 @@ const (
 		"availability-window"
 
 	// Node API Config.
-	nodeAPIRoot    = beaconKitRoot + "node-api."
-	NodeAPIEnabled = nodeAPIRoot + "enabled"
-	NodeAPIAddress = nodeAPIRoot + "address"
-	NodeAPILogging = nodeAPIRoot + "logging"
+	nodeAPIRoot              = beaconKitRoot + "node-api."
+	NodeAPIEnabled           = nodeAPIRoot + "enabled"
+	NodeAPIAddress           = nodeAPIRoot + "address"
+	NodeAPILogging           = nodeAPIRoot + "logging"
+	NodeAPIEventHistorySlots = nodeAPIRoot + "event-history-slots"
+	NodeAPIAuthToken         = nodeAPIRoot + "auth-token"
+	NodeAPIExplorer          = nodeAPIRoot + "explorer"
+
+	// Encryption Config.
+	encryptionRoot           = beaconKitRoot + "encryption."
+	EncryptionEnabled        = encryptionRoot + "enabled"
+	EncryptionPassphraseFile = encryptionRoot + "passphrase-file"
+	EncryptionSaltFile       = encryptionRoot + "salt-file"
+
+	// Compression Config.
+	compressionRoot  = beaconKitRoot + "compression."
+	CompressionCodec = compressionRoot + "codec"
+
+	// Namespace Config.
+	namespaceRoot = beaconKitRoot + "namespace."
+	NamespaceName = namespaceRoot + "name"
 )
 
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
@@ -101,11 +122,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().String(
+		ShadowRPCDialURL,
+		defaultCfg.Engine.ShadowRPCDialURL,
+		"candidate execution client to mirror engine calls to",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().Bool(
+		ForceBuild,
+		defaultCfg.PayloadBuilder.ForceBuild,
+		"build optimistic payloads even while the node is unhealthy",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -116,6 +147,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.KZG.Implementation,
 		"kzg implementation",
 	)
+	startCmd.Flags().Int(
+		KZGProofWorkers,
+		defaultCfg.KZG.ProofWorkers,
+		"number of blobs whose proofs are built concurrently",
+	)
 	startCmd.Flags().String(
 		TimeFormat,
 		defaultCfg.Logger.TimeFormat,
@@ -156,4 +192,44 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Uint64(
+		EmptyPayloadSlots,
+		defaultCfg.PayloadBuilder.EmptyPayloadSlots,
+		"number of slots for which payloads are built without mempool txs",
+	)
+	startCmd.Flags().Uint64(
+		NodeAPIEventHistorySlots,
+		defaultCfg.NodeAPI.EventHistorySlots,
+		"number of slots of events kept for the events history endpoint",
+	)
+	startCmd.Flags().String(
+		NodeAPIAuthToken,
+		defaultCfg.NodeAPI.AuthToken,
+		"bearer token required by the authenticated node api routes",
+	)
+	startCmd.Flags().Bool(
+		EncryptionEnabled,
+		defaultCfg.Encryption.Enabled,
+		"encryption at rest enabled",
+	)
+	startCmd.Flags().String(
+		EncryptionPassphraseFile,
+		defaultCfg.Encryption.PassphraseFile,
+		"encryption passphrase file",
+	)
+	startCmd.Flags().String(
+		EncryptionSaltFile,
+		defaultCfg.Encryption.SaltFile,
+		"encryption salt file",
+	)
+	startCmd.Flags().String(
+		CompressionCodec,
+		defaultCfg.Compression.Codec,
+		"codec blob sidecars are compressed with (none, snappy or zstd)",
+	)
+	startCmd.Flags().String(
+		NamespaceName,
+		defaultCfg.Namespace.Name,
+		"namespace the keys of the node's stores are prefixed with",
+	)
 }
@@ -34,6 +34,12 @@ var (
 		"invalid withdrawal credentials length",
 	)
 
+	// ErrInvalidAddressLength is returned when the execution address is
+	// invalid.
+	ErrInvalidAddressLength = errors.New(
+		"invalid address length",
+	)
+
 	// ErrInvalidAmount is returned when the deposit amount is invalid.
 	ErrInvalidAmount = errors.New(
 		"invalid amount",
@@ -62,6 +62,18 @@ func ConvertWithdrawalCredentials(credentials string) (
 	return types.WithdrawalCredentials(credentialsBytes), nil
 }
 
+// ConvertWithdrawalAddress converts a string to an execution address.
+func ConvertWithdrawalAddress(address string) (common.ExecutionAddress, error) {
+	addressBytes, err := hex.ToBytes(address)
+	if err != nil {
+		return common.ExecutionAddress{}, err
+	}
+	if len(addressBytes) != constants.ExecutionAddressLength {
+		return common.ExecutionAddress{}, ErrInvalidAddressLength
+	}
+	return common.ExecutionAddress(addressBytes), nil
+}
+
 // ConvertAmount converts a string to a deposit amount.
 //
 //nolint:mnd // lots of magic numbers
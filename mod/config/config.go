@@ -21,16 +21,25 @@
 package config
 
 import (
+	"github.com/berachain/beacon-kit/mod/beacon/sink"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/sampling"
 	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/timeliness"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/namespace"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/witness"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -46,10 +55,19 @@ func DefaultConfig() *Config {
 		Engine:            engineclient.DefaultConfig(),
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
+		Sampling:          sampling.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Encryption:        encryption.DefaultConfig(),
+		Cache:             cache.DefaultConfig(),
+		Compression:       compression.DefaultConfig(),
+		Namespace:         namespace.DefaultConfig(),
+		Witness:           witness.DefaultConfig(),
+		HotKeys:           hotkeys.DefaultConfig(),
+		Timeliness:        timeliness.DefaultConfig(),
+		Sinks:             sink.DefaultConfig(),
 	}
 }
 
@@ -61,6 +79,8 @@ type Config struct {
 	Logger log.Config `mapstructure:"logger"`
 	// KZG is the configuration for the KZG blob verifier.
 	KZG kzg.Config `mapstructure:"kzg"`
+	// Sampling is the configuration for data availability sampling.
+	Sampling sampling.Config `mapstructure:"sampling"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
 	// Validator is the configuration for the validator client.
@@ -69,6 +89,26 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Encryption is the configuration for encryption at rest.
+	Encryption encryption.Config `mapstructure:"encryption"`
+	// Cache is the configuration for the in-memory caches.
+	Cache cache.Config `mapstructure:"cache"`
+	// Compression is the configuration for the compression of blob sidecars
+	// on disk.
+	Compression compression.Config `mapstructure:"compression"`
+	// Namespace is the configuration for the namespace of the stores of the
+	// node.
+	Namespace namespace.Config `mapstructure:"namespace"`
+	// Witness is the configuration for the experimental export of block
+	// witnesses.
+	Witness witness.Config `mapstructure:"witness"`
+	// HotKeys is the configuration for the tracing of the keys most read
+	// from the storage.
+	HotKeys hotkeys.Config `mapstructure:"hot-keys"`
+	// Timeliness is the configuration for the tracking of late blocks.
+	Timeliness timeliness.Config `mapstructure:"timeliness"`
+	// Sinks is the configuration for the built-in indexer sinks.
+	Sinks sink.Config `mapstructure:"sinks"`
 }
 
 // GetEngine returns the execution client configuration.
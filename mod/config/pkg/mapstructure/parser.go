@@ -21,6 +21,7 @@
 package mapstructure
 
 import (
+	"encoding"
 	"net/url"
 	"reflect"
 
@@ -39,6 +40,33 @@ func StringToExecutionAddressFunc() mapstructure.DecodeHookFunc {
 	)
 }
 
+// StringToFixedBytesFunc returns a DecodeHookFunc that converts string to
+// fixed size byte arrays, such as `common.DomainType`, by unmarshaling the
+// string as text.
+func StringToFixedBytesFunc() mapstructure.DecodeHookFunc {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{},
+	) (interface{}, error) {
+		if f.Kind() != reflect.String || t.Kind() != reflect.Array {
+			return data, nil
+		}
+
+		result := reflect.New(t)
+		unmarshaler, ok := result.Interface().(encoding.TextUnmarshaler)
+		if !ok {
+			return data, nil
+		}
+		if err := unmarshaler.UnmarshalText(
+			[]byte(data.(string)),
+		); err != nil {
+			return nil, err
+		}
+		return result.Elem().Interface(), nil
+	}
+}
+
 // StringToDialURLFunc returns a DecodeHookFunc that converts
 // string to *url.URL by parsing the string.
 func StringToDialURLFunc() mapstructure.DecodeHookFunc {
@@ -33,8 +33,19 @@ func BetnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(BetnetSpec())
+}
+
+// BetnetSpec returns the parameters of the betnet chain spec.
+func BetnetSpec() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = BetnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
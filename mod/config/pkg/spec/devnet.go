@@ -33,8 +33,19 @@ func DevnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(DevnetSpec())
+}
+
+// DevnetSpec returns the parameters of the devnet chain spec.
+func DevnetSpec() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = DevnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
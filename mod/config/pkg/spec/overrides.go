@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	beaconmapstructure "github.com/berachain/beacon-kit/mod/config/pkg/mapstructure"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/mitchellh/mapstructure"
+)
+
+// EnvOverridePrefix is the prefix of the environment variables overriding
+// chain spec parameters. It is followed by the mapstructure key of the
+// parameter in upper snake case, the keys of nested parameters being joined,
+// e.g. BEACOND_SPEC_SLOTS_PER_EPOCH or
+// BEACOND_SPEC_ELECTRA_FORK_PARAMS_MAX_BLOBS_PER_BLOCK. Lists are comma
+// separated.
+const EnvOverridePrefix = "BEACOND_SPEC_"
+
+// Override is a chain spec parameter set by an environment variable.
+type Override struct {
+	// Env is the name of the environment variable.
+	Env string
+	// Value is the value of the environment variable.
+	Value string
+}
+
+// ApplyEnvOverrides sets the chain spec parameters overridden by the given
+// environment, in the "key=value" form of os.Environ, and validates the
+// resulting spec. It returns the overrides applied, sorted by name.
+func ApplyEnvOverrides(
+	data *chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	],
+	environ []string,
+) ([]Override, error) {
+	keys := make(map[string][]string)
+	collectOverrideKeys(reflect.TypeOf(*data), nil, keys)
+
+	var (
+		overrides []Override
+		input     = make(map[string]any)
+	)
+	for _, env := range environ {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, EnvOverridePrefix) {
+			continue
+		}
+		path, ok := keys[name]
+		if !ok {
+			return nil, errors.Wrap(ErrUnknownSpecOverride, name)
+		}
+		setOverrideKey(input, path, value)
+		overrides = append(overrides, Override{Env: name, Value: value})
+	}
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToSliceHookFunc(","),
+			beaconmapstructure.StringToFixedBytesFunc(),
+		),
+		WeaklyTypedInput: true,
+		Result:           data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = decoder.Decode(input); err != nil {
+		return nil, errors.Wrap(err, "failed to apply chain spec overrides")
+	}
+	if err = validateSpec(data); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(overrides, func(a, b Override) int {
+		return strings.Compare(a.Env, b.Env)
+	})
+	return overrides, nil
+}
+
+// collectOverrideKeys maps the environment variable of every parameter of the
+// given struct type to the path of mapstructure keys of the parameter.
+func collectOverrideKeys(
+	t reflect.Type, prefix []string, keys map[string][]string,
+) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+		if key == "" || !field.IsExported() {
+			continue
+		}
+		path := append(slices.Clone(prefix), key)
+		switch field.Type.Kind() {
+		case reflect.Struct:
+			collectOverrideKeys(field.Type, path, keys)
+		case reflect.Interface:
+			// The CometBFT values are not chain parameters.
+			continue
+		default:
+			name := strings.ReplaceAll(strings.Join(path, "_"), "-", "_")
+			keys[EnvOverridePrefix+strings.ToUpper(name)] = path
+		}
+	}
+}
+
+// setOverrideKey sets the value at the given path of mapstructure keys,
+// creating the maps of the nested parameters as needed.
+func setOverrideKey(input map[string]any, path []string, value string) {
+	for _, key := range path[:len(path)-1] {
+		nested, ok := input[key].(map[string]any)
+		if !ok {
+			nested = make(map[string]any)
+			input[key] = nested
+		}
+		input = nested
+	}
+	input[path[len(path)-1]] = value
+}
+
+// validateSpec checks that the parameters of the spec which the chain divides
+// by are set, and that the proposer selection rule is known.
+func validateSpec(
+	data *chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	],
+) error {
+	for _, param := range []struct {
+		name  string
+		value uint64
+	}{
+		{"slots-per-epoch", data.SlotsPerEpoch},
+		{"slots-per-historical-root", data.SlotsPerHistoricalRoot},
+		{"effective-balance-increment", data.EffectiveBalanceIncrement},
+		{"hysteresis-quotient", data.HysteresisQuotient},
+		{"churn-limit-quotient", data.ChurnLimitQuotient},
+		{"epochs-per-historical-vector", data.EpochsPerHistoricalVector},
+		{"epochs-per-slashings-vector", data.EpochsPerSlashingsVector},
+	} {
+		if param.value == 0 {
+			return errors.Wrapf(
+				ErrInvalidSpec, "%s must not be zero", param.name,
+			)
+		}
+	}
+
+	switch data.ProposerSelection {
+	case chain.ProposerSelectionConsensus:
+		return nil
+	default:
+		return errors.Wrapf(
+			ErrInvalidSpec,
+			"unknown proposer selection %q", data.ProposerSelection,
+		)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/domains"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmttypes "github.com/cometbft/cometbft/types"
 )
@@ -35,10 +36,21 @@ func TestnetChainSpec() chain.Spec[
 	common.ExecutionAddress,
 	math.Slot,
 	any,
+] {
+	return chain.NewChainSpec(TestnetSpec())
+}
+
+// TestnetSpec returns the parameters of the testnet chain spec.
+func TestnetSpec() chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = TestnetEth1ChainID
-	return chain.NewChainSpec(testnetSpec)
+	return testnetSpec
 }
 
 //nolint:mnd // bet.
@@ -64,35 +76,31 @@ func BaseSpec() chain.SpecData[
 		MaxEffectiveBalance:       uint64(32e9),
 		EjectionBalance:           uint64(16e9),
 		EffectiveBalanceIncrement: uint64(1e9),
+		// Effective balance hysteresis.
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		SlotsPerHistoricalRoot:           8,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		// Validator cycle.
+		MinPerEpochChurnLimit:           4,
+		ChurnLimitQuotient:              65536,
+		MaxPerEpochActivationChurnLimit: 8,
+		// Proposers are chosen by CometBFT.
+		ProposerSelection: chain.ProposerSelectionConsensus,
 		// Signature domains.
-		DomainTypeProposer: common.DomainType{
-			0x00, 0x00, 0x00, 0x00,
-		},
-		DomainTypeAttester: common.DomainType{
-			0x01, 0x00, 0x00, 0x00,
-		},
-		DomainTypeRandao: common.DomainType{
-			0x02, 0x00, 0x00, 0x00,
-		},
-		DomainTypeDeposit: common.DomainType{
-			0x03, 0x00, 0x00, 0x00,
-		},
-		DomainTypeVoluntaryExit: common.DomainType{
-			0x04, 0x00, 0x00, 0x00,
-		},
-		DomainTypeSelectionProof: common.DomainType{
-			0x05, 0x00, 0x00, 0x00,
-		},
-		DomainTypeAggregateAndProof: common.DomainType{
-			0x06, 0x00, 0x00, 0x00,
-		},
-		DomainTypeApplicationMask: common.DomainType{
-			0x00, 0x00, 0x00, 0x01,
-		},
+		DomainTypeProposer:          domains.Proposer,
+		DomainTypeAttester:          domains.Attester,
+		DomainTypeRandao:            domains.Randao,
+		DomainTypeDeposit:           domains.Deposit,
+		DomainTypeVoluntaryExit:     domains.VoluntaryExit,
+		DomainTypeSelectionProof:    domains.SelectionProof,
+		DomainTypeAggregateAndProof: domains.AggregateAndProof,
+		DomainTypeApplicationMask:   domains.ApplicationMask,
 		// Eth1-related values.
 		DepositContractAddress: common.NewExecutionAddressFromHex(
 			"0x4242424242424242424242424242424242424242",
@@ -109,7 +117,8 @@ func BaseSpec() chain.SpecData[
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
+		MaxDepositsPerBlock:           16,
+		MaxWithdrawalRequestsPerBlock: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
 		// Capella values.
@@ -45,6 +45,23 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# HTTP url of a candidate execution client that newPayload and forkchoiceUpdated
+# calls are mirrored to. Divergences from the primary execution client are
+# reported but never affect consensus. Leave empty to disable.
+shadow-rpc-dial-url = "{{.BeaconKit.Engine.ShadowRPCDialURL}}"
+
+# Number of forkchoiceUpdated calls, with the response of the execution client,
+# kept in the on-disk forkchoice ledger for post-incident analysis. The ledger
+# is dumped with the forkchoice-ledger command. 0 disables the ledger.
+forkchoice-ledger-size = {{ .BeaconKit.Engine.ForkchoiceLedgerSize }}
+
+# Lowest acceptable version of each execution client, keyed by client code or
+# name, e.g. geth = "1.14.6". The node refuses to start against older clients.
+[beacon-kit.engine.minimum-client-versions]
+{{- range $client, $version := .BeaconKit.Engine.MinimumClientVersions }}
+"{{ $client }}" = "{{ $version }}"
+{{- end }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -64,6 +81,21 @@ trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 # Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+# Number of blobs whose proofs are built concurrently when building sidecars.
+# Zero uses one worker per available CPU.
+proof-workers = {{.BeaconKit.KZG.ProofWorkers}}
+
+[beacon-kit.sampling]
+# Enables data availability sampling of incoming blob sidecars. Until peers
+# serve samples, blobs are still verified in full.
+enabled = {{.BeaconKit.Sampling.Enabled}}
+
+# Number of columns sampled per block.
+samples = {{.BeaconKit.Sampling.Samples}}
+
+# Minimum number of sampled columns peers must serve to accept a block.
+threshold = {{.BeaconKit.Sampling.Threshold}}
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -77,14 +109,69 @@ suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+# Number of consecutive failed forkchoice updates after which optimistic
+# payload builds are skipped. Zero disables the check.
+max-fcu-failures = {{ .BeaconKit.PayloadBuilder.MaxFCUFailures }}
+
+# How far the latest execution payload may lag behind the wall clock before
+# optimistic payload builds are skipped. Zero disables the check.
+max-head-lag = "{{ .BeaconKit.PayloadBuilder.MaxHeadLag }}"
+
+# Build optimistic payloads even while the node is unhealthy. Intended for
+# testing only.
+force-build = {{ .BeaconKit.PayloadBuilder.ForceBuild }}
+
+# How long to keep polling the execution client for a higher value payload
+# once the first one is retrieved. Bounded by the proposal deadline. Zero
+# disables payload upgrades.
+payload-upgrade-window = "{{ .BeaconKit.PayloadBuilder.PayloadUpgradeWindow }}"
+
+# Interval between polls of the execution client for a higher value payload.
+payload-upgrade-interval = "{{ .BeaconKit.PayloadBuilder.PayloadUpgradeInterval }}"
+
+# Number of slots, starting with the first payload built after startup, for
+# which payloads are built without the transactions of the mempool. Meant for
+# incidents where the execution client mempool is poisoned. It can also be set
+# at runtime through the admin API. The execution client must support the
+# noTxPool payload attribute.
+empty-payload-slots = {{ .BeaconKit.PayloadBuilder.EmptyPayloadSlots }}
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
+# It is a Go template which may refer to .Version, .ELClient and .Moniker.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# HaltAfterInvalidPayloads is the number of consecutive payloads built by the node that the
+# execution client must report as invalid for the node to stop proposing, while still
+# following the chain. Zero never stops the node from proposing.
+halt-after-invalid-payloads = {{ .BeaconKit.Validator.HaltAfterInvalidPayloads }}
+
+# DoppelgangerEpochs is the number of epochs the node watches the chain after a restart for
+# blocks of its validator proposed by another node, before it proposes. If one is seen, the
+# node stops proposing until restarted. Zero disables the check.
+doppelganger-epochs = {{ .BeaconKit.Validator.DoppelgangerEpochs }}
+
+# DevnetControls enables pausing block production, producing a number of blocks and
+# skipping slots through the admin API. Meant for devnets only.
+devnet-controls = {{ .BeaconKit.Validator.DevnetControls }}
+
+# DevnetStartPaused starts the node with block production paused. Requires devnet-controls.
+devnet-start-paused = {{ .BeaconKit.Validator.DevnetStartPaused }}
+
+# DevnetSkipSlots are slots the node never builds a block for. Requires devnet-controls.
+devnet-skip-slots = [{{ range $i, $slot := .BeaconKit.Validator.DevnetSkipSlots }}{{ if $i }}, {{ end }}{{ $slot }}{{ end }}]
+
+# Graffiti strings of individual validator keys, keyed by their 0x prefixed public key.
+# Keys without an entry use graffiti.
+[beacon-kit.validator.graffiti-by-key]
+{{- range $pubkey, $graffiti := .BeaconKit.Validator.GraffitiByKey }}
+"{{ $pubkey }}" = "{{ $graffiti }}"
+{{- end }}
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -92,6 +179,14 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# BatchSize is the number of finalized blocks grouped into a single write.
+# Values above 1 reduce write overhead at the cost of lookups for the most
+# recent blocks lagging slightly behind, and suit non-validator nodes.
+batch-size = "{{ .BeaconKit.BlockStoreService.BatchSize }}"
+
+# FlushInterval is the longest a partial batch is held before it is written.
+flush-interval = "{{ .BeaconKit.BlockStoreService.FlushInterval }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -101,4 +196,133 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# EventHistorySlots is the number of slots of events kept for the events
+# history endpoint. Set to 0 to keep every event.
+event-history-slots = "{{ .BeaconKit.NodeAPI.EventHistorySlots }}"
+
+# AuthToken is the bearer token required by the authenticated admin routes,
+# such as maintenance mode and shutdown. Those routes are refused if unset.
+auth-token = "{{ .BeaconKit.NodeAPI.AuthToken }}"
+
+# Explorer serves a minimal block explorer page at /explorer on every listener,
+# rendering recent blocks, validators, deposits and node health from the node
+# API itself. Meant for devnets.
+explorer = "{{ .BeaconKit.NodeAPI.Explorer }}"
+
+# Profiles split the node API across several listeners, each serving a subset
+# of the API namespaces. When no profile is set, a single listener serving all
+# namespaces is bound to the address above. For example:
+#
+# [[beacon-kit.node-api.profiles]]
+# name = "public"
+# address = "0.0.0.0:3500"
+# namespaces = ["beacon", "node", "config"]
+# allow-origins = ["*"]
+#
+# [[beacon-kit.node-api.profiles]]
+# name = "admin"
+# address = "127.0.0.1:3501"
+# logging = true
+# namespaces = ["debug", "events", "admin"]
+{{- range .BeaconKit.NodeAPI.Profiles }}
+
+[[beacon-kit.node-api.profiles]]
+name = "{{ .Name }}"
+address = "{{ .Address }}"
+logging = "{{ .Logging }}"
+namespaces = [{{ range $i, $ns := .Namespaces }}{{ if $i }}, {{ end }}"{{ $ns }}"{{ end }}]
+allow-origins = [{{ range $i, $o := .AllowOrigins }}{{ if $i }}, {{ end }}"{{ $o }}"{{ end }}]
+{{- end }}
+
+[beacon-kit.encryption]
+# Enabled determines if the validator key file and the deposit store are
+# encrypted at rest. Enabling this on an existing node requires the deposit
+# store to be resynced.
+enabled = "{{ .BeaconKit.Encryption.Enabled }}"
+
+# Path to the file holding the passphrase the encryption key is derived from.
+passphrase-file = "{{ .BeaconKit.Encryption.PassphraseFile }}"
+
+# Path to the file holding the key derivation salt. It is created if missing.
+salt-file = "{{ .BeaconKit.Encryption.SaltFile }}"
+
+[beacon-kit.cache]
+# Maximum number of bytes held by the in-memory caches together. When it is
+# exceeded, entries are evicted from the largest cache. 0 disables the cap.
+max-memory = {{ .BeaconKit.Cache.MaxMemory }}
+
+[beacon-kit.compression]
+# Codec blob sidecars are compressed with on disk: "none", "snappy" or "zstd".
+# Sidecars already on disk are read whatever codec they were written with,
+# and can be rewritten with the configured codec by the recompress-blobs
+# command.
+codec = "{{ .BeaconKit.Compression.Codec }}"
+
+[beacon-kit.namespace]
+# Namespace the keys of the application database, the deposit store and the
+# event journal are prefixed with, so that several chains can share them.
+# Blob sidecars are stored in a subdirectory of the same name. Lowercase
+# letters, digits, '-' and '_' only. Changing it on an existing node hides
+# the data stored under the previous namespace.
+name = "{{ .BeaconKit.Namespace.Name }}"
+
+[beacon-kit.witness]
+# Experimental. Records the state keys read and written by the transition of
+# every finalized block and writes them, with Merkle proofs of the beacon state
+# fields they belong to, to witness-<slot>.json. Merkleizing the pre-state of
+# every block is expensive.
+enabled = {{ .BeaconKit.Witness.Enabled }}
+
+# Directory witnesses are written to. Defaults to data/witnesses in the node
+# home.
+dir = "{{ .BeaconKit.Witness.Dir }}"
+
+[beacon-kit.hot-keys]
+# Samples the reads made on the beacon state and the block store, and ranks the
+# keys and collections most read over the last minute. The ranking is served
+# by the /bkit/v1/debug/hot_keys endpoint of the node API.
+enabled = {{ .BeaconKit.HotKeys.Enabled }}
+
+# Fraction of reads that are sampled, between 0 and 1.
+sample-rate = {{ .BeaconKit.HotKeys.SampleRate }}
+
+# Number of keys included in the ranking.
+top-keys = {{ .BeaconKit.HotKeys.TopKeys }}
+
+[beacon-kit.timeliness]
+# How long after the previous block is finalized a block may arrive before it
+# is considered late.
+late-threshold = "{{ .BeaconKit.Timeliness.LateThreshold }}"
+
+# Number of consecutive late blocks after which a proposer is reported as
+# chronically late. Set to 0 to disable the warnings.
+chronic-streak = {{ .BeaconKit.Timeliness.ChronicStreak }}
+
+[beacon-kit.sinks]
+# Number of finalized blocks queued for the sinks. Blocks finalized while the
+# queue is full are not delivered.
+buffer-size = {{ .BeaconKit.Sinks.BufferSize }}
+
+[beacon-kit.sinks.postgres]
+# Enabled determines if finalized blocks, states and deposits are written to
+# a PostgreSQL database.
+enabled = {{ .BeaconKit.Sinks.Postgres.Enabled }}
+
+# Connection string of the database.
+dsn = "{{ .BeaconKit.Sinks.Postgres.DSN }}"
+
+[beacon-kit.sinks.kafka]
+# Enabled determines if finalized blocks, states and deposits are published
+# to Kafka through a Kafka REST proxy.
+enabled = {{ .BeaconKit.Sinks.Kafka.Enabled }}
+
+# URL of the Kafka REST proxy.
+proxy-url = "{{ .BeaconKit.Sinks.Kafka.ProxyURL }}"
+
+# Topic the records are published to.
+topic = "{{ .BeaconKit.Sinks.Kafka.Topic }}"
+
+# Timeout of a request to the proxy.
+timeout = "{{ .BeaconKit.Sinks.Kafka.Timeout }}"
 `
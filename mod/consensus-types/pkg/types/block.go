@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -56,41 +57,56 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	var (
-		block *BeaconBlock
-	)
-
-	switch forkVersion {
-	case version.Deneb:
-		block = &BeaconBlock{
-			Slot:          slot,
-			ProposerIndex: proposerIndex,
-			ParentRoot:    parentBlockRoot,
-			StateRoot:     common.Root{},
-			Body:          &BeaconBlockBody{},
-		}
-	default:
-		return &BeaconBlock{}, ErrForkVersionNotSupported
+	codec, err := ForkCodecFor(forkVersion)
+	if err != nil {
+		return &BeaconBlock{}, err
 	}
 
-	return block, nil
+	return &BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentBlockRoot,
+		StateRoot:     common.Root{},
+		Body:          codec.EmptyBody(),
+	}, nil
 }
 
-// NewFromSSZ creates a new beacon block from the given SSZ bytes.
+// NewFromSSZ creates a new beacon block from the given SSZ bytes, decoded
+// with the codec registered for the fork version.
 func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	var block *BeaconBlock
-	switch forkVersion {
-	case version.Deneb:
-		block = &BeaconBlock{}
-		return block, block.UnmarshalSSZ(bz)
-	case version.DenebPlus:
-		panic("unsupported fork version")
-	default:
-		return block, ErrForkVersionNotSupported
+	codec, err := ForkCodecFor(forkVersion)
+	if err != nil {
+		return nil, err
 	}
+	return codec.UnmarshalBlock(bz)
+}
+
+// UnmarshalSSZReuse decodes a block of the given fork version into b,
+// reusing the body, payload and buffers b already holds, e.g. those of a
+// pooled block. b must not be shared while it is decoded into.
+func (b *BeaconBlock) UnmarshalSSZReuse(
+	bz []byte,
+	forkVersion uint32,
+) error {
+	codec, err := ForkCodecFor(forkVersion)
+	if err != nil {
+		return err
+	}
+	if codec.UnmarshalBlockInto == nil {
+		var blk *BeaconBlock
+		if blk, err = codec.UnmarshalBlock(bz); err != nil {
+			return err
+		}
+		*b = *blk
+		return nil
+	}
+	if b.Body != nil && b.Body.ExecutionPayload != nil {
+		b.Body.ExecutionPayload.reserve()
+	}
+	return codec.UnmarshalBlockInto(bz, b)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -144,12 +160,14 @@ func (b *BeaconBlock) HashTreeRoot() common.Root {
 // MarshalSSZTo marshals the BeaconBlock object to the provided buffer in SSZ
 // format.
 func (b *BeaconBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
-	bz, err := b.MarshalSSZ()
-	if err != nil {
+	// Encode into a pooled scratch buffer, since its contents are copied
+	// into dst anyway.
+	buf := pool.GetBytes(int(b.SizeSSZ(false)))
+	defer pool.PutBytes(buf)
+	if err := ssz.EncodeToBytes(*buf, b); err != nil {
 		return nil, err
 	}
-	dst = append(dst, bz...)
-	return dst, nil
+	return append(dst, *buf...), nil
 }
 
 // HashTreeRootWith ssz hashes the BeaconBlock object with a hasher.
@@ -198,3 +198,69 @@ func TestBeaconBlock_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlock_UnmarshalSSZReuse(t *testing.T) {
+	block := generateValidBeaconBlock()
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	reused := &types.BeaconBlock{}
+	require.NoError(t, reused.UnmarshalSSZReuse(bz, version.Deneb))
+	require.Equal(t, block, reused)
+	payload := reused.GetBody().GetExecutionPayload()
+
+	// Decoding another block reuses the body and payload of the first.
+	block.Slot++
+	block.Body.ExecutionPayload.Number++
+	bz, err = block.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, reused.UnmarshalSSZReuse(bz, version.Deneb))
+	require.Equal(t, block, reused)
+	require.Same(t, payload, reused.GetBody().GetExecutionPayload())
+
+	require.ErrorIs(
+		t, reused.UnmarshalSSZReuse(bz, 100), types.ErrForkVersionNotSupported,
+	)
+}
+
+func TestBeaconBlockForElectra(t *testing.T) {
+	block := generateValidBeaconBlock()
+	block.Body.ExecutionRequests = &engineprimitives.ExecutionRequests{
+		Withdrawals: []*engineprimitives.WithdrawalRequest{{
+			SourceAddress: common.ExecutionAddress{1},
+			Amount:        32e9,
+		}},
+	}
+	bz, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The requests are only part of the body from Electra.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(bz, version.Deneb)
+	require.Error(t, err)
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, block, decoded)
+	require.Equal(t, types.BodyLengthElectra, decoded.GetBody().Length())
+	require.Len(t, decoded.GetBody().GetTopLevelRoots(),
+		int(types.BodyLengthElectra))
+
+	deneb := generateValidBeaconBlock()
+	require.NotEqual(t, deneb.GetBody().HashTreeRoot(),
+		block.GetBody().HashTreeRoot())
+	tree, err := block.GetBody().GetTree()
+	require.NoError(t, err)
+	require.Equal(t, block.GetBody().HashTreeRoot(), common.Root(tree.Hash()))
+
+	// A body reused across forks takes the structure of the decoded fork.
+	bz, err = deneb.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalSSZReuse(bz, version.Deneb))
+	require.Equal(t, deneb, decoded)
+
+	// Blocks built for Electra carry empty requests.
+	built, err := (&types.BeaconBlock{}).NewWithVersion(
+		1, 0, common.Root{}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, built.GetBody().GetExecutionRequests())
+}
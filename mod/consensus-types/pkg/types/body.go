@@ -26,11 +26,12 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
@@ -40,6 +41,10 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the BeaconBlockBody
+	// struct from Electra, which adds the ExecutionRequests.
+	BodyLengthElectra = BodyLengthDeneb + 1
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
@@ -54,17 +59,11 @@ const (
 // Empty returns a new BeaconBlockBody with empty fields
 // for the given fork version.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
-	switch forkVersion {
-	case version.Deneb:
-		return &BeaconBlockBody{
-			Eth1Data: new(Eth1Data),
-			ExecutionPayload: &ExecutionPayload{
-				ExtraData: make([]byte, ExtraDataSize),
-			},
-		}
-	default:
-		panic("unsupported fork version")
+	codec, err := ForkCodecFor(forkVersion)
+	if err != nil {
+		panic(err)
 	}
+	return codec.EmptyBody()
 }
 
 // BlockBodyKZGOffset returns the offset of the KZG commitments in the block
@@ -74,12 +73,11 @@ func BlockBodyKZGOffset(
 	slot math.Slot,
 	cs common.ChainSpec,
 ) uint64 {
-	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
-		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
-	default:
-		panic("unsupported fork version")
+	codec, err := ForkCodecFor(cs.ActiveForkVersionForSlot(slot))
+	if err != nil {
+		panic(err)
 	}
+	return codec.KZGMerkleIndex * cs.MaxBlobCommitmentsPerBlock()
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
@@ -97,6 +95,10 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// ExecutionRequests are the requests triggered on the execution layer.
+	// They are nil in the bodies of the forks before Electra, whose SSZ
+	// definition leaves them out.
+	ExecutionRequests *engineprimitives.ExecutionRequests
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +108,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.ExecutionRequests != nil {
+		size += 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +118,9 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	if b.ExecutionRequests != nil {
+		size += ssz.SizeDynamicObject(b.ExecutionRequests)
+	}
 	return size
 }
 
@@ -127,11 +135,17 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	if b.ExecutionRequests != nil {
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	if b.ExecutionRequests != nil {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -156,12 +170,14 @@ func (b *BeaconBlockBody) HashTreeRoot() common.Root {
 
 // MarshalSSZTo serializes the BeaconBlockBody into a writer.
 func (b *BeaconBlockBody) MarshalSSZTo(dst []byte) ([]byte, error) {
-	bz, err := b.MarshalSSZ()
-	if err != nil {
+	// Encode into a pooled scratch buffer, since its contents are copied
+	// into dst anyway.
+	buf := pool.GetBytes(int(b.SizeSSZ(false)))
+	defer pool.PutBytes(buf)
+	if err := ssz.EncodeToBytes(*buf, b); err != nil {
 		return nil, err
 	}
-	dst = append(dst, bz...)
-	return dst, nil
+	return append(dst, *buf...), nil
 }
 
 // HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher.
@@ -221,6 +237,12 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	// Field (6) 'ExecutionRequests'
+	if b.ExecutionRequests != nil {
+		root := b.ExecutionRequests.HashTreeRoot()
+		hh.PutBytes(root[:])
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -264,6 +286,21 @@ func (b *BeaconBlockBody) SetBlobKzgCommitments(
 	b.BlobKzgCommitments = commitments
 }
 
+// GetExecutionRequests returns the ExecutionRequests of the Body.
+func (
+	b *BeaconBlockBody,
+) GetExecutionRequests() *engineprimitives.ExecutionRequests {
+	return b.ExecutionRequests
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the Body. Bodies of the
+// forks before Electra must not be given requests.
+func (b *BeaconBlockBody) SetExecutionRequests(
+	requests *engineprimitives.ExecutionRequests,
+) {
+	b.ExecutionRequests = requests
+}
+
 // SetEth1Data sets the Eth1Data of the BeaconBlockBody.
 func (b *BeaconBlockBody) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
@@ -291,7 +328,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,10 +337,17 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.ExecutionRequests != nil {
+		roots = append(roots, b.ExecutionRequests.HashTreeRoot())
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.ExecutionRequests != nil {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
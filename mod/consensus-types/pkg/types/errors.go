@@ -36,6 +36,10 @@ var (
 	// version is not supported.
 	ErrForkVersionNotSupported = errors.New("fork version not supported")
 
+	// ErrForkCodecRegistered is an error for when a codec is registered
+	// for a fork version twice.
+	ErrForkCodecRegistered = errors.New("fork codec already registered")
+
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
 )
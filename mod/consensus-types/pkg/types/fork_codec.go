@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"sync"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ForkCodec describes the block structure of a fork. Supporting a fork
+// whose blocks change structure is a matter of registering its codec,
+// rather than extending every switch over fork versions.
+type ForkCodec struct {
+	// EmptyBody returns an empty block body of the fork, ready to be
+	// decoded into or filled by a proposer.
+	EmptyBody func() *BeaconBlockBody
+	// UnmarshalBlock decodes an SSZ encoded block of the fork.
+	UnmarshalBlock func(bz []byte) (*BeaconBlock, error)
+	// UnmarshalBlockInto decodes an SSZ encoded block of the fork into an
+	// existing block, reusing the memory it holds. It is optional, blocks
+	// of forks without it are decoded with UnmarshalBlock instead.
+	UnmarshalBlockInto func(bz []byte, blk *BeaconBlock) error
+	// KZGMerkleIndex is the merkle index of the root of the blob KZG
+	// commitments in the tree of the block body.
+	KZGMerkleIndex uint64
+}
+
+//nolint:gochecknoglobals // registry of fork codecs.
+var (
+	forkCodecsMu sync.RWMutex
+	forkCodecs   = map[uint32]ForkCodec{
+		version.Deneb: {
+			EmptyBody: func() *BeaconBlockBody {
+				return &BeaconBlockBody{
+					Eth1Data: new(Eth1Data),
+					ExecutionPayload: &ExecutionPayload{
+						ExtraData: make([]byte, ExtraDataSize),
+					},
+				}
+			},
+			UnmarshalBlock: func(bz []byte) (*BeaconBlock, error) {
+				block := &BeaconBlock{}
+				return block, block.UnmarshalSSZ(bz)
+			},
+			UnmarshalBlockInto: func(bz []byte, blk *BeaconBlock) error {
+				if blk.Body != nil {
+					blk.Body.ExecutionRequests = nil
+				}
+				return blk.UnmarshalSSZ(bz)
+			},
+			KZGMerkleIndex: KZGMerkleIndexDeneb,
+		},
+		version.Electra: {
+			EmptyBody: emptyBodyElectra,
+			UnmarshalBlock: func(bz []byte) (*BeaconBlock, error) {
+				block := &BeaconBlock{Body: emptyBodyElectra()}
+				return block, block.UnmarshalSSZ(bz)
+			},
+			UnmarshalBlockInto: func(bz []byte, blk *BeaconBlock) error {
+				if blk.Body == nil {
+					blk.Body = emptyBodyElectra()
+				} else if blk.Body.ExecutionRequests == nil {
+					blk.Body.ExecutionRequests = new(
+						engineprimitives.ExecutionRequests,
+					)
+				}
+				return blk.UnmarshalSSZ(bz)
+			},
+			// The execution requests are appended after the blob KZG
+			// commitments, which keep their position in the tree.
+			KZGMerkleIndex: KZGMerkleIndexDeneb,
+		},
+	}
+)
+
+// emptyBodyElectra returns an empty block body of Electra, which carries
+// the execution requests.
+func emptyBodyElectra() *BeaconBlockBody {
+	return &BeaconBlockBody{
+		Eth1Data: new(Eth1Data),
+		ExecutionPayload: &ExecutionPayload{
+			ExtraData: make([]byte, ExtraDataSize),
+		},
+		ExecutionRequests: new(engineprimitives.ExecutionRequests),
+	}
+}
+
+// RegisterForkCodec registers the codec of the blocks of the given fork
+// version. A fork version can only be registered once.
+func RegisterForkCodec(forkVersion uint32, codec ForkCodec) error {
+	forkCodecsMu.Lock()
+	defer forkCodecsMu.Unlock()
+	if _, ok := forkCodecs[forkVersion]; ok {
+		return errors.Wrapf(
+			ErrForkCodecRegistered, "fork version %d", forkVersion,
+		)
+	}
+	forkCodecs[forkVersion] = codec
+	return nil
+}
+
+// ForkCodecFor returns the codec registered for the given fork version.
+func ForkCodecFor(forkVersion uint32) (ForkCodec, error) {
+	forkCodecsMu.RLock()
+	defer forkCodecsMu.RUnlock()
+	codec, ok := forkCodecs[forkVersion]
+	if !ok {
+		return ForkCodec{}, errors.Wrapf(
+			ErrForkVersionNotSupported, "fork version %d", forkVersion,
+		)
+	}
+	return codec, nil
+}
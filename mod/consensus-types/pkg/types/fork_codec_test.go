@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterForkCodecTwice(t *testing.T) {
+	err := types.RegisterForkCodec(version.Deneb, types.ForkCodec{})
+	require.ErrorIs(t, err, types.ErrForkCodecRegistered)
+}
+
+func TestRegisteredForkCodecDecodesBlocks(t *testing.T) {
+	// A fork version no chain uses, so that registering it does not affect
+	// other tests.
+	const testFork uint32 = 0xfe
+
+	_, err := types.ForkCodecFor(testFork)
+	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
+
+	deneb, err := types.ForkCodecFor(version.Deneb)
+	require.NoError(t, err)
+	var decoded int
+	require.NoError(t, types.RegisterForkCodec(testFork, types.ForkCodec{
+		EmptyBody: deneb.EmptyBody,
+		UnmarshalBlock: func(bz []byte) (*types.BeaconBlock, error) {
+			decoded++
+			return deneb.UnmarshalBlock(bz)
+		},
+		KZGMerkleIndex: deneb.KZGMerkleIndex,
+	}))
+
+	bz, err := generateValidBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+
+	blk, err := (&types.BeaconBlock{}).NewFromSSZ(bz, testFork)
+	require.NoError(t, err)
+	require.Equal(t, generateValidBeaconBlock(), blk)
+	require.Equal(t, 1, decoded)
+
+	body := (&types.BeaconBlockBody{}).Empty(testFork)
+	require.NotNil(t, body.ExecutionPayload)
+}
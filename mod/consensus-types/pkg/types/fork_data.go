@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/domains"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/karalabe/ssz"
 )
@@ -99,11 +100,8 @@ func (fd *ForkData) UnmarshalSSZ(buf []byte) error {
 func (fd *ForkData) ComputeDomain(
 	domainType common.DomainType,
 ) common.Domain {
-	forkDataRoot := fd.HashTreeRoot()
-	return common.Domain(
-		append(
-			domainType[:],
-			forkDataRoot[:28]...),
+	return domains.ComputeDomain(
+		domainType, fd.CurrentVersion, fd.GenesisValidatorsRoot,
 	)
 }
 
@@ -112,7 +110,7 @@ func (fd *ForkData) ComputeRandaoSigningRoot(
 	domainType common.DomainType,
 	epoch math.Epoch,
 ) common.Root {
-	return ComputeSigningRootUInt64(
+	return domains.ComputeSigningRootUInt64(
 		epoch.Unwrap(),
 		fd.ComputeDomain(domainType),
 	)
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -51,6 +52,10 @@ type Genesis[
 	// used to initialize the validator set.
 	Deposits []DepositT `json:"deposits"`
 
+	// Validators customizes the balance and activation epoch of the
+	// validators created by the deposits.
+	Validators []*transition.GenesisValidator `json:"validators,omitempty"`
+
 	// ExecutionPayloadHeader is the header of the execution payload
 	// in the genesis.
 	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
@@ -68,6 +73,13 @@ func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) GetDeposits() []DepositT {
 	return g.Deposits
 }
 
+// GetValidators returns the customized validators in the genesis.
+func (g *Genesis[
+	DepositT, ExecutionPayloadHeaderT,
+]) GetValidators() []*transition.GenesisValidator {
+	return g.Validators
+}
+
 // GetExecutionPayloadHeader returns the execution payload header.
 func (g *Genesis[
 	DepositT, ExecutionPayloadHeaderT,
@@ -80,9 +92,10 @@ func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 	data []byte,
 ) error {
 	type genesisMarshalable[Deposit any] struct {
-		ForkVersion            common.Version  `json:"fork_version"`
-		Deposits               []DepositT      `json:"deposits"`
-		ExecutionPayloadHeader json.RawMessage `json:"execution_payload_header"`
+		ForkVersion            common.Version                 `json:"fork_version"`
+		Deposits               []DepositT                     `json:"deposits"`
+		Validators             []*transition.GenesisValidator `json:"validators"`
+		ExecutionPayloadHeader json.RawMessage                `json:"execution_payload_header"`
 	}
 	var g2 genesisMarshalable[DepositT]
 	if err := json.Unmarshal(data, &g2); err != nil {
@@ -102,6 +115,7 @@ func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 	}
 
 	g.Deposits = g2.Deposits
+	g.Validators = g2.Validators
 	g.ForkVersion = g2.ForkVersion
 	g.ExecutionPayloadHeader = payloadHeader
 	return nil
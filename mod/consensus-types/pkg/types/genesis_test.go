@@ -27,7 +27,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
@@ -221,3 +224,25 @@ func TestGenesisUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidatorsJSON(t *testing.T) {
+	g := types.DefaultGenesisDeneb()
+	g.Validators = []*transition.GenesisValidator{
+		{
+			Pubkey:          crypto.BLSPubkey{0x01},
+			Balance:         math.Gwei(64e9),
+			ActivationEpoch: 0,
+		},
+		{
+			Pubkey:          crypto.BLSPubkey{0x02},
+			ActivationEpoch: 3,
+		},
+	}
+
+	bz, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	decoded := types.DefaultGenesisDeneb()
+	require.NoError(t, json.Unmarshal(bz, decoded))
+	require.Equal(t, g.GetValidators(), decoded.GetValidators())
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+//
+// # Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+//
+
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// benchSlotsPerHistoricalRoot fills the block and state root vectors.
+	benchSlotsPerHistoricalRoot = 8192
+	// benchMaxBlobsPerBlock is the Deneb blob limit.
+	benchMaxBlobsPerBlock = 6
+	// benchTxsPerPayload and benchTxSize shape a busy execution payload.
+	benchTxsPerPayload = 1000
+	benchTxSize        = 256
+)
+
+// generateBenchBeaconState returns a state with full history vectors and
+// the given number of active validators.
+func generateBenchBeaconState(numValidators int) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	st := generateValidBeaconState()
+	st.BlockRoots = make([]common.Root, benchSlotsPerHistoricalRoot)
+	st.StateRoots = make([]common.Root, benchSlotsPerHistoricalRoot)
+	for i, root := range generateRandomBytes32(benchSlotsPerHistoricalRoot) {
+		st.BlockRoots[i] = common.Root(root)
+		st.StateRoots[i] = common.Root(root)
+	}
+
+	st.Validators = make([]*types.Validator, numValidators)
+	st.Balances = make([]uint64, numValidators)
+	for i := range numValidators {
+		st.Validators[i] = &types.Validator{
+			Pubkey:                     [48]byte{byte(i), byte(i >> 8)},
+			WithdrawalCredentials:      [32]byte{0x01, byte(i)},
+			EffectiveBalance:           32e9,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  math.Epoch(constants.FarFutureEpoch),
+			WithdrawableEpoch:          math.Epoch(constants.FarFutureEpoch),
+		}
+		st.Balances[i] = 32e9
+	}
+	return st
+}
+
+// generateBenchBeaconBlock returns a block at the blob, deposit and
+// withdrawal limits, with a payload of benchTxsPerPayload transactions.
+func generateBenchBeaconBlock() *types.BeaconBlock {
+	blk := generateValidBeaconBlock()
+	body := blk.Body
+
+	body.ExecutionPayload.Transactions = make([][]byte, benchTxsPerPayload)
+	for i := range body.ExecutionPayload.Transactions {
+		tx := make([]byte, benchTxSize)
+		tx[0] = byte(i)
+		body.ExecutionPayload.Transactions[i] = tx
+	}
+	body.ExecutionPayload.Withdrawals = make(
+		[]*engineprimitives.Withdrawal, constants.MaxWithdrawalsPerPayload,
+	)
+	for i := range body.ExecutionPayload.Withdrawals {
+		body.ExecutionPayload.Withdrawals[i] = &engineprimitives.Withdrawal{
+			Index:  math.U64(i),
+			Amount: 32e9,
+		}
+	}
+	body.Deposits = make([]*types.Deposit, constants.MaxDepositsPerBlock)
+	for i := range body.Deposits {
+		body.Deposits[i] = &types.Deposit{
+			Pubkey: [48]byte{byte(i)},
+			Amount: 32e9,
+			Index:  uint64(i),
+		}
+	}
+	body.BlobKzgCommitments = make(
+		[]eip4844.KZGCommitment, benchMaxBlobsPerBlock,
+	)
+	for i := range body.BlobKzgCommitments {
+		body.BlobKzgCommitments[i] = eip4844.KZGCommitment{byte(i)}
+	}
+	return blk
+}
+
+// BenchmarkBeaconState_HashTreeRoot tracks the cost of hashing a full
+// beacon state as the validator set grows.
+func BenchmarkBeaconState_HashTreeRoot(b *testing.B) {
+	for _, numValidators := range []int{1_000, 10_000, 100_000} {
+		st := generateBenchBeaconState(numValidators)
+		name := fmt.Sprintf("validators=%d", numValidators)
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(st.SizeSSZ(false)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				st.HashTreeRoot()
+			}
+		})
+	}
+}
+
+// BenchmarkBeaconBlock_HashTreeRoot tracks the cost of hashing a block
+// carrying the maximum number of blobs, deposits and withdrawals.
+func BenchmarkBeaconBlock_HashTreeRoot(b *testing.B) {
+	blk := generateBenchBeaconBlock()
+	b.SetBytes(int64(blk.SizeSSZ(false)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		blk.HashTreeRoot()
+	}
+}
+
+// BenchmarkBeaconBlockBody_HashTreeRoot isolates the body, whose payload
+// transactions dominate the hashing of busy blocks.
+func BenchmarkBeaconBlockBody_HashTreeRoot(b *testing.B) {
+	body := generateBenchBeaconBlock().Body
+	b.SetBytes(int64(body.SizeSSZ(false)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		body.HashTreeRoot()
+	}
+}
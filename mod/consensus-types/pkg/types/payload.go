@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
@@ -141,6 +142,28 @@ func (p *ExecutionPayload) UnmarshalSSZ(bz []byte) error {
 	return ssz.DecodeFromBytes(bz, p)
 }
 
+// UnmarshalSSZReuse unmarshals the ExecutionPayload object from a source
+// array into the buffers p already holds, e.g. those of a pooled payload.
+// p must not be shared while it is decoded into.
+func (p *ExecutionPayload) UnmarshalSSZReuse(bz []byte) error {
+	p.reserve()
+	return p.UnmarshalSSZ(bz)
+}
+
+// reserve grows the withdrawals of p to their maximum count, keeping the
+// ones decoded before, as the decoder only reuses the withdrawals within the
+// capacity of the slice.
+func (p *ExecutionPayload) reserve() {
+	if uint64(cap(p.Withdrawals)) >= constants.MaxWithdrawalsPerPayload {
+		return
+	}
+	withdrawals := make(
+		[]*engineprimitives.Withdrawal, constants.MaxWithdrawalsPerPayload,
+	)
+	copy(withdrawals, p.Withdrawals[:cap(p.Withdrawals)])
+	p.Withdrawals = withdrawals[:len(p.Withdrawals)]
+}
+
 // HashTreeRoot returns the hash tree root of the ExecutionPayload.
 func (p *ExecutionPayload) HashTreeRoot() common.Root {
 	return ssz.HashConcurrent(p)
@@ -152,12 +175,14 @@ func (p *ExecutionPayload) HashTreeRoot() common.Root {
 
 // MarshalSSZTo serializes the ExecutionPayload object into a writer.
 func (p *ExecutionPayload) MarshalSSZTo(dst []byte) ([]byte, error) {
-	bz, err := p.MarshalSSZ()
-	if err != nil {
+	// Encode into a pooled scratch buffer, since its contents are copied
+	// into dst anyway.
+	buf := pool.GetBytes(int(p.SizeSSZ(false)))
+	defer pool.PutBytes(buf)
+	if err := ssz.EncodeToBytes(*buf, p); err != nil {
 		return nil, err
 	}
-	dst = append(dst, bz...)
-	return dst, nil
+	return append(dst, *buf...), nil
 }
 
 // HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher.
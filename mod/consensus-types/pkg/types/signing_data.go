@@ -21,10 +21,8 @@
 package types
 
 import (
-	"encoding/binary"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/domains"
 	"github.com/karalabe/ssz"
 )
 
@@ -85,22 +83,13 @@ func ComputeSigningRoot(
 	sszObject interface{ HashTreeRoot() common.Root },
 	domain common.Domain,
 ) common.Root {
-	return (&SigningData{
-		ObjectRoot: sszObject.HashTreeRoot(),
-		Domain:     domain,
-	}).HashTreeRoot()
+	return domains.ComputeSigningRootOf(sszObject, domain)
 }
 
 // ComputeSigningRootUInt64 computes the signing root of a uint64 value.
 func ComputeSigningRootUInt64(
 	value uint64,
 	domain common.Domain,
-
 ) common.Root {
-	bz := make([]byte, constants.RootLength)
-	binary.LittleEndian.PutUint64(bz, value)
-	return (&SigningData{
-		ObjectRoot: common.Root(bz),
-		Domain:     domain,
-	}).HashTreeRoot()
+	return domains.ComputeSigningRootUInt64(value, domain)
 }
@@ -28,6 +28,33 @@ import (
 	"github.com/karalabe/ssz"
 )
 
+// BeaconStateFieldNames are the names of the top-level fields of the
+// BeaconState, in the order in which they are merkleized.
+//
+//nolint:gochecknoglobals // read-only list of the state fields.
+var BeaconStateFieldNames = []string{
+	"genesis_validators_root",
+	"slot",
+	"fork",
+	"latest_block_header",
+	"block_roots",
+	"state_roots",
+	"eth1_data",
+	"eth1_deposit_index",
+	"latest_execution_payload_header",
+	"validators",
+	"balances",
+	"randao_mixes",
+	"next_withdrawal_index",
+	"next_withdrawal_validator_index",
+	"slashings",
+	"total_slashing",
+}
+
+// beaconStateFieldsGIndex is the generalized index of the first top-level
+// field of the BeaconState, the fields being the leaves of a tree of depth 4.
+const beaconStateFieldsGIndex = 16
+
 // BeaconState represents the entire state of the beacon chain.
 type BeaconState[
 	BeaconBlockHeaderT constraints.
@@ -401,3 +428,25 @@ func (st *BeaconState[
 ]) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(st)
 }
+
+// FieldRoots returns the hash tree roots of the top-level fields of the
+// BeaconState, in the order of BeaconStateFieldNames. Comparing them between
+// two states localizes the fields on which their state roots diverge.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) FieldRoots() ([]common.Root, error) {
+	tree, err := st.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Root, len(BeaconStateFieldNames))
+	for i := range roots {
+		var node *fastssz.Node
+		if node, err = tree.Get(beaconStateFieldsGIndex + i); err != nil {
+			return nil, err
+		}
+		roots[i] = common.NewRootFromBytes(node.Hash())
+	}
+	return roots, nil
+}
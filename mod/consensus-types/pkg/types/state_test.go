@@ -27,6 +27,7 @@
 package types_test
 
 import (
+	"encoding/binary"
 	"io"
 	"testing"
 
@@ -191,6 +192,30 @@ func TestGetTree(t *testing.T) {
 	require.NotNil(t, tree)
 }
 
+func TestBeaconState_FieldRoots(t *testing.T) {
+	state := generateValidBeaconState()
+	roots, err := state.FieldRoots()
+	require.NoError(t, err)
+	require.Len(t, roots, len(types.BeaconStateFieldNames))
+
+	// The slot is a basic type, hence its root is its little-endian encoding.
+	var slotRoot common.Root
+	binary.LittleEndian.PutUint64(slotRoot[:], state.Slot.Unwrap())
+	require.Equal(t, slotRoot, roots[1])
+
+	// Changing a single field must only change the root of that field.
+	state.Eth1DepositIndex++
+	updated, err := state.FieldRoots()
+	require.NoError(t, err)
+	for i := range roots {
+		if types.BeaconStateFieldNames[i] == "eth1_deposit_index" {
+			require.NotEqual(t, roots[i], updated[i])
+			continue
+		}
+		require.Equal(t, roots[i], updated[i], types.BeaconStateFieldNames[i])
+	}
+}
+
 func TestBeaconState_UnmarshalSSZ_Error(t *testing.T) {
 	state := &types.BeaconState[
 		*types.BeaconBlockHeader,
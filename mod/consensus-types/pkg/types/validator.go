@@ -297,7 +297,25 @@ func (v Validator) IsPartiallyWithdrawable(
 //
 //nolint:lll
 func (v Validator) HasEth1WithdrawalCredentials() bool {
-	return v.WithdrawalCredentials[0] == EthSecp256k1CredentialPrefix
+	return v.WithdrawalCredentials.IsEth1()
+}
+
+// HasCompoundingWithdrawalCredentials as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_compounding_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasCompoundingWithdrawalCredentials() bool {
+	return v.WithdrawalCredentials.IsCompounding()
+}
+
+// HasExecutionWithdrawalCredentials as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasExecutionWithdrawalCredentials() bool {
+	return v.WithdrawalCredentials.HasExecutionAddress()
 }
 
 // HasMaxEffectiveBalance determines if the validator has the maximum effective
@@ -313,11 +331,48 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// GetActivationEligibilityEpoch returns the epoch in which the validator
+// became eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// SetActivationEligibilityEpoch sets the epoch in which the validator became
+// eligible for activation.
+func (v *Validator) SetActivationEligibilityEpoch(epoch math.Epoch) {
+	v.ActivationEligibilityEpoch = epoch
+}
+
+// GetActivationEpoch returns the epoch in which the validator activates.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch in which the validator activates.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch in which the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch in which the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
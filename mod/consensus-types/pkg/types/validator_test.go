@@ -823,6 +823,27 @@ func TestValidator_GetWithdrawableEpoch(t *testing.T) {
 	}
 }
 
+func TestValidator_LifecycleEpochs(t *testing.T) {
+	v := types.NewValidatorFromDeposit(
+		[48]byte{0x01}, types.WithdrawalCredentials{}, 32e9, 1e9, 32e9,
+	)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	require.Equal(t, farFuture, v.GetActivationEligibilityEpoch())
+	require.Equal(t, farFuture, v.GetActivationEpoch())
+	require.Equal(t, farFuture, v.GetExitEpoch())
+
+	v.SetActivationEligibilityEpoch(1)
+	v.SetActivationEpoch(6)
+	v.SetExitEpoch(20)
+	v.SetWithdrawableEpoch(276)
+	require.Equal(t, math.Epoch(1), v.GetActivationEligibilityEpoch())
+	require.Equal(t, math.Epoch(6), v.GetActivationEpoch())
+	require.Equal(t, math.Epoch(20), v.GetExitEpoch())
+	require.Equal(t, math.Epoch(276), v.GetWithdrawableEpoch())
+	require.True(t, v.IsActive(6))
+	require.False(t, v.IsActive(20))
+}
+
 func TestValidator_GetWithdrawalCredentials(t *testing.T) {
 	tests := []struct {
 		name      string
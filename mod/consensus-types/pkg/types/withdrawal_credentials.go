@@ -26,38 +26,143 @@
 package types
 
 import (
+	"crypto/sha256"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// BLSCredentialPrefix is the prefix for credentials committing to the
+	// hash of a BLS withdrawal key.
+	BLSCredentialPrefix = byte(iota)
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix
+	// CompoundingCredentialPrefix is the prefix for an Ethereum secp256k1
+	// address of a compounding validator, as introduced by EIP-7251.
+	CompoundingCredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
 type WithdrawalCredentials common.Bytes32
 
+// NewCredentialsFromBLSPubkey creates a new 0x00 WithdrawalCredentials
+// committing to the given BLS withdrawal key.
+func NewCredentialsFromBLSPubkey(
+	pubkey crypto.BLSPubkey,
+) WithdrawalCredentials {
+	credentials := WithdrawalCredentials(sha256.Sum256(pubkey[:]))
+	credentials[0] = BLSCredentialPrefix
+	return credentials
+}
+
 // NewCredentialsFromExecutionAddress creates a new WithdrawalCredentials from
 // an.
 func NewCredentialsFromExecutionAddress(
 	address common.ExecutionAddress,
+) WithdrawalCredentials {
+	return newExecutionCredentials(EthSecp256k1CredentialPrefix, address)
+}
+
+// NewCompoundingCredentialsFromExecutionAddress creates a new 0x02
+// WithdrawalCredentials of a compounding validator withdrawing to the given
+// address.
+func NewCompoundingCredentialsFromExecutionAddress(
+	address common.ExecutionAddress,
+) WithdrawalCredentials {
+	return newExecutionCredentials(CompoundingCredentialPrefix, address)
+}
+
+// newExecutionCredentials creates credentials with the given prefix, followed
+// by 11 bytes of zero padding and the given address.
+func newExecutionCredentials(
+	prefix byte,
+	address common.ExecutionAddress,
 ) WithdrawalCredentials {
 	credentials := WithdrawalCredentials{}
-	credentials[0] = EthSecp256k1CredentialPrefix
+	credentials[0] = prefix
 	copy(credentials[12:], address[:])
 	return credentials
 }
 
+// Prefix returns the prefix of the WithdrawalCredentials.
+func (wc WithdrawalCredentials) Prefix() byte {
+	return wc[0]
+}
+
+// IsBLS returns whether the WithdrawalCredentials commit to a BLS key.
+func (wc WithdrawalCredentials) IsBLS() bool {
+	return wc[0] == BLSCredentialPrefix
+}
+
+// IsEth1 returns whether the WithdrawalCredentials withdraw to an execution
+// address with the 0x01 prefix.
+func (wc WithdrawalCredentials) IsEth1() bool {
+	return wc[0] == EthSecp256k1CredentialPrefix
+}
+
+// IsCompounding returns whether the WithdrawalCredentials are those of a
+// compounding validator.
+func (wc WithdrawalCredentials) IsCompounding() bool {
+	return wc[0] == CompoundingCredentialPrefix
+}
+
+// HasExecutionAddress returns whether the WithdrawalCredentials withdraw to
+// an execution address, i.e. they are either 0x01 or 0x02 credentials.
+func (wc WithdrawalCredentials) HasExecutionAddress() bool {
+	return wc.IsEth1() || wc.IsCompounding()
+}
+
+// Validate returns an error if the WithdrawalCredentials have an unknown
+// prefix, or if the padding before the address of 0x01 and 0x02 credentials
+// is not zero.
+func (wc WithdrawalCredentials) Validate() error {
+	switch {
+	case wc.IsBLS():
+		return nil
+	case wc.HasExecutionAddress():
+		if [11]byte(wc[1:12]) != [11]byte{} {
+			return errors.Wrapf(
+				ErrInvalidWithdrawalCredentials,
+				"non-zero padding in %#x credentials", wc[0],
+			)
+		}
+		return nil
+	default:
+		return errors.Wrapf(
+			ErrInvalidWithdrawalCredentials, "unknown prefix %#x", wc[0],
+		)
+	}
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
+// Both 0x01 and 0x02 credentials hold an execution address.
 func (wc WithdrawalCredentials) ToExecutionAddress() (
 	common.ExecutionAddress,
 	error,
 ) {
-	if wc[0] != EthSecp256k1CredentialPrefix {
+	if !wc.HasExecutionAddress() {
 		return common.ExecutionAddress{}, ErrInvalidWithdrawalCredentials
 	}
 	return common.ExecutionAddress(wc[12:]), nil
 }
 
+// ToCompounding converts 0x01 WithdrawalCredentials to the 0x02 credentials
+// of a compounding validator withdrawing to the same address. Compounding
+// credentials are returned as is.
+func (wc WithdrawalCredentials) ToCompounding() (
+	WithdrawalCredentials,
+	error,
+) {
+	address, err := wc.ToExecutionAddress()
+	if err != nil {
+		return WithdrawalCredentials{}, err
+	}
+	return NewCompoundingCredentialsFromExecutionAddress(address), nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface for Bytes32.
 // TODO: Figure out how to not have to do this.
 func (wc *WithdrawalCredentials) UnmarshalJSON(input []byte) error {
@@ -21,10 +21,12 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	types "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,6 +103,90 @@ func TestToExecutionAddress_InvalidPrefix(t *testing.T) {
 	require.Error(t, err, "Expected an error due to invalid prefix")
 }
 
+func TestNewCredentialsFromBLSPubkey(t *testing.T) {
+	pubkey := crypto.BLSPubkey{0x01, 0x02, 0x03}
+	credentials := types.NewCredentialsFromBLSPubkey(pubkey)
+	hash := sha256.Sum256(pubkey[:])
+	require.Equal(t, types.BLSCredentialPrefix, credentials.Prefix())
+	require.Equal(t, hash[1:], credentials[1:])
+	require.True(t, credentials.IsBLS())
+	require.False(t, credentials.HasExecutionAddress())
+	require.NoError(t, credentials.Validate())
+}
+
+func TestNewCompoundingCredentialsFromExecutionAddress(t *testing.T) {
+	address := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	credentials := types.NewCompoundingCredentialsFromExecutionAddress(address)
+	require.Equal(t, types.CompoundingCredentialPrefix, credentials.Prefix())
+	require.True(t, credentials.IsCompounding())
+	require.True(t, credentials.HasExecutionAddress())
+	require.NoError(t, credentials.Validate())
+
+	got, err := credentials.ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, got)
+}
+
+func TestWithdrawalCredentials_Validate(t *testing.T) {
+	address := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	padded := types.NewCredentialsFromExecutionAddress(address)
+	padded[5] = 0xff
+	tests := []struct {
+		name    string
+		wc      types.WithdrawalCredentials
+		wantErr bool
+	}{
+		{
+			name: "bls",
+			wc:   types.WithdrawalCredentials{0x00, 0xff},
+		},
+		{
+			name: "eth1",
+			wc:   types.NewCredentialsFromExecutionAddress(address),
+		},
+		{
+			name: "compounding",
+			wc:   types.NewCompoundingCredentialsFromExecutionAddress(address),
+		},
+		{
+			name:    "non-zero padding",
+			wc:      padded,
+			wantErr: true,
+		},
+		{
+			name:    "unknown prefix",
+			wc:      types.WithdrawalCredentials{0x03},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wc.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidWithdrawalCredentials)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawalCredentials_ToCompounding(t *testing.T) {
+	address := common.ExecutionAddress{0xde, 0xad, 0xbe, 0xef}
+	compounding, err := types.NewCredentialsFromExecutionAddress(address).
+		ToCompounding()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		types.NewCompoundingCredentialsFromExecutionAddress(address),
+		compounding,
+	)
+
+	_, err = types.WithdrawalCredentials{}.ToCompounding()
+	require.ErrorIs(t, err, types.ErrInvalidWithdrawalCredentials)
+}
+
 func TestWithdrawalCredentials_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -22,13 +22,16 @@ package server
 
 import (
 	"context"
+	"path/filepath"
 
 	"cosmossdk.io/store"
 	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	beaconflags "github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	service "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -42,6 +45,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
 )
@@ -142,11 +146,10 @@ func ShowValidatorCmd() *cobra.Command {
 		Use:   "show-validator",
 		Short: "Show this node's CometBFT validator info",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := clicontext.GetConfigFromCmd(cmd)
-			privValidator := pvm.LoadFilePV(
-				cfg.PrivValidatorKeyFile(),
-				cfg.PrivValidatorStateFile(),
-			)
+			privValidator, err := loadFilePV(cmd)
+			if err != nil {
+				return err
+			}
 			pk, err := privValidator.GetPubKey()
 			if err != nil {
 				return err
@@ -177,11 +180,10 @@ func ShowAddressCmd() *cobra.Command {
 		Use:   "show-address",
 		Short: "Shows this node's CometBFT validator consensus address",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := clicontext.GetConfigFromCmd(cmd)
-			privValidator := pvm.LoadFilePV(
-				cfg.PrivValidatorKeyFile(),
-				cfg.PrivValidatorStateFile(),
-			)
+			privValidator, err := loadFilePV(cmd)
+			if err != nil {
+				return err
+			}
 
 			valConsAddr := (sdk.ConsAddress)(privValidator.GetAddress())
 
@@ -193,6 +195,50 @@ func ShowAddressCmd() *cobra.Command {
 	return cmd
 }
 
+// loadFilePV loads the validator key files of the node, decrypting the key
+// file with the passphrase configured in app.toml if encryption at rest is
+// enabled.
+func loadFilePV(cmd *cobra.Command) (*pvm.FilePV, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	v := clicontext.GetViperFromCmd(cmd)
+	if !cast.ToBool(v.Get(beaconflags.EncryptionEnabled)) {
+		return pvm.LoadFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+		), nil
+	}
+
+	cipher, err := encryption.NewCipherFromProvider(
+		encryption.NewPassphraseKeyProvider(
+			resolvePath(
+				cfg.RootDir,
+				cast.ToString(v.Get(beaconflags.EncryptionPassphraseFile)),
+			),
+			resolvePath(
+				cfg.RootDir,
+				cast.ToString(v.Get(beaconflags.EncryptionSaltFile)),
+			),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.LoadFilePV(
+		cfg.PrivValidatorKeyFile(),
+		cfg.PrivValidatorStateFile(),
+		cipher,
+	)
+}
+
+// resolvePath joins the given path with the home directory if it is not
+// absolute.
+func resolvePath(homeDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(homeDir, path)
+}
+
 // VersionCmd prints CometBFT and ABCI version numbers.
 func VersionCmd() *cobra.Command {
 	return &cobra.Command{
@@ -27,12 +27,16 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"cosmossdk.io/store/rootmulti"
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	errorsmod "github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
@@ -182,9 +186,24 @@ func (s *Service[LoggerT]) PrepareProposal(
 	_ context.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
+	start := time.Now()
+	resp, outcome, err := s.internalPrepareProposal(req)
+	if err == nil {
+		s.record(middleware.RecordPrepareProposal, req, resp)
+	}
+	s.observePhase(
+		middleware.PhasePrepareProposal, outcome, start,
+		req, resp, len(resp.GetTxs()),
+	)
+	return resp, err
+}
+
+func (s *Service[LoggerT]) internalPrepareProposal(
+	req *cmtabci.PrepareProposalRequest,
+) (*cmtabci.PrepareProposalResponse, middleware.Outcome, error) {
 	// CometBFT must never call PrepareProposal with a height of 0.
 	if req.Height < 1 {
-		return nil, fmt.Errorf(
+		return nil, middleware.OutcomeError, fmt.Errorf(
 			"prepareProposal at height %v: %w",
 			req.Height,
 			errInvalidHeight,
@@ -195,14 +214,18 @@ func (s *Service[LoggerT]) PrepareProposal(
 	// and be called again in a subsequent round.
 	s.prepareProposalState = s.resetState()
 	s.prepareProposalState.SetContext(
-		s.getContextForProposal(
-			s.prepareProposalState.Context(),
-			req.Height,
-		),
+		withRequestID(s.withConsensusTimeouts(
+			s.getContextForProposal(
+				s.prepareProposalState.Context(),
+				req.Height,
+			),
+		)),
 	)
 
-	blkBz, sidecarsBz, err := s.Middleware.PrepareProposal(
-		s.prepareProposalState.Context(), &types.SlotData[
+	txs, err := s.Middleware.PrepareProposal(
+		s.prepareProposalState.Context(),
+		math.Slot(req.Height),
+		&types.SlotData[
 			*ctypes.AttestationData,
 			*ctypes.SlashingInfo,
 		]{
@@ -212,19 +235,17 @@ func (s *Service[LoggerT]) PrepareProposal(
 	if err != nil {
 		s.logger.Error(
 			"failed to prepare proposal",
-			"height",
-			req.Height,
-			"time",
-			req.Time,
-			"err",
-			err,
+			correlation.LogFields(
+				s.prepareProposalState.Context(),
+				"height", req.Height, "time", req.Time, "err", err,
+			)...,
 		)
-		return &cmtabci.PrepareProposalResponse{Txs: req.Txs}, nil
+		return &cmtabci.PrepareProposalResponse{Txs: req.Txs},
+			middleware.OutcomeFallback, nil
 	}
 
-	return &cmtabci.PrepareProposalResponse{
-		Txs: [][]byte{blkBz, sidecarsBz},
-	}, nil
+	return &cmtabci.PrepareProposalResponse{Txs: txs},
+		middleware.OutcomeOK, nil
 }
 
 // ProcessProposal implements the ProcessProposal ABCI method and returns a
@@ -232,6 +253,26 @@ func (s *Service[LoggerT]) PrepareProposal(
 func (s *Service[LoggerT]) ProcessProposal(
 	_ context.Context,
 	req *cmtabci.ProcessProposalRequest,
+) (*cmtabci.ProcessProposalResponse, error) {
+	start := time.Now()
+	resp, err := s.internalProcessProposal(req)
+	if err == nil {
+		s.record(middleware.RecordProcessProposal, req, resp)
+	}
+
+	outcome := outcomeOf(err)
+	if resp.GetStatus() == cmtabci.PROCESS_PROPOSAL_STATUS_REJECT {
+		outcome = middleware.OutcomeRejected
+	}
+	s.observePhase(
+		middleware.PhaseProcessProposal, outcome, start,
+		req, resp, len(req.GetTxs()),
+	)
+	return resp, err
+}
+
+func (s *Service[LoggerT]) internalProcessProposal(
+	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
 	// CometBFT must never call ProcessProposal with a height of 0.
 	if req.Height < 1 {
@@ -253,10 +294,12 @@ func (s *Service[LoggerT]) ProcessProposal(
 	}
 
 	s.processProposalState.SetContext(
-		s.getContextForProposal(
-			s.processProposalState.Context(),
-			req.Height,
-		),
+		withRequestID(s.withConsensusTimeouts(
+			s.getContextForProposal(
+				s.processProposalState.Context(),
+				req.Height,
+			),
+		)),
 	)
 
 	resp, err := s.Middleware.ProcessProposal(
@@ -266,14 +309,11 @@ func (s *Service[LoggerT]) ProcessProposal(
 	if err != nil {
 		s.logger.Error(
 			"failed to process proposal",
-			"height",
-			req.Height,
-			"time",
-			req.Time,
-			"hash",
-			fmt.Sprintf("%X", req.Hash),
-			"err",
-			err,
+			correlation.LogFields(
+				s.processProposalState.Context(),
+				"height", req.Height, "time", req.Time,
+				"hash", fmt.Sprintf("%X", req.Hash), "err", err,
+			)...,
 		)
 		return &cmtabci.ProcessProposalResponse{
 			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
@@ -296,6 +336,9 @@ func (s *Service[LoggerT]) internalFinalizeBlock(
 	if s.finalizeBlockState == nil {
 		s.finalizeBlockState = s.resetState()
 	}
+	s.finalizeBlockState.SetContext(
+		withRequestID(s.finalizeBlockState.Context()),
+	)
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
 	// them, gathering the execution results.
@@ -381,10 +424,21 @@ func (s *Service[_]) FinalizeBlock(
 	_ context.Context,
 	req *cmtabci.FinalizeBlockRequest,
 ) (*cmtabci.FinalizeBlockResponse, error) {
+	if err := s.checkUpgradeHeight(req.Height); err != nil {
+		return nil, err
+	}
+	start := time.Now()
 	res, err := s.internalFinalizeBlock(req)
 	if res != nil {
 		res.AppHash = s.workingHash()
 	}
+	if err == nil {
+		s.record(middleware.RecordFinalizeBlock, req, res)
+	}
+	s.observePhase(
+		middleware.PhaseFinalizeBlock, outcomeOf(err), start,
+		req, res, len(req.GetTxs()),
+	)
 
 	return res, err
 }
@@ -392,13 +446,13 @@ func (s *Service[_]) FinalizeBlock(
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned cmtabci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// latest header and reset the deliver state. Also, if an upgrade is scheduled
+// at the next height and the running binary does not implement it, Commit
+// gracefully halts the node.
 func (s *Service[LoggerT]) Commit(
-	context.Context, *cmtabci.CommitRequest,
+	_ context.Context, req *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
+	start := time.Now()
 	if s.finalizeBlockState == nil {
 		// This is unexpected since CometBFT should call Commit only
 		// after FinalizeBlock has been called. Panic appeases nilaway.
@@ -414,10 +468,46 @@ func (s *Service[LoggerT]) Commit(
 	s.sm.CommitMultiStore().Commit()
 
 	s.finalizeBlockState = nil
+	s.maybeCheckpoint(header.Height)
+	s.maybeHaltForUpgrade(header.Height)
 
-	return &cmtabci.CommitResponse{
+	resp := &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
-	}, nil
+	}
+	s.observePhase(middleware.PhaseCommit, middleware.OutcomeOK, start,
+		req, resp, 0,
+	)
+	return resp, nil
+}
+
+// sizer is an ABCI request or response whose encoded size is known.
+type sizer interface {
+	Size() int
+}
+
+// observePhase reports the handling of an ABCI request to the middleware
+// metrics.
+func (s *Service[_]) observePhase(
+	phase middleware.Phase,
+	outcome middleware.Outcome,
+	start time.Time,
+	req, resp sizer,
+	numTxs int,
+) {
+	s.Middleware.ObservePhase(phase, outcome, middleware.PhaseStats{
+		Start:        start,
+		RequestSize:  req.Size(),
+		ResponseSize: resp.Size(),
+		NumTxs:       numTxs,
+	})
+}
+
+// outcomeOf returns the outcome of a request that returned err.
+func outcomeOf(err error) middleware.Outcome {
+	if err != nil {
+		return middleware.OutcomeError
+	}
+	return middleware.OutcomeOK
 }
 
 // workingHash gets the apphash that will be finalized in commit.
@@ -471,12 +561,42 @@ func (s *Service[LoggerT]) getContextForProposal(
 	return ctx
 }
 
+// withConsensusTimeouts attaches the consensus timeouts of the node to ctx,
+// so that the phases of block processing run under it stay within what
+// consensus waits for.
+func (s *Service[_]) withConsensusTimeouts(ctx sdk.Context) sdk.Context {
+	if s.cmtCfg == nil || s.cmtCfg.Consensus == nil {
+		return ctx
+	}
+	cfg := s.cmtCfg.Consensus
+	return ctx.WithContext(budget.WithTimeouts(
+		ctx.Context(), budget.Timeouts{
+			Propose:   cfg.TimeoutPropose,
+			Prevote:   cfg.TimeoutPrevote,
+			Precommit: cfg.TimeoutPrecommit,
+			Commit:    cfg.TimeoutCommit,
+		},
+	))
+}
+
+// withRequestID attaches a new correlation ID to ctx, identifying the ABCI
+// request it is created for in the logs of the services handling it and in
+// the calls they make to the execution client.
+func withRequestID(ctx sdk.Context) sdk.Context {
+	return ctx.WithContext(
+		correlation.WithID(ctx.Context(), correlation.NewID()),
+	)
+}
+
 // CreateQueryContext creates a new sdk.Context for a query, taking as args
 // the block height and whether the query needs a proof or not.
 func (s *Service[LoggerT]) CreateQueryContext(
 	height int64,
 	prove bool,
 ) (sdk.Context, error) {
+	s.replicaMu.RLock()
+	defer s.replicaMu.RUnlock()
+
 	// use custom query multi-store if provided
 	lastBlockHeight := s.sm.CommitMultiStore().LatestVersion()
 	if lastBlockHeight == 0 {
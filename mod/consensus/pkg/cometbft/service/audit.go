@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRegistryAudited is returned by Start once `audit-registry` found no
+	// anomaly in the validator registry. Like ErrChainVerified, it stops the
+	// remaining node lifecycle.
+	ErrRegistryAudited = errors.New("registry audited")
+
+	// errRegistryCorrupt is returned when the audit found at least one
+	// anomaly in the validator registry.
+	errRegistryCorrupt = errors.New("validator registry has index anomalies")
+)
+
+// runRegistryAudit audits the validator registry of the latest committed
+// beacon state, reporting every index anomaly found.
+func (s *Service[_]) runRegistryAudit() error {
+	if s.chainVerifier == nil {
+		return errNoChainVerifier
+	}
+
+	height := s.LastBlockHeight()
+	queryCtx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return err
+	}
+
+	anomalies, err := s.chainVerifier.AuditRegistry(queryCtx)
+	if err != nil {
+		return err
+	}
+	for _, anomaly := range anomalies {
+		s.logger.Error("Validator registry anomaly",
+			"height", height, "reason", anomaly,
+		)
+	}
+
+	s.logger.Info("Finished registry audit",
+		"height", height, "anomalies", len(anomalies),
+	)
+	if len(anomalies) == 0 {
+		return ErrRegistryAudited
+	}
+	return fmt.Errorf("%d anomalies: %w", len(anomalies), errRegistryCorrupt)
+}
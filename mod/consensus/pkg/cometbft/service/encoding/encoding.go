@@ -25,7 +25,8 @@ import (
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
-// request.
+// request. The block is decoded with the structure of the given fork
+// version, as registered by its type.
 func ExtractBlobsAndBlockFromRequest[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BlobSidecarsT interface {
@@ -67,8 +68,8 @@ func ExtractBlobsAndBlockFromRequest[
 	return blk, blobs, nil
 }
 
-// UnmarshalBeaconBlockFromABCIRequest extracts a beacon block from an ABCI
-// request.
+// UnmarshalBeaconBlockFromABCIRequest extracts a beacon block of the given
+// fork version from an ABCI request.
 func UnmarshalBeaconBlockFromABCIRequest[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 ](
@@ -114,22 +115,50 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 	bzIndex uint,
 ) (BlobSidecarsT, error) {
 	var sidecars BlobSidecarsT
+	sidecarBz, err := txFromABCIRequest(req, bzIndex)
+	if err != nil {
+		return sidecars, err
+	}
+
+	// TODO: Do some research to figure out how to make this more
+	// elegant.
+	sidecars = sidecars.Empty()
+	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+}
+
+// UnmarshalBlobSidecarsFromABCIRequestInto extracts blob sidecars from an
+// ABCI request into the given sidecars, reusing the memory they hold.
+func UnmarshalBlobSidecarsFromABCIRequestInto[
+	BlobSidecarsT interface {
+		UnmarshalSSZReuse([]byte) error
+	},
+](
+	req ABCIRequest,
+	bzIndex uint,
+	sidecars BlobSidecarsT,
+) error {
+	sidecarBz, err := txFromABCIRequest(req, bzIndex)
+	if err != nil {
+		return err
+	}
+	return sidecars.UnmarshalSSZReuse(sidecarBz)
+}
+
+// txFromABCIRequest returns the transaction at the given index of an ABCI
+// request.
+func txFromABCIRequest(req ABCIRequest, bzIndex uint) ([]byte, error) {
 	if req == nil {
-		return sidecars, ErrNilABCIRequest
+		return nil, ErrNilABCIRequest
 	}
 
 	txs := req.GetTxs()
 	if len(txs) == 0 || bzIndex >= uint(len(txs)) {
-		return sidecars, ErrNoBeaconBlockInRequest
+		return nil, ErrNoBeaconBlockInRequest
 	}
 
-	sidecarBz := txs[bzIndex]
-	if sidecarBz == nil {
-		return sidecars, ErrNilBeaconBlockInRequest
+	bz := txs[bzIndex]
+	if bz == nil {
+		return nil, ErrNilBeaconBlockInRequest
 	}
-
-	// TODO: Do some research to figure out how to make this more
-	// elegant.
-	sidecars = sidecars.Empty()
-	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+	return bz, nil
 }
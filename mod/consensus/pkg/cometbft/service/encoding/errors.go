@@ -39,6 +39,10 @@ var (
 	// is nil.
 	ErrNilABCIRequest = errors.New("nil abci request")
 
+	// ErrDuplicateTx is an error for when a transaction kind is registered
+	// twice in a TxRegistry.
+	ErrDuplicateTx = errors.New("duplicate consensus tx")
+
 	// ErrInvalidType is an error for when the type is invalid.
 	ErrInvalidType = errors.New("invalid type")
 )
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// TxDecoder decodes one kind of consensus transaction carried in a CometBFT
+// block.
+type TxDecoder interface {
+	// Decode decodes bz, returning an error if it is not a well-formed
+	// transaction of this kind for the given fork.
+	Decode(bz []byte, forkVersion uint32) error
+}
+
+// ConsensusTx is an additional consensus transaction, e.g. an oracle
+// transaction, that chains embedding beacon-kit carry in every block after
+// the beacon block and its blob sidecars.
+type ConsensusTx interface {
+	TxDecoder
+	// Build returns the transaction to include in the proposal for slot.
+	Build(ctx context.Context, slot math.Slot) ([]byte, error)
+}
+
+// registeredTx is a named entry of the TxRegistry.
+type registeredTx struct {
+	name string
+	// tx is nil for the transactions built and decoded by beacon-kit.
+	tx ConsensusTx
+}
+
+// TxRegistry describes the layout of the transactions of a CometBFT block.
+// The transaction at index i of a block is the i-th registered one.
+type TxRegistry struct {
+	txs []registeredTx
+}
+
+// NewTxRegistry returns an empty TxRegistry.
+func NewTxRegistry() *TxRegistry {
+	return &TxRegistry{}
+}
+
+// Reserve appends a transaction built and decoded by beacon-kit itself to
+// the layout and returns its index.
+func (r *TxRegistry) Reserve(name string) (uint, error) {
+	return r.register(registeredTx{name: name})
+}
+
+// RegisterConsensusTx appends an additional consensus transaction to the
+// layout and returns its index.
+func (r *TxRegistry) RegisterConsensusTx(
+	name string,
+	tx ConsensusTx,
+) (uint, error) {
+	return r.register(registeredTx{name: name, tx: tx})
+}
+
+// register appends tx to the layout if its name is not taken yet.
+func (r *TxRegistry) register(tx registeredTx) (uint, error) {
+	if _, ok := r.IndexOf(tx.name); ok {
+		return 0, errors.Wrap(ErrDuplicateTx, tx.name)
+	}
+	r.txs = append(r.txs, tx)
+	return uint(len(r.txs) - 1), nil
+}
+
+// Len returns the number of transactions in a well-formed block.
+func (r *TxRegistry) Len() int {
+	return len(r.txs)
+}
+
+// IndexOf returns the index of the transaction registered under name.
+func (r *TxRegistry) IndexOf(name string) (uint, bool) {
+	for i, entry := range r.txs {
+		if entry.name == name {
+			return uint(i), true
+		}
+	}
+	return 0, false
+}
+
+// BuildConsensusTxs builds the additional consensus transactions for slot,
+// in layout order.
+func (r *TxRegistry) BuildConsensusTxs(
+	ctx context.Context,
+	slot math.Slot,
+) ([][]byte, error) {
+	txs := make([][]byte, 0, len(r.txs))
+	for _, entry := range r.txs {
+		if entry.tx == nil {
+			continue
+		}
+		bz, err := entry.tx.Build(ctx, slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "building %s tx", entry.name)
+		}
+		txs = append(txs, bz)
+	}
+	return txs, nil
+}
+
+// DecodeConsensusTxs decodes the additional consensus transactions of req,
+// returning an error if any of them is malformed. The beacon block and blob
+// sidecars are left to their typed decoders.
+func (r *TxRegistry) DecodeConsensusTxs(
+	req ABCIRequest,
+	forkVersion uint32,
+) error {
+	if req == nil {
+		return ErrNilABCIRequest
+	}
+
+	txs := req.GetTxs()
+	for i, entry := range r.txs {
+		if entry.tx == nil {
+			continue
+		}
+		if i >= len(txs) {
+			return ErrBzIndexOutOfBounds
+		}
+		if err := entry.tx.Decode(txs[i], forkVersion); err != nil {
+			return errors.Wrapf(err, "decoding %s tx", entry.name)
+		}
+	}
+	return nil
+}
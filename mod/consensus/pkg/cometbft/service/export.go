@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	// ValidatorSetFormatGenesis exports the validator set as the deposits
+	// of a beacon genesis.
+	ValidatorSetFormatGenesis = "genesis"
+	// ValidatorSetFormatJSON exports the validator set as a JSON listing of
+	// the validators with their balances.
+	ValidatorSetFormatJSON = "json"
+)
+
+var (
+	// ErrValidatorSetExported is returned by Start once
+	// `export-validator-set` wrote the validator set. Like ErrChainVerified,
+	// it stops the remaining node lifecycle.
+	ErrValidatorSetExported = errors.New("validator set exported")
+
+	// ErrUnsupportedValidatorSetFormat is returned when exporting the
+	// validator set in a format other than the ValidatorSetFormat ones.
+	ErrUnsupportedValidatorSetFormat = errors.New(
+		"unsupported validator set format",
+	)
+)
+
+// ExportValidatorSetOptions configures an `export-validator-set` run.
+type ExportValidatorSetOptions struct {
+	// Slot is the slot whose state the validator set is read from. Zero
+	// reads the latest committed state.
+	Slot int64
+	// Format is the format the validator set is exported in, one of the
+	// ValidatorSetFormat constants.
+	Format string
+	// Output is the file the validator set is written to. Empty writes it
+	// to the standard output.
+	Output string
+}
+
+// exportValidatorSet writes the active validator set of the state of the
+// requested slot in the requested format.
+func (s *Service[_]) exportValidatorSet() error {
+	if s.chainVerifier == nil {
+		return errNoChainVerifier
+	}
+
+	height := s.exportOpts.Slot
+	if height == 0 {
+		height = s.LastBlockHeight()
+	}
+	queryCtx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return err
+	}
+
+	bz, err := s.chainVerifier.ExportValidatorSet(
+		queryCtx, s.exportOpts.Format,
+	)
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	if s.exportOpts.Output == "" {
+		if _, err = os.Stdout.Write(bz); err != nil {
+			return err
+		}
+	} else if err = os.WriteFile(
+		s.exportOpts.Output, bz, 0o600, //nolint:mnd // file permissions.
+	); err != nil {
+		return err
+	}
+
+	s.logger.Info("Exported validator set",
+		"height", height, "format", s.exportOpts.Format,
+	)
+	return ErrValidatorSetExported
+}
@@ -27,12 +27,28 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
+// awaitContext bounds the wait for the services handling a request by
+// AwaitTimeout, and by the budget consensus allows for phase when ctx
+// carries the consensus timeouts.
+func awaitContext(
+	ctx context.Context,
+	phase budget.Phase,
+) (context.Context, context.CancelFunc) {
+	phaseCtx, cancelPhase := budget.WithPhase(ctx, phase)
+	awaitCtx, cancel := context.WithTimeout(phaseCtx, AwaitTimeout)
+	return awaitCtx, func() {
+		cancel()
+		cancelPhase()
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                 InitGenesis                                */
 /* -------------------------------------------------------------------------- */
@@ -83,20 +99,22 @@ func (h *ABCIMiddleware[
 /*                               PrepareProposal                              */
 /* -------------------------------------------------------------------------- */
 
-// prepareProposal is the internal handler for preparing proposals.
+// PrepareProposal builds the transactions of a proposal: the beacon block
+// and its blob sidecars, followed by any registered consensus transactions.
 func (h *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, _, SlotDataT,
 ]) PrepareProposal(
 	ctx context.Context,
+	slot math.Slot,
 	slotData SlotDataT,
-) ([]byte, []byte, error) {
+) ([][]byte, error) {
 	var (
 		err              error
 		builtBeaconBlock BeaconBlockT
 		builtSidecars    BlobSidecarsT
 		numMsgs          int
 		startTime        = time.Now()
-		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		awaitCtx, cancel = awaitContext(ctx, budget.BlockBuild)
 	)
 
 	defer cancel()
@@ -118,22 +136,33 @@ func (h *ABCIMiddleware[
 			ctx, async.NewSlot, slotData,
 		),
 	); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// wait for built beacon block
 	builtBeaconBlock, err = h.waitForBuiltBeaconBlock(awaitCtx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	// wait for built sidecars
 	builtSidecars, err = h.waitForBuiltSidecars(awaitCtx)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return h.handleBuiltBeaconBlockAndSidecars(builtBeaconBlock, builtSidecars)
+	bbBz, scBz, err := h.handleBuiltBeaconBlockAndSidecars(
+		builtBeaconBlock, builtSidecars,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusTxs, err := h.txRegistry.BuildConsensusTxs(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{bbBz, scBz}, consensusTxs...), nil
 }
 
 // waitForBuiltBeaconBlock waits for the built beacon block to be received.
@@ -201,7 +230,7 @@ func (h *ABCIMiddleware[
 		blk              BeaconBlockT
 		numMsgs          int
 		sidecars         BlobSidecarsT
-		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		awaitCtx, cancel = awaitContext(ctx, budget.BlockVerification)
 	)
 	defer cancel()
 	// flush the channels to ensure that we are not handling old data.
@@ -218,46 +247,56 @@ func (h *ABCIMiddleware[
 
 	defer h.metrics.measureProcessProposalDuration(startTime)
 
+	// Reject malformed consensus transactions before doing any beacon work.
+	forkVersion := h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height))
+	if err = h.txRegistry.DecodeConsensusTxs(req, forkVersion); err != nil {
+		return h.rejectMalformedProposal(req, err)
+	}
+
 	// Request the beacon block.
 	if blk, err = encoding.
 		UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
-		req, 0, h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
+		req,
+		BeaconBlockTxIndex,
+		forkVersion,
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return h.createProcessProposalResponse(req, errors.WrapNonFatal(err))
 	}
 
 	// notify that the beacon block has been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.BeaconBlockReceived, blk),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return h.createProcessProposalResponse(req, errors.WrapNonFatal(err))
 	}
 
-	// Request the blob sidecars.
-	if sidecars, err = encoding.
-		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
-		req, 1,
+	// Request the blob sidecars, decoding them into pooled ones.
+	sidecars = h.sidecars.get()
+	if err = encoding.UnmarshalBlobSidecarsFromABCIRequestInto(
+		req, BlobSidecarsTxIndex, sidecars,
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		h.sidecars.put(sidecars)
+		return h.createProcessProposalResponse(req, errors.WrapNonFatal(err))
 	}
+	h.sidecars.hold(req.Height, sidecars)
 
 	// notify that the sidecars have been received.
 	if err = h.dispatcher.Publish(
 		async.NewEvent(ctx, async.SidecarsReceived, sidecars),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return h.createProcessProposalResponse(req, errors.WrapNonFatal(err))
 	}
 
 	// err if the built beacon block or sidecars failed verification.
 	_, err = h.waitForBeaconBlockVerification(awaitCtx)
 	if err != nil {
-		return h.createProcessProposalResponse(err)
+		return h.createProcessProposalResponse(req, err)
 	}
 	_, err = h.waitForSidecarVerification(awaitCtx)
 	if err != nil {
-		return h.createProcessProposalResponse(err)
+		return h.createProcessProposalResponse(req, err)
 	}
-	return h.createProcessProposalResponse(nil)
+	return h.createProcessProposalResponse(req, nil)
 }
 
 // waitForBeaconBlockVerification waits for the built beacon block to be
@@ -290,18 +329,39 @@ func (h *ABCIMiddleware[
 }
 
 // createResponse generates the appropriate ProcessProposalResponse based on the
-// error.
-func (*ABCIMiddleware[
+// error, reporting the reason of a rejection.
+func (h *ABCIMiddleware[
 	BeaconBlockT, _, BlobSidecarsT, _,
 ]) createProcessProposalResponse(
+	req *cmtabci.ProcessProposalRequest,
 	err error,
 ) (*cmtabci.ProcessProposalResponse, error) {
-	status := cmtabci.PROCESS_PROPOSAL_STATUS_REJECT
 	if !errors.IsFatal(err) {
-		status = cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT
-		err = nil
+		return &cmtabci.ProcessProposalResponse{
+			Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		}, nil
 	}
-	return &cmtabci.ProcessProposalResponse{Status: status}, err
+	h.reportRejection(req, h.rejectRules.classify(err), err)
+	return &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+	}, err
+}
+
+// rejectMalformedProposal rejects a proposal whose transactions failed to
+// decode.
+func (h *ABCIMiddleware[
+	_, _, _, _,
+]) rejectMalformedProposal(
+	req *cmtabci.ProcessProposalRequest,
+	err error,
+) (*cmtabci.ProcessProposalResponse, error) {
+	if !errors.IsFatal(err) {
+		return h.createProcessProposalResponse(req, err)
+	}
+	h.reportRejection(req, RejectMalformed, err)
+	return &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+	}, err
 }
 
 /* -------------------------------------------------------------------------- */
@@ -321,6 +381,10 @@ func (h *ABCIMiddleware[
 		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
 	)
 	defer cancel()
+	// The sidecars of the proposals far enough below this block are no
+	// longer read by anyone.
+	h.sidecars.release(req.Height)
+
 	// flush the channel to ensure that we are not handling old data.
 	if numMsgs := async.ClearChan(h.subFinalValidatorUpdates); numMsgs > 0 {
 		h.logger.Error(
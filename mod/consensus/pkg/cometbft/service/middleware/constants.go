@@ -32,3 +32,12 @@ const (
 	// AwaitTimeout is the timeout for awaiting events.
 	AwaitTimeout = 2 * time.Second
 )
+
+const (
+	// BeaconBlockTxName is the name of the beacon block transaction in the
+	// proposal tx layout.
+	BeaconBlockTxName = "beacon_block"
+	// BlobSidecarsTxName is the name of the blob sidecars transaction in the
+	// proposal tx layout.
+	BlobSidecarsTxName = "blob_sidecars"
+)
@@ -27,6 +27,10 @@ var (
 	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
 	ErrUnexpectedEvent = errors.New("unexpected event")
 
+	// ErrInvalidRecord is returned when a recorded ABCI exchange cannot be
+	// encoded or decoded.
+	ErrInvalidRecord = errors.New("invalid abci record")
+
 	ErrInitGenesisTimeout = func(errTimeout error) error {
 		return errors.Wrapf(errTimeout,
 			"A timeout occurred while waiting for genesis data processing",
@@ -24,6 +24,48 @@ import (
 	"time"
 )
 
+// Phase is an ABCI method whose handling is measured.
+type Phase string
+
+const (
+	// PhasePrepareProposal is the PrepareProposal ABCI method.
+	PhasePrepareProposal Phase = "prepare_proposal"
+	// PhaseProcessProposal is the ProcessProposal ABCI method.
+	PhaseProcessProposal Phase = "process_proposal"
+	// PhaseFinalizeBlock is the FinalizeBlock ABCI method.
+	PhaseFinalizeBlock Phase = "finalize_block"
+	// PhaseCommit is the Commit ABCI method.
+	PhaseCommit Phase = "commit"
+)
+
+// Outcome is how the handling of an ABCI method ended.
+type Outcome string
+
+const (
+	// OutcomeOK is a request that was handled successfully.
+	OutcomeOK Outcome = "ok"
+	// OutcomeFallback is a PrepareProposal request that failed to build a
+	// block and proposed the transactions of the request instead.
+	OutcomeFallback Outcome = "fallback"
+	// OutcomeRejected is a ProcessProposal request whose proposal was
+	// rejected.
+	OutcomeRejected Outcome = "rejected"
+	// OutcomeError is a request that returned an error to CometBFT.
+	OutcomeError Outcome = "error"
+)
+
+// PhaseStats are the measurements of the handling of an ABCI request.
+type PhaseStats struct {
+	// Start is when the request was received.
+	Start time.Time
+	// RequestSize is the size of the request in bytes.
+	RequestSize int
+	// ResponseSize is the size of the response in bytes.
+	ResponseSize int
+	// NumTxs is the number of transactions in the proposal or block.
+	NumTxs int
+}
+
 // ABCIMiddlewareMetrics is a struct that contains metrics for the chain.
 type ABCIMiddlewareMetrics struct {
 	// sink is the sink for the metrics.
@@ -56,3 +98,37 @@ func (cm *ABCIMiddlewareMetrics) measureProcessProposalDuration(
 		"beacon_kit.runtime.process_proposal_duration", start,
 	)
 }
+
+// markProposalRejected increments the counter of proposals rejected for the
+// given reason.
+func (cm *ABCIMiddlewareMetrics) markProposalRejected(reason RejectReason) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.runtime.process_proposal_rejected",
+		"reason", string(reason),
+	)
+}
+
+// observePhase records the duration, request and response sizes and number
+// of transactions of the handling of an ABCI request.
+func (cm *ABCIMiddlewareMetrics) observePhase(
+	phase Phase,
+	outcome Outcome,
+	stats PhaseStats,
+) {
+	labels := []string{"phase", string(phase), "outcome", string(outcome)}
+	cm.sink.MeasureSince(
+		"beacon_kit.runtime.abci_phase_duration", stats.Start, labels...,
+	)
+	cm.sink.AddSample(
+		"beacon_kit.runtime.abci_request_size",
+		float32(stats.RequestSize), labels...,
+	)
+	cm.sink.AddSample(
+		"beacon_kit.runtime.abci_response_size",
+		float32(stats.ResponseSize), labels...,
+	)
+	cm.sink.AddSample(
+		"beacon_kit.runtime.abci_num_txs",
+		float32(stats.NumTxs), labels...,
+	)
+}
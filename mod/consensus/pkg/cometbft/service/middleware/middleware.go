@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -58,6 +59,12 @@ type ABCIMiddleware[
 	// subFinalValidatorUpdates is the channel to hold
 	// FinalValidatorUpdatesProcessed events.
 	subFinalValidatorUpdates chan async.Event[validatorUpdates]
+	// txRegistry is the layout of the transactions in a proposal.
+	txRegistry *encoding.TxRegistry
+	// rejectRules classify the errors proposals are rejected with.
+	rejectRules *rejectRules
+	// sidecars pools the blob sidecars decoded from proposals.
+	sidecars *sidecarRecycler[BlobSidecarsT]
 }
 
 // NewABCIMiddleware creates a new instance of the Handler struct.
@@ -74,6 +81,14 @@ func NewABCIMiddleware[
 ) *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 ] {
+	// The beacon block and blob sidecars always lead the proposal.
+	txRegistry := encoding.NewTxRegistry()
+	for _, name := range []string{BeaconBlockTxName, BlobSidecarsTxName} {
+		if _, err := txRegistry.Reserve(name); err != nil {
+			panic(err)
+		}
+	}
+
 	return &ABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 	]{
@@ -87,9 +102,35 @@ func NewABCIMiddleware[
 		subBBVerified:            make(chan async.Event[BeaconBlockT]),
 		subSCVerified:            make(chan async.Event[BlobSidecarsT]),
 		subFinalValidatorUpdates: make(chan async.Event[validatorUpdates]),
+		txRegistry:               txRegistry,
+		rejectRules:              new(rejectRules),
+		sidecars: newSidecarRecycler(func() BlobSidecarsT {
+			var sidecars BlobSidecarsT
+			return sidecars.Empty()
+		}),
 	}
 }
 
+// RegisterConsensusTx appends an additional consensus transaction to every
+// proposal, after the beacon block and blob sidecars. It must be called
+// before the middleware handles its first proposal.
+func (am *ABCIMiddleware[_, _, _, _]) RegisterConsensusTx(
+	name string,
+	tx encoding.ConsensusTx,
+) error {
+	_, err := am.txRegistry.RegisterConsensusTx(name, tx)
+	return err
+}
+
+// ObservePhase records the metrics of the handling of an ABCI request.
+func (am *ABCIMiddleware[_, _, _, _]) ObservePhase(
+	phase Phase,
+	outcome Outcome,
+	stats PhaseStats,
+) {
+	am.metrics.observePhase(phase, outcome, stats)
+}
+
 // Start subscribes the middleware to the events it needs to listen for.
 func (am *ABCIMiddleware[_, _, _, _]) Start(
 	_ context.Context,
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// RecordKind identifies the ABCI method a recorded exchange belongs to.
+type RecordKind uint8
+
+const (
+	// RecordPrepareProposal marks a PrepareProposal exchange.
+	RecordPrepareProposal RecordKind = iota + 1
+	// RecordProcessProposal marks a ProcessProposal exchange.
+	RecordProcessProposal
+	// RecordFinalizeBlock marks a FinalizeBlock exchange.
+	RecordFinalizeBlock
+)
+
+// maxRecordFieldSize bounds the size of a single request or response so
+// that a corrupted length prefix cannot trigger an unbounded allocation.
+const maxRecordFieldSize = 1 << 30
+
+// String returns the name of the ABCI method for the kind.
+func (k RecordKind) String() string {
+	switch k {
+	case RecordPrepareProposal:
+		return "PrepareProposal"
+	case RecordProcessProposal:
+		return "ProcessProposal"
+	case RecordFinalizeBlock:
+		return "FinalizeBlock"
+	default:
+		return "Unknown"
+	}
+}
+
+// protoMarshaler is satisfied by the gogoproto generated ABCI messages.
+type protoMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// Record is a single recorded ABCI exchange. Request and Response hold the
+// protobuf encoding of the messages exchanged with CometBFT.
+type Record struct {
+	Kind     RecordKind
+	Request  []byte
+	Response []byte
+}
+
+// Recorder appends ABCI requests and the responses we returned to a file.
+// Each record is written as a one byte kind followed by the request and the
+// response, each prefixed with its big-endian uint32 length.
+type Recorder struct {
+	mu sync.Mutex
+	f  *os.File
+	w  *bufio.Writer
+}
+
+// NewRecorder opens (or creates) the file at path in append mode and
+// returns a Recorder writing to it.
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	//#nosec:G304 // path is supplied by the operator.
+	f, err := os.OpenFile(
+		filepath.Clean(path), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Record encodes and appends a request/response pair to the file. The
+// record is flushed before returning so that a crash never loses more than
+// the exchange in flight.
+func (r *Recorder) Record(
+	kind RecordKind,
+	req, resp protoMarshaler,
+) error {
+	reqBz, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+	respBz, err := resp.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err = r.w.WriteByte(byte(kind)); err != nil {
+		return err
+	}
+	if err = writeField(r.w, reqBz); err != nil {
+		return err
+	}
+	if err = writeField(r.w, respBz); err != nil {
+		return err
+	}
+	return r.w.Flush()
+}
+
+// Close flushes any buffered data and closes the underlying file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return errors.Join(r.w.Flush(), r.f.Close())
+}
+
+// RecordReader reads records written by a Recorder.
+type RecordReader struct {
+	r *bufio.Reader
+}
+
+// NewRecordReader returns a RecordReader consuming records from r.
+func NewRecordReader(r io.Reader) *RecordReader {
+	return &RecordReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record in the stream. It returns io.EOF once the
+// stream is exhausted on a record boundary.
+func (rr *RecordReader) Next() (*Record, error) {
+	kind, err := rr.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &Record{Kind: RecordKind(kind)}
+	if rec.Kind < RecordPrepareProposal || rec.Kind > RecordFinalizeBlock {
+		return nil, errors.Wrapf(ErrInvalidRecord, "unknown kind %d", kind)
+	}
+	if rec.Request, err = readField(rr.r); err != nil {
+		return nil, err
+	}
+	if rec.Response, err = readField(rr.r); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// writeField writes bz prefixed with its length.
+func writeField(w io.Writer, bz []byte) error {
+	if len(bz) > maxRecordFieldSize {
+		return errors.Wrapf(ErrInvalidRecord, "field of %d bytes", len(bz))
+	}
+	var prefix [4]byte
+	//#nosec:G115 // bounded by maxRecordFieldSize above.
+	binary.BigEndian.PutUint32(prefix[:], uint32(len(bz)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+// readField reads a length-prefixed field. A stream ending part way through
+// a field is reported as io.ErrUnexpectedEOF.
+func readField(r io.Reader) ([]byte, error) {
+	var prefix [4]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	size := binary.BigEndian.Uint32(prefix[:])
+	if size > maxRecordFieldSize {
+		return nil, errors.Wrapf(ErrInvalidRecord, "field of %d bytes", size)
+	}
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return bz, nil
+}
+
+// unexpectedEOF converts a clean EOF in the middle of a record into
+// io.ErrUnexpectedEOF.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+)
+
+// recycleDepth is the number of blocks finalized after the height of a
+// proposal before the sidecars decoded from it are put back in the pool.
+const recycleDepth = 2
+
+// pendingSidecars are sidecars decoded from the proposal at a height.
+type pendingSidecars[BlobSidecarsT any] struct {
+	height   int64
+	sidecars BlobSidecarsT
+}
+
+// sidecarRecycler pools the blob sidecars decoded from proposals, so that
+// their blobs are not allocated anew on every proposal. The sidecars decoded
+// from a proposal are handed to the services verifying and announcing them,
+// which may still read them after the proposal is processed, so they are
+// only put back in the pool once recycleDepth more blocks are finalized.
+type sidecarRecycler[BlobSidecarsT any] struct {
+	pool *pool.Pool[BlobSidecarsT]
+
+	mu      sync.Mutex
+	pending []pendingSidecars[BlobSidecarsT]
+}
+
+// newSidecarRecycler returns a sidecarRecycler creating new sidecars with
+// the given function whenever its pool is empty.
+func newSidecarRecycler[BlobSidecarsT any](
+	newFn func() BlobSidecarsT,
+) *sidecarRecycler[BlobSidecarsT] {
+	return &sidecarRecycler[BlobSidecarsT]{pool: pool.New(newFn)}
+}
+
+// get returns sidecars to decode into.
+func (r *sidecarRecycler[BlobSidecarsT]) get() BlobSidecarsT {
+	return r.pool.Get()
+}
+
+// put returns sidecars that were never handed out to the pool right away.
+func (r *sidecarRecycler[BlobSidecarsT]) put(sidecars BlobSidecarsT) {
+	r.pool.Put(sidecars)
+}
+
+// hold keeps the sidecars decoded from the proposal at height out of the
+// pool until the block recycleDepth heights later is finalized.
+func (r *sidecarRecycler[BlobSidecarsT]) hold(
+	height int64,
+	sidecars BlobSidecarsT,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = append(r.pending, pendingSidecars[BlobSidecarsT]{
+		height: height, sidecars: sidecars,
+	})
+}
+
+// release puts back in the pool the sidecars held for the proposals at
+// least recycleDepth heights below the finalized height.
+func (r *sidecarRecycler[BlobSidecarsT]) release(finalized int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.pending[:0]
+	for _, p := range r.pending {
+		if p.height+recycleDepth > finalized {
+			kept = append(kept, p)
+			continue
+		}
+		r.pool.Put(p.sidecars)
+	}
+	clear(r.pending[len(kept):])
+	r.pending = kept
+}
@@ -37,13 +37,21 @@ type BeaconBlock[SelfT any] interface {
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
+	// AddSample adds a sample to the histogram metric identified by the
+	// provided key.
+	AddSample(key string, value float32, args ...string)
 }
 
 type BlobSidecars[T any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[T]
+	// UnmarshalSSZReuse unmarshals the sidecars into the memory they hold.
+	UnmarshalSSZReuse([]byte) error
 }
 
 type validatorUpdates = transition.ValidatorUpdates
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// RejectReason classifies why a proposal was rejected in ProcessProposal.
+type RejectReason string
+
+const (
+	// RejectMalformed marks a proposal whose transactions do not decode to
+	// the expected layout.
+	RejectMalformed RejectReason = "malformed"
+	// RejectInvalidSignature marks a proposal carrying an invalid signature.
+	RejectInvalidSignature RejectReason = "invalid_signature"
+	// RejectDataAvailability marks a proposal whose blob sidecars failed
+	// verification.
+	RejectDataAvailability RejectReason = "data_availability"
+	// RejectExecutionInvalid marks a proposal whose execution payload the
+	// execution client deemed INVALID.
+	RejectExecutionInvalid RejectReason = "execution_invalid"
+	// RejectStateRootMismatch marks a proposal whose state root does not
+	// match the state computed from it.
+	RejectStateRootMismatch RejectReason = "state_root_mismatch"
+	// RejectTimeout marks a proposal that could not be verified in time.
+	RejectTimeout RejectReason = "timeout"
+	// RejectOther marks a proposal rejected for any other reason.
+	RejectOther RejectReason = "other"
+)
+
+// rejectRules maps the errors a proposal can be rejected with to the reason
+// reported for them.
+type rejectRules struct {
+	mu    sync.RWMutex
+	rules []rejectRule
+}
+
+// rejectRule reports reason for the errors wrapping any of errs.
+type rejectRule struct {
+	reason RejectReason
+	errs   []error
+}
+
+// add appends a rule, checked after the ones already registered.
+func (r *rejectRules) add(reason RejectReason, errs ...error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = append(r.rules, rejectRule{reason: reason, errs: errs})
+}
+
+// classify returns the reason of the first rule matching err.
+func (r *rejectRules) classify(err error) RejectReason {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, rule := range r.rules {
+		if errors.IsAny(err, rule.errs...) {
+			return rule.reason
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return RejectTimeout
+	}
+	return RejectOther
+}
+
+// RegisterRejectReason reports proposals rejected with an error wrapping any
+// of errs under the given reason. Rules are checked in registration order.
+func (am *ABCIMiddleware[_, _, _, _]) RegisterRejectReason(
+	reason RejectReason,
+	errs ...error,
+) {
+	am.rejectRules.add(reason, errs...)
+}
+
+// reportRejection logs and counts the rejection of the proposal, so that a
+// misbehaving proposer can be identified across the validator set.
+func (am *ABCIMiddleware[_, _, _, _]) reportRejection(
+	req *cmtabci.ProcessProposalRequest,
+	reason RejectReason,
+	err error,
+) {
+	am.logger.Warn(
+		"Rejecting proposal",
+		"reason", reason,
+		"height", req.GetHeight(),
+		"proposer", cmtbytes.HexBytes(req.GetProposerAddress()),
+		"error", err,
+	)
+	am.metrics.markProposalRejected(reason)
+}
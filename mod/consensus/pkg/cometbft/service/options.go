@@ -21,9 +21,16 @@
 package cometbft
 
 import (
+	"time"
+
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/reqresp"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/upgrade"
 	"github.com/berachain/beacon-kit/mod/log"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // File for storing in-package cometbft optional functions,
@@ -81,3 +88,99 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetReqRespReactor registers the beacon request/response reactor with the
+// p2p switch of the CometBFT node.
+func SetReqRespReactor[
+	LoggerT log.AdvancedLogger[LoggerT],
+](reactor *reqresp.Reactor) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.reqResp = reactor }
+}
+
+// SetABCIRecorder records every PrepareProposal, ProcessProposal and
+// FinalizeBlock exchange with CometBFT through the given recorder.
+func SetABCIRecorder[
+	LoggerT log.AdvancedLogger[LoggerT],
+](recorder *middleware.Recorder) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.recorder = recorder }
+}
+
+// SetABCIReplayPath makes Start replay the recording at path through the
+// application instead of starting a CometBFT node.
+func SetABCIReplayPath[
+	LoggerT log.AdvancedLogger[LoggerT],
+](path string) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.replayPath = path }
+}
+
+// SetChainVerifier sets the beacon checks run by `verify-chain` on every
+// stored block.
+func SetChainVerifier[
+	LoggerT log.AdvancedLogger[LoggerT],
+](verifier ChainVerifier) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.chainVerifier = verifier }
+}
+
+// SetVerifyChain makes Start verify the blocks of the CometBFT block store
+// instead of starting a CometBFT node.
+func SetVerifyChain[
+	LoggerT log.AdvancedLogger[LoggerT],
+](opts VerifyChainOptions) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.verifyOpts = &opts }
+}
+
+// SetAuditRegistry makes Start audit the validator registry of the latest
+// committed state instead of starting a CometBFT node.
+func SetAuditRegistry[
+	LoggerT log.AdvancedLogger[LoggerT],
+]() func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.auditRegistry = true }
+}
+
+// SetExportValidatorSet makes Start export the validator set of a committed
+// state instead of starting a CometBFT node.
+func SetExportValidatorSet[
+	LoggerT log.AdvancedLogger[LoggerT],
+](opts ExportValidatorSetOptions) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.exportOpts = &opts }
+}
+
+// SetUpgradeManager halts the node at the height of a scheduled upgrade the
+// running binary does not implement.
+func SetUpgradeManager[
+	LoggerT log.AdvancedLogger[LoggerT],
+](manager *upgrade.Manager) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.upgrades = manager }
+}
+
+// SetPrivValidator makes the CometBFT node sign with the given validator
+// instead of the key files of the node, e.g. when the key is held by a remote
+// validator client or the key file is encrypted at rest.
+func SetPrivValidator[
+	LoggerT log.AdvancedLogger[LoggerT],
+](pv cmttypes.PrivValidator) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.privValidator = pv }
+}
+
+// SetCheckpointer makes the node write checkpoints of the application
+// database for read replicas as blocks are committed.
+func SetCheckpointer[
+	LoggerT log.AdvancedLogger[LoggerT],
+](checkpointer *storagedb.Checkpointer) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.checkpointer = checkpointer }
+}
+
+// SetReadReplica makes Start follow the checkpoints served by the given
+// replica database instead of starting a CometBFT node, looking for a newer
+// checkpoint every refresh interval.
+func SetReadReplica[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	replica *storagedb.ReplicaDB,
+	refresh time.Duration,
+) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) {
+		s.replica = replica
+		s.replicaRefresh = refresh
+	}
+}
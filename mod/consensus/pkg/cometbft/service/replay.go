@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/log"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+var (
+	// ErrReplayFinished is returned by Start once every record of an ABCI
+	// replay has been fed through the application. It stops the remaining
+	// node lifecycle, since no CometBFT node is running during a replay.
+	ErrReplayFinished = errors.New("abci replay finished")
+
+	// errReplayMismatch is returned when at least one replayed response
+	// diverged from the recorded one.
+	errReplayMismatch = errors.New("replayed responses diverged")
+
+	// errReplaySkipped signals that a record targets an already committed
+	// height.
+	errReplaySkipped = errors.New("record already committed")
+
+	// errReplayNotInitialized is returned when replaying against an
+	// application that has not processed InitChain yet.
+	errReplayNotInitialized = errors.New(
+		"abci replay requires an initialized application state",
+	)
+)
+
+// record appends the exchange to the ABCI recorder, if one is configured.
+// Failures are logged rather than returned so that recording never affects
+// consensus.
+func (s *Service[_]) record(
+	kind middleware.RecordKind,
+	req, resp interface{ Marshal() ([]byte, error) },
+) {
+	if s.recorder == nil {
+		return
+	}
+	if err := s.recorder.Record(kind, req, resp); err != nil {
+		s.logger.Error("failed to record abci exchange",
+			"method", kind.String(), "err", err,
+		)
+	}
+}
+
+// replay feeds the exchanges recorded at s.replayPath back through the
+// ABCI methods of the service, committing after every FinalizeBlock, and
+// compares our responses against the recorded ones. Records at or below
+// the last committed height are skipped, so a replay can be resumed against
+// the same application database.
+func (s *Service[LoggerT]) replay(ctx context.Context) error {
+	if s.LastBlockHeight() == 0 {
+		return errReplayNotInitialized
+	}
+
+	//#nosec:G304 // path is supplied by the operator.
+	f, err := os.Open(filepath.Clean(s.replayPath))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var (
+		reader     = middleware.NewRecordReader(f)
+		replayed   int
+		mismatches int
+		rec        *middleware.Record
+		match      bool
+	)
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		rec, err = reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		match, err = s.replayRecord(ctx, rec)
+		switch {
+		case errors.Is(err, errReplaySkipped):
+			continue
+		case err != nil:
+			return fmt.Errorf("replaying %s: %w", rec.Kind, err)
+		}
+
+		replayed++
+		if !match {
+			mismatches++
+		}
+	}
+
+	s.logger.Info("Finished abci replay",
+		"replayed", replayed,
+		"mismatches", mismatches,
+		"height", s.LastBlockHeight(),
+	)
+	if mismatches > 0 {
+		return fmt.Errorf(
+			"%d of %d: %w", mismatches, replayed, errReplayMismatch,
+		)
+	}
+	return ErrReplayFinished
+}
+
+// replayRecord re-executes a single record and reports whether our response
+// matches the recorded one.
+func (s *Service[LoggerT]) replayRecord(
+	ctx context.Context,
+	rec *middleware.Record,
+) (bool, error) {
+	switch rec.Kind {
+	case middleware.RecordPrepareProposal:
+		return replayExchange(
+			s, rec, &cmtabci.PrepareProposalRequest{},
+			&cmtabci.PrepareProposalResponse{},
+			func(req *cmtabci.PrepareProposalRequest) (
+				*cmtabci.PrepareProposalResponse, error,
+			) {
+				return s.PrepareProposal(ctx, req)
+			},
+			func(req *cmtabci.PrepareProposalRequest) int64 {
+				return req.Height
+			},
+			// The payload is rebuilt against the current execution client,
+			// so only the shape of the proposal is expected to match.
+			func(want, got *cmtabci.PrepareProposalResponse) bool {
+				return len(want.Txs) == len(got.Txs)
+			},
+		)
+	case middleware.RecordProcessProposal:
+		return replayExchange(
+			s, rec, &cmtabci.ProcessProposalRequest{},
+			&cmtabci.ProcessProposalResponse{},
+			func(req *cmtabci.ProcessProposalRequest) (
+				*cmtabci.ProcessProposalResponse, error,
+			) {
+				return s.ProcessProposal(ctx, req)
+			},
+			func(req *cmtabci.ProcessProposalRequest) int64 {
+				return req.Height
+			},
+			func(want, got *cmtabci.ProcessProposalResponse) bool {
+				return want.Status == got.Status
+			},
+		)
+	case middleware.RecordFinalizeBlock:
+		return replayExchange(
+			s, rec, &cmtabci.FinalizeBlockRequest{},
+			&cmtabci.FinalizeBlockResponse{},
+			func(req *cmtabci.FinalizeBlockRequest) (
+				*cmtabci.FinalizeBlockResponse, error,
+			) {
+				resp, err := s.FinalizeBlock(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				_, err = s.Commit(ctx, &cmtabci.CommitRequest{})
+				return resp, err
+			},
+			func(req *cmtabci.FinalizeBlockRequest) int64 {
+				return req.Height
+			},
+			func(want, got *cmtabci.FinalizeBlockResponse) bool {
+				return bytes.Equal(want.AppHash, got.AppHash) &&
+					len(want.ValidatorUpdates) == len(got.ValidatorUpdates)
+			},
+		)
+	default:
+		return false, fmt.Errorf("unknown record kind %d", rec.Kind)
+	}
+}
+
+// replayExchange decodes a recorded request/response pair, executes the
+// request through exec and compares the result with the recorded response.
+func replayExchange[
+	LoggerT log.AdvancedLogger[LoggerT],
+	ReqT interface{ Unmarshal([]byte) error },
+	RespT interface{ Unmarshal([]byte) error },
+](
+	s *Service[LoggerT],
+	rec *middleware.Record,
+	req ReqT,
+	want RespT,
+	exec func(ReqT) (RespT, error),
+	height func(ReqT) int64,
+	equal func(RespT, RespT) bool,
+) (bool, error) {
+	if err := req.Unmarshal(rec.Request); err != nil {
+		return false, err
+	}
+	if err := want.Unmarshal(rec.Response); err != nil {
+		return false, err
+	}
+	if height(req) <= s.LastBlockHeight() {
+		return false, errReplaySkipped
+	}
+
+	got, err := exec(req)
+	if err != nil {
+		return false, err
+	}
+
+	match := equal(want, got)
+	if !match {
+		s.logger.Warn("Replayed response diverged from recording",
+			"method", rec.Kind.String(), "height", height(req),
+		)
+	}
+	return match, nil
+}
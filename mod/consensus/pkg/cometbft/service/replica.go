@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"time"
+)
+
+// defaultReplicaRefresh is the interval at which a replica looks for a newer
+// checkpoint if none is configured.
+const defaultReplicaRefresh = 2 * time.Second
+
+// maybeCheckpoint writes a checkpoint of the application database for read
+// replicas if one is due at the committed height. A failed checkpoint does
+// not affect consensus, so it is only logged.
+func (s *Service[_]) maybeCheckpoint(committed int64) {
+	if s.checkpointer == nil {
+		return
+	}
+	if err := s.checkpointer.MaybeCheckpoint(committed); err != nil {
+		s.logger.Error(
+			"Failed to checkpoint application database for read replicas",
+			"height", committed,
+			"error", err,
+		)
+	}
+}
+
+// followReplica reloads the latest committed state whenever the primary
+// writes a newer checkpoint, until ctx is done. The replica runs no CometBFT
+// node and processes no blocks, it only serves the state of the primary.
+func (s *Service[_]) followReplica(ctx context.Context) {
+	refresh := s.replicaRefresh
+	if refresh <= 0 {
+		refresh = defaultReplicaRefresh
+	}
+	s.logger.Info(
+		"Serving read replica of primary checkpoints",
+		"checkpoint", s.replica.Checkpoint(),
+		"height", s.LastBlockHeight(),
+	)
+
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		reloaded, err := s.replica.Reload()
+		if err != nil {
+			s.logger.Error("Failed to reload replica checkpoint", "error", err)
+			continue
+		} else if !reloaded {
+			continue
+		}
+		s.replicaMu.Lock()
+		err = s.sm.LoadLatestVersion()
+		s.replicaMu.Unlock()
+		if err != nil {
+			s.logger.Error("Failed to load replicated state", "error", err)
+			continue
+		}
+		s.logger.Info(
+			"Replica caught up with primary checkpoint",
+			"checkpoint", s.replica.Checkpoint(),
+			"height", s.LastBlockHeight(),
+		)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reqresp
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrPeerNotFound is returned when a request targets a peer that is not
+	// connected to the switch.
+	ErrPeerNotFound = errors.New("peer not found")
+
+	// ErrSendFailed is returned when a request could not be queued on the
+	// peer connection.
+	ErrSendFailed = errors.New("failed to send request to peer")
+
+	// ErrMalformedMessage is returned when a message received on the channel
+	// cannot be decoded.
+	ErrMalformedMessage = errors.New("malformed request/response message")
+
+	// ErrTooManyRequested is returned when a request asks for more items than
+	// the protocol allows in a single request.
+	ErrTooManyRequested = errors.New("too many items requested")
+
+	// ErrRemote is returned when the peer answered the request with an error.
+	ErrRemote = errors.New("peer returned an error")
+
+	// ErrReactorNotStarted is returned when a request is made before the
+	// reactor has been attached to a switch.
+	ErrReactorNotStarted = errors.New("reactor not attached to a switch")
+)
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reqresp
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// messageKind identifies the type of a message sent over the channel.
+type messageKind byte
+
+const (
+	kindBlocksByRange messageKind = iota + 1
+	kindBlocksByRoot
+	kindBlobSidecarsByRange
+	kindBlobSidecarsByRoot
+	kindResponse
+	kindError
+)
+
+const (
+	// headerLength is the length of the kind byte followed by the request id.
+	headerLength = 1 + 8
+	// rangeLength is the length of a (start slot, count) range body.
+	rangeLength = 8 + 8
+	// itemPrefixLength is the length prefix of each item in a response.
+	itemPrefixLength = 4
+)
+
+// message is a decoded request or response. Every message carries the id of
+// the request it belongs to, so that responses can be matched to callers.
+type message struct {
+	kind  messageKind
+	id    uint64
+	start uint64
+	count uint64
+	roots []common.Root
+	items [][]byte
+	err   string
+}
+
+// isRequest returns true if the message is a request to be served.
+func (m *message) isRequest() bool {
+	return m.kind >= kindBlocksByRange && m.kind <= kindBlobSidecarsByRoot
+}
+
+// marshal encodes the message as kind || id || body.
+func (m *message) marshal() []byte {
+	buf := make([]byte, headerLength, headerLength+m.bodyLength())
+	buf[0] = byte(m.kind)
+	binary.LittleEndian.PutUint64(buf[1:headerLength], m.id)
+
+	switch m.kind {
+	case kindBlocksByRange, kindBlobSidecarsByRange:
+		buf = binary.LittleEndian.AppendUint64(buf, m.start)
+		buf = binary.LittleEndian.AppendUint64(buf, m.count)
+	case kindBlocksByRoot, kindBlobSidecarsByRoot:
+		for _, root := range m.roots {
+			buf = append(buf, root[:]...)
+		}
+	case kindResponse:
+		for _, item := range m.items {
+			//#nosec:G115 // items are bounded by the p2p message size.
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(item)))
+			buf = append(buf, item...)
+		}
+	case kindError:
+		buf = append(buf, m.err...)
+	}
+	return buf
+}
+
+// bodyLength returns the encoded length of the message body.
+func (m *message) bodyLength() int {
+	switch m.kind {
+	case kindBlocksByRange, kindBlobSidecarsByRange:
+		return rangeLength
+	case kindBlocksByRoot, kindBlobSidecarsByRoot:
+		return len(m.roots) * common.RootSize
+	case kindResponse:
+		var n int
+		for _, item := range m.items {
+			n += itemPrefixLength + len(item)
+		}
+		return n
+	case kindError:
+		return len(m.err)
+	default:
+		return 0
+	}
+}
+
+// unmarshalMessage decodes a message produced by marshal.
+func unmarshalMessage(bz []byte) (*message, error) {
+	if len(bz) < headerLength {
+		return nil, ErrMalformedMessage
+	}
+
+	m := &message{
+		kind: messageKind(bz[0]),
+		id:   binary.LittleEndian.Uint64(bz[1:headerLength]),
+	}
+	body := bz[headerLength:]
+
+	switch m.kind {
+	case kindBlocksByRange, kindBlobSidecarsByRange:
+		if len(body) != rangeLength {
+			return nil, ErrMalformedMessage
+		}
+		m.start = binary.LittleEndian.Uint64(body[:8])
+		m.count = binary.LittleEndian.Uint64(body[8:])
+	case kindBlocksByRoot, kindBlobSidecarsByRoot:
+		rootLength := common.RootSize
+		if len(body)%rootLength != 0 {
+			return nil, ErrMalformedMessage
+		}
+		m.roots = make([]common.Root, 0, len(body)/rootLength)
+		for ; len(body) > 0; body = body[rootLength:] {
+			m.roots = append(m.roots, common.Root(body[:rootLength]))
+		}
+	case kindResponse:
+		for len(body) > 0 {
+			if len(body) < itemPrefixLength {
+				return nil, ErrMalformedMessage
+			}
+			n := binary.LittleEndian.Uint32(body[:itemPrefixLength])
+			body = body[itemPrefixLength:]
+			if uint64(len(body)) < uint64(n) {
+				return nil, ErrMalformedMessage
+			}
+			m.items = append(m.items, body[:n])
+			body = body[n:]
+		}
+	case kindError:
+		m.err = string(body)
+	default:
+		return nil, ErrMalformedMessage
+	}
+	return m, nil
+}
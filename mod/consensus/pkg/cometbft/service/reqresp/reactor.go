@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package reqresp
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+const (
+	// ReactorName is the name the reactor is registered under on the switch.
+	ReactorName = "BEACON_REQRESP"
+
+	// Channel is the p2p channel used for beacon request/response messages.
+	Channel = byte(0x70)
+
+	// MaxRequestBlocks is the maximum number of blocks served for a single
+	// blocks request.
+	MaxRequestBlocks = 128
+
+	// MaxRequestBlobSidecars is the maximum number of blocks whose sidecars
+	// are served for a single sidecars request.
+	MaxRequestBlobSidecars = 128
+
+	// beaconBlockTxIndex and blobSidecarsTxIndex are the positions of the
+	// beacon block and its sidecars in the CometBFT block transactions.
+	beaconBlockTxIndex  = 0
+	blobSidecarsTxIndex = 1
+
+	// maxMessageSize bounds a single response. Sidecars for a full block are
+	// ~800KiB, so this leaves room for a full range of sidecars.
+	maxMessageSize = 128 << 20
+)
+
+// Reactor serves historical beacon blocks and blob sidecars to peers over
+// the CometBFT p2p switch, and lets the node request them from its peers.
+// It mirrors the semantics of the beacon_blocks_by_range/by_root and
+// blob_sidecars_by_range/by_root protocols, with the difference that
+// sidecars are served per block as they are stored, rather than by index.
+type Reactor struct {
+	p2p.BaseReactor
+
+	logger log.Logger
+	// resolver maps block roots to the slot they were proposed in.
+	resolver SlotResolver
+	// source is set once the CometBFT node, and its block store, exist.
+	source atomic.Pointer[BlockSource]
+
+	// nextID is the id assigned to the next outgoing request.
+	nextID atomic.Uint64
+	// mu protects pending.
+	mu sync.Mutex
+	// pending holds the response channels of in-flight requests.
+	pending map[uint64]chan *message
+}
+
+// NewReactor creates a new request/response reactor.
+func NewReactor(logger log.Logger, resolver SlotResolver) *Reactor {
+	r := &Reactor{
+		logger:   logger,
+		resolver: resolver,
+		pending:  make(map[uint64]chan *message),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor(ReactorName, r)
+	return r
+}
+
+// SetBlockSource sets the source blocks and sidecars are served from.
+func (r *Reactor) SetBlockSource(source BlockSource) {
+	r.source.Store(&source)
+}
+
+// GetChannels implements p2p.Reactor.
+func (r *Reactor) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{
+		{
+			ID:                  Channel,
+			Priority:            1,
+			SendQueueCapacity:   16,
+			RecvBufferCapacity:  1 << 20,
+			RecvMessageCapacity: maxMessageSize,
+			MessageType:         &gogotypes.BytesValue{},
+		},
+	}
+}
+
+// Receive implements p2p.Reactor.
+func (r *Reactor) Receive(e p2p.Envelope) {
+	bv, ok := e.Message.(*gogotypes.BytesValue)
+	if !ok {
+		r.logger.Error("unexpected message type", "peer", e.Src.ID())
+		return
+	}
+
+	msg, err := unmarshalMessage(bv.GetValue())
+	if err != nil {
+		r.logger.Error(
+			"failed to decode message", "peer", e.Src.ID(), "error", err,
+		)
+		return
+	}
+
+	if !msg.isRequest() {
+		r.deliver(msg)
+		return
+	}
+
+	resp := r.serve(msg)
+	if !e.Src.Send(p2p.Envelope{
+		ChannelID: Channel,
+		Message:   &gogotypes.BytesValue{Value: resp.marshal()},
+	}) {
+		r.logger.Warn(
+			"failed to send response", "peer", e.Src.ID(), "request", msg.id,
+		)
+	}
+}
+
+// BlocksByRange requests up to count SSZ encoded beacon blocks from the peer,
+// starting at the given slot. Slots without a block are skipped.
+func (r *Reactor) BlocksByRange(
+	ctx context.Context, peerID p2p.ID, start math.Slot, count uint64,
+) ([][]byte, error) {
+	if count > MaxRequestBlocks {
+		return nil, ErrTooManyRequested
+	}
+	return r.request(ctx, peerID, &message{
+		kind: kindBlocksByRange, start: start.Unwrap(), count: count,
+	})
+}
+
+// BlocksByRoot requests the SSZ encoded beacon blocks with the given roots
+// from the peer. Blocks unknown to the peer are omitted from the response.
+func (r *Reactor) BlocksByRoot(
+	ctx context.Context, peerID p2p.ID, roots []common.Root,
+) ([][]byte, error) {
+	if len(roots) > MaxRequestBlocks {
+		return nil, ErrTooManyRequested
+	}
+	return r.request(ctx, peerID, &message{
+		kind: kindBlocksByRoot, roots: roots,
+	})
+}
+
+// BlobSidecarsByRange requests the SSZ encoded blob sidecars of up to count
+// blocks from the peer, starting at the given slot.
+func (r *Reactor) BlobSidecarsByRange(
+	ctx context.Context, peerID p2p.ID, start math.Slot, count uint64,
+) ([][]byte, error) {
+	if count > MaxRequestBlobSidecars {
+		return nil, ErrTooManyRequested
+	}
+	return r.request(ctx, peerID, &message{
+		kind: kindBlobSidecarsByRange, start: start.Unwrap(), count: count,
+	})
+}
+
+// BlobSidecarsByRoot requests the SSZ encoded blob sidecars of the blocks
+// with the given roots from the peer.
+func (r *Reactor) BlobSidecarsByRoot(
+	ctx context.Context, peerID p2p.ID, roots []common.Root,
+) ([][]byte, error) {
+	if len(roots) > MaxRequestBlobSidecars {
+		return nil, ErrTooManyRequested
+	}
+	return r.request(ctx, peerID, &message{
+		kind: kindBlobSidecarsByRoot, roots: roots,
+	})
+}
+
+// request sends the request to the peer and waits for its response.
+func (r *Reactor) request(
+	ctx context.Context, peerID p2p.ID, req *message,
+) ([][]byte, error) {
+	sw := r.Switch
+	if sw == nil {
+		return nil, ErrReactorNotStarted
+	}
+	peer := sw.Peers().Get(peerID)
+	if peer == nil {
+		return nil, ErrPeerNotFound
+	}
+
+	req.id = r.nextID.Add(1)
+	ch := make(chan *message, 1)
+	r.mu.Lock()
+	r.pending[req.id] = ch
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.pending, req.id)
+		r.mu.Unlock()
+	}()
+
+	if !peer.Send(p2p.Envelope{
+		ChannelID: Channel,
+		Message:   &gogotypes.BytesValue{Value: req.marshal()},
+	}) {
+		return nil, ErrSendFailed
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case resp := <-ch:
+		if resp.kind == kindError {
+			return nil, errors.Wrap(ErrRemote, resp.err)
+		}
+		return resp.items, nil
+	}
+}
+
+// deliver hands a response to the caller waiting on it. Responses to
+// requests that already timed out are dropped.
+func (r *Reactor) deliver(resp *message) {
+	r.mu.Lock()
+	ch, ok := r.pending[resp.id]
+	r.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	select {
+	case ch <- resp:
+	default:
+	}
+}
+
+// serve builds the response to a request from a peer.
+func (r *Reactor) serve(req *message) *message {
+	src := r.source.Load()
+	if src == nil {
+		return &message{
+			kind: kindError, id: req.id, err: ErrReactorNotStarted.Error(),
+		}
+	}
+
+	var (
+		txIndex = beaconBlockTxIndex
+		limit   = uint64(MaxRequestBlocks)
+	)
+	if req.kind == kindBlobSidecarsByRange ||
+		req.kind == kindBlobSidecarsByRoot {
+		txIndex, limit = blobSidecarsTxIndex, MaxRequestBlobSidecars
+	}
+
+	slots, err := r.slotsFor(req, limit)
+	if err != nil {
+		return &message{kind: kindError, id: req.id, err: err.Error()}
+	}
+
+	resp := &message{kind: kindResponse, id: req.id}
+	for _, slot := range slots {
+		//#nosec:G115 // slots are well below max int64.
+		txs, ok := (*src).TxsAtHeight(int64(slot))
+		if !ok || len(txs) <= txIndex {
+			continue
+		}
+		resp.items = append(resp.items, txs[txIndex])
+	}
+	return resp
+}
+
+// slotsFor returns the slots a request refers to. Roots that cannot be
+// resolved are skipped, matching the by_root semantics of only returning
+// the blocks that are known.
+func (r *Reactor) slotsFor(req *message, limit uint64) ([]uint64, error) {
+	if req.kind == kindBlocksByRange ||
+		req.kind == kindBlobSidecarsByRange {
+		if req.count > limit {
+			return nil, ErrTooManyRequested
+		}
+		slots := make([]uint64, 0, req.count)
+		for i := range req.count {
+			slots = append(slots, req.start+i)
+		}
+		return slots, nil
+	}
+
+	if uint64(len(req.roots)) > limit {
+		return nil, ErrTooManyRequested
+	}
+	slots := make([]uint64, 0, len(req.roots))
+	for _, root := range req.roots {
+		slot, err := r.resolver.GetSlotByBlockRoot(root)
+		if err != nil {
+			continue
+		}
+		slots = append(slots, slot.Unwrap())
+	}
+	return slots, nil
+}
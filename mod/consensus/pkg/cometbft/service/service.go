@@ -23,21 +23,28 @@ package cometbft
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/params"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/reqresp"
 	statem "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/state"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/upgrade"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -80,6 +87,46 @@ type Service[
 	minRetainBlocks uint64
 
 	chainID string
+
+	// reqResp serves historical blocks and sidecars to peers, if set.
+	reqResp *reqresp.Reactor
+
+	// recorder persists the ABCI exchanges with CometBFT, if set.
+	recorder *middleware.Recorder
+	// replayPath is the recording to replay in place of running a CometBFT
+	// node, if set.
+	replayPath string
+
+	// chainVerifier runs the beacon checks of `verify-chain`, if set.
+	chainVerifier ChainVerifier
+	// verifyOpts makes Start verify the stored chain in place of running a
+	// CometBFT node, if set.
+	verifyOpts *VerifyChainOptions
+	// auditRegistry makes Start audit the validator registry in place of
+	// running a CometBFT node.
+	auditRegistry bool
+	// exportOpts makes Start export the validator set in place of running a
+	// CometBFT node, if set.
+	exportOpts *ExportValidatorSetOptions
+
+	// upgrades halts the node at the height of a scheduled upgrade, if set.
+	upgrades *upgrade.Manager
+
+	// privValidator signs in place of the key files of the node, if set.
+	privValidator cmttypes.PrivValidator
+
+	// checkpointer writes checkpoints of the application database for read
+	// replicas, if set.
+	checkpointer *storagedb.Checkpointer
+	// replica makes Start follow the checkpoints of a primary node in place
+	// of running a CometBFT node, if set.
+	replica *storagedb.ReplicaDB
+	// replicaRefresh is the interval at which the replica looks for a newer
+	// checkpoint.
+	replicaRefresh time.Duration
+	// replicaMu keeps queries from reading the multistore while a replica
+	// reloads it.
+	replicaMu sync.RWMutex
 }
 
 func NewService[
@@ -126,25 +173,67 @@ func NewService[
 func (s *Service[_]) Start(
 	ctx context.Context,
 ) error {
+	if s.replayPath != "" {
+		return s.replay(ctx)
+	}
+	if s.verifyOpts != nil {
+		return s.verifyChain(ctx)
+	}
+	if s.auditRegistry {
+		return s.runRegistryAudit()
+	}
+	if s.exportOpts != nil {
+		return s.exportValidatorSet()
+	}
+	if s.replica != nil {
+		go s.followReplica(ctx)
+		return nil
+	}
+
+	if err := s.verifyUpgradeBinary(); err != nil {
+		return err
+	}
+
 	cfg := s.cmtCfg
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
 	}
 
+	privValidator := s.privValidator
+	if privValidator == nil {
+		privValidator = pvm.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+		)
+	} else {
+		// The validator is either already connected to the remote signer,
+		// which the node must not listen for a second time, or reads a key
+		// file the node cannot load on its own.
+		nodeCfg := *cfg
+		nodeCfg.PrivValidatorListenAddr = ""
+		cfg = &nodeCfg
+	}
+
+	var nodeOpts []node.Option
+	if s.reqResp != nil {
+		s.reqResp.SetBlockSource(s)
+		nodeOpts = append(nodeOpts, node.CustomReactors(
+			map[string]p2p.Reactor{reqresp.ReactorName: s.reqResp},
+		))
+	}
+
 	s.node, err = node.NewNode(
 		ctx,
 		cfg,
-		pvm.LoadOrGenFilePV(
-			cfg.PrivValidatorKeyFile(),
-			cfg.PrivValidatorStateFile(),
-		),
+		privValidator,
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
 		cmtcfg.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
+		nodeOpts...,
 	)
 	if err != nil {
 		return err
@@ -163,6 +252,13 @@ func (s *Service[_]) Close() error {
 		_ = s.node.Stop()
 	}
 
+	if s.recorder != nil {
+		s.logger.Info("Closing ABCI recorder")
+		if err := s.recorder.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, err)
@@ -199,11 +295,51 @@ func (s *Service[_]) MountStore(
 	s.sm.CommitMultiStore().MountStoreWithDB(key, typ, nil)
 }
 
+// TxsAtHeight returns the transactions of the CometBFT block at the given
+// height, and false if the node does not have it.
+func (s *Service[_]) TxsAtHeight(height int64) ([][]byte, bool) {
+	if s.node == nil {
+		return nil, false
+	}
+	blk, _ := s.node.BlockStore().LoadBlock(height)
+	if blk == nil {
+		return nil, false
+	}
+	txs := make([][]byte, len(blk.Txs))
+	for i, tx := range blk.Txs {
+		txs[i] = tx
+	}
+	return txs, true
+}
+
 // LastBlockHeight returns the last committed block height.
 func (s *Service[_]) LastBlockHeight() int64 {
 	return s.sm.CommitMultiStore().LastCommitID().Version
 }
 
+// BlockStoreRange returns the heights of the lowest and highest blocks in the
+// node's block store, and false if the node has not been started.
+func (s *Service[_]) BlockStoreRange() (int64, int64, bool) {
+	if s.node == nil {
+		return 0, 0, false
+	}
+	return s.node.BlockStore().Base(), s.node.BlockStore().Height(), true
+}
+
+// InitialHeight returns the height of the first block of the chain.
+func (s *Service[_]) InitialHeight() int64 {
+	return max(s.initialHeight, 1)
+}
+
+// IsCatchingUp returns true while the node is syncing blocks from its peers
+// rather than participating in consensus.
+func (s *Service[_]) IsCatchingUp() bool {
+	if s.node == nil {
+		return true
+	}
+	return s.node.ConsensusReactor().WaitSync()
+}
+
 func (s *Service[_]) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
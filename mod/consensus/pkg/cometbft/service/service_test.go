@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package cometbft
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	pvm "github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "beacon-test"
+
+// testMiddleware is a middleware that starts the chain with a single
+// validator and accepts every block.
+type testMiddleware struct {
+	pubkey crypto.BLSPubkey
+}
+
+func (m testMiddleware) InitGenesis(
+	context.Context, []byte,
+) (transition.ValidatorUpdates, error) {
+	return transition.ValidatorUpdates{{
+		Pubkey:           m.pubkey,
+		EffectiveBalance: math.Gwei(32e9),
+	}}, nil
+}
+
+func (testMiddleware) PrepareProposal(
+	context.Context, math.Slot,
+	*types.SlotData[*ctypes.AttestationData, *ctypes.SlashingInfo],
+) ([][]byte, error) {
+	return nil, nil
+}
+
+func (testMiddleware) ProcessProposal(
+	context.Context, *cmtabci.ProcessProposalRequest,
+) (*cmtabci.ProcessProposalResponse, error) {
+	return &cmtabci.ProcessProposalResponse{
+		Status: cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+	}, nil
+}
+
+func (testMiddleware) FinalizeBlock(
+	context.Context, *cmtabci.FinalizeBlockRequest,
+) (transition.ValidatorUpdates, error) {
+	return nil, nil
+}
+
+func (testMiddleware) ObservePhase(
+	middleware.Phase, middleware.Outcome, middleware.PhaseStats,
+) {
+}
+
+// testChainSpec is a chain spec that only provides the CometBFT consensus
+// parameters.
+type testChainSpec struct {
+	common.ChainSpec
+}
+
+func (testChainSpec) GetCometBFTConfigForSlot(math.Slot) any {
+	params := cmttypes.DefaultConsensusParams()
+	params.Validator.PubKeyTypes = []string{bls12381.KeyType}
+	return params
+}
+
+// newTestHome creates a node home with a genesis file and a plaintext BLS
+// key file, returning its config and the public key of the validator.
+func newTestHome(t *testing.T) (*cmtcfg.Config, crypto.BLSPubkey) {
+	t.Helper()
+	cfg := cmtcfg.DefaultConfig().SetRoot(t.TempDir())
+	cmtcfg.EnsureRoot(cfg.RootDir)
+	cfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cfg.RPC.ListenAddress = ""
+	cfg.GRPC.ListenAddress = ""
+
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	pvm.NewFilePV(
+		privKey, cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(),
+	).Save()
+
+	params, ok := testChainSpec{}.
+		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	require.True(t, ok)
+	appGenesis := &genutiltypes.AppGenesis{
+		AppName:       appName,
+		GenesisTime:   time.Now().UTC(),
+		ChainID:       testChainID,
+		InitialHeight: 1,
+		AppState:      json.RawMessage(`{"beacon":{}}`),
+		Consensus:     &genutiltypes.ConsensusGenesis{Params: params},
+	}
+	require.NoError(t, appGenesis.SaveAs(cfg.GenesisFile()))
+	return cfg, crypto.BLSPubkey(privKey.PubKey().Bytes())
+}
+
+func TestStart_EncryptedKeyFile(t *testing.T) {
+	cfg, pubkey := newTestHome(t)
+	cipher, err := encryption.NewCipher(make([]byte, encryption.KeyLength))
+	require.NoError(t, err)
+
+	// Loading the validator seals the plaintext key file in place, which
+	// CometBFT can no longer read on its own.
+	pv, err := encryption.LoadFilePV(
+		cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(), cipher,
+	)
+	require.NoError(t, err)
+	keyBz, err := os.ReadFile(cfg.PrivValidatorKeyFile())
+	require.NoError(t, err)
+	require.False(t, json.Valid(keyBz))
+
+	logCfg := phuslu.DefaultConfig()
+	s := NewService(
+		storetypes.NewKVStoreKey("beacon"),
+		phuslu.NewLogger(io.Discard, &logCfg),
+		dbm.NewMemDB(),
+		testMiddleware{pubkey: pubkey},
+		cfg,
+		testChainSpec{},
+		SetChainID[*phuslu.Logger](testChainID),
+		SetPrivValidator[*phuslu.Logger](pv),
+	)
+	require.NoError(t, s.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+
+	nodePubkey, err := s.node.PrivValidator().GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pubkey[:], nodePubkey.Bytes())
+	// The node never writes the key back in plaintext.
+	keyBz, err = os.ReadFile(cfg.PrivValidatorKeyFile())
+	require.NoError(t, err)
+	require.False(t, json.Valid(keyBz))
+}
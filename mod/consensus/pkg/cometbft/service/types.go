@@ -24,6 +24,7 @@ import (
 	"context"
 
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -53,9 +54,9 @@ type MiddlewareI interface {
 	InitGenesis(
 		ctx context.Context, bz []byte,
 	) (transition.ValidatorUpdates, error)
-	PrepareProposal(context.Context, *types.SlotData[
+	PrepareProposal(context.Context, math.Slot, *types.SlotData[
 		*ctypes.AttestationData,
-		*ctypes.SlashingInfo]) ([]byte, []byte, error)
+		*ctypes.SlashingInfo]) ([][]byte, error)
 	ProcessProposal(
 		ctx context.Context, req *cmtabci.ProcessProposalRequest,
 	) (*cmtabci.ProcessProposalResponse, error)
@@ -63,6 +64,29 @@ type MiddlewareI interface {
 		ctx context.Context,
 		req *cmtabci.FinalizeBlockRequest,
 	) (transition.ValidatorUpdates, error)
+	ObservePhase(
+		phase middleware.Phase,
+		outcome middleware.Outcome,
+		stats middleware.PhaseStats,
+	)
+}
+
+// ChainVerifier decodes the beacon blocks and states referenced by
+// `verify-chain`, `audit-registry` and `export-validator-set`.
+type ChainVerifier interface {
+	// BlockRoots decodes the beacon block committed at height and returns
+	// its root, the root of its parent and its state root.
+	BlockRoots(
+		height int64, bz []byte,
+	) (common.Root, common.Root, common.Root, error)
+	// StateRoot returns the hash tree root of the beacon state held by ctx.
+	StateRoot(ctx context.Context) common.Root
+	// AuditRegistry returns the index anomalies found in the validator
+	// registry of the beacon state held by ctx.
+	AuditRegistry(ctx context.Context) ([]string, error)
+	// ExportValidatorSet encodes the active validator set of the beacon
+	// state held by ctx in the given format.
+	ExportValidatorSet(ctx context.Context, format string) ([]byte, error)
 }
 
 // SlashingInfo is an interface for accessing the slashing info.
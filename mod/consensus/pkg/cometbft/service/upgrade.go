@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"os"
+	"syscall"
+)
+
+// verifyUpgradeBinary checks the running binary against the upgrade the node
+// is scheduled for or last halted for.
+func (s *Service[_]) verifyUpgradeBinary() error {
+	if s.upgrades == nil {
+		return nil
+	}
+	return s.upgrades.VerifyBinary(s.LastBlockHeight())
+}
+
+// checkUpgradeHeight refuses to process the block at the given height if it
+// belongs to an upgrade the running binary does not implement.
+func (s *Service[_]) checkUpgradeHeight(height int64) error {
+	if s.upgrades == nil {
+		return nil
+	}
+	return s.upgrades.CheckHeight(height)
+}
+
+// maybeHaltForUpgrade halts the node once the block before a scheduled
+// upgrade has been committed, leaving it to the operator, or cosmovisor, to
+// restart the node with the upgraded binary.
+func (s *Service[_]) maybeHaltForUpgrade(committed int64) {
+	if s.upgrades == nil || !s.upgrades.ShouldHalt(committed) {
+		return
+	}
+
+	plan := s.upgrades.Plan()
+	s.logger.Error(
+		"UPGRADE NEEDED - halting node, restart it with the upgraded binary",
+		"upgrade", plan.Name,
+		"upgrade_height", plan.Height,
+		"last_committed_height", committed,
+		"reason", s.upgrades.Halt(),
+	)
+
+	// Shut down through the signal handling of the start command, so that
+	// the node stops the same way it does when the operator stops it.
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		err = p.Signal(syscall.SIGTERM)
+	}
+	if err != nil {
+		s.logger.Error("Failed to halt node for upgrade", "error", err)
+	}
+}
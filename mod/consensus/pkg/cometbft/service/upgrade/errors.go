@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidPlan is returned when an upgrade is scheduled without both a
+	// name and a positive height.
+	ErrInvalidPlan = errors.New("invalid upgrade plan")
+
+	// ErrUpgradeNeeded is returned once the chain reaches the height of an
+	// upgrade the running binary does not implement.
+	ErrUpgradeNeeded = errors.New("upgrade needed")
+
+	// ErrBinaryUpdatedEarly is returned when a binary implementing the
+	// scheduled upgrade is started before the chain reached its height.
+	ErrBinaryUpdatedEarly = errors.New("binary updated before upgrade height")
+)
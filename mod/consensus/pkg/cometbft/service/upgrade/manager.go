@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Manager coordinates a binary upgrade at a scheduled height. The running
+// binary halts once the last block before the upgrade is committed, unless it
+// implements the upgrade itself, and a restarted binary is checked against
+// the upgrade the node halted for.
+type Manager struct {
+	// plan is the scheduled upgrade.
+	plan Plan
+	// known lists the upgrades implemented by the running binary.
+	known Names
+	// dataDir is the directory the upgrade info file is written to.
+	dataDir string
+}
+
+// NewManager creates a new upgrade manager for the given plan.
+func NewManager(plan Plan, known Names, dataDir string) (*Manager, error) {
+	if plan.IsScheduled() && plan.Height <= 0 {
+		return nil, errors.Wrapf(
+			ErrInvalidPlan, "upgrade %q has height %d", plan.Name, plan.Height,
+		)
+	}
+	return &Manager{
+		plan:    plan,
+		known:   known,
+		dataDir: dataDir,
+	}, nil
+}
+
+// Plan returns the scheduled upgrade.
+func (m *Manager) Plan() Plan {
+	return m.plan
+}
+
+// implements returns true if the running binary implements the upgrade.
+func (m *Manager) implements(name string) bool {
+	return slices.Contains(m.known, name)
+}
+
+// VerifyBinary checks that the running binary may resume the chain from the
+// given committed height. It must implement the upgrade the node last halted
+// for, and must not implement the scheduled upgrade ahead of its height.
+func (m *Manager) VerifyBinary(lastHeight int64) error {
+	halted, err := readInfo(m.dataDir)
+	if err != nil {
+		return err
+	}
+	if halted.IsScheduled() && lastHeight < halted.Height &&
+		!m.implements(halted.Name) {
+		return errors.Wrapf(
+			ErrUpgradeNeeded,
+			"node halted for upgrade %q at height %d, which this binary "+
+				"does not implement", halted.Name, halted.Height,
+		)
+	}
+
+	if m.plan.IsScheduled() && lastHeight+1 < m.plan.Height &&
+		m.implements(m.plan.Name) {
+		return errors.Wrapf(
+			ErrBinaryUpdatedEarly,
+			"upgrade %q is scheduled at height %d, last committed height "+
+				"is %d", m.plan.Name, m.plan.Height, lastHeight,
+		)
+	}
+	return nil
+}
+
+// CheckHeight returns ErrUpgradeNeeded if the block at the given height must
+// be processed by an upgraded binary.
+func (m *Manager) CheckHeight(height int64) error {
+	if !m.plan.IsScheduled() || height < m.plan.Height ||
+		m.implements(m.plan.Name) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrUpgradeNeeded,
+		"upgrade %q needed at height %d", m.plan.Name, m.plan.Height,
+	)
+}
+
+// ShouldHalt returns true if the node must halt after committing the block
+// at the given height.
+func (m *Manager) ShouldHalt(committed int64) bool {
+	return m.CheckHeight(committed+1) != nil
+}
+
+// Halt records the scheduled upgrade in the data directory and returns the
+// error describing why the node halts.
+func (m *Manager) Halt() error {
+	if err := writeInfo(m.dataDir, m.plan); err != nil {
+		return err
+	}
+	return m.CheckHeight(m.plan.Height)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package upgrade
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// InfoFileName is the name of the file, in the data directory of the node,
+// recording the upgrade the node halted for. The layout matches the one of
+// the Cosmos SDK upgrade module, so that cosmovisor can swap the binary.
+const InfoFileName = "upgrade-info.json"
+
+// Plan is an upgrade scheduled at a given height.
+type Plan struct {
+	// Name identifies the upgrade. A binary implementing the upgrade lists
+	// this name among its Names.
+	Name string `json:"name"`
+	// Height is the first block height processed by the upgraded binary.
+	Height int64 `json:"height"`
+}
+
+// IsScheduled returns true if the plan names an upgrade.
+func (p Plan) IsScheduled() bool {
+	return p.Name != ""
+}
+
+// Names lists the upgrades implemented by a binary.
+type Names []string
+
+// readInfo reads the upgrade the node last halted for from dir. It returns
+// the zero Plan if the node never halted for an upgrade.
+func readInfo(dir string) (Plan, error) {
+	var plan Plan
+	bz, err := os.ReadFile(filepath.Join(dir, InfoFileName))
+	if os.IsNotExist(err) {
+		return plan, nil
+	} else if err != nil {
+		return plan, err
+	}
+	return plan, json.Unmarshal(bz, &plan)
+}
+
+// writeInfo records in dir the upgrade the node halts for.
+func writeInfo(dir string, plan Plan) error {
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	//#nosec:G306 // cosmovisor reads the file as another user.
+	return os.WriteFile(filepath.Join(dir, InfoFileName), bz, 0o644)
+}
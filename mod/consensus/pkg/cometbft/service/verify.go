@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// ErrChainVerified is returned by Start once `verify-chain` walked the
+	// block store without finding an inconsistency. Like ErrReplayFinished,
+	// it stops the remaining node lifecycle.
+	ErrChainVerified = errors.New("chain verified")
+
+	// errChainCorrupt is returned when at least one stored block failed
+	// verification.
+	errChainCorrupt = errors.New("stored blocks failed verification")
+
+	// errNoChainVerifier is returned when verifying without the beacon
+	// checks wired into the service.
+	errNoChainVerifier = errors.New("no chain verifier configured")
+
+	// errPruneCommitted is returned when the first corrupt block has already
+	// been applied, so removing it would leave the node inconsistent.
+	errPruneCommitted = errors.New(
+		"corrupt block already applied, use `rollback` instead of pruning",
+	)
+
+	// errMissingCommit is returned for a stored block without a commit.
+	errMissingCommit = errors.New("no commit stored for block")
+
+	// errCommitMismatch is returned when the stored commit signs another
+	// block.
+	errCommitMismatch = errors.New("commit does not sign the stored block")
+)
+
+// VerifyChainOptions configures a `verify-chain` run.
+type VerifyChainOptions struct {
+	// From is the first height to verify. Zero starts at the block store
+	// base.
+	From int64
+	// To is the last height to verify. Zero stops at the block store height.
+	To int64
+	// Prune removes the blocks from the first one failing verification up
+	// to the tip of the block store, as long as neither the application nor
+	// the CometBFT state has applied them yet.
+	Prune bool
+}
+
+// storedBlock is the part of a verified block its successor links to.
+type storedBlock struct {
+	hash []byte
+	root common.Root
+}
+
+// verifyChain walks the CometBFT block store checking that every block
+// links to its parent, carries a commit signed by the validator set of its
+// height, and that its beacon block links to the parent beacon block and
+// matches the beacon state stored for its height, when still retained.
+func (s *Service[_]) verifyChain(ctx context.Context) error {
+	if s.chainVerifier == nil {
+		return errNoChainVerifier
+	}
+
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: s.cmtCfg},
+	)
+	if err != nil {
+		return err
+	}
+	blockStore := cmtstore.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: s.cmtCfg},
+	)
+	if err != nil {
+		return err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	defer stateStore.Close()
+
+	from, to := s.verifyOpts.From, s.verifyOpts.To
+	if from < blockStore.Base() {
+		from = blockStore.Base()
+	}
+	if to == 0 || to > blockStore.Height() {
+		to = blockStore.Height()
+	}
+
+	var (
+		parent    *storedBlock
+		corrupt   int
+		firstBad  int64
+		unchecked int
+	)
+	for height := from; height <= to; height++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		blk, problems, stateChecked := s.verifyStoredBlock(
+			blockStore, stateStore, height, parent,
+		)
+		if !stateChecked {
+			unchecked++
+		}
+		for _, problem := range problems {
+			s.logger.Error("Stored block failed verification",
+				"height", height, "reason", problem,
+			)
+		}
+		if len(problems) > 0 {
+			corrupt++
+			if firstBad == 0 {
+				firstBad = height
+			}
+		}
+		parent = blk
+	}
+
+	s.logger.Info("Finished chain verification",
+		"from", from,
+		"to", to,
+		"corrupt", corrupt,
+		"state_roots_unchecked", unchecked,
+	)
+	if corrupt == 0 {
+		return ErrChainVerified
+	}
+
+	if s.verifyOpts.Prune {
+		if err = s.pruneOrphanedBlocks(
+			blockStore, stateStore, firstBad,
+		); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf(
+		"%d of %d: %w", corrupt, to-from+1, errChainCorrupt,
+	)
+}
+
+// verifyStoredBlock verifies the block at height against its parent,
+// returning the problems found and whether its state root could be checked.
+func (s *Service[_]) verifyStoredBlock(
+	blockStore *cmtstore.BlockStore,
+	stateStore sm.Store,
+	height int64,
+	parent *storedBlock,
+) (*storedBlock, []string, bool) {
+	blk, _ := blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, []string{"block missing from the block store"}, false
+	}
+
+	var problems []string
+	stored := &storedBlock{hash: blk.Hash()}
+	if parent != nil && !bytes.Equal(blk.LastBlockID.Hash, parent.hash) {
+		problems = append(problems, "last block id does not match parent")
+	}
+	if err := s.verifyCommit(blockStore, stateStore, blk); err != nil {
+		problems = append(problems, "commit: "+err.Error())
+	}
+
+	if uint(len(blk.Txs)) <= middleware.BeaconBlockTxIndex {
+		return stored, append(problems, "no beacon block in block"), false
+	}
+	root, parentRoot, stateRoot, err := s.chainVerifier.BlockRoots(
+		height, blk.Txs[middleware.BeaconBlockTxIndex],
+	)
+	if err != nil {
+		return stored, append(problems, "beacon block: "+err.Error()), false
+	}
+	stored.root = root
+	if parent != nil && parent.root != (common.Root{}) &&
+		parentRoot != parent.root {
+		problems = append(problems, fmt.Sprintf(
+			"parent root %s does not match parent block root %s",
+			parentRoot, parent.root,
+		))
+	}
+
+	// States are pruned along with the application store, so older blocks
+	// can only be checked for their links.
+	queryCtx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return stored, problems, false
+	}
+	if got := s.chainVerifier.StateRoot(queryCtx); got != stateRoot {
+		problems = append(problems, fmt.Sprintf(
+			"state root %s does not match stored state root %s",
+			stateRoot, got,
+		))
+	}
+	return stored, problems, true
+}
+
+// verifyCommit checks that blk carries a commit signed by more than two
+// thirds of the validator set of its height.
+func (s *Service[_]) verifyCommit(
+	blockStore *cmtstore.BlockStore,
+	stateStore sm.Store,
+	blk *cmttypes.Block,
+) error {
+	commit := blockStore.LoadBlockCommit(blk.Height)
+	if commit == nil {
+		// The commit of the tip is only known from the votes we saw.
+		commit = blockStore.LoadSeenCommit(blk.Height)
+	}
+	if commit == nil {
+		return errMissingCommit
+	}
+	if !bytes.Equal(commit.BlockID.Hash, blk.Hash()) {
+		return errCommitMismatch
+	}
+	vals, err := stateStore.LoadValidators(blk.Height)
+	if err != nil {
+		return err
+	}
+	return vals.VerifyCommitLight(
+		s.chainID, commit.BlockID, blk.Height, commit,
+	)
+}
+
+// pruneOrphanedBlocks deletes the blocks from the given height up to the tip
+// of the block store. Only blocks neither the application nor CometBFT have
+// applied are removed; anything older needs a rollback instead.
+func (s *Service[_]) pruneOrphanedBlocks(
+	blockStore *cmtstore.BlockStore,
+	stateStore sm.Store,
+	from int64,
+) error {
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if from <= max(state.LastBlockHeight, s.LastBlockHeight()) {
+		return fmt.Errorf("height %d: %w", from, errPruneCommitted)
+	}
+
+	for blockStore.Height() >= from {
+		if err = blockStore.DeleteLatestBlock(); err != nil {
+			return err
+		}
+	}
+	s.logger.Info("Pruned orphaned blocks",
+		"from", from, "height", blockStore.Height(),
+	)
+	return nil
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"golang.org/x/sync/errgroup"
+)
+
+// DataColumnFactory is a factory for data column sidecars, which extend the
+// blobs of a block with an erasure code and carry them column by column, in
+// the style of PeerDAS (EIP-7594).
+type DataColumnFactory[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody,
+	BeaconBlockHeaderT BeaconBlockHeader,
+] struct {
+	// chainSpec defines the specifications of the blockchain.
+	chainSpec ChainSpec
+	// prover computes the cells of the extended blobs and their proofs.
+	prover CellProver
+	// kzgPosition is the position of the KZG commitments in the block body.
+	kzgPosition uint64
+	// workers bounds the number of blobs extended concurrently.
+	workers int
+	// metrics is used to collect and report factory metrics.
+	metrics *factoryMetrics
+}
+
+// NewDataColumnFactory creates a new data column sidecar factory.
+func NewDataColumnFactory[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody,
+	BeaconBlockHeaderT BeaconBlockHeader,
+](
+	chainSpec ChainSpec,
+	prover CellProver,
+	kzgPosition uint64,
+	workers int,
+	telemetrySink TelemetrySink,
+) *DataColumnFactory[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+] {
+	return &DataColumnFactory[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	]{
+		chainSpec:   chainSpec,
+		prover:      prover,
+		kzgPosition: kzgPosition,
+		workers:     proofWorkers(workers),
+		metrics:     newFactoryMetrics(telemetrySink),
+	}
+}
+
+// BuildSidecars builds a data column sidecar for every column of the
+// extended blobs of the block. It fails if data columns are not active at
+// the slot of the block.
+func (f *DataColumnFactory[BeaconBlockT, _, _]) BuildSidecars(
+	blk BeaconBlockT,
+	bundle engineprimitives.BlobsBundle,
+) (*types.DataColumnSidecars, error) {
+	var (
+		header   = blk.GetHeader()
+		body     = blk.GetBody()
+		blobs    = bundle.GetBlobs()
+		numBlobs = len(blobs)
+		cells    = make([][]types.Cell, numBlobs)
+		proofs   = make([][]eip4844.KZGProof, numBlobs)
+		g        = errgroup.Group{}
+	)
+
+	if slot := header.GetSlot(); !f.chainSpec.ActiveForkForSlot(
+		slot,
+	).DataColumns {
+		return nil, errors.Wrapf(
+			ErrDataColumnsNotActive, "slot: %d", slot,
+		)
+	}
+
+	startTime := time.Now()
+	//#nosec:G115 // the number of blobs is never negative.
+	defer f.metrics.measureBuildDataColumnsDuration(
+		startTime, math.U64(numBlobs),
+	)
+
+	// Extend every blob and compute the proofs of its cells.
+	g.SetLimit(f.workers)
+	for i := range numBlobs {
+		g.Go(func() error {
+			var err error
+			cells[i], proofs[i], err = f.prover.ComputeCellsAndKZGProofs(
+				blobs[i],
+			)
+			if err != nil {
+				return err
+			}
+			if len(cells[i]) != types.NumberOfColumns ||
+				len(proofs[i]) != types.NumberOfColumns {
+				return errors.Wrapf(
+					ErrInvalidCellCount, "blob: %d, cells: %d, proofs: %d",
+					i, len(cells[i]), len(proofs[i]),
+				)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Every column proves the same commitments list in the block body.
+	inclusionProof, err := buildBlockBodyProof(body, f.kzgPosition)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments := bundle.GetCommitments()
+	sidecars := make([]*types.DataColumnSidecar, types.NumberOfColumns)
+	for j := range sidecars {
+		column := make([]*types.Cell, numBlobs)
+		columnProofs := make([]eip4844.KZGProof, numBlobs)
+		for i := range numBlobs {
+			column[i] = &cells[i][j]
+			columnProofs[i] = proofs[i][j]
+		}
+		//#nosec:G115 // the column index is never negative.
+		sidecars[j] = types.BuildDataColumnSidecar(
+			math.U64(j), header,
+			column,
+			commitments,
+			columnProofs,
+			inclusionProof,
+		)
+	}
+	return &types.DataColumnSidecars{Sidecars: sidecars}, nil
+}
@@ -21,6 +21,7 @@
 package blob
 
 import (
+	"runtime"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
@@ -31,8 +32,9 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// SidecarFactory is a factory for sidecars.
-type SidecarFactory[
+// BlobSidecarFactory is a factory for blob sidecars, carrying each blob of a
+// block as a whole.
+type BlobSidecarFactory[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody,
 	BeaconBlockHeaderT any,
@@ -43,12 +45,14 @@ type SidecarFactory[
 	//
 	// TODO: This needs to be made configurable / modular.
 	kzgPosition uint64
+	// workers bounds the number of blobs whose proofs are built concurrently.
+	workers int
 	// metrics is used to collect and report factory metrics.
 	metrics *factoryMetrics
 }
 
-// NewSidecarFactory creates a new sidecar factory.
-func NewSidecarFactory[
+// NewBlobSidecarFactory creates a new blob sidecar factory.
+func NewBlobSidecarFactory[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT, BeaconBlockHeaderT],
 	BeaconBlockBodyT BeaconBlockBody,
 	BeaconBlockHeaderT any,
@@ -56,22 +60,25 @@ func NewSidecarFactory[
 	chainSpec ChainSpec,
 	// todo: calculate from config.
 	kzgPosition uint64,
+	workers int,
 	telemetrySink TelemetrySink,
-) *SidecarFactory[
+) *BlobSidecarFactory[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 ] {
-	return &SidecarFactory[
+	return &BlobSidecarFactory[
 		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	]{
 		chainSpec: chainSpec,
 		// TODO: This should be configurable / modular.
 		kzgPosition: kzgPosition,
+		workers:     proofWorkers(workers),
 		metrics:     newFactoryMetrics(telemetrySink),
 	}
 }
 
-// BuildSidecars builds a sidecar.
-func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
+// BuildSidecars builds a sidecar for every blob of the bundle. The inclusion
+// proofs of the blobs are built concurrently by the workers of the factory.
+func (f *BlobSidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	blk BeaconBlockT,
 	bundle engineprimitives.BlobsBundle,
 ) (*types.BlobSidecars, error) {
@@ -81,6 +88,7 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 		proofs      = bundle.GetProofs()
 		numBlobs    = uint64(len(blobs))
 		sidecars    = make([]*types.BlobSidecar, numBlobs)
+		header      = blk.GetHeader()
 		body        = blk.GetBody()
 		g           = errgroup.Group{}
 	)
@@ -89,20 +97,29 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 	defer f.metrics.measureBuildSidecarsDuration(
 		startTime, math.U64(numBlobs),
 	)
+
+	// Every blob proves the same commitments list in the block body, hence
+	// the block body proof is only built once.
+	bodyProof, err := f.BuildBlockBodyProof(body)
+	if err != nil {
+		return nil, err
+	}
+
+	g.SetLimit(f.workers)
 	for i := range numBlobs {
 		g.Go(func() error {
-			inclusionProof, err := f.BuildKZGInclusionProof(
+			commitmentProof, err := f.BuildCommitmentProof(
 				body, math.U64(i),
 			)
 			if err != nil {
 				return err
 			}
 			sidecars[i] = types.BuildBlobSidecar(
-				math.U64(i), blk.GetHeader(),
+				math.U64(i), header,
 				blobs[i],
 				commitments[i],
 				proofs[i],
-				inclusionProof,
+				append(commitmentProof, bodyProof...),
 			)
 			return nil
 		})
@@ -112,7 +129,7 @@ func (f *SidecarFactory[BeaconBlockT, _, _]) BuildSidecars(
 }
 
 // BuildKZGInclusionProof builds a KZG inclusion proof.
-func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
+func (f *BlobSidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 	body BeaconBlockBodyT,
 	index math.U64,
 ) ([]common.Root, error) {
@@ -138,11 +155,20 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildKZGInclusionProof(
 }
 
 // BuildBlockBodyProof builds a block body proof.
-func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildBlockBodyProof(
+func (f *BlobSidecarFactory[_, BeaconBlockBodyT, _]) BuildBlockBodyProof(
 	body BeaconBlockBodyT,
 ) ([]common.Root, error) {
 	startTime := time.Now()
 	defer f.metrics.measureBuildBlockBodyProofDuration(startTime)
+	return buildBlockBodyProof(body, f.kzgPosition)
+}
+
+// buildBlockBodyProof builds the proof of the field at the given position
+// in the block body.
+func buildBlockBodyProof[BeaconBlockBodyT BeaconBlockBody](
+	body BeaconBlockBodyT,
+	position uint64,
+) ([]common.Root, error) {
 	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](
 		body.GetTopLevelRoots(),
 		body.Length()-1,
@@ -150,12 +176,13 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildBlockBodyProof(
 	if err != nil {
 		return nil, err
 	}
+	defer tree.Release()
 
-	return tree.MerkleProof(f.kzgPosition)
+	return tree.MerkleProof(position)
 }
 
 // BuildCommitmentProof builds a commitment proof.
-func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildCommitmentProof(
+func (f *BlobSidecarFactory[_, BeaconBlockBodyT, _]) BuildCommitmentProof(
 	body BeaconBlockBodyT,
 	index math.U64,
 ) ([]common.Root, error) {
@@ -168,6 +195,16 @@ func (f *SidecarFactory[_, BeaconBlockBodyT, _]) BuildCommitmentProof(
 	if err != nil {
 		return nil, err
 	}
+	defer bodyTree.Release()
 
 	return bodyTree.MerkleProofWithMixin(index.Unwrap())
 }
+
+// proofWorkers returns the number of workers building proofs concurrently,
+// defaulting to one per available CPU.
+func proofWorkers(workers int) int {
+	if workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return workers
+}
@@ -85,3 +85,16 @@ func (fm *factoryMetrics) measureBuildCommitmentProofDuration(
 		startTime,
 	)
 }
+
+// measureBuildDataColumnsDuration measures the duration of building the data
+// column sidecars of a block.
+func (fm *factoryMetrics) measureBuildDataColumnsDuration(
+	startTime time.Time, numBlobs math.U64,
+) {
+	fm.sink.MeasureSince(
+		"beacon_kit.da.blob.factory.build_data_columns_duration",
+		startTime,
+		"num_blobs",
+		numBlobs.Base10(),
+	)
+}
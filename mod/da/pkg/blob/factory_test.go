@@ -37,7 +37,7 @@ func (m *MockSpec) MaxBlobCommitmentsPerBlock() uint64 {
 // TODO: Re-enable once we can easily decouple from core/types.
 // func TestBuildKZGInclusionProof(t *testing.T) {
 // 	chainspec := &MockSpec{}
-// 	factory := da.NewSidecarFactory[da.BeaconBlockBody](
+// 	factory := da.NewBlobSidecarFactory[da.BeaconBlockBody](
 // 		chainspec,
 // 		5,
 // 	)
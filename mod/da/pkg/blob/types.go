@@ -24,6 +24,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -59,6 +62,16 @@ type BeaconBlockHeader interface {
 	GetSlot() math.Slot
 }
 
+// CellProver computes the cells of an extended blob along with their KZG
+// proofs, as in compute_cells_and_kzg_proofs of EIP-7594.
+type CellProver interface {
+	// ComputeCellsAndKZGProofs returns the NumberOfColumns cells of the
+	// extended blob and the KZG proof of each cell.
+	ComputeCellsAndKZGProofs(
+		blob *eip4844.Blob,
+	) ([]types.Cell, []eip4844.KZGProof, error)
+}
+
 //nolint:revive // name conflict
 type BlobVerifier[BlobSidecarsT any] interface {
 	VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
@@ -73,6 +86,16 @@ type Sidecar[BeaconBlockHeaderT any] interface {
 	GetKzgCommitment() eip4844.KZGCommitment
 }
 
+// SidecarFactory builds the sidecars that carry the blobs of a block, so
+// that the encoding of the blob data can change without touching the blob
+// pipeline.
+type SidecarFactory[BeaconBlockT any, SidecarsT any] interface {
+	// BuildSidecars builds the sidecars for a given block and blobs bundle.
+	BuildSidecars(
+		blk BeaconBlockT, bundle engineprimitives.BlobsBundle,
+	) (SidecarsT, error)
+}
+
 type Sidecars[SidecarT any] interface {
 	Len() int
 	Get(index int) SidecarT
@@ -83,6 +106,7 @@ type Sidecars[SidecarT any] interface {
 
 // ChainSpec represents a chain spec.
 type ChainSpec interface {
+	ActiveForkForSlot(slot math.Slot) chain.Fork[math.Epoch]
 	MaxBlobCommitmentsPerBlock() uint64
 }
 
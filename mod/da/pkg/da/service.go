@@ -24,8 +24,11 @@ import (
 	"context"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 )
 
 // The Data Availability service is responsible for verifying and processing
@@ -41,6 +44,8 @@ type Service[
 		AvailabilityStoreT,
 		BlobSidecarsT,
 	]
+	// sampler, if set, is consulted before verifying blobs in full.
+	sampler    AvailabilitySampler
 	dispatcher asynctypes.EventDispatcher
 	logger     log.Logger
 	// subSidecarsReceived is a channel holding SidecarsReceived events.
@@ -58,6 +63,7 @@ func NewService[
 	bp BlobProcessor[
 		AvailabilityStoreT, BlobSidecarsT,
 	],
+	sampler AvailabilitySampler,
 	dispatcher asynctypes.EventDispatcher,
 	logger log.Logger,
 ) *Service[
@@ -68,6 +74,7 @@ func NewService[
 	]{
 		avs:                  avs,
 		bp:                   bp,
+		sampler:              sampler,
 		dispatcher:           dispatcher,
 		logger:               logger,
 		subSidecarsReceived:  make(chan async.Event[BlobSidecarsT]),
@@ -132,8 +139,7 @@ func (s *Service[_, BlobSidecarsT]) handleFinalSidecarsReceived(
 	if err := s.processSidecars(msg.Context(), msg.Data()); err != nil {
 		s.logger.Error(
 			"Failed to process blob sidecars",
-			"error",
-			err,
+			correlation.LogFields(msg.Context(), "error", err)...,
 		)
 	}
 }
@@ -145,11 +151,12 @@ func (s *Service[_, BlobSidecarsT]) handleSidecarsReceived(
 ) {
 	var sidecarsErr error
 	// verify the sidecars.
-	if sidecarsErr = s.verifySidecars(msg.Data()); sidecarsErr != nil {
+	if sidecarsErr = s.verifySidecars(
+		msg.Context(), msg.Data(),
+	); sidecarsErr != nil {
 		s.logger.Error(
 			"Failed to receive blob sidecars",
-			"error",
-			sidecarsErr,
+			correlation.LogFields(msg.Context(), "error", sidecarsErr)...,
 		)
 	}
 
@@ -182,6 +189,7 @@ func (s *Service[_, BlobSidecarsT]) processSidecars(
 
 // VerifyIncomingBlobs receives blobs from the network and processes them.
 func (s *Service[_, BlobSidecarsT]) verifySidecars(
+	ctx context.Context,
 	sidecars BlobSidecarsT,
 ) error {
 	// If there are no blobs to verify, return early.
@@ -191,22 +199,97 @@ func (s *Service[_, BlobSidecarsT]) verifySidecars(
 
 	s.logger.Info(
 		"Received incoming blob sidecars",
+		correlation.LogFields(ctx)...,
 	)
 
+	// Sampling can settle availability without verifying every blob.
+	if accepted, err := s.sampleAvailability(ctx, sidecars); err != nil {
+		return err
+	} else if accepted {
+		return nil
+	}
+
 	// Verify the blobs and ensure they match the local state.
-	if err := s.bp.VerifySidecars(sidecars); err != nil {
+	if err := s.verifyWithinBudget(ctx, sidecars); err != nil {
 		s.logger.Error(
 			"rejecting incoming blob sidecars",
-			"reason", err,
+			correlation.LogFields(ctx, "reason", err)...,
 		)
 		return err
 	}
 
 	s.logger.Info(
 		"Blob sidecars verification succeeded - accepting incoming blob sidecars",
-		"num_blobs",
-		sidecars.Len(),
+		correlation.LogFields(ctx, "num_blobs", sidecars.Len())...,
 	)
 
 	return nil
 }
+
+// sampleAvailability samples the block the sidecars belong to. It returns
+// true if sampling accepted the blobs and an error if it rejected them. If
+// no sampler is set or no verdict could be reached, it returns false so
+// that the blobs are verified in full.
+func (s *Service[_, BlobSidecarsT]) sampleAvailability(
+	ctx context.Context,
+	sidecars BlobSidecarsT,
+) (bool, error) {
+	if s.sampler == nil {
+		return false, nil
+	}
+
+	verdict, err := s.sampler.Sample(ctx, sidecars.BlockRoot())
+	if err != nil {
+		s.logger.Debug(
+			"Availability sampling inconclusive - verifying blobs in full",
+			correlation.LogFields(ctx, "reason", err)...,
+		)
+		return false, nil
+	}
+
+	if !verdict.Accepted {
+		s.logger.Error(
+			"rejecting incoming blob sidecars",
+			correlation.LogFields(
+				ctx,
+				"reason", ErrAvailabilitySamplingFailed,
+				"requested", len(verdict.Requested),
+				"available", len(verdict.Available),
+			)...,
+		)
+		return false, ErrAvailabilitySamplingFailed
+	}
+
+	s.logger.Info(
+		"Availability sampling succeeded - accepting incoming blob sidecars",
+		correlation.LogFields(
+			ctx,
+			"num_blobs", sidecars.Len(),
+			"available", len(verdict.Available),
+		)...,
+	)
+	return true, nil
+}
+
+// verifyWithinBudget runs the blob processor's verification, giving up once
+// the blob verification budget derived from the consensus timeouts expires.
+// Without timeouts on the context it waits for verification to complete.
+func (s *Service[_, BlobSidecarsT]) verifyWithinBudget(
+	ctx context.Context,
+	sidecars BlobSidecarsT,
+) error {
+	ctx, cancel := budget.WithPhase(ctx, budget.BlobVerification)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.bp.VerifySidecars(sidecars)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "blob sidecar verification timed out")
+	}
+}
@@ -20,6 +20,24 @@
 
 package da
 
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/sampling"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// AvailabilitySampler decides whether the blobs of a block are available
+// from a random sample of their columns.
+type AvailabilitySampler interface {
+	// Sample samples the block with the given root. An error means no
+	// verdict could be reached.
+	Sample(
+		ctx context.Context,
+		blockRoot common.Root,
+	) (*sampling.Verdict, error)
+}
+
 // BlobProcessor is the interface for the blobs processor.
 type BlobProcessor[AvailabilityStoreT any, BlobSidecarsT any] interface {
 	// ProcessSidecars processes the blobs and ensures they match the local
@@ -40,4 +58,6 @@ type BlobSidecar interface {
 	Len() int
 	// IsNil checks if the sidecar is nil.
 	IsNil() bool
+	// BlockRoot returns the root of the block the sidecars belong to.
+	BlockRoot() common.Root
 }
@@ -33,6 +33,9 @@ type Config struct {
 	TrustedSetupPath string `mapstructure:"trusted-setup-path"`
 	// Implementation is the KZG implementation to use.
 	Implementation string `mapstructure:"implementation"`
+	// ProofWorkers is the number of blobs whose proofs are built concurrently
+	// when building sidecars. Zero uses one worker per available CPU.
+	ProofWorkers int `mapstructure:"proof-workers"`
 }
 
 // DefaultConfig returns the default configuration.
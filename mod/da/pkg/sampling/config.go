@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sampling
+
+// Config is the configuration for data availability sampling.
+type Config struct {
+	// Enabled turns on sampling of incoming blob sidecars. While no peer
+	// serves samples, verification falls back to checking every blob.
+	Enabled bool `mapstructure:"enabled"`
+	// Samples is the number of columns queried per block.
+	Samples uint64 `mapstructure:"samples"`
+	// Threshold is the minimum number of sampled columns that must be
+	// returned by peers for the block's blobs to be deemed available.
+	Threshold uint64 `mapstructure:"threshold"`
+}
+
+// DefaultConfig returns the default sampling configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:   false,
+		Samples:   defaultSamples,
+		Threshold: defaultSamples,
+	}
+}
+
+// Validate checks that the configuration can be satisfied.
+func (c Config) Validate() error {
+	switch {
+	case c.Samples == 0 || c.Samples > numberOfColumns:
+		return ErrInvalidSampleCount
+	case c.Threshold == 0 || c.Threshold > c.Samples:
+		return ErrInvalidThreshold
+	default:
+		return nil
+	}
+}
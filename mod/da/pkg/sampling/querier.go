@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sampling
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Querier requests columns of a block's extended blobs from the network.
+type Querier interface {
+	// Query asks peers for the given columns of the block with the given
+	// root and returns the indices of the columns that were served.
+	Query(
+		ctx context.Context,
+		blockRoot common.Root,
+		columns []uint64,
+	) ([]uint64, error)
+}
+
+// NoopQuerier is a querier for networks without sampling-capable peers. It
+// always fails with ErrNoSamplingPeers.
+type NoopQuerier struct{}
+
+// NewNoopQuerier returns a new NoopQuerier.
+func NewNoopQuerier() NoopQuerier {
+	return NoopQuerier{}
+}
+
+// Query returns ErrNoSamplingPeers.
+func (NoopQuerier) Query(
+	context.Context, common.Root, []uint64,
+) ([]uint64, error) {
+	return nil, ErrNoSamplingPeers
+}
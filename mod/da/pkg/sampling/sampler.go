@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sampling
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// defaultSamples is the default number of columns sampled per block.
+const defaultSamples = 16
+
+// Verdict is the outcome of sampling a block.
+type Verdict struct {
+	// Requested are the columns that were queried.
+	Requested []uint64
+	// Available are the columns that peers served.
+	Available []uint64
+	// Accepted is true if enough columns were served to deem the block's
+	// blobs available.
+	Accepted bool
+}
+
+// Sampler decides whether a block's blobs are available by querying a
+// random subset of the columns of their extension.
+type Sampler struct {
+	selector  Selector
+	querier   Querier
+	samples   uint64
+	threshold uint64
+}
+
+// NewSampler returns a new Sampler.
+func NewSampler(
+	cfg Config,
+	selector Selector,
+	querier Querier,
+) (*Sampler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Sampler{
+		selector:  selector,
+		querier:   querier,
+		samples:   cfg.Samples,
+		threshold: cfg.Threshold,
+	}, nil
+}
+
+// Sample queries the selected columns of the block with the given root and
+// accepts it if at least the threshold of them was served. An error means
+// no verdict could be reached and the caller should verify the blobs in
+// full.
+func (s *Sampler) Sample(
+	ctx context.Context,
+	blockRoot common.Root,
+) (*Verdict, error) {
+	requested := s.selector.Select(blockRoot, s.samples)
+	served, err := s.querier.Query(ctx, blockRoot, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only count columns that were actually requested, once each.
+	wanted := make(map[uint64]struct{}, len(requested))
+	for _, column := range requested {
+		wanted[column] = struct{}{}
+	}
+	available := make([]uint64, 0, len(served))
+	for _, column := range served {
+		if _, ok := wanted[column]; ok {
+			available = append(available, column)
+			delete(wanted, column)
+		}
+	}
+
+	return &Verdict{
+		Requested: requested,
+		Available: available,
+		Accepted:  uint64(len(available)) >= s.threshold,
+	}, nil
+}
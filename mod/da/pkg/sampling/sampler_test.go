@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sampling_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/sampling"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+// servingQuerier serves the first n requested columns plus any extra ones.
+type servingQuerier struct {
+	n     int
+	extra []uint64
+}
+
+func (q servingQuerier) Query(
+	_ context.Context, _ common.Root, columns []uint64,
+) ([]uint64, error) {
+	served := append([]uint64{}, columns[:min(q.n, len(columns))]...)
+	return append(served, q.extra...), nil
+}
+
+func TestRandomSelector(t *testing.T) {
+	selector := sampling.NewRandomSelector()
+	root := common.Root{1}
+
+	columns := selector.Select(root, 16)
+	require.Len(t, columns, 16)
+	seen := make(map[uint64]struct{})
+	for _, column := range columns {
+		require.Less(t, column, uint64(128))
+		seen[column] = struct{}{}
+	}
+	require.Len(t, seen, 16, "columns must be distinct")
+
+	require.Equal(t, columns, selector.Select(root, 16))
+	require.Len(t, selector.Select(root, 1000), 128)
+}
+
+func TestSamplerThreshold(t *testing.T) {
+	cfg := sampling.Config{Enabled: true, Samples: 8, Threshold: 6}
+	tests := []struct {
+		name     string
+		querier  servingQuerier
+		accepted bool
+	}{
+		{"all served", servingQuerier{n: 8}, true},
+		{"at threshold", servingQuerier{n: 6}, true},
+		{"below threshold", servingQuerier{n: 5}, false},
+		{
+			"duplicates are not counted",
+			servingQuerier{n: 5, extra: []uint64{200, 200}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler, err := sampling.NewSampler(
+				cfg, sampling.NewRandomSelector(), tt.querier,
+			)
+			require.NoError(t, err)
+
+			verdict, err := sampler.Sample(context.Background(), common.Root{})
+			require.NoError(t, err)
+			require.Len(t, verdict.Requested, 8)
+			require.Equal(t, tt.accepted, verdict.Accepted)
+		})
+	}
+}
+
+func TestSamplerNoPeers(t *testing.T) {
+	sampler, err := sampling.NewSampler(
+		sampling.Config{Samples: 4, Threshold: 4},
+		sampling.NewRandomSelector(),
+		sampling.NewNoopQuerier(),
+	)
+	require.NoError(t, err)
+
+	_, err = sampler.Sample(context.Background(), common.Root{})
+	require.ErrorIs(t, err, sampling.ErrNoSamplingPeers)
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, sampling.DefaultConfig().Validate())
+	require.ErrorIs(t,
+		sampling.Config{Samples: 0, Threshold: 0}.Validate(),
+		sampling.ErrInvalidSampleCount,
+	)
+	require.ErrorIs(t,
+		sampling.Config{Samples: 129, Threshold: 1}.Validate(),
+		sampling.ErrInvalidSampleCount,
+	)
+	require.ErrorIs(t,
+		sampling.Config{Samples: 4, Threshold: 5}.Validate(),
+		sampling.ErrInvalidThreshold,
+	)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sampling
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// numberOfColumns is the number of columns an extended blob is split into.
+const numberOfColumns = types.NumberOfColumns
+
+// Selector picks the columns to sample for a block.
+type Selector interface {
+	// Select returns count distinct column indices for the block with the
+	// given root.
+	Select(blockRoot common.Root, count uint64) []uint64
+}
+
+// RandomSelector selects columns pseudo-randomly from a secret seed, so the
+// columns a node samples cannot be predicted by the block proposer. The
+// selection for a given block is stable for the lifetime of the selector.
+type RandomSelector struct {
+	seed [32]byte
+}
+
+// NewRandomSelector returns a RandomSelector with a freshly generated seed.
+func NewRandomSelector() *RandomSelector {
+	s := &RandomSelector{}
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(s.seed[:])
+	return s
+}
+
+// Select returns count distinct column indices for the given block, using a
+// partial Fisher-Yates shuffle driven by hashes of the seed and the root.
+func (s *RandomSelector) Select(
+	blockRoot common.Root,
+	count uint64,
+) []uint64 {
+	count = min(count, numberOfColumns)
+	columns := make([]uint64, numberOfColumns)
+	for i := range columns {
+		columns[i] = uint64(i)
+	}
+	for i := range count {
+		j := i + s.draw(blockRoot, i)%(numberOfColumns-i)
+		columns[i], columns[j] = columns[j], columns[i]
+	}
+	return columns[:count]
+}
+
+// draw returns the pseudo-random value used for the i-th swap.
+func (s *RandomSelector) draw(blockRoot common.Root, i uint64) uint64 {
+	var counter [8]byte
+	binary.LittleEndian.PutUint64(counter[:], i)
+
+	h := sha256.New()
+	h.Write(s.seed[:])
+	h.Write(blockRoot[:])
+	h.Write(counter[:])
+	return binary.LittleEndian.Uint64(h.Sum(nil))
+}
@@ -21,16 +21,33 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/retry"
 	"github.com/sourcegraph/conc/iter"
 )
 
+const (
+	// writeAttempts is the number of times a sidecar write is attempted,
+	// backing off from writeBackoff between the attempts.
+	writeAttempts = 3
+	writeBackoff  = 50 * time.Millisecond
+	// writeRetryBudget bounds the retries of failing sidecar writes, so
+	// that a failing disk is not flooded with them. Every successful write
+	// refunds writeRetryRefill of a retry.
+	writeRetryBudget = 32
+	writeRetryRefill = 0.1
+)
+
 // Store is the default implementation of the AvailabilityStore.
 type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	// IndexDB is a basic database interface.
@@ -39,6 +56,23 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger
 	// chainSpec contains the chain specification.
 	chainSpec common.ChainSpec
+	// writePolicy retries the sidecar writes failing transiently.
+	writePolicy retry.Policy
+
+	// mu protects locations and indexed.
+	mu sync.RWMutex
+	// locations holds the location of every stored blob by the versioned
+	// hash of its commitment.
+	locations map[common.ExecutionHash]blobLocation
+	// indexed reports whether the blobs stored before the node started have
+	// been added to locations.
+	indexed bool
+}
+
+// blobLocation is the slot and the index in its block of a stored blob.
+type blobLocation struct {
+	slot  math.Slot
+	index uint64
 }
 
 // New creates a new instance of the AvailabilityStore.
@@ -51,6 +85,14 @@ func New[BeaconBlockT BeaconBlockBody](
 		IndexDB:   db,
 		chainSpec: chainSpec,
 		logger:    logger,
+		writePolicy: retry.Policy{
+			Initial:     writeBackoff,
+			Multiplier:  2,
+			Jitter:      0.2,
+			MaxAttempts: writeAttempts,
+			Budget:      retry.NewBudget(writeRetryBudget, writeRetryRefill),
+		},
+		locations: make(map[common.ExecutionHash]blobLocation),
 	}
 }
 
@@ -106,14 +148,127 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
+			return s.writePolicy.Do(
+				context.Background(),
+				func(context.Context) error {
+					return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
+				},
+			)
 		},
 	)...); err != nil {
 		return err
 	}
+	s.addLocations(slot, sidecars.Sidecars)
 
 	s.logger.Info("Successfully stored all blob sidecars 🚗",
 		"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
 	)
 	return nil
 }
+
+// GetBlobSidecars returns the sidecars stored for the given slot, ordered by
+// blob index. A slot outside the DA period, or without blobs, has none.
+func (s *Store[_]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	values, err := s.IndexDB.GetByIndex(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make([]*types.BlobSidecar, len(values))
+	for i, bz := range values {
+		sidecars[i] = new(types.BlobSidecar)
+		if err = sidecars[i].UnmarshalSSZ(bz); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to decode blob sidecar at slot %d", slot,
+			)
+		}
+	}
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
+// BlobLocation returns the slot and the index in its block of the stored blob
+// whose KZG commitment has the given versioned hash. It reports false if no
+// stored blob has it.
+func (s *Store[_]) BlobLocation(
+	versionedHash common.ExecutionHash,
+) (math.Slot, uint64, bool) {
+	s.indexStoredBlobs()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	location, ok := s.locations[versionedHash]
+	return location.slot, location.index, ok
+}
+
+// Prune removes the sidecars of the slots in [start, end), along with their
+// versioned hashes.
+func (s *Store[_]) Prune(start, end uint64) error {
+	if err := s.IndexDB.Prune(start, end); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for hash, location := range s.locations {
+		if location.slot.Unwrap() >= start && location.slot.Unwrap() < end {
+			delete(s.locations, hash)
+		}
+	}
+	return nil
+}
+
+// addLocations records the versioned hashes of the given sidecars, stored at
+// the given slot.
+func (s *Store[_]) addLocations(
+	slot math.Slot, sidecars []*types.BlobSidecar,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sidecar := range sidecars {
+		hash := common.ExecutionHash(
+			sidecar.KzgCommitment.ToVersionedHash(),
+		)
+		s.locations[hash] = blobLocation{
+			slot:  slot,
+			index: sidecar.Index,
+		}
+	}
+}
+
+// indexStoredBlobs records the versioned hashes of the sidecars stored before
+// the node started, once. It is retried on the next lookup if the sidecars
+// cannot be read.
+func (s *Store[_]) indexStoredBlobs() {
+	s.mu.RLock()
+	indexed := s.indexed
+	s.mu.RUnlock()
+	if indexed {
+		return
+	}
+
+	slots, err := s.IndexDB.Indexes()
+	if err != nil {
+		s.logger.Error("Failed to list stored blob sidecars", "error", err)
+		return
+	}
+	for _, slot := range slots {
+		sidecars, gErr := s.GetBlobSidecars(math.Slot(slot))
+		if gErr != nil {
+			s.logger.Error(
+				"Failed to index stored blob sidecars",
+				"slot", slot, "error", gErr,
+			)
+			return
+		}
+		s.addLocations(math.Slot(slot), sidecars.Sidecars)
+	}
+
+	s.mu.Lock()
+	s.indexed = true
+	s.mu.Unlock()
+}
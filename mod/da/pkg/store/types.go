@@ -38,6 +38,8 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	GetByIndex(index uint64) ([][]byte, error)
+	Indexes() ([]uint64, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
 	Prune(start uint64, end uint64) error
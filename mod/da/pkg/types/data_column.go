@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// NumberOfColumns is the number of columns an extended blob is split
+	// into.
+	NumberOfColumns = 128
+	// BytesPerCell is the size of a cell, made of 64 field elements.
+	BytesPerCell = 2048
+	// maxBlobCommitmentsPerBlock is the maximum number of blob commitments
+	// in a block body.
+	maxBlobCommitmentsPerBlock = 16
+	// commitmentsInclusionProofDepth is the depth of the inclusion proof of
+	// the KZG commitments list in the block body.
+	commitmentsInclusionProofDepth = 3
+)
+
+// Cell is the part of an extended blob that falls into a single column.
+type Cell [BytesPerCell]byte
+
+// SizeSSZ returns the size of the Cell object in SSZ encoding.
+func (c *Cell) SizeSSZ() uint32 {
+	return BytesPerCell
+}
+
+// DefineSSZ defines the SSZ encoding for the Cell object. The SSZ library
+// has no fixed size byte array of the length of a cell, so the cell is
+// encoded through a slice of its bytes.
+func (c *Cell) DefineSSZ(codec *ssz.Codec) {
+	bz := c[:]
+	ssz.DefineCheckedStaticBytes(codec, &bz, BytesPerCell)
+}
+
+// DataColumnSidecar as per the EIP-7594 specification, carrying one column
+// of the extended blobs of a block:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/_features/eip7594/das-core.md#datacolumnsidecar
+//
+//nolint:lll
+type DataColumnSidecar struct {
+	// Index is the index of the column in the extended blobs.
+	Index uint64
+	// Column holds the cell of every blob of the block at this column.
+	Column []*Cell
+	// KzgCommitments are the KZG commitments of the blobs of the block.
+	KzgCommitments []eip4844.KZGCommitment
+	// KzgProofs are the KZG proofs of the cells of the column.
+	KzgProofs []eip4844.KZGProof
+	// BeaconBlockHeader is the header of the block the column belongs to.
+	BeaconBlockHeader *types.BeaconBlockHeader
+	// KzgCommitmentsInclusionProof is the inclusion proof of the KZG
+	// commitments list in the beacon block body.
+	KzgCommitmentsInclusionProof []common.Root
+}
+
+// BuildDataColumnSidecar creates a data column sidecar from the cells and
+// proofs of the given column.
+func BuildDataColumnSidecar[
+	BeaconBlockHeaderT any,
+](
+	index math.U64,
+	header BeaconBlockHeaderT,
+	column []*Cell,
+	commitments []eip4844.KZGCommitment,
+	proofs []eip4844.KZGProof,
+	inclusionProof []common.Root,
+) *DataColumnSidecar {
+	return &DataColumnSidecar{
+		Index:                        index.Unwrap(),
+		Column:                       column,
+		KzgCommitments:               commitments,
+		KzgProofs:                    proofs,
+		BeaconBlockHeader:            any(header).(*types.BeaconBlockHeader),
+		KzgCommitmentsInclusionProof: inclusionProof,
+	}
+}
+
+func (d *DataColumnSidecar) GetIndex() uint64 {
+	return d.Index
+}
+
+func (d *DataColumnSidecar) GetColumn() []*Cell {
+	return d.Column
+}
+
+func (d *DataColumnSidecar) GetKzgCommitments() []eip4844.KZGCommitment {
+	return d.KzgCommitments
+}
+
+func (d *DataColumnSidecar) GetKzgProofs() []eip4844.KZGProof {
+	return d.KzgProofs
+}
+
+func (d *DataColumnSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
+	return d.BeaconBlockHeader
+}
+
+// DefineSSZ defines the SSZ encoding for the DataColumnSidecar object.
+func (d *DataColumnSidecar) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &d.Index)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &d.Column, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineStaticObject(codec, &d.BeaconBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &d.KzgCommitmentsInclusionProof,
+		commitmentsInclusionProofDepth,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &d.Column, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &d.KzgProofs, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// SizeSSZ returns the size of the DataColumnSidecar object in SSZ encoding.
+func (d *DataColumnSidecar) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 8 + // Index
+		4 + // Column offset
+		4 + // KzgCommitments offset
+		4 + // KzgProofs offset
+		112 + // BeaconBlockHeader
+		commitmentsInclusionProofDepth*32 // KzgCommitmentsInclusionProof
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(d.Column)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgCommitments)
+	size += ssz.SizeSliceOfStaticBytes(d.KzgProofs)
+	return size
+}
+
+// MarshalSSZ marshals the DataColumnSidecar object to SSZ format.
+func (d *DataColumnSidecar) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DataColumnSidecar object from SSZ format.
+func (d *DataColumnSidecar) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the DataColumnSidecar
+// object.
+func (d *DataColumnSidecar) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// DataColumnSidecars are the data column sidecars of a block.
+type DataColumnSidecars struct {
+	// Sidecars holds a sidecar for every column of the extended blobs.
+	Sidecars []*DataColumnSidecar
+}
+
+func (ds *DataColumnSidecars) Len() int {
+	return len(ds.Sidecars)
+}
+
+func (ds *DataColumnSidecars) GetSidecars() []*DataColumnSidecar {
+	return ds.Sidecars
+}
+
+func (ds *DataColumnSidecars) Get(index int) *DataColumnSidecar {
+	return ds.Sidecars[index]
+}
+
+// IsNil checks to see if the sidecars are nil.
+func (ds *DataColumnSidecars) IsNil() bool {
+	return ds == nil || ds.Sidecars == nil
+}
+
+// ValidateBlockRoots checks to make sure that all columns are from the same
+// block.
+func (ds *DataColumnSidecars) ValidateBlockRoots() error {
+	if sc := ds.Sidecars; len(sc) > 1 {
+		firstHtr := sc[0].BeaconBlockHeader.HashTreeRoot()
+		for i := 1; i < len(sc); i++ {
+			if firstHtr != sc[i].BeaconBlockHeader.HashTreeRoot() {
+				return ErrSidecarContainsDifferingBlockRoots
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateIndices checks to make sure that the columns are ordered by
+// strictly increasing index and within the number of columns.
+func (ds *DataColumnSidecars) ValidateIndices() error {
+	for i, sc := range ds.Sidecars {
+		if sc.Index >= NumberOfColumns {
+			return ErrColumnIndexOutOfRange
+		}
+		if i > 0 && sc.Index <= ds.Sidecars[i-1].Index {
+			return ErrSidecarIndicesOutOfOrder
+		}
+	}
+	return nil
+}
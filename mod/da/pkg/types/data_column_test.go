@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataColumnSidecarMarshalling(t *testing.T) {
+	sidecar := types.BuildDataColumnSidecar(
+		math.U64(3),
+		&ctypes.BeaconBlockHeader{Slot: 7},
+		[]*types.Cell{{0x01}, {0x02}},
+		[]eip4844.KZGCommitment{{0x03}, {0x04}},
+		[]eip4844.KZGProof{{0x05}, {0x06}},
+		[]common.Root{{0x07}, {0x08}, {0x09}},
+	)
+
+	marshalled, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := &types.DataColumnSidecar{}
+	require.NoError(t, unmarshalled.UnmarshalSSZ(marshalled))
+	require.Equal(t, sidecar, unmarshalled)
+	require.Equal(t, sidecar.HashTreeRoot(), unmarshalled.HashTreeRoot())
+}
+
+func TestDataColumnSidecarsValidateIndices(t *testing.T) {
+	build := func(indices ...uint64) *types.DataColumnSidecars {
+		sidecars := &types.DataColumnSidecars{}
+		for _, index := range indices {
+			sidecars.Sidecars = append(
+				sidecars.Sidecars, &types.DataColumnSidecar{Index: index},
+			)
+		}
+		return sidecars
+	}
+
+	require.NoError(t, build(0, 1, 127).ValidateIndices())
+	require.ErrorIs(
+		t, build(1, 1).ValidateIndices(), types.ErrSidecarIndicesOutOfOrder,
+	)
+	require.ErrorIs(
+		t, build(0, types.NumberOfColumns).ValidateIndices(),
+		types.ErrColumnIndexOutOfRange,
+	)
+}
@@ -28,6 +28,11 @@ var (
 	ErrSidecarContainsDifferingBlockRoots = errors.New(
 		"sidecar contains blobs with differing block roots")
 
+	// ErrSidecarIndicesOutOfOrder is returned when the sidecars are not sorted
+	// by strictly increasing blob index.
+	ErrSidecarIndicesOutOfOrder = errors.New(
+		"sidecar indices are not strictly increasing")
+
 	// ErrAttemptedToVerifyNilSidecar is returned when
 	// an attempt is made to store a nil sidecar.
 	ErrAttemptedToVerifyNilSidecar = errors.New(
@@ -38,4 +43,29 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrSidecarsTooShort is returned when an encoded set of sidecars is
+	// shorter than its fixed-size part.
+	ErrSidecarsTooShort = errors.New("encoded sidecars are too short")
+
+	// ErrInvalidSidecarsOffset is returned when the offset of the sidecars
+	// list does not immediately follow the fixed-size part.
+	ErrInvalidSidecarsOffset = errors.New("invalid sidecars offset")
+
+	// ErrInvalidSidecarSize is returned when an encoded sidecar does not
+	// have the size of a BlobSidecar.
+	ErrInvalidSidecarSize = errors.New("invalid encoded sidecar size")
+
+	// ErrTooManySidecars is returned when an encoded set of sidecars holds
+	// more sidecars than a block may carry.
+	ErrTooManySidecars = errors.New("too many sidecars")
+
+	// ErrColumnIndexOutOfRange is returned when a data column sidecar has
+	// an index beyond the number of columns of the extended blobs.
+	ErrColumnIndexOutOfRange = errors.New("column index out of range")
+
+	// ErrInvalidInclusionProofDepth is returned when a sidecar carries an
+	// inclusion proof of the wrong depth.
+	ErrInvalidInclusionProofDepth = errors.New(
+		"invalid KZG commitment inclusion proof depth")
 )
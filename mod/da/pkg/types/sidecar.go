@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -29,6 +30,10 @@ import (
 	"github.com/karalabe/ssz"
 )
 
+// inclusionProofDepth is the depth of the KZG commitment inclusion proof of
+// a sidecar.
+const inclusionProofDepth = 8
+
 // BlobSidecar as per the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/p2p-interface.md?ref=bankless.ghost.io#blobsidecar
 //
@@ -94,6 +99,10 @@ func (b *BlobSidecar) GetBlob() eip4844.Blob {
 	return b.Blob
 }
 
+func (b *BlobSidecar) GetIndex() uint64 {
+	return b.Index
+}
+
 func (b *BlobSidecar) GetKzgProof() eip4844.KZGProof {
 	return b.KzgProof
 }
@@ -106,6 +115,10 @@ func (b *BlobSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
 	return b.BeaconBlockHeader
 }
 
+func (b *BlobSidecar) GetInclusionProof() []common.Root {
+	return b.InclusionProof
+}
+
 // DefineSSZ defines the SSZ encoding for the BlobSidecar object.
 func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &b.Index)
@@ -113,8 +126,9 @@ func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineStaticBytes(codec, &b.KzgCommitment)
 	ssz.DefineStaticBytes(codec, &b.KzgProof)
 	ssz.DefineStaticObject(codec, &b.BeaconBlockHeader)
-	//nolint:mnd // depth of 8
-	ssz.DefineCheckedArrayOfStaticBytes(codec, &b.InclusionProof, 8)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &b.InclusionProof, inclusionProofDepth,
+	)
 }
 
 // SizeSSZ returns the size of the BlobSidecar object in SSZ encoding.
@@ -124,7 +138,7 @@ func (b *BlobSidecar) SizeSSZ() uint32 {
 		48 + // KzgCommitment
 		48 + // KzgProof
 		112 + // BeaconBlockHeader
-		8*32 // InclusionProof
+		inclusionProofDepth*32 // InclusionProof
 }
 
 // MarshalSSZ marshals the BlobSidecar object to SSZ format.
@@ -135,7 +149,29 @@ func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
 
 // UnmarshalSSZ unmarshals the BlobSidecar object from SSZ format.
 func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, b)
+	//#nosec:G701 // the size of a sidecar fits in an int.
+	if size := int(b.SizeSSZ()); len(buf) != size {
+		return errors.Wrapf(
+			ErrInvalidSidecarSize, "expected %d bytes, got %d",
+			size, len(buf),
+		)
+	}
+	if err := ssz.DecodeFromBytes(buf, b); err != nil {
+		return err
+	}
+	return b.validateDecoded()
+}
+
+// validateDecoded checks the fields of a decoded sidecar that the SSZ
+// schema alone does not pin down.
+func (b *BlobSidecar) validateDecoded() error {
+	if len(b.InclusionProof) != inclusionProofDepth {
+		return errors.Wrapf(
+			ErrInvalidInclusionProofDepth, "expected %d, got %d",
+			inclusionProofDepth, len(b.InclusionProof),
+		)
+	}
+	return nil
 }
 
 // MarshalSSZTo marshals the BlobSidecar object to the provided buffer in SSZ
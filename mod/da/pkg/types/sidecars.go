@@ -22,11 +22,18 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/karalabe/ssz"
 	"github.com/sourcegraph/conc/iter"
 )
 
+// maxBlobSidecars is the maximum number of sidecars that can be carried
+// alongside a block.
+const maxBlobSidecars = 6
+
 // BlobSidecars is a slice of blob side cars to be included in the block.
 type BlobSidecars struct {
 	// Sidecars is a slice of blob side cars to be included in the block.
@@ -50,6 +57,15 @@ func (bs *BlobSidecars) Get(index int) *BlobSidecar {
 	return bs.Sidecars[index]
 }
 
+// BlockRoot returns the root of the block the sidecars belong to, or the
+// zero root if there are none. It assumes ValidateBlockRoots has passed.
+func (bs *BlobSidecars) BlockRoot() common.Root {
+	if len(bs.Sidecars) == 0 {
+		return common.Root{}
+	}
+	return bs.Sidecars[0].BeaconBlockHeader.HashTreeRoot()
+}
+
 // IsNil checks to see if blobs are nil.
 func (bs *BlobSidecars) IsNil() bool {
 	return bs == nil || bs.Sidecars == nil
@@ -94,8 +110,8 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 
 // DefineSSZ defines the SSZ encoding for the BlobSidecars object.
 func (bs *BlobSidecars) DefineSSZ(codec *ssz.Codec) {
-	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, 6)
-	ssz.DefineSliceOfStaticObjectsContent(codec, &bs.Sidecars, 6)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &bs.Sidecars, maxBlobSidecars)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &bs.Sidecars, maxBlobSidecars)
 }
 
 // SizeSSZ returns the size of the BlobSidecars object in SSZ encoding.
@@ -118,7 +134,69 @@ func (bs *BlobSidecars) MarshalSSZTo(buf []byte) ([]byte, error) {
 	return buf, ssz.EncodeToBytes(buf, bs)
 }
 
-// UnmarshalSSZ unmarshals the BlobSidecars object from SSZ format.
+// UnmarshalSSZ unmarshals the BlobSidecars object from SSZ format. The
+// layout of the buffer is checked before decoding, so that malformed
+// sidecars are rejected before any of them is allocated.
 func (bs *BlobSidecars) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, bs)
+	if err := validateSidecarsEncoding(buf); err != nil {
+		return err
+	}
+	if err := ssz.DecodeFromBytes(buf, bs); err != nil {
+		return err
+	}
+	for i, sc := range bs.Sidecars {
+		if err := sc.validateDecoded(); err != nil {
+			return errors.Wrapf(err, "sidecar %d", i)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZReuse unmarshals the BlobSidecars object from SSZ format into
+// the sidecars bs already holds, so that decoding into a pooled object does
+// not allocate new blobs. bs must not be shared while it is decoded into.
+func (bs *BlobSidecars) UnmarshalSSZReuse(buf []byte) error {
+	// The decoder only reuses the sidecars within the capacity of the slice,
+	// so grow it to the maximum up front, keeping the ones allocated before.
+	if cap(bs.Sidecars) < maxBlobSidecars {
+		sidecars := make([]*BlobSidecar, maxBlobSidecars)
+		copy(sidecars, bs.Sidecars[:cap(bs.Sidecars)])
+		bs.Sidecars = sidecars[:len(bs.Sidecars)]
+	}
+	return bs.UnmarshalSSZ(buf)
+}
+
+// validateSidecarsEncoding checks that buf is laid out as the SSZ encoding of
+// at most maxBlobSidecars sidecars.
+func validateSidecarsEncoding(buf []byte) error {
+	const offsetSize = 4
+	if len(buf) < offsetSize {
+		return errors.Wrapf(
+			ErrSidecarsTooShort, "expected at least %d bytes, got %d",
+			offsetSize, len(buf),
+		)
+	}
+	if offset := binary.LittleEndian.Uint32(buf); offset != offsetSize {
+		return errors.Wrapf(
+			ErrInvalidSidecarsOffset, "expected %d, got %d",
+			offsetSize, offset,
+		)
+	}
+
+	//#nosec:G701 // the size of a sidecar fits in an int.
+	size := int((&BlobSidecar{}).SizeSSZ())
+	body := len(buf) - offsetSize
+	if body%size != 0 {
+		return errors.Wrapf(
+			ErrInvalidSidecarSize,
+			"%d bytes is not a multiple of the sidecar size %d", body, size,
+		)
+	}
+	if count := body / size; count > maxBlobSidecars {
+		return errors.Wrapf(
+			ErrTooManySidecars, "expected at most %d, got %d",
+			maxBlobSidecars, count,
+		)
+	}
+	return nil
 }
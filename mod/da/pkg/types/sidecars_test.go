@@ -143,3 +143,101 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestBlobSidecarsUnmarshalValidation(t *testing.T) {
+	inclusionProof := make([]common.Root, 8)
+	sidecar := types.BuildBlobSidecar(
+		math.U64(0),
+		&ctypes.BeaconBlockHeader{},
+		&eip4844.Blob{},
+		eip4844.KZGCommitment{},
+		eip4844.KZGProof{},
+		inclusionProof,
+	)
+	sidecarBz, err := sidecar.MarshalSSZ()
+	require.NoError(t, err)
+
+	// Encode the list by hand, as the encoder refuses to exceed the limit.
+	encodeSidecars := func(n int) []byte {
+		bz := []byte{4, 0, 0, 0}
+		for range n {
+			bz = append(bz, sidecarBz...)
+		}
+		return bz
+	}
+
+	valid := encodeSidecars(2)
+	tooMany := encodeSidecars(7)
+
+	tests := []struct {
+		name     string
+		buf      []byte
+		expected error
+	}{
+		{name: "Valid", buf: valid},
+		{
+			name:     "Too Short",
+			buf:      valid[:2],
+			expected: types.ErrSidecarsTooShort,
+		},
+		{
+			name:     "Invalid Offset",
+			buf:      append([]byte{8, 0, 0, 0}, valid[4:]...),
+			expected: types.ErrInvalidSidecarsOffset,
+		},
+		{
+			name:     "Truncated Sidecar",
+			buf:      valid[:len(valid)-1],
+			expected: types.ErrInvalidSidecarSize,
+		},
+		{
+			name:     "Too Many Sidecars",
+			buf:      tooMany,
+			expected: types.ErrTooManySidecars,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&types.BlobSidecars{}).UnmarshalSSZ(tt.buf)
+			if tt.expected == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestBlobSidecarsUnmarshalSSZReuse(t *testing.T) {
+	encode := func(indices ...uint64) []byte {
+		sidecars := &types.BlobSidecars{}
+		for _, index := range indices {
+			sidecar := types.BuildBlobSidecar(
+				math.U64(index),
+				&ctypes.BeaconBlockHeader{Slot: 1},
+				&eip4844.Blob{byte(index)},
+				eip4844.KZGCommitment{},
+				eip4844.KZGProof{},
+				make([]common.Root, 8),
+			)
+			sidecars.Sidecars = append(sidecars.Sidecars, sidecar)
+		}
+		bz, err := sidecars.MarshalSSZ()
+		require.NoError(t, err)
+		return bz
+	}
+
+	reused := &types.BlobSidecars{}
+	require.NoError(t, reused.UnmarshalSSZReuse(encode(0, 1)))
+	first := reused.Get(0)
+
+	// Decoding more sidecars keeps the ones allocated before.
+	require.NoError(t, reused.UnmarshalSSZReuse(encode(2, 3, 4)))
+	require.Equal(t, 3, reused.Len())
+	require.Same(t, first, reused.Get(0))
+
+	expected := &types.BlobSidecars{}
+	require.NoError(t, expected.UnmarshalSSZ(encode(2, 3, 4)))
+	require.Equal(t, expected.GetSidecars(), reused.GetSidecars())
+}
@@ -61,6 +61,10 @@ type PayloadAttributes[
 	// to the block currently being processed. This field was added for
 	// EIP-4788.
 	ParentBeaconBlockRoot common.Root `json:"parentBeaconBlockRoot"`
+	// NoTxPool requests a payload built without the transactions of the
+	// mempool of the execution client. It is only honored by execution
+	// clients that support the noTxPool attribute.
+	NoTxPool bool `json:"noTxPool,omitempty"`
 }
 
 // NewPayloadAttributes creates a new PayloadAttributes.
@@ -111,6 +115,12 @@ func (p *PayloadAttributes[WithdrawalT]) New(
 	return p, err
 }
 
+// SetNoTxPool sets whether the payload is built without the transactions of
+// the mempool.
+func (p *PayloadAttributes[WithdrawalT]) SetNoTxPool(noTxPool bool) {
+	p.NoTxPool = noTxPool
+}
+
 // IsNil returns true if the PayloadAttributes is nil.
 func (p *PayloadAttributes[WithdrawalT]) IsNil() bool {
 	return p == nil
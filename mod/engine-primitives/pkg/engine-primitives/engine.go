@@ -102,5 +102,17 @@ type PayloadStatusV1 struct {
 	ValidationError *string `json:"validationError"`
 }
 
+// ExecutionPayloadBodyV1 represents the body of an execution payload as per
+// the EngineAPI Specification:
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/shanghai.md#executionpayloadbodyv1
+//
+//nolint:lll // link.
+type ExecutionPayloadBodyV1 struct {
+	// Transactions are the transactions of the payload.
+	Transactions []bytes.Bytes `json:"transactions"`
+	// Withdrawals are the withdrawals of the payload.
+	Withdrawals []*Withdrawal `json:"withdrawals"`
+}
+
 // PayloadID is an identifier for the payload build process.
 type PayloadID = bytes.B8
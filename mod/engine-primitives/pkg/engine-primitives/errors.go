@@ -52,4 +52,8 @@ var (
 	ErrPayloadBlockHashMismatch = errors.New(
 		"block hash in payload does not match assembled block",
 	)
+
+	// ErrTooManyExecutionRequests indicates that a payload carries more
+	// execution requests of a type than allowed.
+	ErrTooManyExecutionRequests = errors.New("too many execution requests")
 )
@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// Request types as defined by EIP-7685, in the order they must appear in the
+// requests list sent to the execution client.
+const (
+	DepositRequestType       byte = 0x00
+	WithdrawalRequestType    byte = 0x01
+	ConsolidationRequestType byte = 0x02
+)
+
+const (
+	// DepositRequestSize is the size of the DepositRequest in bytes.
+	DepositRequestSize = 192
+	// WithdrawalRequestSize is the size of the WithdrawalRequest in bytes.
+	WithdrawalRequestSize = 76
+	// ConsolidationRequestSize is the size of the ConsolidationRequest in
+	// bytes.
+	ConsolidationRequestSize = 116
+
+	// MaxDepositRequestsPerPayload is the maximum number of deposit requests
+	// in a single payload.
+	MaxDepositRequestsPerPayload = 8192
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in a single payload.
+	MaxWithdrawalRequestsPerPayload = 16
+	// MaxConsolidationRequestsPerPayload is the maximum number of
+	// consolidation requests in a single payload.
+	MaxConsolidationRequestsPerPayload = 2
+)
+
+var (
+	_ ssz.StaticObject  = (*DepositRequest)(nil)
+	_ ssz.StaticObject  = (*WithdrawalRequest)(nil)
+	_ ssz.StaticObject  = (*ConsolidationRequest)(nil)
+	_ ssz.DynamicObject = (*ExecutionRequests)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                               DepositRequest                               */
+/* -------------------------------------------------------------------------- */
+
+// DepositRequest is a deposit made on the execution layer, as per EIP-6110.
+type DepositRequest struct {
+	// Pubkey is the BLS public key of the validator.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	// WithdrawalCredentials are the credentials used for withdrawals.
+	WithdrawalCredentials common.Bytes32 `json:"withdrawalCredentials"`
+	// Amount is the amount of Gwei deposited.
+	Amount math.Gwei `json:"amount"`
+	// Signature is the signature over the deposit message.
+	Signature crypto.BLSSignature `json:"signature"`
+	// Index is the index of the deposit in the deposit contract.
+	Index math.U64 `json:"index"`
+}
+
+// SizeSSZ returns the size of the DepositRequest in bytes when SSZ encoded.
+func (*DepositRequest) SizeSSZ() uint32 {
+	return DepositRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the DepositRequest.
+func (d *DepositRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.WithdrawalCredentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+	ssz.DefineUint64(c, &d.Index)
+}
+
+// HashTreeRoot returns the hash tree root of the DepositRequest.
+func (d *DepositRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+// MarshalSSZ marshals the DepositRequest to SSZ format.
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DepositRequest from SSZ format.
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              WithdrawalRequest                             */
+/* -------------------------------------------------------------------------- */
+
+// WithdrawalRequest is a withdrawal triggered from the execution layer, as
+// per EIP-7002.
+type WithdrawalRequest struct {
+	// SourceAddress is the address that triggered the withdrawal.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// ValidatorPubkey is the public key of the withdrawing validator.
+	ValidatorPubkey crypto.BLSPubkey `json:"validatorPubkey"`
+	// Amount is the amount of Gwei to withdraw, zero for a full exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest in bytes when SSZ encoded.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest.
+func (w *WithdrawalRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &w.SourceAddress)
+	ssz.DefineStaticBytes(c, &w.ValidatorPubkey)
+	ssz.DefineUint64(c, &w.Amount)
+}
+
+// HashTreeRoot returns the hash tree root of the WithdrawalRequest.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, w.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the WithdrawalRequest from SSZ format.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            ConsolidationRequest                            */
+/* -------------------------------------------------------------------------- */
+
+// ConsolidationRequest is a request to consolidate the balance of one
+// validator into another, as per EIP-7251.
+type ConsolidationRequest struct {
+	// SourceAddress is the address that triggered the consolidation.
+	SourceAddress common.ExecutionAddress `json:"sourceAddress"`
+	// SourcePubkey is the public key of the validator being consolidated.
+	SourcePubkey crypto.BLSPubkey `json:"sourcePubkey"`
+	// TargetPubkey is the public key of the validator consolidated into.
+	TargetPubkey crypto.BLSPubkey `json:"targetPubkey"`
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest in bytes when SSZ
+// encoded.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest.
+func (c *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &c.SourceAddress)
+	ssz.DefineStaticBytes(codec, &c.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &c.TargetPubkey)
+}
+
+// HashTreeRoot returns the hash tree root of the ConsolidationRequest.
+func (c *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the ConsolidationRequest to SSZ format.
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, c.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the ConsolidationRequest from SSZ format.
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              ExecutionRequests                             */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionRequests is the envelope of requests triggered on the execution
+// layer and included in the beacon block body, as per EIP-7685.
+type ExecutionRequests struct {
+	// Deposits are the deposit requests.
+	Deposits []*DepositRequest `json:"deposits"`
+	// Withdrawals are the withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations are the consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+// IsEmpty returns true if there are no requests of any type.
+func (r *ExecutionRequests) IsEmpty() bool {
+	return r == nil || len(r.Deposits)+len(r.Withdrawals)+
+		len(r.Consolidations) == 0
+}
+
+// SizeSSZ returns the size of the ExecutionRequests in bytes when SSZ
+// encoded.
+func (r *ExecutionRequests) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(r.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(r.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(r.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the ExecutionRequests.
+func (r *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Deposits, MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Withdrawals, MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Consolidations, MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// HashTreeRoot returns the hash tree root of the ExecutionRequests.
+func (r *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// MarshalSSZ marshals the ExecutionRequests to SSZ format.
+func (r *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, r.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the ExecutionRequests from SSZ format.
+func (r *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// Validate checks the requests against the per-payload limits.
+func (r *ExecutionRequests) Validate() error {
+	switch {
+	case r == nil:
+		return nil
+	case len(r.Deposits) > MaxDepositRequestsPerPayload:
+		return errors.Wrapf(
+			ErrTooManyExecutionRequests, "deposits: %d", len(r.Deposits),
+		)
+	case len(r.Withdrawals) > MaxWithdrawalRequestsPerPayload:
+		return errors.Wrapf(
+			ErrTooManyExecutionRequests,
+			"withdrawals: %d", len(r.Withdrawals),
+		)
+	case len(r.Consolidations) > MaxConsolidationRequestsPerPayload:
+		return errors.Wrapf(
+			ErrTooManyExecutionRequests,
+			"consolidations: %d", len(r.Consolidations),
+		)
+	default:
+		return nil
+	}
+}
+
+// EncodeForEngine encodes the requests as the list expected by
+// engine_newPayloadV4: one entry per non-empty request type, in ascending
+// type order, each being the type byte followed by the SSZ encoding of the
+// requests of that type.
+func (r *ExecutionRequests) EncodeForEngine() ([]bytes.Bytes, error) {
+	if r == nil {
+		return []bytes.Bytes{}, nil
+	}
+
+	var (
+		encoded = make([]bytes.Bytes, 0, 3)
+		err     error
+	)
+	if encoded, err = appendRequests(
+		encoded, DepositRequestType, r.Deposits,
+	); err != nil {
+		return nil, err
+	}
+	if encoded, err = appendRequests(
+		encoded, WithdrawalRequestType, r.Withdrawals,
+	); err != nil {
+		return nil, err
+	}
+	return appendRequests(
+		encoded, ConsolidationRequestType, r.Consolidations,
+	)
+}
+
+// appendRequests appends the typed encoding of the requests to encoded,
+// skipping empty request lists.
+func appendRequests[RequestT interface{ MarshalSSZ() ([]byte, error) }](
+	encoded []bytes.Bytes,
+	requestType byte,
+	requests []RequestT,
+) ([]bytes.Bytes, error) {
+	if len(requests) == 0 {
+		return encoded, nil
+	}
+	buf := []byte{requestType}
+	for _, req := range requests {
+		bz, err := req.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, bz...)
+	}
+	return append(encoded, buf), nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engineprimitives_test
+
+import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequestsSSZRoundTrip(t *testing.T) {
+	reqs := &engineprimitives.ExecutionRequests{
+		Deposits: []*engineprimitives.DepositRequest{
+			{Pubkey: crypto.BLSPubkey{0x01}, Amount: 32e9, Index: 7},
+		},
+		Withdrawals: []*engineprimitives.WithdrawalRequest{
+			{ValidatorPubkey: crypto.BLSPubkey{0x02}, Amount: 1e9},
+		},
+		Consolidations: []*engineprimitives.ConsolidationRequest{},
+	}
+
+	bz, err := reqs.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(reqs.SizeSSZ(false)))
+
+	decoded := new(engineprimitives.ExecutionRequests)
+	require.NoError(t, decoded.UnmarshalSSZ(bz))
+	require.Equal(t, reqs.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t, reqs.Deposits, decoded.Deposits)
+	require.Equal(t, reqs.Withdrawals, decoded.Withdrawals)
+	require.Empty(t, decoded.Consolidations)
+}
+
+func TestExecutionRequestsEncodeForEngine(t *testing.T) {
+	reqs := &engineprimitives.ExecutionRequests{
+		Consolidations: []*engineprimitives.ConsolidationRequest{
+			{SourcePubkey: crypto.BLSPubkey{0x03}},
+		},
+		Deposits: []*engineprimitives.DepositRequest{{}, {}},
+	}
+
+	encoded, err := reqs.EncodeForEngine()
+	require.NoError(t, err)
+
+	// Empty withdrawals are skipped and the types are in ascending order.
+	require.Len(t, encoded, 2)
+	require.Equal(t, engineprimitives.DepositRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+2*engineprimitives.DepositRequestSize)
+	require.Equal(
+		t, engineprimitives.ConsolidationRequestType, encoded[1][0],
+	)
+	require.Len(t, encoded[1], 1+engineprimitives.ConsolidationRequestSize)
+
+	var nilReqs *engineprimitives.ExecutionRequests
+	encoded, err = nilReqs.EncodeForEngine()
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+	require.True(t, nilReqs.IsEmpty())
+}
+
+func TestExecutionRequestsValidate(t *testing.T) {
+	reqs := &engineprimitives.ExecutionRequests{
+		Consolidations: make(
+			[]*engineprimitives.ConsolidationRequest,
+			engineprimitives.MaxConsolidationRequestsPerPayload+1,
+		),
+	}
+	require.ErrorIs(
+		t, reqs.Validate(), engineprimitives.ErrTooManyExecutionRequests,
+	)
+
+	reqs.Consolidations = reqs.Consolidations[:1]
+	require.NoError(t, reqs.Validate())
+}
@@ -93,6 +93,12 @@ var (
 		"nil payload status received from execution client",
 	)
 
+	// ErrUnexpectedPayloadBodies is returned when the execution client does
+	// not return one payload body per requested block.
+	ErrUnexpectedPayloadBodies = errors.New(
+		"unexpected number of payload bodies received from execution client",
+	)
+
 	// ErrEngineAPITimeout is returned when the engine API call times out.
 	ErrEngineAPITimeout = errors.New(
 		"engine API call timed out",
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package errors
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// InvalidPayloadError is returned when the execution client reports a
+// payload as INVALID. It carries the latest valid ancestor the execution
+// client reported, so that callers can reason about which of the payload's
+// ancestors are invalid as well.
+type InvalidPayloadError struct {
+	// BlockHash is the hash of the payload that was reported invalid.
+	BlockHash common.ExecutionHash
+	// LatestValidHash is the latest valid ancestor of the payload, if the
+	// execution client was able to determine it.
+	LatestValidHash *common.ExecutionHash
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *InvalidPayloadError) Error() string {
+	if e.LatestValidHash == nil {
+		return fmt.Sprintf("%v: block_hash=%s", e.Err, e.BlockHash)
+	}
+	return fmt.Sprintf(
+		"%v: block_hash=%s latest_valid_hash=%s",
+		e.Err, e.BlockHash, *e.LatestValidHash,
+	)
+}
+
+// Unwrap returns the underlying error.
+func (e *InvalidPayloadError) Unwrap() error {
+	return e.Err
+}
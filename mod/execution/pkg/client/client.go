@@ -24,15 +24,30 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ledger"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/retry"
+)
+
+const (
+	// startupCheckMultiplier grows the interval between the startup checks
+	// of the connection, up to startupCheckMaxFactor times the configured
+	// interval.
+	startupCheckMultiplier = 2
+	startupCheckMaxFactor  = 4
+	// startupCheckJitter is the fraction of the interval between the
+	// startup checks that is randomized.
+	startupCheckJitter = 0.1
 )
 
 // EngineClient is a struct that holds a pointer to an Eth1Client.
@@ -51,6 +66,14 @@ type EngineClient[
 	metrics *clientMetrics
 	// capabilities is a map of capabilities that the execution client has.
 	capabilities map[string]struct{}
+	// shadow mirrors engine calls to a candidate execution client, if one
+	// is configured.
+	shadow *shadowClient[ExecutionPayloadT]
+	// ledger records the forkchoiceUpdated calls made to the execution
+	// client, if it is configured.
+	ledger *ledger.Ledger
+	// clientVersion identifies the execution client once connected.
+	clientVersion atomic.Pointer[engineprimitives.ClientVersionV1]
 }
 
 // New creates a new engine client EngineClient.
@@ -65,9 +88,31 @@ func New[
 	jwtSecret *jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
+	fcuLedger *ledger.Ledger,
+	callHook ethclientrpc.CallHook,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	metrics := newClientMetrics(telemetrySink, logger, cfg.RPCDialURL.Host)
+
+	var shadow *shadowClient[ExecutionPayloadT]
+	if cfg.ShadowRPCDialURL != "" {
+		shadow = &shadowClient[ExecutionPayloadT]{
+			Client: ethclient.New[ExecutionPayloadT](
+				ethclientrpc.NewClient(
+					cfg.ShadowRPCDialURL,
+					ethclientrpc.WithJWTSecret(jwtSecret),
+					ethclientrpc.WithJWTRefreshInterval(
+						cfg.RPCJWTRefreshInterval,
+					),
+				)),
+			cfg:     cfg,
+			logger:  logger,
+			metrics: metrics,
+			calls:   make(chan func(context.Context), shadowQueueSize),
+		}
+	}
+
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
 		cfg:    cfg,
 		logger: logger,
@@ -78,10 +123,14 @@ func New[
 				ethclientrpc.WithJWTRefreshInterval(
 					cfg.RPCJWTRefreshInterval,
 				),
+				ethclientrpc.WithCallObserver(metrics),
+				ethclientrpc.WithCallHook(callHook),
 			)),
 		capabilities: make(map[string]struct{}),
 		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		metrics:      metrics,
+		shadow:       shadow,
+		ledger:       fcuLedger,
 	}
 }
 
@@ -100,39 +149,38 @@ func (s *EngineClient[
 ) error {
 	// Start the Clien.
 	go s.Client.Start(ctx)
+	if s.shadow != nil {
+		go s.shadow.start(ctx)
+	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
 	)
 
-	// If the connection connection succeeds, we can skip the
-	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
-		return nil
-	}
-
-	// Attempt to initialize the connection to the execution client.
-	ticker := time.NewTicker(s.cfg.RPCStartupCheckInterval)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
+	// Attempt to initialize the connection to the execution client, backing
+	// off while it is starting. An execution client on another network or
+	// of a rejected version is never going to become valid, so refuse to
+	// start.
+	return retry.Policy{
+		Initial:    s.cfg.RPCStartupCheckInterval,
+		Max:        startupCheckMaxFactor * s.cfg.RPCStartupCheckInterval,
+		Multiplier: startupCheckMultiplier,
+		Jitter:     startupCheckJitter,
+		OnRetry: func(uint64, error, time.Duration) {
 			s.logger.Info(
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
-				continue
-			}
-			return nil
+		},
+	}.Do(ctx, func(ctx context.Context) error {
+		err := s.verifyChainIDAndConnection(ctx)
+		if isFatalConnectionError(err) {
+			s.logger.Error(err.Error())
+			return retry.Permanent(err)
 		}
-	}
+		return err
+	})
 }
 
 /* -------------------------------------------------------------------------- */
@@ -193,5 +241,18 @@ func (s *EngineClient[
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
+
+	// Identify the execution client and check it is recent enough.
+	if err = s.identifyClient(ctx); err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
 	return nil
 }
+
+// isFatalConnectionError reports whether retrying the connection to the
+// execution client cannot succeed.
+func isFatalConnectionError(err error) bool {
+	return errors.Is(err, ErrMismatchedEth1ChainID) ||
+		errors.Is(err, ErrExecutionClientTooOld)
+}
@@ -34,6 +34,9 @@ const (
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
+	// defaultForkchoiceLedgerSize keeps the forkchoice updates of roughly
+	// the last day at one update per two second slot.
+	defaultForkchoiceLedgerSize = 43200
 )
 
 // DefaultConfig is the default configuration for the engine client.
@@ -47,6 +50,8 @@ func DefaultConfig() Config {
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		ForkchoiceLedgerSize:    defaultForkchoiceLedgerSize,
+		MinimumClientVersions:   make(map[string]string),
 	}
 }
 
@@ -67,4 +72,15 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// ShadowRPCDialURL is the HTTP url of a candidate execution client that
+	// engine calls are mirrored to for comparison. It shares the JWT secret
+	// of the primary execution client. Empty disables shadowing.
+	ShadowRPCDialURL string `mapstructure:"shadow-rpc-dial-url"`
+	// ForkchoiceLedgerSize is the number of forkchoiceUpdated calls kept in
+	// the on-disk forkchoice ledger. Zero disables the ledger.
+	ForkchoiceLedgerSize uint64 `mapstructure:"forkchoice-ledger-size"`
+	// MinimumClientVersions is the lowest acceptable version of each
+	// execution client, keyed by client code or name (e.g. "GE" or "geth").
+	// The node refuses to start against an older client.
+	MinimumClientVersions map[string]string `mapstructure:"minimum-client-versions"`
 }
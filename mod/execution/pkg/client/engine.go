@@ -28,7 +28,9 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -44,6 +46,7 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
@@ -55,6 +58,7 @@ func (s *EngineClient[
 	// Call the appropriate RPC method based on the payload version.
 	result, err := s.Client.NewPayload(
 		cctx, payload, versionedHashes, parentBeaconBlockRoot,
+		executionRequests,
 	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -64,6 +68,13 @@ func (s *EngineClient[
 	} else if result == nil {
 		return nil, engineerrors.ErrNilPayloadStatus
 	}
+	s.metrics.incrementPayloadStatus("engine_newPayload", result.Status)
+	if s.shadow != nil {
+		s.shadow.mirrorNewPayload(
+			payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, result,
+		)
+	}
 
 	// This case is only true when the payload is invalid, so
 	// `processPayloadStatusResult` below will return an error.
@@ -110,6 +121,7 @@ func (s *EngineClient[
 	result, err := s.Client.ForkchoiceUpdated(
 		cctx, state, attrs, forkVersion,
 	)
+	s.recordForkchoiceUpdated(state, attrs, forkVersion, result, err)
 
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -119,6 +131,14 @@ func (s *EngineClient[
 	} else if result == nil {
 		return nil, nil, engineerrors.ErrNilForkchoiceResponse
 	}
+	s.metrics.incrementPayloadStatus(
+		"engine_forkchoiceUpdated", result.PayloadStatus.Status,
+	)
+	if s.shadow != nil {
+		s.shadow.mirrorForkchoiceUpdated(
+			state, forkVersion, &result.PayloadStatus,
+		)
+	}
 
 	latestValidHash, err := processPayloadStatusResult((&result.PayloadStatus))
 	if err != nil {
@@ -165,6 +185,65 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHash calls the engine_getPayloadBodiesByHashV1 method via
+// JSON-RPC. It returns one body per hash, in order, which is nil if the
+// execution client does not know the block or has pruned it.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByHash(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Client.GetPayloadBodiesByHashV1(cctx, hashes)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if len(result) != len(hashes) {
+		return nil, errors.Wrapf(
+			engineerrors.ErrUnexpectedPayloadBodies,
+			"requested %d, got %d", len(hashes), len(result),
+		)
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. It returns the bodies of up to count blocks starting at the
+// given block number, fewer if the range goes past the latest block.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start math.U64,
+	count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Client.GetPayloadBodiesByRangeV1(cctx, start, count)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if uint64(len(result)) > count.Unwrap() {
+		return nil, errors.Wrapf(
+			engineerrors.ErrUnexpectedPayloadBodies,
+			"requested %d, got %d", count, len(result),
+		)
+	}
+	return result, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                    Other                                   */
+/* -------------------------------------------------------------------------- */
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
@@ -53,6 +53,14 @@ var (
 	// ErrMismatchedEth1ChainID is returned when the chainID does not
 	// match the expected chain ID.
 	ErrMismatchedEth1ChainID = errors.New("mismatched chain ID")
+
+	// ErrExecutionClientTooOld is returned when the version of the
+	// execution client is below the configured minimum.
+	ErrExecutionClientTooOld = errors.New("execution client version too old")
+
+	// ErrInvalidClientVersion is returned when a client version cannot be
+	// parsed.
+	ErrInvalidClientVersion = errors.New("invalid client version")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -27,6 +27,8 @@ func BeaconKitSupportedCapabilities() []string {
 		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadBodiesByHashMethodV1,
+		GetPayloadBodiesByRangeMethodV1,
 		GetClientVersionV1,
 	}
 }
@@ -35,10 +37,18 @@ func BeaconKitSupportedCapabilities() []string {
 const (
 	// NewPayloadMethodV3 for creating a new payload in Deneb.
 	NewPayloadMethodV3 = "engine_newPayloadV3"
+	// NewPayloadMethodV4 for creating a new payload with execution requests.
+	NewPayloadMethodV4 = "engine_newPayloadV4"
 	// ForkchoiceUpdatedMethodV3 for updating fork choice in Deneb.
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadBodiesByHashMethodV1 for retrieving payload bodies by block
+	// hash.
+	GetPayloadBodiesByHashMethodV1 = "engine_getPayloadBodiesByHashV1"
+	// GetPayloadBodiesByRangeMethodV1 for retrieving payload bodies by block
+	// number range.
+	GetPayloadBodiesByRangeMethodV1 = "engine_getPayloadBodiesByRangeV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
@@ -24,8 +24,10 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -33,18 +35,24 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method matching the payload
+// version via JSON-RPC.
 func (s *Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 ) (*engineprimitives.PayloadStatusV1, error) {
 	switch payload.Version() {
 	case version.Deneb, version.DenebPlus:
 		return s.NewPayloadV3(
 			ctx, payload, versionedHashes, parentBlockRoot,
 		)
+	case version.Electra:
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot, executionRequests,
+		)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -66,6 +74,25 @@ func (s *Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 is used to call the underlying JSON-RPC method for newPayload
+// with the EIP-7685 execution requests.
+func (s *Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Call(
+		ctx, result, NewPayloadMethodV4,
+		payload, versionedHashes, parentBlockRoot, executionRequests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -159,6 +186,43 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                              GetPayloadBodies                              */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByHashV1 calls the engine_getPayloadBodiesByHashV1 method
+// via JSON-RPC. The body of a block unknown to the execution client is nil.
+func (s *Client[ExecutionPayloadT]) GetPayloadBodiesByHashV1(
+	ctx context.Context,
+	hashes []common.ExecutionHash,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, len(hashes))
+	if err := s.Call(
+		ctx, &result, GetPayloadBodiesByHashMethodV1, hashes,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC for the count blocks starting at the given block number. The
+// result stops at the latest known block, and the body of a block unknown to
+// the execution client is nil.
+func (s *Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context,
+	start math.U64,
+	count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0, count)
+	if err := s.Call(
+		ctx, &result, GetPayloadBodiesByRangeMethodV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
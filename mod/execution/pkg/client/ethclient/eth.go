@@ -44,6 +44,28 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// BlockNumber retrieves the number of the most recent block.
+func (ec *Client[ExecutionPayloadT]) BlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	var result math.U64
+	if err := ec.Call(ctx, &result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// ClientVersion retrieves the version string of the execution client.
+func (ec *Client[ExecutionPayloadT]) ClientVersion(
+	ctx context.Context,
+) (string, error) {
+	var result string
+	if err := ec.Call(ctx, &result, "web3_clientVersion"); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
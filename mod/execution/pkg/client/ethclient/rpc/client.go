@@ -23,13 +23,14 @@ package rpc
 import (
 	"bytes"
 	"context"
-	"io"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 )
 
 // Client is an Ethereum RPC client that provides a
@@ -39,8 +40,8 @@ type Client struct {
 	url string
 	// client is the HTTP client used to make RPC calls.
 	client *http.Client
-	// reqPool is a sync.Pool for reusing RPC request objects.
-	reqPool *sync.Pool
+	// reqPool is a pool for reusing RPC request objects.
+	reqPool *pool.Pool[*Request]
 	// jwtSecret is the JWT secret used for authentication.
 	jwtSecret *jwt.Secret
 	// jwtRefershInterval is the interval at which the JWT token should be
@@ -52,6 +53,11 @@ type Client struct {
 
 	// header is the HTTP header used for RPC requests.
 	header http.Header
+
+	// observer is notified of every call, if set.
+	observer CallObserver
+	// hook is run after every call, if set.
+	hook CallHook
 }
 
 // New create new rpc client with given url.
@@ -59,14 +65,12 @@ func NewClient(url string, options ...func(rpc *Client)) *Client {
 	rpc := &Client{
 		url:    url,
 		client: http.DefaultClient,
-		reqPool: &sync.Pool{
-			New: func() any {
-				return &Request{
-					ID:      1,
-					JSONRPC: "2.0",
-				}
-			},
-		},
+		reqPool: pool.New(func() *Request {
+			return &Request{
+				ID:      1,
+				JSONRPC: "2.0",
+			}
+		}),
 		header: http.Header{"Content-Type": {"application/json"}},
 	}
 
@@ -124,10 +128,27 @@ func (rpc *Client) Call(
 func (rpc *Client) CallRaw(
 	ctx context.Context, method string, params ...any,
 ) (json.RawMessage, error) {
+	start := time.Now()
+	result, reqSize, respSize, err := rpc.callRaw(ctx, method, params...)
+	if rpc.hook != nil {
+		if hookErr := rpc.hook(ctx, method); hookErr != nil && err == nil {
+			result, err = nil, hookErr
+		}
+	}
+	if rpc.observer != nil {
+		rpc.observer.ObserveCall(method, start, reqSize, respSize, err)
+	}
+	return result, err
+}
+
+// callRaw performs the call and additionally returns the size of the encoded
+// request and of the raw response body.
+func (rpc *Client) callRaw(
+	ctx context.Context, method string, params ...any,
+) (json.RawMessage, int, int, error) {
 	// Pull a request from the pool, we know that it already has the correct
 	// JSONRPC version and ID set.
-	//nolint:errcheck // this is safe.
-	request := rpc.reqPool.Get().(*Request)
+	request := rpc.reqPool.Get()
 	defer rpc.reqPool.Put(request)
 
 	// Update the request with the method and params.
@@ -136,8 +157,9 @@ func (rpc *Client) CallRaw(
 
 	body, err := json.Marshal(request)
 	if err != nil {
-		return nil, err
+		return nil, 0, 0, err
 	}
+	reqSize := len(body)
 
 	req, err := http.NewRequestWithContext(
 		ctx,
@@ -146,35 +168,44 @@ func (rpc *Client) CallRaw(
 		bytes.NewBuffer(body),
 	)
 	if err != nil {
-		return nil, err
+		return nil, reqSize, 0, err
 	}
 
 	rpc.mu.RLock()
 	req.Header = rpc.header.Clone()
 	rpc.mu.RUnlock()
+	if id, ok := correlation.IDFromContext(ctx); ok {
+		req.Header.Set(correlation.Header, id)
+	}
 
 	response, err := rpc.client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, reqSize, 0, err
 	}
 	if response == nil {
-		return nil, ErrNilResponse
+		return nil, reqSize, 0, ErrNilResponse
 	}
 	defer response.Body.Close()
 
-	data, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
+	// Read the body into a pooled buffer, the result is copied out of it
+	// when the response is decoded.
+	data := pool.GetBytes(0)
+	defer pool.PutBytes(data)
+	buf := bytes.NewBuffer(*data)
+	if _, err = buf.ReadFrom(response.Body); err != nil {
+		return nil, reqSize, 0, err
 	}
+	*data = buf.Bytes()
+	respSize := len(*data)
 
 	resp := new(Response)
-	if err = json.Unmarshal(data, resp); err != nil {
-		return nil, err
+	if err = json.Unmarshal(*data, resp); err != nil {
+		return nil, reqSize, respSize, err
 	}
 
 	if resp.Error != nil {
-		return nil, *resp.Error
+		return nil, reqSize, respSize, *resp.Error
 	}
 
-	return resp.Result, nil
+	return resp.Result, reqSize, respSize, nil
 }
@@ -39,3 +39,18 @@ func WithJWTRefreshInterval(interval time.Duration) func(rpc *Client) {
 		rpc.jwtRefreshInterval = interval
 	}
 }
+
+// WithCallHook sets the hook run after every call made by the RPC client.
+func WithCallHook(hook CallHook) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.hook = hook
+	}
+}
+
+// WithCallObserver sets the observer notified of every call made by the RPC
+// client.
+func WithCallObserver(observer CallObserver) func(rpc *Client) {
+	return func(rpc *Client) {
+		rpc.observer = observer
+	}
+}
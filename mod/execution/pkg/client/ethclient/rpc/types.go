@@ -21,7 +21,9 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 )
@@ -62,3 +64,19 @@ type Error struct {
 func (err Error) Error() string {
 	return fmt.Sprintf("Error %d (%s)", err.Code, err.Message)
 }
+
+// CallObserver is notified once every JSON-RPC call made by the client has
+// completed.
+type CallObserver interface {
+	// ObserveCall is called with the method, the time the call started,
+	// the size in bytes of the encoded request and of the raw response, and
+	// the error the call returned, if any.
+	ObserveCall(
+		method string, start time.Time, reqSize, respSize int, err error,
+	)
+}
+
+// CallHook is run once every JSON-RPC call made by the client has returned,
+// before its result is handed back, with the context and method of the call.
+// An error is returned in place of the result of a successful call.
+type CallHook func(ctx context.Context, method string) error
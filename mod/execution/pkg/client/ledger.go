@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"encoding/json"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ledger"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+)
+
+// recordForkchoiceUpdated records a forkchoiceUpdated call and the response
+// of the execution client in the forkchoice ledger, if one is configured.
+// Failing to record the call is logged, as the ledger is only an audit aid.
+func (s *EngineClient[
+	_, PayloadAttributesT,
+]) recordForkchoiceUpdated(
+	state *engineprimitives.ForkchoiceStateV1,
+	attrs PayloadAttributesT,
+	forkVersion uint32,
+	result *engineprimitives.ForkchoiceResponseV1,
+	err error,
+) {
+	if s.ledger == nil {
+		return
+	}
+
+	entry := &ledger.Entry{
+		Time:               time.Now(),
+		ForkVersion:        forkVersion,
+		HeadBlockHash:      state.HeadBlockHash,
+		SafeBlockHash:      state.SafeBlockHash,
+		FinalizedBlockHash: state.FinalizedBlockHash,
+	}
+	if !attrs.IsNil() {
+		bz, marshalErr := json.Marshal(attrs)
+		if marshalErr != nil {
+			s.logger.Error(
+				"Failed to encode payload attributes for the ledger",
+				"error", marshalErr,
+			)
+		} else {
+			entry.AttributesHash = sha256.Hash(bz)
+		}
+	}
+	switch {
+	case err != nil:
+		entry.Error = err.Error()
+	case result != nil:
+		entry.Status = result.PayloadStatus.Status
+		entry.LatestValidHash = result.PayloadStatus.LatestValidHash
+		entry.PayloadID = result.PayloadID
+		if result.PayloadStatus.ValidationError != nil {
+			entry.Error = *result.PayloadStatus.ValidationError
+		}
+	}
+
+	if err = s.ledger.Record(entry); err != nil {
+		s.logger.Error(
+			"Failed to record forkchoice update in the ledger", "error", err,
+		)
+	}
+}
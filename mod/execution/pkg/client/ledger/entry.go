@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+const (
+	// statusLength is the space reserved for the payload status.
+	statusLength = 24
+	// errorLength is the space reserved for the error of a failed call.
+	// Longer errors are truncated.
+	errorLength = 96
+	// entryLength is the length of an encoded entry.
+	entryLength = 8 + 4 + 4*32 + 1 + 32 + 8 + statusLength + errorLength
+)
+
+// Entry is the record of a forkchoiceUpdated call to the execution client.
+type Entry struct {
+	// Time is when the response was received.
+	Time time.Time `json:"time"`
+	// ForkVersion is the fork version the call was made for.
+	ForkVersion uint32 `json:"fork_version"`
+	// HeadBlockHash, SafeBlockHash and FinalizedBlockHash are the forkchoice
+	// state sent to the execution client.
+	HeadBlockHash      common.ExecutionHash `json:"head_block_hash"`
+	SafeBlockHash      common.ExecutionHash `json:"safe_block_hash"`
+	FinalizedBlockHash common.ExecutionHash `json:"finalized_block_hash"`
+	// AttributesHash is the sha256 hash of the JSON encoding of the payload
+	// attributes sent. It is zero if no attributes were sent.
+	AttributesHash common.Root `json:"attributes_hash"`
+	// Status is the payload status returned. It is empty if the call
+	// failed.
+	Status string `json:"status,omitempty"`
+	// LatestValidHash is the latest valid hash returned, if any.
+	LatestValidHash *common.ExecutionHash `json:"latest_valid_hash,omitempty"`
+	// PayloadID is the ID of the payload build started, if any.
+	PayloadID *engineprimitives.PayloadID `json:"payload_id,omitempty"`
+	// Error is the error of a failed call, truncated.
+	Error string `json:"error,omitempty"`
+}
+
+// flags of an encoded entry, recording which optional fields are set.
+const (
+	hasLatestValidHash byte = 1 << iota
+	hasPayloadID
+)
+
+// marshal encodes the entry into its fixed length layout.
+func (e *Entry) marshal() []byte {
+	buf := make([]byte, 0, entryLength)
+	//#nosec:G115 // unix nanoseconds are positive.
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(e.Time.UnixNano()))
+	buf = binary.LittleEndian.AppendUint32(buf, e.ForkVersion)
+	buf = append(buf, e.HeadBlockHash[:]...)
+	buf = append(buf, e.SafeBlockHash[:]...)
+	buf = append(buf, e.FinalizedBlockHash[:]...)
+	buf = append(buf, e.AttributesHash[:]...)
+
+	var (
+		flags           byte
+		latestValidHash common.ExecutionHash
+		payloadID       engineprimitives.PayloadID
+	)
+	if e.LatestValidHash != nil {
+		flags |= hasLatestValidHash
+		latestValidHash = *e.LatestValidHash
+	}
+	if e.PayloadID != nil {
+		flags |= hasPayloadID
+		payloadID = *e.PayloadID
+	}
+	buf = append(buf, flags)
+	buf = append(buf, latestValidHash[:]...)
+	buf = append(buf, payloadID[:]...)
+	buf = appendPadded(buf, e.Status, statusLength)
+	return appendPadded(buf, e.Error, errorLength)
+}
+
+// unmarshal decodes the entry from its fixed length layout.
+func (e *Entry) unmarshal(buf []byte) {
+	//#nosec:G115 // written from a unix nanosecond timestamp.
+	e.Time = time.Unix(0, int64(binary.LittleEndian.Uint64(buf))).UTC()
+	e.ForkVersion = binary.LittleEndian.Uint32(buf[8:])
+	buf = buf[12:]
+	buf = buf[copy(e.HeadBlockHash[:], buf):]
+	buf = buf[copy(e.SafeBlockHash[:], buf):]
+	buf = buf[copy(e.FinalizedBlockHash[:], buf):]
+	buf = buf[copy(e.AttributesHash[:], buf):]
+
+	flags := buf[0]
+	buf = buf[1:]
+	if flags&hasLatestValidHash != 0 {
+		e.LatestValidHash = new(common.ExecutionHash)
+		copy(e.LatestValidHash[:], buf)
+	}
+	buf = buf[len(common.ExecutionHash{}):]
+	if flags&hasPayloadID != 0 {
+		e.PayloadID = new(engineprimitives.PayloadID)
+		copy(e.PayloadID[:], buf)
+	}
+	buf = buf[len(engineprimitives.PayloadID{}):]
+	e.Status = string(bytes.TrimRight(buf[:statusLength], "\x00"))
+	e.Error = string(bytes.TrimRight(buf[statusLength:], "\x00"))
+}
+
+// appendPadded appends s to buf, truncated or zero padded to n bytes.
+func appendPadded(buf []byte, s string, n int) []byte {
+	if len(s) > n {
+		s = s[:n]
+	}
+	buf = append(buf, s...)
+	return append(buf, make([]byte, n-len(s))...)
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ledger
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// FileName is the name of the ledger file in the data directory of the
+	// node.
+	FileName = "forkchoice_ledger.db"
+	// magic identifies a ledger file.
+	magic = "fcul"
+	// headerLength is the length of the magic, the capacity and the number
+	// of entries ever recorded at the start of the file.
+	headerLength = len(magic) + 8 + 8
+)
+
+// Ledger is a bounded on-disk ring of the forkchoiceUpdated calls made to the
+// execution client. Once the ledger is full, every new entry overwrites the
+// oldest one.
+type Ledger struct {
+	mu   sync.Mutex
+	file *os.File
+	// capacity is the number of entries the ledger holds.
+	capacity uint64
+	// count is the number of entries ever recorded.
+	count uint64
+}
+
+// Open opens the ledger file at the given path, creating it with the given
+// capacity if it does not exist. A capacity of zero opens an existing ledger
+// with whatever capacity it was created with.
+func Open(path string, capacity uint64) (*Ledger, error) {
+	flag := os.O_RDWR
+	if capacity != 0 {
+		flag |= os.O_CREATE
+	}
+	file, err := os.OpenFile(path, flag, 0o600)
+	if os.IsNotExist(err) {
+		return nil, ErrLedgerNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	l := &Ledger{file: file}
+	if err = l.init(capacity); err != nil {
+		return nil, errors.Join(err, file.Close())
+	}
+	return l, nil
+}
+
+// init reads the header of the ledger file, or writes it if the file is
+// empty.
+func (l *Ledger) init(capacity uint64) error {
+	header := make([]byte, headerLength)
+	_, err := io.ReadFull(l.file, header)
+	switch {
+	case errors.Is(err, io.EOF):
+		if capacity == 0 {
+			return ErrLedgerNotFound
+		}
+		l.capacity = capacity
+		return l.writeHeader()
+	case err != nil:
+		return err
+	case string(header[:len(magic)]) != magic:
+		return ErrInvalidLedger
+	}
+
+	l.capacity = binary.LittleEndian.Uint64(header[len(magic):])
+	l.count = binary.LittleEndian.Uint64(header[len(magic)+8:])
+	if capacity != 0 && capacity != l.capacity {
+		return errors.Wrapf(
+			ErrCapacityMismatch,
+			"ledger holds %d entries, configured for %d",
+			l.capacity, capacity,
+		)
+	}
+	return nil
+}
+
+// Record appends the entry to the ledger, overwriting the oldest entry if
+// the ledger is full.
+func (l *Ledger) Record(e *Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.file.WriteAt(
+		e.marshal(), l.offset(l.count%l.capacity),
+	); err != nil {
+		return err
+	}
+	l.count++
+	return l.writeHeader()
+}
+
+// Entries returns the entries held by the ledger, oldest first.
+func (l *Ledger) Entries() ([]*Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	first := uint64(0)
+	if l.count > l.capacity {
+		first = l.count - l.capacity
+	}
+	entries := make([]*Entry, 0, l.count-first)
+	buf := make([]byte, entryLength)
+	for i := first; i < l.count; i++ {
+		if _, err := l.file.ReadAt(buf, l.offset(i%l.capacity)); err != nil {
+			return nil, err
+		}
+		e := new(Entry)
+		e.unmarshal(buf)
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// Close closes the ledger file.
+func (l *Ledger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// writeHeader writes the header of the ledger file.
+func (l *Ledger) writeHeader() error {
+	header := make([]byte, 0, headerLength)
+	header = append(header, magic...)
+	header = binary.LittleEndian.AppendUint64(header, l.capacity)
+	header = binary.LittleEndian.AppendUint64(header, l.count)
+	_, err := l.file.WriteAt(header, 0)
+	return err
+}
+
+// offset returns the offset of the entry at the given position of the ring.
+func (l *Ledger) offset(pos uint64) int64 {
+	//#nosec:G115 // bounded by the capacity of the ledger.
+	return int64(headerLength) + int64(pos*entryLength)
+}
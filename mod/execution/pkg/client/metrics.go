@@ -21,8 +21,12 @@
 package client
 
 import (
+	"strconv"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -32,19 +36,76 @@ type clientMetrics struct {
 	sink TelemetrySink
 	// logger is the logger for the engineMetrics.
 	logger log.Logger
+	// endpoint is the host of the execution client, used as a label.
+	endpoint string
 }
 
 // newClientMetrics creates a new engineMetrics.
 func newClientMetrics(
 	sink TelemetrySink,
 	logger log.Logger,
+	endpoint string,
 ) *clientMetrics {
 	return &clientMetrics{
-		sink:   sink,
-		logger: logger,
+		sink:     sink,
+		logger:   logger,
+		endpoint: endpoint,
 	}
 }
 
+// ObserveCall records the latency, the request and response sizes and the
+// outcome of a JSON-RPC call, labelled by method and endpoint.
+func (cm *clientMetrics) ObserveCall(
+	method string,
+	start time.Time,
+	reqSize, respSize int,
+	err error,
+) {
+	labels := []string{"method", method, "endpoint", cm.endpoint}
+	cm.sink.MeasureSince(
+		"beacon_kit.execution.client.rpc_duration", start, labels...,
+	)
+	cm.sink.AddSample(
+		"beacon_kit.execution.client.rpc_request_bytes",
+		float32(reqSize),
+		labels...,
+	)
+	cm.sink.AddSample(
+		"beacon_kit.execution.client.rpc_response_bytes",
+		float32(respSize),
+		labels...,
+	)
+	if err != nil {
+		cm.sink.IncrementCounter(
+			"beacon_kit.execution.client.rpc_error",
+			append(labels, "code", rpcErrorCode(err))...,
+		)
+	}
+}
+
+// incrementShadowResult increments the counter for the outcome of a call
+// mirrored to the shadow execution client.
+func (cm *clientMetrics) incrementShadowResult(method, result string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.shadow_result",
+		"method", method, "result", result,
+	)
+}
+
+// incrementPayloadStatus increments the counter for the payload status
+// returned by the execution client for the given engine method.
+func (cm *clientMetrics) incrementPayloadStatus(
+	method string,
+	status engineprimitives.PayloadStatusStr,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.execution.client.payload_status",
+		"method", method,
+		"endpoint", cm.endpoint,
+		"status", string(status),
+	)
+}
+
 // measureForkchoiceUpdateDuration measures the duration of the forkchoice
 // update.
 func (cm *clientMetrics) measureForkchoiceUpdateDuration(startTime time.Time) {
@@ -182,3 +243,13 @@ func (cm *clientMetrics) incrementInternalServerErrorCounter() {
 func (cm *clientMetrics) incrementErrorCounter(metricName string) {
 	cm.sink.IncrementCounter(metricName)
 }
+
+// rpcErrorCode returns the JSON-RPC error code of err, or "transport" if the
+// call failed before the execution client returned a JSON-RPC error.
+func rpcErrorCode(err error) string {
+	var rpcErr ethclientrpc.Error
+	if errors.As(err, &rpcErr) {
+		return strconv.Itoa(rpcErr.Code)
+	}
+	return "transport"
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// shadowQueueSize is the number of mirrored calls that may be pending on the
+// shadow client. Calls are dropped while the queue is full, so a slow
+// candidate never holds up the primary execution client.
+const shadowQueueSize = 64
+
+// shadowClient mirrors the engine calls made to the primary execution client
+// to a candidate execution client, and reports every response that diverges
+// from the primary's. The candidate's responses never reach consensus.
+type shadowClient[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	*ethclient.Client[ExecutionPayloadT]
+	// cfg is the configuration of the engine client.
+	cfg *Config
+	// logger is the logger for the shadow client.
+	logger log.Logger
+	// metrics is the metrics for the engine client.
+	metrics *clientMetrics
+	// calls is the queue of mirrored calls, executed in order.
+	calls chan func(context.Context)
+}
+
+// start starts the shadow client and executes mirrored calls until ctx is
+// cancelled.
+func (s *shadowClient[_]) start(ctx context.Context) {
+	go s.Client.Start(ctx)
+	s.logger.Info(
+		"Mirroring engine calls to shadow execution client 👥",
+		"dial_url", s.cfg.ShadowRPCDialURL,
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case call := <-s.calls:
+			cctx, cancel := context.WithTimeoutCause(
+				ctx, s.cfg.RPCTimeout, engineerrors.ErrEngineAPITimeout,
+			)
+			call(cctx)
+			cancel()
+		}
+	}
+}
+
+// enqueue schedules a mirrored call, dropping it if the queue is full.
+func (s *shadowClient[_]) enqueue(call func(context.Context)) {
+	select {
+	case s.calls <- call:
+	default:
+		s.logger.Warn("Shadow execution client is lagging, dropping call")
+	}
+}
+
+// mirrorNewPayload replays a newPayload call on the candidate and compares
+// its status with the status returned by the primary.
+func (s *shadowClient[ExecutionPayloadT]) mirrorNewPayload(
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+	primary *engineprimitives.PayloadStatusV1,
+) {
+	s.enqueue(func(ctx context.Context) {
+		result, err := s.Client.NewPayload(
+			ctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests,
+		)
+		s.compare(
+			"engine_newPayload", primary, result, err,
+			"parent_beacon_block_root", parentBeaconBlockRoot,
+		)
+	})
+}
+
+// mirrorForkchoiceUpdated replays a forkchoiceUpdated call on the candidate
+// and compares its status with the status returned by the primary. Payload
+// attributes are not mirrored, so the candidate never builds payloads.
+func (s *shadowClient[_]) mirrorForkchoiceUpdated(
+	state *engineprimitives.ForkchoiceStateV1,
+	forkVersion uint32,
+	primary *engineprimitives.PayloadStatusV1,
+) {
+	s.enqueue(func(ctx context.Context) {
+		var status *engineprimitives.PayloadStatusV1
+		result, err := s.Client.ForkchoiceUpdated(
+			ctx, state, nil, forkVersion,
+		)
+		if result != nil {
+			status = &result.PayloadStatus
+		}
+		s.compare(
+			"engine_forkchoiceUpdated", primary, status, err,
+			"head_block_hash", state.HeadBlockHash,
+		)
+	})
+}
+
+// compare reports whether the candidate's response to a call agrees with the
+// primary's. The given key-value pairs identify the call in the logs.
+func (s *shadowClient[_]) compare(
+	method string,
+	primary, candidate *engineprimitives.PayloadStatusV1,
+	err error,
+	keyVals ...any,
+) {
+	keyVals = append([]any{"method", method}, keyVals...)
+	switch {
+	case err != nil:
+		s.logger.Warn(
+			"Shadow execution client call failed",
+			append(keyVals, "error", err)...,
+		)
+		s.metrics.incrementShadowResult(method, "error")
+	case candidate == nil:
+		s.metrics.incrementShadowResult(method, "error")
+	case candidate.Status != primary.Status ||
+		!sameHash(candidate.LatestValidHash, primary.LatestValidHash):
+		s.logger.Warn(
+			"Shadow execution client diverged from primary ⚠️",
+			append(keyVals,
+				"primary_status", primary.Status,
+				"shadow_status", candidate.Status,
+				"primary_latest_valid_hash", primary.LatestValidHash,
+				"shadow_latest_valid_hash", candidate.LatestValidHash,
+			)...,
+		)
+		s.metrics.incrementShadowResult(method, "diverged")
+	default:
+		s.metrics.incrementShadowResult(method, "matched")
+	}
+}
+
+// sameHash reports whether two optional hashes are equal.
+func sameHash(a, b *common.ExecutionHash) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -41,4 +41,7 @@ type TelemetrySink interface {
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
+	// AddSample adds a sample to the histogram metric identified by the
+	// provided key.
+	AddSample(key string, value float32, args ...string)
 }
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+)
+
+// ClientVersion returns the identity of the execution client, if it has been
+// identified.
+func (s *EngineClient[
+	_, _,
+]) ClientVersion() (engineprimitives.ClientVersionV1, bool) {
+	version := s.clientVersion.Load()
+	if version == nil {
+		return engineprimitives.ClientVersionV1{}, false
+	}
+	return *version, true
+}
+
+// identifyClient asks the execution client for its version, preferring
+// engine_getClientVersionV1 over web3_clientVersion, and enforces the
+// configured minimum version for it. A client that cannot be identified is
+// let through with a warning.
+func (s *EngineClient[
+	_, _,
+]) identifyClient(ctx context.Context) error {
+	version, err := s.fetchClientVersion(ctx)
+	if err != nil {
+		s.logger.Warn(
+			"Could not identify the execution client", "err", err,
+		)
+		return nil
+	}
+	s.clientVersion.Store(&version)
+
+	s.logger.Info(
+		"Identified execution client",
+		"code", version.Code,
+		"name", version.Name,
+		"version", version.Version,
+		"commit", version.Commit,
+	)
+	return s.checkMinimumVersion(version)
+}
+
+// fetchClientVersion retrieves the version of the execution client.
+func (s *EngineClient[
+	_, _,
+]) fetchClientVersion(
+	ctx context.Context,
+) (engineprimitives.ClientVersionV1, error) {
+	if _, ok := s.capabilities[ethclient.GetClientVersionV1]; ok {
+		versions, err := s.GetClientVersionV1(ctx)
+		if err == nil && len(versions) > 0 {
+			return versions[0], nil
+		}
+	}
+
+	raw, err := s.Client.ClientVersion(ctx)
+	if err != nil {
+		return engineprimitives.ClientVersionV1{}, err
+	}
+	return parseWeb3ClientVersion(raw)
+}
+
+// checkMinimumVersion returns ErrExecutionClientTooOld if the configured
+// minimum version for the client is above its version.
+func (s *EngineClient[
+	_, _,
+]) checkMinimumVersion(version engineprimitives.ClientVersionV1) error {
+	for client, minimum := range s.cfg.MinimumClientVersions {
+		if !strings.EqualFold(client, version.Code) &&
+			!strings.EqualFold(client, version.Name) {
+			continue
+		}
+
+		cmp, err := compareVersions(version.Version, minimum)
+		if err != nil {
+			return err
+		}
+		if cmp < 0 {
+			return errors.Wrapf(
+				ErrExecutionClientTooOld,
+				"%s %s is below the minimum version %s",
+				version.Name, version.Version, minimum,
+			)
+		}
+	}
+	return nil
+}
+
+// parseWeb3ClientVersion parses a web3_clientVersion string such as
+// "Geth/v1.14.8-stable-a9523b64/linux-amd64/go1.22.6".
+func parseWeb3ClientVersion(
+	raw string,
+) (engineprimitives.ClientVersionV1, error) {
+	parts := strings.Split(raw, "/")
+	if len(parts) < 2 {
+		return engineprimitives.ClientVersionV1{}, errors.Wrap(
+			ErrInvalidClientVersion, raw,
+		)
+	}
+
+	version := engineprimitives.ClientVersionV1{Name: parts[0]}
+	// Some clients report a node name before the version.
+	for _, part := range parts[1:] {
+		if _, err := parseVersion(part); err == nil {
+			version.Version = part
+			break
+		}
+	}
+	if version.Version == "" {
+		return engineprimitives.ClientVersionV1{}, errors.Wrap(
+			ErrInvalidClientVersion, raw,
+		)
+	}
+	return version, nil
+}
+
+// compareVersions compares the major, minor and patch numbers of two
+// versions, returning -1, 0 or 1 like strings.Compare.
+func compareVersions(a, b string) (int, error) {
+	va, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range va {
+		switch {
+		case va[i] < vb[i]:
+			return -1, nil
+		case va[i] > vb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseVersion parses the major, minor and patch numbers of a version such
+// as "v1.14.8-stable-a9523b64". Missing minor or patch numbers are zero.
+func parseVersion(version string) ([3]uint64, error) {
+	var parsed [3]uint64
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) > len(parsed) {
+		return parsed, errors.Wrap(ErrInvalidClientVersion, version)
+	}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return parsed, errors.Wrap(ErrInvalidClientVersion, version)
+		}
+		parsed[i] = n
+	}
+	return parsed, nil
+}
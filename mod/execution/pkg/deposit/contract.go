@@ -71,19 +71,21 @@ func NewWrappedBeaconDepositContract[
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits emitted in the blocks from start to end,
+// inclusive. It fails with ErrDepositLogRemoved if the execution client
+// reports a log as removed by a reorg while it is being read.
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	start, end math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   blkNum.Unwrap(),
-			End:     (*uint64)(&blkNum),
+			Start:   start.Unwrap(),
+			End:     (*uint64)(&end),
 		},
 	)
 	if err != nil {
@@ -98,6 +100,12 @@ func (dc *WrappedBeaconDepositContract[
 			d      DepositT
 			sign   bytes.B96
 		)
+		if logs.Event.Raw.Removed {
+			return nil, fmt.Errorf(
+				"%w: block %d", ErrDepositLogRemoved,
+				logs.Event.Raw.BlockNumber,
+			)
+		}
 		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
 		if err != nil {
 			return nil, fmt.Errorf("failed reading pub key: %w", err)
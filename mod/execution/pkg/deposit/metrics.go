@@ -22,6 +22,7 @@ package deposit
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -47,3 +48,35 @@ func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
 		strconv.FormatUint(blockNum.Unwrap(), 10),
 	)
 }
+
+// setPendingDeposits sets the gauge for the number of deposits awaiting
+// inclusion in a finalized block.
+func (m *metrics) setPendingDeposits(numPending int) {
+	m.sink.SetGauge(
+		"beacon_kit.execution.deposit.pending",
+		int64(numPending),
+	)
+}
+
+// measureInclusionDelay measures the time from a deposit being fetched to
+// its inclusion in a finalized block.
+func (m *metrics) measureInclusionDelay(fetchedAt time.Time) {
+	m.sink.MeasureSince(
+		"beacon_kit.execution.deposit.inclusion_delay",
+		fetchedAt,
+	)
+}
+
+// measureFetchDuration measures the time taken to read the deposit logs of
+// the newly confirmed blocks.
+func (m *metrics) measureFetchDuration(start time.Time) {
+	m.sink.MeasureSince(
+		"beacon_kit.execution.deposit.fetch_duration", start,
+	)
+}
+
+// markReorgDetected increments the counter for reorgs detected in the deposit
+// logs of confirmed blocks.
+func (m *metrics) markReorgDetected() {
+	m.sink.IncrementCounter("beacon_kit.execution.deposit.reorg_detected")
+}
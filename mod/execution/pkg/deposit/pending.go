@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"sync"
+	"time"
+)
+
+// reconcileBatchSize is the number of deposits read from the store at a time
+// when reconciling the pending queue.
+const reconcileBatchSize = 256
+
+// PendingQueue layers in-memory tracking over the deposit store. It keeps
+// the deposits that were fetched from the execution layer but are not yet
+// included in a finalized block, along with the time they were fetched.
+type PendingQueue[
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT any,
+] struct {
+	// ds is the deposit store backing the queue.
+	ds Store[DepositT]
+	// metrics is the metrics for the deposit service.
+	metrics *metrics
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// reconciled is set once the queue was rebuilt from the store.
+	reconciled bool
+	// pending maps the index of every pending deposit to the time it was
+	// fetched.
+	pending map[uint64]time.Time
+}
+
+// NewPendingQueue creates a new pending queue over the given deposit store.
+func NewPendingQueue[
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	WithdrawalCredentialsT any,
+](
+	ds Store[DepositT],
+	metrics *metrics,
+) *PendingQueue[DepositT, WithdrawalCredentialsT] {
+	return &PendingQueue[DepositT, WithdrawalCredentialsT]{
+		ds:      ds,
+		metrics: metrics,
+		pending: make(map[uint64]time.Time),
+	}
+}
+
+// Enqueue stores the given deposits and tracks them as pending.
+func (q *PendingQueue[DepositT, _]) Enqueue(deposits []DepositT) error {
+	if err := q.ds.EnqueueDeposits(deposits); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, deposit := range deposits {
+		if _, ok := q.pending[deposit.GetIndex().Unwrap()]; !ok {
+			q.pending[deposit.GetIndex().Unwrap()] = now
+		}
+	}
+	q.metrics.setPendingDeposits(len(q.pending))
+	return nil
+}
+
+// MarkIncluded stops tracking the given deposits, which were included in a
+// finalized block. Deposits are included in order, so every pending deposit
+// with a lower index is dropped as well.
+func (q *PendingQueue[DepositT, _]) MarkIncluded(deposits []DepositT) {
+	if len(deposits) == 0 {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, deposit := range deposits {
+		index := deposit.GetIndex().Unwrap()
+		if fetchedAt, ok := q.pending[index]; ok {
+			q.metrics.measureInclusionDelay(fetchedAt)
+			delete(q.pending, index)
+		}
+	}
+	q.dropThrough(deposits[len(deposits)-1].GetIndex().Unwrap())
+	q.metrics.setPendingDeposits(len(q.pending))
+}
+
+// Reconcile rebuilds the queue from the deposit store once after a restart.
+// Every stored deposit from nextIndex, the index of the next deposit to be
+// included in the beacon state, onwards is pending.
+func (q *PendingQueue[DepositT, _]) Reconcile(nextIndex uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.reconciled {
+		return nil
+	}
+
+	now := time.Now()
+	for start := nextIndex; ; start += reconcileBatchSize {
+		deposits, err := q.ds.GetDepositsByIndex(start, reconcileBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, deposit := range deposits {
+			if _, ok := q.pending[deposit.GetIndex().Unwrap()]; !ok {
+				q.pending[deposit.GetIndex().Unwrap()] = now
+			}
+		}
+		if len(deposits) < reconcileBatchSize {
+			break
+		}
+	}
+	if nextIndex > 0 {
+		q.dropThrough(nextIndex - 1)
+	}
+	q.reconciled = true
+	q.metrics.setPendingDeposits(len(q.pending))
+	return nil
+}
+
+// Reconciled returns whether the queue was rebuilt from the store.
+func (q *PendingQueue[DepositT, _]) Reconciled() bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.reconciled
+}
+
+// Len returns the number of pending deposits.
+func (q *PendingQueue[DepositT, _]) Len() int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return len(q.pending)
+}
+
+// Oldest returns the time the longest pending deposit was fetched, and false
+// if no deposit is pending.
+func (q *PendingQueue[DepositT, _]) Oldest() (time.Time, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	var oldest time.Time
+	for _, fetchedAt := range q.pending {
+		if oldest.IsZero() || fetchedAt.Before(oldest) {
+			oldest = fetchedAt
+		}
+	}
+	return oldest, !oldest.IsZero()
+}
+
+// dropThrough stops tracking every deposit with an index up to and including
+// the given one. The caller must hold the lock.
+func (q *PendingQueue[DepositT, _]) dropThrough(index uint64) {
+	for pending := range q.pending {
+		if pending <= index {
+			delete(q.pending, pending)
+		}
+	}
+}
@@ -23,9 +23,17 @@ package deposit
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// pruneMarginBlocks is the number of blocks worth of deposits that are kept
+// below the finalized deposit index, as a safety margin.
+const pruneMarginBlocks = 2
+
+// BuildPruneRangeFn returns the range of deposits that can be pruned once a
+// block is finalized. Only deposits whose inclusion has been observed in a
+// finalized block, that is those below the eth1 deposit index that follows
+// it, are pruned, minus a margin of pruneMarginBlocks full blocks of
+// deposits. Deposits that are merely old, but not yet included, are kept.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT interface {
@@ -34,18 +42,22 @@ func BuildPruneRangeFn[
 	DepositT Deposit[DepositT, WithdrawalCredentialsT],
 	WithdrawalCredentialsT any,
 ](cs common.ChainSpec) func(async.Event[BeaconBlockT]) (uint64, uint64) {
+	margin := pruneMarginBlocks * cs.MaxDepositsPerBlock()
 	return func(event async.Event[BeaconBlockT]) (uint64, uint64) {
 		deposits := event.Data().GetBody().GetDeposits()
-		if len(deposits) == 0 || cs.MaxDepositsPerBlock() == 0 {
+		if len(deposits) == 0 {
 			return 0, 0
 		}
-		index := deposits[len(deposits)-1].GetIndex()
 
-		end := min(index.Unwrap(), cs.MaxDepositsPerBlock())
-		if index < math.U64(cs.MaxDepositsPerBlock()) {
-			return 0, end
+		// Deposits are processed in order, so every deposit up to the last
+		// one of the block has been included by now.
+		finalized := deposits[len(deposits)-1].GetIndex().Unwrap() + 1
+		if finalized <= margin {
+			return 0, 0
 		}
 
-		return index.Unwrap() - cs.MaxDepositsPerBlock(), end
+		// The store only prunes past its watermark, so the range can always
+		// start from the first deposit.
+		return 0, finalized - margin
 	}
 }
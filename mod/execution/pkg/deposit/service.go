@@ -22,8 +22,6 @@ package deposit
 
 import (
 	"context"
-	"maps"
-	"slices"
 	"sync"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
@@ -48,6 +46,11 @@ type Service[
 	dc Contract[DepositT]
 	// ds is the deposit store that stores deposits.
 	ds Store[DepositT]
+	// pending tracks the deposits awaiting inclusion in a finalized block.
+	pending *PendingQueue[DepositT, WithdrawalCredentialsT]
+	// depositIndex reads the next deposit index from the beacon state, which
+	// the pending queue is reconciled against.
+	depositIndex DepositIndexFn
 	// dispatcher is the dispatcher for the service.
 	dispatcher asynctypes.EventDispatcher
 	// subFinalizedBlockEvents is the channel holding BeaconBlockFinalized
@@ -55,11 +58,34 @@ type Service[
 	subFinalizedBlockEvents chan async.Event[BeaconBlockT]
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// mu protects failedBlocks for concurrent access.
+	// mu protects confirmed for concurrent access.
 	mu sync.RWMutex
-	// failedBlocks is a map of blocks that failed to be processed
-	// and should be retried.
-	failedBlocks map[math.U64]struct{}
+	// confirmed is the highest execution block one follow distance behind
+	// a finalized block.
+	confirmed math.U64
+	// fetchSignal wakes the fetch loop when new blocks are confirmed.
+	fetchSignal chan struct{}
+	// cursor tracks the progress of the fetch loop, which is the only
+	// goroutine that accesses it.
+	cursor fetchCursor
+}
+
+// fetchCursor is the position of the fetch loop in the execution chain and
+// in the deposit index sequence.
+type fetchCursor struct {
+	// started is set once the cursor is positioned at the first confirmed
+	// block seen after startup.
+	started bool
+	// next is the next execution block whose deposit logs are read.
+	next math.U64
+	// indexKnown is set once a deposit was read, from which point the
+	// indices of later deposits are checked for gaps.
+	indexKnown bool
+	// nextIndex is the index of the deposit expected next.
+	nextIndex uint64
+	// rewound is set while the confirmation window is re-read after a
+	// reorg was detected.
+	rewound bool
 }
 
 // NewService creates a new instance of the Service struct.
@@ -75,23 +101,29 @@ func NewService[
 	telemetrySink TelemetrySink,
 	ds Store[DepositT],
 	dc Contract[DepositT],
+	depositIndex DepositIndexFn,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
 	BeaconBlockT, BeaconBlockBodyT, DepositT,
 	ExecutionPayloadT, WithdrawalCredentialsT,
 ] {
+	m := newMetrics(telemetrySink)
 	return &Service[
 		BeaconBlockT, BeaconBlockBodyT, DepositT,
 		ExecutionPayloadT, WithdrawalCredentialsT,
 	]{
-		dc:                      dc,
-		dispatcher:              dispatcher,
-		ds:                      ds,
-		eth1FollowDistance:      eth1FollowDistance,
-		failedBlocks:            make(map[math.Slot]struct{}),
+		dc:                 dc,
+		depositIndex:       depositIndex,
+		dispatcher:         dispatcher,
+		ds:                 ds,
+		eth1FollowDistance: eth1FollowDistance,
+		fetchSignal:        make(chan struct{}, 1),
+		pending: NewPendingQueue[DepositT, WithdrawalCredentialsT](
+			ds, m,
+		),
 		subFinalizedBlockEvents: make(chan async.Event[BeaconBlockT]),
 		logger:                  logger,
-		metrics:                 newMetrics(telemetrySink),
+		metrics:                 m,
 	}
 }
 
@@ -108,11 +140,11 @@ func (s *Service[
 		return err
 	}
 
-	// Listen for finalized block events and fetch deposits for the block.
+	// Listen for finalized block events and confirm their execution blocks.
 	go s.eventLoop(ctx)
 
-	// Catchup deposits for failed blocks.
-	go s.depositCatchupFetcher(ctx)
+	// Fetch deposits for confirmed blocks and retry failed fetches.
+	go s.fetchLoop(ctx)
 	return nil
 }
 
@@ -137,27 +169,3 @@ func (s *Service[
 ]) Name() string {
 	return "deposit-handler"
 }
-
-func (s *Service[
-	_, _, _, _, _,
-]) markFailedBlock(blockNum math.U64) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.failedBlocks[blockNum] = struct{}{}
-}
-
-func (s *Service[
-	_, _, _, _, _,
-]) clearFailedBlock(blockNum math.U64) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.failedBlocks, blockNum)
-}
-
-func (s *Service[
-	_, _, _, _, _,
-]) getFailedBlocks() []math.U64 {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	return slices.Collect(maps.Keys(s.failedBlocks))
-}
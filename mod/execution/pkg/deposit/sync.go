@@ -24,75 +24,296 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/retry"
+	"golang.org/x/sync/errgroup"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the interval at which failed fetches are
+	// retried and pending deposits are reported.
+	defaultRetryInterval = 20 * time.Second
+	// fetchRangeSize is the number of blocks whose deposit logs are read in
+	// a single request.
+	fetchRangeSize = 1000
+	// maxConcurrentFetches bounds the number of ranges read at once.
+	maxConcurrentFetches = 4
+	// fetchAttempts is the number of times a range is read before the
+	// fetch is left to the next retry interval, backing off from
+	// fetchBackoff between the attempts.
+	fetchAttempts = 3
+	fetchBackoff  = 500 * time.Millisecond
+)
 
-// depositFetcher returns a function that retrieves the block number from the
-// event and fetches and stores the deposits for that block.
+// fetchPolicy retries the reads of a range failing transiently.
+//
+//nolint:gochecknoglobals // read-only.
+var fetchPolicy = retry.Policy{
+	Initial:     fetchBackoff,
+	Multiplier:  2,
+	Jitter:      0.2,
+	MaxAttempts: fetchAttempts,
+}
+
+// depositFetcher marks the deposits of the finalized block as included and
+// confirms the execution block one follow distance behind its payload.
 func (s *Service[
 	BeaconBlockT, _, _, _, _,
-]) depositFetcher(ctx context.Context, event async.Event[BeaconBlockT]) {
-	blockNum := event.Data().GetBody().GetExecutionPayload().GetNumber()
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+]) depositFetcher(_ context.Context, event async.Event[BeaconBlockT]) {
+	body := event.Data().GetBody()
+	s.reconcilePending(event.Context())
+	s.pending.MarkIncluded(body.GetDeposits())
+
+	blockNum := body.GetExecutionPayload().GetNumber()
+	if blockNum < s.eth1FollowDistance {
+		return
+	}
+	s.confirm(blockNum - s.eth1FollowDistance)
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// reconcilePending rebuilds the pending queue from the deposit store the
+// first time a finalized block is seen after startup.
 func (s *Service[
 	_, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
+]) reconcilePending(ctx context.Context) {
+	if s.pending.Reconciled() {
+		return
+	}
+
+	nextIndex, err := s.depositIndex(ctx)
+	if err != nil {
+		s.logger.Error("Failed to read deposit index", "error", err)
+		return
+	}
+	if err = s.pending.Reconcile(nextIndex); err != nil {
+		s.logger.Error("Failed to reconcile pending deposits", "error", err)
+	}
+}
+
+// confirm raises the highest confirmed execution block and wakes the fetch
+// loop. Signals are coalesced, so a loop that falls behind reads every block
+// confirmed in the meantime in a single pass.
+func (s *Service[
+	_, _, _, _, _,
+]) confirm(blockNum math.U64) {
+	s.mu.Lock()
+	s.confirmed = max(s.confirmed, blockNum)
+	s.mu.Unlock()
+
+	select {
+	case s.fetchSignal <- struct{}{}:
+	default:
+	}
+}
+
+// fetchLoop reads the deposit logs of newly confirmed blocks and retries
+// ranges that failed to be read.
+func (s *Service[
+	_, _, _, _, _,
+]) fetchLoop(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.fetchSignal:
+			s.fetchConfirmed(ctx)
 		case <-ticker.C:
-			failedBlks := s.getFailedBlocks()
-			if len(failedBlks) == 0 {
-				continue
-			}
-			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				failedBlks,
-			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
+			s.logPendingDeposits()
+			if s.cursor.started && s.cursor.next <= s.getConfirmed() {
+				s.logger.Warn(
+					"Deposits of confirmed blocks not yet read, retrying...",
+					"from", s.cursor.next, "to", s.getConfirmed(),
+				)
 			}
+			s.fetchConfirmed(ctx)
 		}
 	}
 }
 
+// fetchConfirmed reads the deposit logs of every confirmed block that has not
+// been read yet and stores the deposits in order. On failure the cursor does
+// not move, so the same blocks are read again on the next attempt.
 func (s *Service[
 	_, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+]) fetchConfirmed(ctx context.Context) {
+	end := s.getConfirmed()
+	if !s.cursor.started {
+		// Blocks confirmed before startup were read by the previous run.
+		s.cursor.next, s.cursor.started = end, true
+	}
+	start := s.cursor.next
+	if start > end {
+		return
+	}
+
+	deposits, err := s.fetchRange(ctx, start, end)
+	if err != nil {
+		s.logger.Error(
+			"Failed to read deposits",
+			"from", start, "to", end, "error", err,
+		)
+		s.metrics.markFailedToGetBlockLogs(start)
+		if errors.Is(err, ErrDepositLogRemoved) {
+			s.rewind(err)
+		}
+		return
+	}
+
+	deposits, nextIndex, err := s.sequence(deposits)
 	if err != nil {
-		s.logger.Error("Failed to read deposits", "error", err)
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.markFailedBlock(blockNum)
+		s.rewind(err)
 		return
 	}
 
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from", start, "to", end, "deposits", len(deposits),
 		)
 	}
 
-	if err = s.ds.EnqueueDeposits(deposits); err != nil {
+	if err = s.pending.Enqueue(deposits); err != nil {
 		s.logger.Error("Failed to store deposits", "error", err)
-		s.markFailedBlock(blockNum)
 		return
 	}
 
-	s.clearFailedBlock(blockNum)
+	s.cursor.next, s.cursor.rewound = end+1, false
+	if len(deposits) > 0 {
+		s.cursor.nextIndex, s.cursor.indexKnown = nextIndex, true
+	}
+}
+
+// fetchRange reads the deposit logs of the blocks from start to end in
+// ranges of fetchRangeSize, up to maxConcurrentFetches at a time, and
+// returns the deposits ordered by block.
+func (s *Service[
+	_, _, DepositT, _, _,
+]) fetchRange(
+	ctx context.Context,
+	start, end math.U64,
+) ([]DepositT, error) {
+	defer s.metrics.measureFetchDuration(time.Now())
+
+	numRanges := int((end-start)/fetchRangeSize + 1)
+	results := make([][]DepositT, numRanges)
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentFetches)
+	for i := range numRanges {
+		from := start + math.U64(i)*fetchRangeSize
+		to := min(from+fetchRangeSize-1, end)
+		g.Go(func() error {
+			if err := fetchPolicy.Do(gCtx, func(ctx context.Context) error {
+				deposits, err := s.dc.ReadDeposits(ctx, from, to)
+				if errors.Is(err, ErrDepositLogRemoved) {
+					// Reading the range again cannot bring the log back.
+					return retry.Permanent(err)
+				} else if err != nil {
+					return err
+				}
+				results[i] = deposits
+				return nil
+			}); err != nil {
+				return errors.Wrapf(
+					err, "reading blocks %d to %d", from, to,
+				)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	deposits := make([]DepositT, 0)
+	for _, result := range results {
+		deposits = append(deposits, result...)
+	}
+	return deposits, nil
+}
+
+// sequence drops the deposits that were already stored and checks that the
+// rest continue the deposit index sequence. It returns the deposits to store
+// and the index of the deposit expected next. A gap that persists after the
+// confirmation window was re-read is logged and accepted, since the missing
+// deposits are older than the window and cannot be recovered from it.
+func (s *Service[
+	_, _, DepositT, _, _,
+]) sequence(deposits []DepositT) ([]DepositT, uint64, error) {
+	var (
+		fresh     = make([]DepositT, 0, len(deposits))
+		nextIndex = s.cursor.nextIndex
+		known     = s.cursor.indexKnown
+	)
+	for _, deposit := range deposits {
+		index := deposit.GetIndex().Unwrap()
+		switch {
+		case known && index < nextIndex:
+			continue
+		case known && index > nextIndex:
+			err := errors.Wrapf(
+				ErrDepositGap, "expected deposit %d, read %d",
+				nextIndex, index,
+			)
+			if !s.cursor.rewound {
+				return nil, 0, err
+			}
+			s.logger.Error(
+				"Deposit gap persists after re-reading confirmed blocks",
+				"error", err,
+			)
+		}
+		fresh = append(fresh, deposit)
+		nextIndex, known = index+1, true
+	}
+	return fresh, nextIndex, nil
+}
+
+// rewind handles a reorg of blocks that were already read: logs removed from
+// a confirmed block, or a gap in the deposit indices showing that the blocks
+// behind the cursor changed after they were read. The cursor moves back by
+// the follow distance, once, so the confirmation window is read again and
+// the deposits already stored are skipped on the second read.
+func (s *Service[
+	_, _, _, _, _,
+]) rewind(err error) {
+	s.metrics.markReorgDetected()
+	if s.cursor.rewound {
+		return
+	}
+
+	from := s.cursor.next - min(s.cursor.next, s.eth1FollowDistance)
+	s.logger.Warn(
+		"Execution reorg detected in deposit logs, re-reading blocks",
+		"from", from, "error", err,
+	)
+	s.cursor.next, s.cursor.rewound = from, true
+}
+
+// getConfirmed returns the highest confirmed execution block.
+func (s *Service[
+	_, _, _, _, _,
+]) getConfirmed() math.U64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.confirmed
+}
+
+// logPendingDeposits reports the backlog of deposits awaiting inclusion in a
+// finalized block.
+func (s *Service[
+	_, _, _, _, _,
+]) logPendingDeposits() {
+	oldest, ok := s.pending.Oldest()
+	if !ok {
+		return
+	}
+	s.logger.Info(
+		"Deposits pending inclusion",
+		"num_pending", s.pending.Len(),
+		"oldest_age", time.Since(oldest).Truncate(time.Second),
+	)
 }
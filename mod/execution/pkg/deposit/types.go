@@ -22,6 +22,7 @@ package deposit
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -56,16 +57,21 @@ type BlockEvent[
 }
 
 // ExecutionPayload is an interface for execution payloads.
+// DepositIndexFn returns the index of the next deposit to be included in the
+// beacon state of the given context.
+type DepositIndexFn func(ctx context.Context) (uint64, error)
+
 type ExecutionPayload interface {
 	GetNumber() math.U64
 }
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits emitted by the deposit contract in
+	// the blocks from start to end, inclusive, ordered by deposit index.
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		start, end math.U64,
 	) ([]DepositT, error)
 }
 
@@ -85,8 +91,11 @@ type Deposit[DepositT, WithdrawalCredentialsT any] interface {
 
 // Store defines the interface for managing deposit operations.
 type Store[DepositT any] interface {
+	// GetDepositsByIndex returns `numView` deposits starting from the given
+	// index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
 	// Prune prunes the deposit store of [start, end)
-	Prune(index uint64, numPrune uint64) error
+	Prune(start, end uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
 }
@@ -96,4 +105,10 @@ type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
 	// keys.
 	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time,
+	// identified by the provided keys.
+	MeasureSince(key string, start time.Time, args ...string)
 }
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
 )
 
@@ -51,6 +52,9 @@ type Engine[
 	logger log.Logger
 	// metrics is the metrics for the engine.
 	metrics *engineMetrics
+	// optimistic tracks the payloads awaiting validation by the execution
+	// client.
+	optimistic *optimisticPayloads
 }
 
 // New creates a new Engine.
@@ -74,9 +78,10 @@ func New[
 		ExecutionPayloadT, PayloadAttributesT, PayloadIDT,
 		WithdrawalsT,
 	]{
-		ec:      engineClient,
-		logger:  logger,
-		metrics: newEngineMetrics(telemtrySink, logger),
+		ec:         engineClient,
+		logger:     logger,
+		metrics:    newEngineMetrics(telemtrySink, logger),
+		optimistic: newOptimisticPayloads(),
 	}
 }
 
@@ -93,6 +98,29 @@ func (ee *Engine[_, _, _, _]) Start(
 	return nil
 }
 
+// OptimisticPayloads returns the number of payloads imported while the
+// execution client was syncing that it has yet to validate, and the number of
+// the latest payload it reported as VALID.
+func (ee *Engine[_, _, _, _]) OptimisticPayloads() (uint64, math.U64) {
+	return ee.optimistic.status()
+}
+
+// ExecutionBlockNumber returns the number of the execution client's latest
+// block.
+func (ee *Engine[_, _, _, _]) ExecutionBlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	return ee.ec.BlockNumber(ctx)
+}
+
+// ExecutionClientVersion returns the identity of the execution client, if
+// it has been identified.
+func (ee *Engine[
+	_, _, _, _,
+]) ExecutionClientVersion() (engineprimitives.ClientVersionV1, bool) {
+	return ee.ec.ClientVersion()
+}
+
 // GetPayload returns the payload and blobs bundle for the given slot.
 func (ee *Engine[
 	ExecutionPayloadT, _, _, _,
@@ -162,6 +190,7 @@ func (ee *Engine[
 		ee.metrics.markForkchoiceUpdateValid(
 			req.State, hasPayloadAttributes, payloadID,
 		)
+		ee.optimistic.markValidHash(req.State.HeadBlockHash)
 	}
 
 	// If we reached here, and we have a nil payload ID, we should log a
@@ -204,12 +233,18 @@ func (ee *Engine[
 		return err
 	}
 
+	executionRequests, err := req.ExecutionRequests.EncodeForEngine()
+	if err != nil {
+		return err
+	}
+
 	// Otherwise we will send the payload to the execution client.
 	lastValidHash, err := ee.ec.NewPayload(
 		ctx,
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		executionRequests,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -229,6 +264,10 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.markOptimistic(
+			req.ExecutionPayload.GetBlockHash(),
+			req.ExecutionPayload.GetNumber(),
+		)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -243,10 +282,16 @@ func (ee *Engine[
 		)
 
 		// We want to return bad block irrespective of
-		// if we are running in optimistic mode or not.
+		// if we are running in optimistic mode or not. The latest valid
+		// hash is surfaced so that descendants of the payload can be
+		// rejected without consulting the execution client again.
 		//
 		// TODO: should we still nillify the error in optimistic mode?
-		return ErrBadBlockProduced
+		return &engineerrors.InvalidPayloadError{
+			BlockHash:       req.ExecutionPayload.GetBlockHash(),
+			LatestValidHash: lastValidHash,
+			Err:             ErrBadBlockProduced,
+		}
 
 	case jsonrpc.IsPreDefinedError(err):
 		// Protect against possible nil value.
@@ -274,6 +319,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.markValid(req.ExecutionPayload.GetNumber())
 	}
 
 	// Under the optimistic condition, we are fine ignoring the error. This
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package engine
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// optimisticPayloads tracks the payloads imported while the execution client
+// was syncing, which have not yet been validated by it. A payload is
+// validated once the execution client reports it, or a descendant, as
+// VALID.
+type optimisticPayloads struct {
+	mu sync.Mutex
+	// pending maps the hashes of the unvalidated payloads to their block
+	// numbers.
+	pending map[common.ExecutionHash]math.U64
+	// latestValid is the number of the latest payload reported as VALID.
+	latestValid math.U64
+}
+
+// newOptimisticPayloads creates a new tracker of optimistic payloads.
+func newOptimisticPayloads() *optimisticPayloads {
+	return &optimisticPayloads{
+		pending: make(map[common.ExecutionHash]math.U64),
+	}
+}
+
+// markOptimistic records a payload the execution client accepted without
+// validating it.
+func (o *optimisticPayloads) markOptimistic(
+	hash common.ExecutionHash, number math.U64,
+) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if number > o.latestValid {
+		o.pending[hash] = number
+	}
+}
+
+// markValid records a payload the execution client reported as VALID,
+// validating its ancestors along with it.
+func (o *optimisticPayloads) markValid(number math.U64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.latestValid = max(o.latestValid, number)
+	for hash, pending := range o.pending {
+		if pending <= o.latestValid {
+			delete(o.pending, hash)
+		}
+	}
+}
+
+// markValidHash records that the execution client reported the payload with
+// the given hash as VALID. Payloads that are not tracked are ignored.
+func (o *optimisticPayloads) markValidHash(hash common.ExecutionHash) {
+	o.mu.Lock()
+	number, found := o.pending[hash]
+	o.mu.Unlock()
+	if found {
+		o.markValid(number)
+	}
+}
+
+// status returns the number of payloads awaiting validation and the number
+// of the latest payload reported as VALID.
+func (o *optimisticPayloads) status() (uint64, math.U64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return uint64(len(o.pending)), o.latestValid
+}
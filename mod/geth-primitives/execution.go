@@ -45,16 +45,23 @@ type (
 	LogsBloom      = coretypes.Bloom
 	Header         = coretypes.Header
 	Receipt        = coretypes.Receipt
+	Signer         = coretypes.Signer
 	Transaction    = coretypes.Transaction
 	Transactions   = coretypes.Transactions
 	Withdrawals    = coretypes.Withdrawals
 )
 
+// ReceiptStatusSuccessful is the status of a receipt of a successful
+// transaction.
+const ReceiptStatusSuccessful = coretypes.ReceiptStatusSuccessful
+
 //nolint:gochecknoglobals // alias.
 var (
-	BlockToExecutableData = engine.BlockToExecutableData
-	NewBlockWithHeader    = coretypes.NewBlockWithHeader
-	DeriveSha             = coretypes.DeriveSha
-	EmptyUncleHash        = coretypes.EmptyUncleHash
-	NewStackTrie          = trie.NewStackTrie
+	BlockToExecutableData  = engine.BlockToExecutableData
+	NewBlockWithHeader     = coretypes.NewBlockWithHeader
+	DeriveSha              = coretypes.DeriveSha
+	EmptyUncleHash         = coretypes.EmptyUncleHash
+	NewStackTrie           = trie.NewStackTrie
+	LatestSignerForChainID = coretypes.LatestSignerForChainID
+	Sender                 = coretypes.Sender
 )
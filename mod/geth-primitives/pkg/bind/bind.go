@@ -30,4 +30,7 @@ type (
 )
 
 //nolint:gochecknoglobals //used an alias.
-var WaitMined = bind.WaitMined
+var (
+	NewKeyedTransactorWithChainID = bind.NewKeyedTransactorWithChainID
+	WaitMined                     = bind.WaitMined
+)
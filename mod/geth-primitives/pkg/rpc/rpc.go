@@ -24,4 +24,12 @@ import "github.com/ethereum/go-ethereum/rpc"
 
 type (
 	BlockNumber = rpc.BlockNumber
+	Client      = rpc.Client
+	HTTPAuth    = rpc.HTTPAuth
+)
+
+//nolint:gochecknoglobals // its okay.
+var (
+	DialOptions  = rpc.DialOptions
+	WithHTTPAuth = rpc.WithHTTPAuth
 )
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AttestationDataAtSlot returns the attestation data for the given slot and
+// committee index.
+//
+// NOTE: Blocks are final as soon as CometBFT commits them, so the justified
+// checkpoint used as source is the boundary of the previous epoch, and the
+// genesis boundary during the first epoch.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttestationDataAtSlot(
+	slot math.Slot, committeeIndex uint64,
+) (*validatortypes.AttestationData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	epoch := b.cs.SlotToEpoch(slot)
+
+	active, err := b.activeValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	perSlot := utils.CommitteeCountPerSlot(
+		uint64(len(active)), b.cs.SlotsPerEpoch(),
+	)
+	if committeeIndex >= perSlot {
+		return nil, errors.Wrapf(
+			types.ErrInvalidRequest,
+			"committee index %d out of range, %d committees per slot",
+			committeeIndex, perSlot,
+		)
+	}
+
+	slotsPerHistoricalRoot := b.cs.SlotsPerHistoricalRoot()
+	blockRoot, err := st.GetBlockRootAtIndex(
+		slot.Unwrap() % slotsPerHistoricalRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	target, err := b.epochBoundaryCheckpoint(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	source := target
+	if epoch > 0 {
+		if source, err = b.epochBoundaryCheckpoint(st, epoch-1); err != nil {
+			return nil, err
+		}
+	}
+	return &validatortypes.AttestationData{
+		Slot:            slot.Unwrap(),
+		Index:           committeeIndex,
+		BeaconBlockRoot: blockRoot,
+		Source:          source,
+		Target:          target,
+	}, nil
+}
+
+// epochBoundaryCheckpoint returns the checkpoint of the block at the start
+// slot of the given epoch.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) epochBoundaryCheckpoint(
+	st BeaconStateT, epoch math.Epoch,
+) (validatortypes.Checkpoint, error) {
+	startSlot := epoch.Unwrap() * b.cs.SlotsPerEpoch()
+	root, err := st.GetBlockRootAtIndex(
+		startSlot % b.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return validatortypes.Checkpoint{}, err
+	}
+	return validatortypes.Checkpoint{Epoch: epoch.Unwrap(), Root: root}, nil
+}
@@ -34,7 +34,7 @@ type Backend[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -47,8 +47,8 @@ type Backend[
 	ContextT context.Context,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	Eth1DataT,
-	ExecutionPayloadHeaderT,
+	Eth1DataT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT any,
 	NodeT Node[ContextT],
 	StateStoreT any,
@@ -64,7 +64,12 @@ type Backend[
 	cs   common.ChainSpec
 	node NodeT
 
-	sp StateProcessor[BeaconStateT]
+	sp StateProcessor[BeaconBlockT, BeaconStateT]
+	pt PerformanceTracker
+	el PayloadBodyFetcher
+	bf BlobFeeTracker
+	bt BlobTxTracker
+	tt TimelinessTracker
 }
 
 // New creates and returns a new Backend instance.
@@ -72,7 +77,7 @@ func New[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
 	],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -85,8 +90,8 @@ func New[
 	ContextT context.Context,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	Eth1DataT,
-	ExecutionPayloadHeaderT,
+	Eth1DataT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT any,
 	NodeT Node[ContextT],
 	StateStoreT any,
@@ -100,7 +105,12 @@ func New[
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
-	sp StateProcessor[BeaconStateT],
+	sp StateProcessor[BeaconBlockT, BeaconStateT],
+	pt PerformanceTracker,
+	el PayloadBodyFetcher,
+	bf BlobFeeTracker,
+	bt BlobTxTracker,
+	tt TimelinessTracker,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
@@ -118,6 +128,11 @@ func New[
 		sb: storageBackend,
 		cs: cs,
 		sp: sp,
+		pt: pt,
+		el: el,
+		bf: bf,
+		bt: bt,
+		tt: tt,
 	}
 }
 
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+)
+
+// BlobFees returns up to limit of the blob market records of the latest
+// finalized blocks, oldest first. All the records kept are returned if limit
+// is zero.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobFees(limit int) []*beacontypes.BlobFeeData {
+	records := b.bf.History(limit)
+	data := make([]*beacontypes.BlobFeeData, 0, len(records))
+	for _, record := range records {
+		data = append(data, &beacontypes.BlobFeeData{
+			Slot:            record.Slot.Unwrap(),
+			BlockNumber:     record.BlockNumber.Unwrap(),
+			BlobGasUsed:     record.BlobGasUsed.Unwrap(),
+			ExcessBlobGas:   record.ExcessBlobGas.Unwrap(),
+			Utilization:     record.Utilization,
+			BlobBaseFee:     record.BlobBaseFee.String(),
+			NextBlobBaseFee: record.NextBlobBaseFee.String(),
+		})
+	}
+	return data
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BlobTransactionsAtSlot returns the blob carrying transactions of the
+// execution payload of the block at the given slot, joined with the indices
+// of their blob sidecars, and whether the sidecars are still stored.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobTransactionsAtSlot(
+	slot math.Slot,
+) ([]*beacontypes.BlobTransactionData, error) {
+	record, ok := b.bt.Lookup(slot)
+	if !ok {
+		return nil, errors.Wrapf(
+			types.ErrNotFound,
+			"blob transactions of slot %d are not known", slot,
+		)
+	}
+
+	data := make(
+		[]*beacontypes.BlobTransactionData, 0, len(record.Transactions),
+	)
+	for _, tx := range record.Transactions {
+		blobs := make([]*beacontypes.BlobTransactionBlob, 0, len(tx.Blobs))
+		for _, blob := range tx.Blobs {
+			storedSlot, storedIndex, stored := b.sb.AvailabilityStore().
+				BlobLocation(blob.VersionedHash)
+			blobs = append(blobs, &beacontypes.BlobTransactionBlob{
+				Index:         blob.Index,
+				VersionedHash: blob.VersionedHash,
+				KZGCommitment: blob.KZGCommitment,
+				Stored: stored && storedSlot == record.Slot &&
+					storedIndex == blob.Index,
+			})
+		}
+		data = append(data, &beacontypes.BlobTransactionData{
+			Index: tx.Index,
+			Hash:  tx.Hash,
+			From:  tx.From,
+			To:    tx.To,
+			Blobs: blobs,
+		})
+	}
+	return data, nil
+}
@@ -56,6 +56,14 @@ func (b Backend[
 	return st.GetBlockRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
+// BlobSidecarsAtSlot returns the blob sidecars stored for the block at the
+// given slot.
+func (b Backend[
+	_, _, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error) {
+	return b.sb.AvailabilityStore().GetBlobSidecars(slot)
+}
+
 // TODO: Implement this.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
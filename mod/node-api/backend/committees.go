@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/shuffle"
+)
+
+// CommitteesAtEpoch returns all beacon committees for the given epoch,
+// computed from the state at the given slot. If no epoch is given, it is
+// inferred from the slot of the state.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) CommitteesAtEpoch(
+	slot math.Slot, requestedEpoch *math.Epoch,
+) ([]*beacontypes.CommitteeData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	epoch := b.cs.SlotToEpoch(slot)
+	if requestedEpoch != nil {
+		epoch = *requestedEpoch
+	}
+
+	active, err := b.activeValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// get_seed(state, epoch, DOMAIN_BEACON_ATTESTER) as defined in the
+	// consensus specs.
+	mix, err := st.GetRandaoMixAtIndex(
+		shuffle.MixIndex(epoch, b.cs.EpochsPerHistoricalVector()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	seed := shuffle.Seed(b.cs.DomainTypeAttester(), epoch, mix)
+
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	perSlot := utils.CommitteeCountPerSlot(uint64(len(active)), slotsPerEpoch)
+	count := perSlot * slotsPerEpoch
+	startSlot := epoch.Unwrap() * slotsPerEpoch
+	committees := make([]*beacontypes.CommitteeData, 0, count)
+	for s := range slotsPerEpoch {
+		for index := range perSlot {
+			committees = append(committees, &beacontypes.CommitteeData{
+				Index: index,
+				Slot:  startSlot + s,
+				Validators: utils.ComputeCommittee(
+					active, seed, s*perSlot+index, count,
+				),
+			})
+		}
+	}
+	return committees, nil
+}
+
+// activeValidatorIndices returns the indices of the validators in the given
+// state that are active at the given epoch.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activeValidatorIndices(
+	st BeaconStateT, epoch math.Epoch,
+) ([]uint64, error) {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	active := make([]uint64, 0, len(validators))
+	for i, val := range validators {
+		if val.IsActive(epoch) {
+			active = append(active, uint64(i))
+		}
+	}
+	return active, nil
+}
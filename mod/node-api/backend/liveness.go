@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/node-api/performance"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ValidatorLiveness returns whether each of the given validators performed
+// its duties in the given epoch, as recorded by the performance tracker.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorLiveness(
+	epoch math.Epoch, indices []math.ValidatorIndex,
+) ([]*validatortypes.ValidatorLiveness, error) {
+	liveness := make([]*validatortypes.ValidatorLiveness, 0, len(indices))
+	for _, index := range indices {
+		isLive, err := b.pt.IsLive(epoch, index)
+		if errors.Is(err, performance.ErrEpochNotTracked) {
+			return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+		liveness = append(liveness, &validatortypes.ValidatorLiveness{
+			Index:  index.Unwrap(),
+			IsLive: isLive,
+		})
+	}
+	return liveness, nil
+}
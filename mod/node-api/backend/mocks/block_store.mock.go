@@ -190,6 +190,55 @@ func (_c *BlockStore_GetSlotByStateRoot_Call[BeaconBlockT]) RunAndReturn(run fun
 	return _c
 }
 
+// GetSummariesInRange provides a mock function with given fields: from, to
+func (_m *BlockStore[BeaconBlockT]) GetSummariesInRange(from math.U64, to math.U64) []common.BlockSummary {
+	ret := _m.Called(from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummariesInRange")
+	}
+
+	var r0 []common.BlockSummary
+	if rf, ok := ret.Get(0).(func(math.U64, math.U64) []common.BlockSummary); ok {
+		r0 = rf(from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.BlockSummary)
+		}
+	}
+
+	return r0
+}
+
+// BlockStore_GetSummariesInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSummariesInRange'
+type BlockStore_GetSummariesInRange_Call[BeaconBlockT any] struct {
+	*mock.Call
+}
+
+// GetSummariesInRange is a helper method to define mock.On call
+//   - from math.U64
+//   - to math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetSummariesInRange(from interface{}, to interface{}) *BlockStore_GetSummariesInRange_Call[BeaconBlockT] {
+	return &BlockStore_GetSummariesInRange_Call[BeaconBlockT]{Call: _e.mock.On("GetSummariesInRange", from, to)}
+}
+
+func (_c *BlockStore_GetSummariesInRange_Call[BeaconBlockT]) Run(run func(from math.U64, to math.U64)) *BlockStore_GetSummariesInRange_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSummariesInRange_Call[BeaconBlockT]) Return(_a0 []common.BlockSummary) *BlockStore_GetSummariesInRange_Call[BeaconBlockT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BlockStore_GetSummariesInRange_Call[BeaconBlockT]) RunAndReturn(run func(math.U64, math.U64) []common.BlockSummary) *BlockStore_GetSummariesInRange_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBlockStore creates a new instance of BlockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlockStore[BeaconBlockT any](t interface {
@@ -169,6 +169,61 @@ func (_c *DepositStore_Prune_Call[DepositT]) RunAndReturn(run func(uint64, uint6
 	return _c
 }
 
+// PruneWatermark provides a mock function with given fields:
+func (_m *DepositStore[DepositT]) PruneWatermark() (uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PruneWatermark")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepositStore_PruneWatermark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PruneWatermark'
+type DepositStore_PruneWatermark_Call[DepositT any] struct {
+	*mock.Call
+}
+
+// PruneWatermark is a helper method to define mock.On call
+func (_e *DepositStore_Expecter[DepositT]) PruneWatermark() *DepositStore_PruneWatermark_Call[DepositT] {
+	return &DepositStore_PruneWatermark_Call[DepositT]{Call: _e.mock.On("PruneWatermark")}
+}
+
+func (_c *DepositStore_PruneWatermark_Call[DepositT]) Run(run func()) *DepositStore_PruneWatermark_Call[DepositT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DepositStore_PruneWatermark_Call[DepositT]) Return(_a0 uint64, _a1 error) *DepositStore_PruneWatermark_Call[DepositT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepositStore_PruneWatermark_Call[DepositT]) RunAndReturn(run func() (uint64, error)) *DepositStore_PruneWatermark_Call[DepositT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDepositStore creates a new instance of DepositStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDepositStore[DepositT any](t interface {
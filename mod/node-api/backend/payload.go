@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ExecutionPayloadAtSlot reconstructs the execution payload of the block at
+// the given slot. The beacon state only keeps the payload header, so the body
+// is fetched from the execution client and checked against the header roots.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, ExecutionPayloadHeaderT, _, _, _, _, _,
+	_, _, _,
+]) ExecutionPayloadAtSlot(
+	slot math.Slot,
+) (*beacontypes.ExecutionPayloadData, error) {
+	st, _, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := header.GetBlockHash()
+	bodies, err := b.el.GetPayloadBodiesByHash(
+		context.Background(), []common.ExecutionHash{blockHash},
+	)
+	if err != nil {
+		return nil, err
+	}
+	body := bodies[0]
+	if body == nil {
+		return nil, errors.Wrapf(
+			types.ErrNotFound,
+			"execution client has no body for block %s", blockHash,
+		)
+	}
+	if err = b.verifyPayloadBody(header, body); err != nil {
+		return nil, err
+	}
+
+	return &beacontypes.ExecutionPayloadData{
+		Header:       header,
+		Transactions: body.Transactions,
+		Withdrawals:  body.Withdrawals,
+	}, nil
+}
+
+// verifyPayloadBody checks that the transactions and withdrawals of the body
+// hash to the roots committed to in the header.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, ExecutionPayloadHeaderT, _, _, _, _, _,
+	_, _, _,
+]) verifyPayloadBody(
+	header ExecutionPayloadHeaderT,
+	body *engineprimitives.ExecutionPayloadBodyV1,
+) error {
+	// bArtio commits to its transactions with a different hashing scheme.
+	const bArtioChainID = 80084
+
+	txs := make([][]byte, len(body.Transactions))
+	for i, tx := range body.Transactions {
+		txs[i] = tx
+	}
+	txsRoot := engineprimitives.Transactions(txs).HashTreeRoot()
+	if b.cs.DepositEth1ChainID() == bArtioChainID {
+		txsRoot = engineprimitives.BartioTransactions(txs).HashTreeRoot()
+	}
+
+	switch {
+	case txsRoot != header.GetTransactionsRoot():
+		return errors.Wrap(ErrPayloadBodyMismatch, "transactions root")
+	case engineprimitives.Withdrawals(body.Withdrawals).HashTreeRoot() !=
+		header.GetWithdrawalsRoot():
+		return errors.Wrap(ErrPayloadBodyMismatch, "withdrawals root")
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// SimulateBlock decodes the given SSZ block, as a block of the fork active at
+// its slot unless the options name another, and runs the state transition for
+// it on top of the state at the given slot. The state is read through a
+// cached query context, so nothing the transition writes is persisted.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SimulateBlock(
+	slot math.Slot, blockSSZ []byte, opts *admintypes.SimulationOptions,
+) (*admintypes.SimulationResult, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	forkVersion := b.cs.ActiveForkVersionForSlot(slot + 1)
+	if opts.ForkVersion != nil {
+		forkVersion = *opts.ForkVersion
+	}
+	var blk BeaconBlockT
+	blk, err = blk.NewFromSSZ(blockSSZ, forkVersion)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	result := &admintypes.SimulationResult{
+		ValidatorUpdates: make([]*admintypes.ValidatorUpdate, 0),
+	}
+	updates, err := b.sp.Transition(
+		&transition.Context{
+			Context:                 context.Background(),
+			SkipPayloadVerification: opts.SkipPayloadVerification,
+			SkipValidateRandao:      opts.SkipValidateRandao,
+			SkipValidateResult:      opts.SkipValidateResult,
+		},
+		st, blk,
+	)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.StateRoot = st.HashTreeRoot()
+	for _, update := range updates.CanonicalSort() {
+		result.ValidatorUpdates = append(
+			result.ValidatorUpdates, &admintypes.ValidatorUpdate{
+				Pubkey:           update.Pubkey,
+				EffectiveBalance: update.EffectiveBalance.Unwrap(),
+			},
+		)
+	}
+	return result, nil
+}
@@ -34,6 +34,18 @@ func (b *Backend[
 	return b.stateFromSlotRaw(slot)
 }
 
+// ForkVersionAtSlot returns the version of the fork active at the given
+// slot, which determines the schema of the blocks and states of the slot.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ForkVersionAtSlot(slot math.Slot) (uint32, error) {
+	_, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return 0, err
+	}
+	return b.cs.ActiveForkVersionForSlot(slot), nil
+}
+
 // GetStateRoot returns the root of the state at the given slot.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+)
+
+// ProposerTimeliness returns the timeliness of the blocks of every proposer
+// observed by this node, ordered by validator index.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProposerTimeliness() []*beacontypes.ProposerTimelinessData {
+	records := b.tt.Records()
+	data := make([]*beacontypes.ProposerTimelinessData, 0, len(records))
+	for _, record := range records {
+		var avg int64
+		if record.Blocks > 0 {
+			//#nosec:G701 // won't overflow in practice.
+			avg = record.TotalLateness.Milliseconds() / int64(record.Blocks)
+		}
+		data = append(data, &beacontypes.ProposerTimelinessData{
+			ValidatorIndex: record.ValidatorIndex.Unwrap(),
+			Blocks:         record.Blocks,
+			LateBlocks:     record.LateBlocks,
+			LateStreak:     record.LateStreak,
+			AvgLatenessMs:  avg,
+			MaxLatenessMs:  record.MaxLateness.Milliseconds(),
+			LastLatenessMs: record.LastLateness.Milliseconds(),
+			LastSlot:       record.LastSlot.Unwrap(),
+		})
+	}
+	return data
+}
@@ -23,6 +23,10 @@ package backend
 import (
 	"context"
 
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/blobfees"
+	"github.com/berachain/beacon-kit/mod/node-api/blobtxs"
+	"github.com/berachain/beacon-kit/mod/node-api/timeliness"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -34,6 +38,11 @@ import (
 // sidecars for specific blocks, as well as verifying sidecars that have already
 // been stored.
 type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	// BlobLocation returns the slot and the index in its block of the stored
+	// blob with the given versioned hash, and whether it is stored.
+	BlobLocation(common.ExecutionHash) (math.Slot, uint64, bool)
+	// GetBlobSidecars returns the sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 	// IsDataAvailable ensures that all blobs referenced in the block are
 	// securely stored before it returns without an error.
 	IsDataAvailable(
@@ -44,6 +53,12 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	Persist(math.Slot, BlobSidecarsT) error
 }
 
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock[BeaconBlockT any] interface {
+	// NewFromSSZ decodes a beacon block of the given fork version.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+}
+
 // BeaconBlockHeader is the interface for a beacon block header.
 type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
 	constraints.SSZMarshallableRootable
@@ -69,6 +84,10 @@ type BeaconState[
 ] interface {
 	// SetSlot sets the slot on the beacon state.
 	SetSlot(math.Slot) error
+	// HashTreeRoot returns the hash tree root of the beacon state.
+	HashTreeRoot() common.Root
+	// GetBalances returns the balances of all validators.
+	GetBalances() ([]uint64, error)
 
 	core.ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -84,6 +103,9 @@ type BlockStore[BeaconBlockT any] interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number.
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	// GetSummariesInRange returns the summaries of the blocks stored in the
+	// given range of slots, both included.
+	GetSummariesInRange(from, to math.Slot) []common.BlockSummary
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -92,10 +114,23 @@ type DepositStore[DepositT any] interface {
 	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
 	// Prune prunes the deposit store of [start, end)
 	Prune(start, end uint64) error
+	// PruneWatermark returns the index below which all deposits have been
+	// pruned.
+	PruneWatermark() (uint64, error)
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
 }
 
+// ExecutionPayloadHeader is the interface for an execution payload header.
+type ExecutionPayloadHeader interface {
+	// GetBlockHash returns the hash of the execution block.
+	GetBlockHash() common.ExecutionHash
+	// GetTransactionsRoot returns the root of the payload transactions.
+	GetTransactionsRoot() common.Root
+	// GetWithdrawalsRoot returns the root of the payload withdrawals.
+	GetWithdrawalsRoot() common.Root
+}
+
 // Node is the interface for a node.
 type Node[ContextT any] interface {
 	// CreateQueryContext creates a query context for a given height and proof
@@ -103,8 +138,50 @@ type Node[ContextT any] interface {
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
 }
 
-type StateProcessor[BeaconStateT any] interface {
+// PayloadBodyFetcher fetches execution payload bodies from the execution
+// client.
+type PayloadBodyFetcher interface {
+	// GetPayloadBodiesByHash returns the bodies of the payloads with the
+	// given block hashes, nil for the ones the execution client lacks.
+	GetPayloadBodiesByHash(
+		ctx context.Context, hashes []common.ExecutionHash,
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
+}
+
+// BlobFeeTracker records the blob market data of recent finalized blocks.
+type BlobFeeTracker interface {
+	// History returns up to limit of the latest records, oldest first.
+	History(limit int) []*blobfees.Record
+}
+
+// BlobTxTracker links the blob transactions of recent finalized blocks to
+// their blob sidecars.
+type BlobTxTracker interface {
+	// Lookup returns the record of the block at the given slot, and whether
+	// the blob transactions of that slot are known.
+	Lookup(slot math.Slot) (*blobtxs.Record, bool)
+}
+
+// TimelinessTracker records how late the blocks of every proposer arrive.
+type TimelinessTracker interface {
+	// Records returns the record of every proposer observed, ordered by
+	// validator index.
+	Records() []*timeliness.Record
+}
+
+// PerformanceTracker records which validators performed their duties in
+// recent epochs.
+type PerformanceTracker interface {
+	// IsLive returns whether the validator with the given index performed a
+	// duty in the given epoch.
+	IsLive(epoch math.Epoch, index math.ValidatorIndex) (bool, error)
+}
+
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+	Transition(
+		*transition.Context, BeaconStateT, BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -121,15 +198,29 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch from which the validator can
+	// withdraw.
+	GetWithdrawableEpoch() math.Epoch
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// IsActive checks if the validator is active at the given epoch.
+	IsActive(epoch math.Epoch) bool
 	// IsFullyWithdrawable checks if the validator is fully withdrawable given a
 	// certain Gwei amount and epoch.
 	IsFullyWithdrawable(amount math.Gwei, epoch math.Epoch) bool
 	// IsPartiallyWithdrawable checks if the validator is partially withdrawable
 	// given two Gwei amounts.
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
 }
 
 // Withdrawal represents an interface for a withdrawal.
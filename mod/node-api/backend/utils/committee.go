@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/shuffle"
+
+const (
+	// ShuffleRoundCount is the number of rounds used by the swap-or-not
+	// shuffle when computing committees.
+	ShuffleRoundCount = shuffle.RoundCount
+	// TargetCommitteeSize is the desired number of validators per committee.
+	TargetCommitteeSize = 128
+	// MaxCommitteesPerSlot is the upper bound of committees in a single slot.
+	MaxCommitteesPerSlot = 64
+	// MinSeedLookahead is the number of epochs the seed is looked up ahead.
+	MinSeedLookahead = shuffle.MinSeedLookahead
+)
+
+// ComputeShuffledIndex returns the shuffled index of `index` in a list of
+// `indexCount` elements, using the swap-or-not shuffle as defined in the
+// consensus specs.
+func ComputeShuffledIndex(index, indexCount uint64, seed [32]byte) uint64 {
+	return shuffle.ComputeShuffledIndex(index, indexCount, seed)
+}
+
+// ComputeCommittee returns the committee at `index` out of `count` committees
+// for the given list of active validator indices and seed.
+func ComputeCommittee(
+	indices []uint64, seed [32]byte, index, count uint64,
+) []uint64 {
+	total := uint64(len(indices))
+	start := total * index / count
+	end := total * (index + 1) / count
+	committee := make([]uint64, 0, end-start)
+	for i := start; i < end; i++ {
+		committee = append(
+			committee, indices[ComputeShuffledIndex(i, total, seed)],
+		)
+	}
+	return committee
+}
+
+// CommitteeCountPerSlot returns the number of committees in each slot for
+// an epoch with the given number of active validators.
+func CommitteeCountPerSlot(activeCount, slotsPerEpoch uint64) uint64 {
+	return max(1, min(
+		MaxCommitteesPerSlot,
+		activeCount/slotsPerEpoch/TargetCommitteeSize,
+	))
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCommitteePartitionsIndices(t *testing.T) {
+	var (
+		seed    = [32]byte{0x01, 0x02, 0x03}
+		indices = make([]uint64, 300)
+		count   = uint64(7)
+		seen    = make(map[uint64]int, len(indices))
+	)
+	for i := range indices {
+		indices[i] = uint64(i) * 2
+	}
+
+	for index := range count {
+		for _, v := range utils.ComputeCommittee(indices, seed, index, count) {
+			seen[v]++
+		}
+	}
+
+	require.Len(t, seen, len(indices))
+	for _, v := range indices {
+		require.Equal(t, 1, seen[v])
+	}
+}
+
+func TestComputeShuffledIndexIsPermutation(t *testing.T) {
+	var (
+		seed  = [32]byte{0xff}
+		total = uint64(100)
+		seen  = make(map[uint64]struct{}, total)
+	)
+	for i := range total {
+		shuffled := utils.ComputeShuffledIndex(i, total, seed)
+		require.Less(t, shuffled, total)
+		seen[shuffled] = struct{}{}
+	}
+	require.Len(t, seen, int(total))
+}
+
+func TestCommitteeCountPerSlot(t *testing.T) {
+	require.Equal(t, uint64(1), utils.CommitteeCountPerSlot(0, 32))
+	require.Equal(t, uint64(2), utils.CommitteeCountPerSlot(2*32*128, 32))
+	require.Equal(
+		t,
+		uint64(utils.MaxCommitteesPerSlot),
+		utils.CommitteeCountPerSlot(1<<30, 32),
+	)
+}
@@ -22,7 +22,9 @@ package utils
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -44,3 +46,95 @@ func ValidatorIndexByID[
 	}
 	return st.ValidatorIndexByPubkey(key)
 }
+
+// ValidatorIndicesByIDs resolves the given validator indices or pubkeys to
+// validator indices, dropping duplicates while keeping the order of the IDs.
+func ValidatorIndicesByIDs[
+	BeaconStateT interface {
+		ValidatorIndexByPubkey(key crypto.BLSPubkey) (math.U64, error)
+	},
+](st BeaconStateT, ids []string) ([]math.U64, error) {
+	var (
+		indices = make([]math.U64, 0, len(ids))
+		seen    = make(map[math.U64]struct{}, len(ids))
+	)
+	for _, id := range ids {
+		index, err := ValidatorIndexByID(st, id)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[index]; ok {
+			continue
+		}
+		seen[index] = struct{}{}
+		indices = append(indices, index)
+	}
+	return indices, nil
+}
+
+// Validator statuses defined by the Beacon Node API.
+const (
+	StatusPendingInitialized = "pending_initialized"
+	StatusPendingQueued      = "pending_queued"
+	StatusActiveOngoing      = "active_ongoing"
+	StatusActiveExiting      = "active_exiting"
+	StatusActiveSlashed      = "active_slashed"
+	StatusExitedUnslashed    = "exited_unslashed"
+	StatusExitedSlashed      = "exited_slashed"
+	StatusWithdrawalPossible = "withdrawal_possible"
+	StatusWithdrawalDone     = "withdrawal_done"
+)
+
+// ValidatorStatus returns the status of a validator with the given balance
+// at the given epoch, as defined by the Beacon Node API.
+func ValidatorStatus[
+	ValidatorT interface {
+		GetActivationEligibilityEpoch() math.Epoch
+		GetActivationEpoch() math.Epoch
+		GetExitEpoch() math.Epoch
+		GetWithdrawableEpoch() math.Epoch
+		IsSlashed() bool
+	},
+](validator ValidatorT, balance math.Gwei, epoch math.Epoch) string {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < validator.GetActivationEpoch():
+		if validator.GetActivationEligibilityEpoch() == farFutureEpoch {
+			return StatusPendingInitialized
+		}
+		return StatusPendingQueued
+	case epoch < validator.GetExitEpoch():
+		if validator.GetExitEpoch() == farFutureEpoch {
+			return StatusActiveOngoing
+		}
+		if validator.IsSlashed() {
+			return StatusActiveSlashed
+		}
+		return StatusActiveExiting
+	case epoch < validator.GetWithdrawableEpoch():
+		if validator.IsSlashed() {
+			return StatusExitedSlashed
+		}
+		return StatusExitedUnslashed
+	case balance != 0:
+		return StatusWithdrawalPossible
+	default:
+		return StatusWithdrawalDone
+	}
+}
+
+// MatchesStatuses reports whether the given validator status is one of the
+// given statuses, which may also name the generic pending, active, exited
+// and withdrawal statuses. Every status matches an empty list.
+func MatchesStatuses(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	generic, _, _ := strings.Cut(status, "_")
+	for _, s := range statuses {
+		if s == status || s == generic {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+const farFuture = math.Epoch(constants.FarFutureEpoch)
+
+type testValidator struct {
+	eligibility, activation, exit, withdrawable math.Epoch
+	slashed                                     bool
+}
+
+func (v testValidator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.eligibility
+}
+
+func (v testValidator) GetActivationEpoch() math.Epoch { return v.activation }
+
+func (v testValidator) GetExitEpoch() math.Epoch { return v.exit }
+
+func (v testValidator) GetWithdrawableEpoch() math.Epoch {
+	return v.withdrawable
+}
+
+func (v testValidator) IsSlashed() bool { return v.slashed }
+
+func TestValidatorStatus(t *testing.T) {
+	exiting := testValidator{
+		eligibility: 1, activation: 2, exit: 20, withdrawable: 30,
+	}
+	slashed := exiting
+	slashed.slashed = true
+
+	tests := []struct {
+		name      string
+		validator testValidator
+		balance   math.Gwei
+		epoch     math.Epoch
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: testValidator{
+				eligibility: farFuture, activation: farFuture,
+				exit: farFuture, withdrawable: farFuture,
+			},
+			want: utils.StatusPendingInitialized,
+		},
+		{
+			name: "pending queued",
+			validator: testValidator{
+				eligibility: 1, activation: farFuture,
+				exit: farFuture, withdrawable: farFuture,
+			},
+			epoch: 5,
+			want:  utils.StatusPendingQueued,
+		},
+		{
+			name: "active ongoing",
+			validator: testValidator{
+				eligibility: 1, activation: 2,
+				exit: farFuture, withdrawable: farFuture,
+			},
+			epoch: 2,
+			want:  utils.StatusActiveOngoing,
+		},
+		{
+			name:      "active exiting",
+			validator: exiting,
+			epoch:     19,
+			want:      utils.StatusActiveExiting,
+		},
+		{
+			name:      "active slashed",
+			validator: slashed,
+			epoch:     10,
+			want:      utils.StatusActiveSlashed,
+		},
+		{
+			name:      "exited unslashed",
+			validator: exiting,
+			epoch:     20,
+			want:      utils.StatusExitedUnslashed,
+		},
+		{
+			name:      "exited slashed",
+			validator: slashed,
+			epoch:     29,
+			want:      utils.StatusExitedSlashed,
+		},
+		{
+			name:      "withdrawal possible",
+			validator: exiting,
+			balance:   32e9,
+			epoch:     30,
+			want:      utils.StatusWithdrawalPossible,
+		},
+		{
+			name:      "withdrawal done",
+			validator: slashed,
+			epoch:     40,
+			want:      utils.StatusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want,
+				utils.ValidatorStatus(tt.validator, tt.balance, tt.epoch),
+			)
+		})
+	}
+}
+
+func TestMatchesStatuses(t *testing.T) {
+	require.True(t, utils.MatchesStatuses(utils.StatusActiveOngoing, nil))
+	require.True(t, utils.MatchesStatuses(
+		utils.StatusActiveOngoing,
+		[]string{utils.StatusPendingQueued, utils.StatusActiveOngoing},
+	))
+	require.True(t, utils.MatchesStatuses(
+		utils.StatusActiveSlashed, []string{"active"},
+	))
+	require.True(t, utils.MatchesStatuses(
+		utils.StatusWithdrawalDone, []string{"withdrawal"},
+	))
+	require.False(t, utils.MatchesStatuses(
+		utils.StatusExitedSlashed, []string{"active", "pending"},
+	))
+	require.False(t, utils.MatchesStatuses(
+		utils.StatusActiveExiting, []string{utils.StatusActiveOngoing},
+	))
+}
@@ -21,8 +21,13 @@
 package backend
 
 import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -34,14 +39,91 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return b.validatorByID(st, id, b.cs.SlotToEpoch(slot))
+}
+
+// bulkLookupThreshold is the number of requested validators above which the
+// whole registry and the balances are read at once, which is cheaper than
+// reading every validator and balance on its own.
+const bulkLookupThreshold = 64
+
+// ValidatorsByIDs returns the validators with the given indices or pubkeys in
+// the state at the given slot, or every validator if no ID is given, keeping
+// only those with one of the given statuses if any. Duplicate IDs are
+// returned once.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorsByIDs(
+	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData[ValidatorT], error) {
+	// The state is resolved once for all IDs; each pubkey is then looked
+	// up through the persisted pubkey index rather than by scanning the
+	// registry.
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := utils.ValidatorIndicesByIDs(st, ids)
 	if err != nil {
 		return nil, err
 	}
+
+	var (
+		epoch          = b.cs.SlotToEpoch(slot)
+		validatorsData []*beacontypes.ValidatorData[ValidatorT]
+	)
+	if len(ids) == 0 || len(indices) > bulkLookupThreshold {
+		validatorsData, err = b.validatorsInBulk(st, indices, epoch)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		validatorsData = make(
+			[]*beacontypes.ValidatorData[ValidatorT], 0, len(indices),
+		)
+		for _, index := range indices {
+			validatorData, vErr := b.validatorByIndex(st, index, epoch)
+			if vErr != nil {
+				return nil, vErr
+			}
+			validatorsData = append(validatorsData, validatorData)
+		}
+	}
+	return slices.DeleteFunc(
+		validatorsData,
+		func(v *beacontypes.ValidatorData[ValidatorT]) bool {
+			return !utils.MatchesStatuses(v.Status, statuses)
+		},
+	), nil
+}
+
+// validatorByID returns the validator with the given index or pubkey in the
+// given state, with its status at the given epoch.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _,
+]) validatorByID(
+	st BeaconStateT, id string, epoch math.Epoch,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
 	index, err := utils.ValidatorIndexByID(st, id)
 	if err != nil {
 		return nil, err
 	}
+	return b.validatorByIndex(st, index, epoch)
+}
+
+// validatorByIndex returns the validator with the given index in the given
+// state, with its status at the given epoch.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _,
+]) validatorByIndex(
+	st BeaconStateT, index math.ValidatorIndex, epoch math.Epoch,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
 	validator, err := st.ValidatorByIndex(index)
 	if err != nil {
 		return nil, err
@@ -55,51 +137,100 @@ func (b Backend[
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status:    utils.ValidatorStatus(validator, balance, epoch),
 		Validator: validator,
 	}, nil
 }
 
-// TODO: filter by status
+// validatorsInBulk returns the validators with the given indices, or every
+// validator if there are none, with their statuses at the given epoch,
+// reading the registry and the balances once.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
-]) ValidatorsByIDs(
-	slot math.Slot, ids []string, _ []string,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _,
+]) validatorsInBulk(
+	st BeaconStateT, indices []math.ValidatorIndex, epoch math.Epoch,
 ) ([]*beacontypes.ValidatorData[ValidatorT], error) {
-	validatorsData := make([]*beacontypes.ValidatorData[ValidatorT], 0)
-	for _, id := range ids {
-		// TODO: we can probably optimize this via a getAllValidators
-		// query and then filtering but blocked by the fact that IDs
-		// can be indices and the hard type only holds its own pubkey.
-		validatorData, err := b.ValidatorByID(slot, id)
-		if err != nil {
-			return nil, err
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		indices = allValidatorIndices(len(validators))
+	}
+
+	validatorsData := make(
+		[]*beacontypes.ValidatorData[ValidatorT], 0, len(indices),
+	)
+	for _, index := range indices {
+		if index.Unwrap() >= uint64(len(validators)) ||
+			index.Unwrap() >= uint64(len(balances)) {
+			return nil, errors.Wrapf(ErrUnknownValidator, "index %d", index)
 		}
-		validatorsData = append(validatorsData, validatorData)
+		validatorsData = append(
+			validatorsData, &beacontypes.ValidatorData[ValidatorT]{
+				ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+					Index:   index.Unwrap(),
+					Balance: balances[index],
+				},
+				Status: utils.ValidatorStatus(
+					validators[index], math.Gwei(balances[index]), epoch,
+				),
+				Validator: validators[index],
+			},
+		)
 	}
 	return validatorsData, nil
 }
 
+// ValidatorBalancesByIDs returns the balances of the validators with the
+// given indices or pubkeys in the state at the given slot, or of every
+// validator if no ID is given. Duplicate IDs are returned once.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ValidatorBalancesByIDs(
 	slot math.Slot, ids []string,
 ) ([]*beacontypes.ValidatorBalanceData, error) {
-	var index math.U64
 	st, _, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	balances := make([]*beacontypes.ValidatorBalanceData, 0)
-	for _, id := range ids {
-		index, err = utils.ValidatorIndexByID(st, id)
-		if err != nil {
+	indices, err := utils.ValidatorIndicesByIDs(st, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*beacontypes.ValidatorBalanceData, 0, len(indices))
+	if len(ids) == 0 || len(indices) > bulkLookupThreshold {
+		var all []uint64
+		if all, err = st.GetBalances(); err != nil {
 			return nil, err
 		}
-		var balance math.U64
+		if len(indices) == 0 {
+			indices = allValidatorIndices(len(all))
+		}
+		for _, index := range indices {
+			if index.Unwrap() >= uint64(len(all)) {
+				return nil, errors.Wrapf(
+					ErrUnknownValidator, "index %d", index,
+				)
+			}
+			balances = append(balances, &beacontypes.ValidatorBalanceData{
+				Index:   index.Unwrap(),
+				Balance: all[index],
+			})
+		}
+		return balances, nil
+	}
+
+	for _, index := range indices {
+		var balance math.Gwei
 		// TODO: same issue as above, shouldn't error on not found.
-		balance, err = st.GetBalance(index)
-		if err != nil {
+		if balance, err = st.GetBalance(index); err != nil {
 			return nil, err
 		}
 		balances = append(balances, &beacontypes.ValidatorBalanceData{
@@ -109,3 +240,91 @@ func (b Backend[
 	}
 	return balances, nil
 }
+
+// allValidatorIndices returns the indices of a registry of the given size.
+func allValidatorIndices(size int) []math.ValidatorIndex {
+	indices := make([]math.ValidatorIndex, size)
+	for i := range indices {
+		//#nosec:G701 // won't overflow in practice.
+		indices[i] = math.ValidatorIndex(i)
+	}
+	return indices
+}
+
+// ValidatorQueue returns the activation and exit queues of the state at the
+// given slot. Activation epochs of queued validators are estimated assuming
+// the activation churn limit stays constant.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorQueue(
+	slot math.Slot,
+) (*beacontypes.ValidatorQueueData, error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		epoch          = b.cs.SlotToEpoch(slot)
+		farFutureEpoch = math.Epoch(constants.FarFutureEpoch)
+		activeCount    uint64
+		activations    []*beacontypes.ValidatorQueueEntry
+		exits          []*beacontypes.ValidatorQueueEntry
+	)
+	for i, val := range validators {
+		if val.IsActive(epoch) {
+			activeCount++
+		}
+		if val.GetActivationEpoch() == farFutureEpoch &&
+			val.GetActivationEligibilityEpoch() != farFutureEpoch {
+			activations = append(activations, &beacontypes.ValidatorQueueEntry{
+				Index: uint64(i),
+				Epoch: val.GetActivationEligibilityEpoch().Unwrap(),
+			})
+		}
+		if exitEpoch := val.GetExitEpoch(); exitEpoch != farFutureEpoch &&
+			exitEpoch > epoch {
+			exits = append(exits, &beacontypes.ValidatorQueueEntry{
+				Index: uint64(i),
+				Epoch: exitEpoch.Unwrap(),
+			})
+		}
+	}
+
+	// Both queues are ordered by epoch and then by validator index, matching
+	// the order in which the state processor dequeues them.
+	byEpoch := func(a, b *beacontypes.ValidatorQueueEntry) int {
+		return cmp.Compare(a.Epoch, b.Epoch)
+	}
+	slices.SortStableFunc(activations, byEpoch)
+	slices.SortStableFunc(exits, byEpoch)
+
+	activationChurn := b.cs.ActivationChurnLimit(activeCount)
+	for i, entry := range activations {
+		//#nosec:G701 // won't overflow in practice.
+		position := uint64(i)
+		// The queued validator is dequeued once it is eligible and enough
+		// churn is available, and activates after the seed lookahead.
+		dequeueEpoch := max(
+			epoch+math.Epoch(position/max(activationChurn, 1)),
+			math.Epoch(entry.Epoch),
+		)
+		entry.Position = position
+		entry.Epoch = b.cs.ActivationExitEpoch(dequeueEpoch).Unwrap()
+	}
+	for i, entry := range exits {
+		//#nosec:G701 // won't overflow in practice.
+		entry.Position = uint64(i)
+	}
+
+	return &beacontypes.ValidatorQueueData{
+		ActivationChurnLimit: activationChurn,
+		ExitChurnLimit:       b.cs.ValidatorChurnLimit(activeCount),
+		ActivationQueue:      activations,
+		ExitQueue:            exits,
+	}, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobfees
+
+import "math/big"
+
+const (
+	// GasPerBlob is the blob gas consumed by a single blob.
+	GasPerBlob = 1 << 17
+	// minBlobBaseFee is the lowest blob base fee, in wei.
+	minBlobBaseFee = 1
+	// blobBaseFeeUpdateFraction controls how quickly the blob base fee
+	// reacts to the excess blob gas.
+	blobBaseFeeUpdateFraction = 3338477
+)
+
+// BlobBaseFee returns the blob base fee, in wei, of a block with the given
+// excess blob gas, as specified by EIP-4844.
+func BlobBaseFee(excessBlobGas uint64) *big.Int {
+	return fakeExponential(
+		big.NewInt(minBlobBaseFee),
+		new(big.Int).SetUint64(excessBlobGas),
+		big.NewInt(blobBaseFeeUpdateFraction),
+	)
+}
+
+// NextExcessBlobGas returns the excess blob gas of the block following a
+// block with the given excess and used blob gas.
+func NextExcessBlobGas(excessBlobGas, blobGasUsed, target uint64) uint64 {
+	if excessBlobGas+blobGasUsed < target {
+		return 0
+	}
+	return excessBlobGas + blobGasUsed - target
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// Taylor expansion.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	var (
+		output  = new(big.Int)
+		accum   = new(big.Int).Mul(factor, denominator)
+		divisor = new(big.Int)
+	)
+	for i := int64(1); accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+		accum.Mul(accum, numerator)
+		accum.Div(accum, divisor.Mul(denominator, big.NewInt(i)))
+	}
+	return output.Div(output, denominator)
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobfees
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DefaultHistorySize is the number of blocks the tracker keeps by default.
+const DefaultHistorySize = 256
+
+// Record is the blob market data of a finalized block.
+type Record struct {
+	// Slot is the slot of the beacon block.
+	Slot math.Slot
+	// BlockNumber is the number of the execution block.
+	BlockNumber math.U64
+	// BlobGasUsed is the blob gas used by the execution block.
+	BlobGasUsed math.U64
+	// ExcessBlobGas is the excess blob gas of the execution block.
+	ExcessBlobGas math.U64
+	// Utilization is the share of the maximum blob gas per block used.
+	Utilization float64
+	// BlobBaseFee is the blob base fee paid in the execution block.
+	BlobBaseFee *big.Int
+	// NextBlobBaseFee is the blob base fee of the next execution block.
+	NextBlobBaseFee *big.Int
+}
+
+// Tracker records the blob gas usage and blob base fee of finalized blocks,
+// exporting them as metrics and keeping a bounded history of them.
+type Tracker[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec provides the maximum number of blobs per block.
+	chainSpec common.ChainSpec
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// size is the maximum number of records kept.
+	size int
+
+	// mu protects history.
+	mu sync.RWMutex
+	// history holds the latest records, oldest first.
+	history []*Record
+}
+
+// NewTracker creates a new blob fee tracker keeping the given number of
+// records.
+func NewTracker[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload,
+](
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+	sink TelemetrySink,
+	size int,
+) *Tracker[BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT] {
+	return &Tracker[BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT]{
+		logger:                logger,
+		chainSpec:             chainSpec,
+		dispatcher:            dispatcher,
+		sink:                  sink,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		size:                  size,
+		history:               make([]*Record, 0, size),
+	}
+}
+
+// Name returns the name of the service.
+func (t *Tracker[_, _, _]) Name() string {
+	return "blob-fee-tracker"
+}
+
+// Start subscribes the tracker to BeaconBlockFinalized events and starts its
+// event loop.
+func (t *Tracker[_, _, _]) Start(ctx context.Context) error {
+	if err := t.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, t.subFinalizedBlkEvents,
+	); err != nil {
+		t.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	go t.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the tracker.
+func (t *Tracker[_, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-t.subFinalizedBlkEvents:
+			blk := event.Data()
+			t.Observe(blk.GetSlot(), blk.GetBody().GetExecutionPayload())
+		}
+	}
+}
+
+// Observe records the blob market data of the payload finalized at the given
+// slot and returns it.
+func (t *Tracker[_, _, ExecutionPayloadT]) Observe(
+	slot math.Slot, payload ExecutionPayloadT,
+) *Record {
+	var (
+		maxBlobGas  = t.chainSpec.MaxBlobsPerBlock() * GasPerBlob
+		excess      = payload.GetExcessBlobGas().Unwrap()
+		used        = payload.GetBlobGasUsed().Unwrap()
+		utilization float64
+	)
+	if maxBlobGas != 0 {
+		utilization = float64(used) / float64(maxBlobGas)
+	}
+	record := &Record{
+		Slot:          slot,
+		BlockNumber:   payload.GetNumber(),
+		BlobGasUsed:   payload.GetBlobGasUsed(),
+		ExcessBlobGas: payload.GetExcessBlobGas(),
+		Utilization:   utilization,
+		BlobBaseFee:   BlobBaseFee(excess),
+		NextBlobBaseFee: BlobBaseFee(
+			NextExcessBlobGas(excess, used, maxBlobGas/2),
+		),
+	}
+	t.export(record)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.history) == t.size {
+		t.history = append(t.history[:0], t.history[1:]...)
+	}
+	t.history = append(t.history, record)
+	return record
+}
+
+// History returns up to limit of the latest records, oldest first. All the
+// records kept are returned if limit is not positive.
+func (t *Tracker[_, _, _]) History(limit int) []*Record {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	start := 0
+	if limit > 0 && limit < len(t.history) {
+		start = len(t.history) - limit
+	}
+	history := make([]*Record, len(t.history)-start)
+	copy(history, t.history[start:])
+	return history
+}
+
+// export sets the blob market gauges to the given record.
+func (t *Tracker[_, _, _]) export(record *Record) {
+	//#nosec:G701 // blob gas stays far below the int64 range.
+	t.sink.SetGauge(
+		"beacon_kit.blob_market.blob_gas_used",
+		int64(record.BlobGasUsed.Unwrap()),
+	)
+	//#nosec:G701 // blob gas stays far below the int64 range.
+	t.sink.SetGauge(
+		"beacon_kit.blob_market.excess_blob_gas",
+		int64(record.ExcessBlobGas.Unwrap()),
+	)
+	t.sink.SetGauge(
+		"beacon_kit.blob_market.utilization_percent",
+		int64(record.Utilization*100),
+	)
+	if record.NextBlobBaseFee.IsInt64() {
+		t.sink.SetGauge(
+			"beacon_kit.blob_market.next_blob_base_fee",
+			record.NextBlobBaseFee.Int64(),
+		)
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobfees_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/blobfees"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type executionPayload struct {
+	number, blobGasUsed, excessBlobGas math.U64
+}
+
+func (p *executionPayload) GetNumber() math.U64 { return p.number }
+
+func (p *executionPayload) GetBlobGasUsed() math.U64 {
+	return p.blobGasUsed
+}
+
+func (p *executionPayload) GetExcessBlobGas() math.U64 {
+	return p.excessBlobGas
+}
+
+type beaconBlockBody struct{ payload *executionPayload }
+
+func (b *beaconBlockBody) GetExecutionPayload() *executionPayload {
+	return b.payload
+}
+
+type beaconBlock struct {
+	slot math.Slot
+	body *beaconBlockBody
+}
+
+func (b *beaconBlock) GetSlot() math.Slot        { return b.slot }
+func (b *beaconBlock) GetBody() *beaconBlockBody { return b.body }
+
+type noopSink struct{}
+
+func (noopSink) SetGauge(string, int64, ...string) {}
+
+const maxBlobsPerBlock = 6
+
+func newTracker(size int) *blobfees.Tracker[
+	*beaconBlock, *beaconBlockBody, *executionPayload,
+] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			MaxBlobsPerBlock: maxBlobsPerBlock,
+		},
+	)
+	return blobfees.NewTracker[
+		*beaconBlock, *beaconBlockBody, *executionPayload,
+	](noop.NewLogger[any](), cs, nil, noopSink{}, size)
+}
+
+func TestBlobBaseFee(t *testing.T) {
+	tests := []struct {
+		excess   uint64
+		expected int64
+	}{
+		{excess: 0, expected: 1},
+		{excess: 393216, expected: 1},
+		{excess: 3338477, expected: 2},
+		{excess: 10 * 3338477, expected: 22026},
+	}
+	for _, tt := range tests {
+		require.Equal(
+			t, big.NewInt(tt.expected), blobfees.BlobBaseFee(tt.excess),
+		)
+	}
+}
+
+func TestNextExcessBlobGas(t *testing.T) {
+	const target = 3 * blobfees.GasPerBlob
+	require.Zero(t, blobfees.NextExcessBlobGas(0, blobfees.GasPerBlob, target))
+	require.Equal(t,
+		uint64(blobfees.GasPerBlob),
+		blobfees.NextExcessBlobGas(0, 4*blobfees.GasPerBlob, target),
+	)
+}
+
+func TestTracker_Observe(t *testing.T) {
+	tracker := newTracker(4)
+	record := tracker.Observe(1, &executionPayload{
+		number:        10,
+		blobGasUsed:   3 * blobfees.GasPerBlob,
+		excessBlobGas: 0,
+	})
+	require.Equal(t, math.Slot(1), record.Slot)
+	require.Equal(t, math.U64(10), record.BlockNumber)
+	require.InDelta(t, 0.5, record.Utilization, 1e-9)
+	require.Equal(t, big.NewInt(1), record.BlobBaseFee)
+}
+
+func TestTracker_History(t *testing.T) {
+	tracker := newTracker(3)
+	for slot := range math.Slot(5) {
+		tracker.Observe(slot, &executionPayload{number: slot})
+	}
+
+	history := tracker.History(0)
+	require.Len(t, history, 3)
+	for i, record := range history {
+		require.Equal(t, math.Slot(i+2), record.Slot)
+	}
+
+	history = tracker.History(2)
+	require.Len(t, history, 2)
+	require.Equal(t, math.Slot(3), history[0].Slot)
+	require.Equal(t, math.Slot(4), history[1].Slot)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobfees
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is a generic interface for a beacon block.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetBody returns the body of the block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody is a generic interface for a beacon block body.
+type BeaconBlockBody[ExecutionPayloadT any] interface {
+	// GetExecutionPayload returns the execution payload of the body.
+	GetExecutionPayload() ExecutionPayloadT
+}
+
+// ExecutionPayload is the interface for the blob gas accounting of an
+// execution payload.
+type ExecutionPayload interface {
+	// GetNumber returns the block number of the payload.
+	GetNumber() math.U64
+	// GetBlobGasUsed returns the blob gas used by the payload.
+	GetBlobGasUsed() math.U64
+	// GetExcessBlobGas returns the excess blob gas of the payload.
+	GetExcessBlobGas() math.U64
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
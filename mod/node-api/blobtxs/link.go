@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobtxs
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ErrVersionedHashMismatch is returned when the blob versioned hashes of the
+// transactions of a payload do not match the KZG commitments of its block.
+var ErrVersionedHashMismatch = errors.New(
+	"versioned hashes do not match the blob commitments",
+)
+
+// Record is the blob transactions of a finalized block.
+type Record struct {
+	// Slot is the slot of the beacon block.
+	Slot math.Slot
+	// Transactions are the blob carrying transactions of the execution
+	// payload, in payload order.
+	Transactions []*Transaction
+}
+
+// Transaction is a blob carrying transaction of an execution payload.
+type Transaction struct {
+	// Index is the position of the transaction in the payload.
+	Index uint64
+	// Hash is the hash of the transaction.
+	Hash common.ExecutionHash
+	// From is the sender of the transaction.
+	From common.ExecutionAddress
+	// To is the recipient of the transaction.
+	To common.ExecutionAddress
+	// Blobs are the blobs of the transaction.
+	Blobs []*Blob
+}
+
+// Blob is a blob of a transaction.
+type Blob struct {
+	// Index is the index of the blob sidecar in the block.
+	Index uint64
+	// VersionedHash is the versioned hash referenced by the transaction.
+	VersionedHash common.ExecutionHash
+	// KZGCommitment is the commitment of the block to the blob.
+	KZGCommitment eip4844.KZGCommitment
+}
+
+// Link decodes the given payload transactions and returns the blob carrying
+// ones, each joined with the KZG commitments of its blobs. Blob sidecars are
+// indexed in the order their versioned hashes appear in the payload, which
+// is checked against the commitments of the block.
+func Link(
+	signer gethprimitives.Signer,
+	txs [][]byte,
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) ([]*Transaction, error) {
+	var (
+		linked = make([]*Transaction, 0)
+		index  uint64
+	)
+	for i, encTx := range txs {
+		var tx gethprimitives.Transaction
+		if err := tx.UnmarshalBinary(encTx); err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction %d", i)
+		}
+		hashes := tx.BlobHashes()
+		if len(hashes) == 0 {
+			continue
+		}
+
+		from, err := gethprimitives.Sender(signer, &tx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "sender of transaction %d", i)
+		}
+		linkedTx := &Transaction{
+			Index: uint64(i),
+			Hash:  common.ExecutionHash(tx.Hash()),
+			From:  common.ExecutionAddress(from),
+			Blobs: make([]*Blob, 0, len(hashes)),
+		}
+		if to := tx.To(); to != nil {
+			linkedTx.To = common.ExecutionAddress(*to)
+		}
+
+		for _, hash := range hashes {
+			if index >= uint64(len(commitments)) ||
+				commitments[index].ToVersionedHash() != hash {
+				return nil, errors.Wrapf(
+					ErrVersionedHashMismatch,
+					"transaction %d, blob %d", i, index,
+				)
+			}
+			linkedTx.Blobs = append(linkedTx.Blobs, &Blob{
+				Index:         index,
+				VersionedHash: common.ExecutionHash(hash),
+				KZGCommitment: commitments[index],
+			})
+			index++
+		}
+		linked = append(linked, linkedTx)
+	}
+
+	if index != uint64(len(commitments)) {
+		return nil, errors.Wrapf(
+			ErrVersionedHashMismatch,
+			"expected %d blobs, got %d", len(commitments), index,
+		)
+	}
+	return linked, nil
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobtxs
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DefaultHistorySize is the number of blocks with blobs the tracker keeps by
+// default.
+const DefaultHistorySize = 4096
+
+// Tracker links the blob transactions of finalized blocks to their blob
+// sidecars, keeping a bounded history of the blocks carrying blobs. As the
+// payload transactions are only known to the consensus layer while a block
+// is processed, only the blocks finalized since the node started are known.
+type Tracker[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// signer recovers the senders of the transactions.
+	signer gethprimitives.Signer
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// size is the maximum number of records kept.
+	size int
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// records holds the records of the blocks with blobs by slot.
+	records map[math.Slot]*Record
+	// slots holds the slots of the records, oldest first.
+	slots []math.Slot
+	// observed is whether a block was observed.
+	observed bool
+	// low and high bound the slots whose blob transactions are all known.
+	low, high math.Slot
+}
+
+// NewTracker creates a new blob transaction tracker keeping the records of
+// the given number of blocks with blobs.
+func NewTracker[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	ExecutionPayloadT ExecutionPayload,
+](
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+	size int,
+) *Tracker[BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT] {
+	return &Tracker[BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT]{
+		logger: logger,
+		signer: gethprimitives.LatestSignerForChainID(
+			new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+		),
+		dispatcher:            dispatcher,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		size:                  size,
+		records:               make(map[math.Slot]*Record),
+	}
+}
+
+// Name returns the name of the service.
+func (t *Tracker[_, _, _]) Name() string {
+	return "blob-tx-tracker"
+}
+
+// Start subscribes the tracker to BeaconBlockFinalized events and starts its
+// event loop.
+func (t *Tracker[_, _, _]) Start(ctx context.Context) error {
+	if err := t.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, t.subFinalizedBlkEvents,
+	); err != nil {
+		t.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	go t.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the tracker.
+func (t *Tracker[_, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-t.subFinalizedBlkEvents:
+			blk := event.Data()
+			if _, err := t.Observe(blk.GetSlot(), blk.GetBody()); err != nil {
+				t.logger.Error(
+					"failed to link blob transactions",
+					"slot", blk.GetSlot(),
+					"error", err,
+				)
+			}
+		}
+	}
+}
+
+// Observe links the blob transactions of the block body finalized at the
+// given slot and returns its record.
+func (t *Tracker[_, BeaconBlockBodyT, _]) Observe(
+	slot math.Slot, body BeaconBlockBodyT,
+) (*Record, error) {
+	txs, err := Link(
+		t.signer,
+		body.GetExecutionPayload().GetTransactions(),
+		body.GetBlobKzgCommitments(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{Slot: slot, Transactions: txs}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.observed {
+		t.observed, t.low = true, slot
+	}
+	t.high = slot
+	if len(txs) == 0 {
+		return record, nil
+	}
+
+	if len(t.slots) == t.size {
+		delete(t.records, t.slots[0])
+		t.low = t.slots[0] + 1
+		t.slots = append(t.slots[:0], t.slots[1:]...)
+	}
+	t.records[slot] = record
+	t.slots = append(t.slots, slot)
+	return record, nil
+}
+
+// Lookup returns the record of the block at the given slot, and whether the
+// blob transactions of that slot are known. A slot known to have no blobs
+// has a record without transactions.
+func (t *Tracker[_, _, _]) Lookup(slot math.Slot) (*Record, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if record, ok := t.records[slot]; ok {
+		return record, true
+	}
+	if !t.observed || slot < t.low || slot > t.high {
+		return nil, false
+	}
+	return &Record{Slot: slot, Transactions: []*Transaction{}}, true
+}
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobtxs_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/blobtxs"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = 80087
+
+type kzgCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+type executionPayload struct{ txs engineprimitives.Transactions }
+
+func (p *executionPayload) GetTransactions() engineprimitives.Transactions {
+	return p.txs
+}
+
+type beaconBlockBody struct {
+	payload     *executionPayload
+	commitments kzgCommitments
+}
+
+func (b *beaconBlockBody) GetExecutionPayload() *executionPayload {
+	return b.payload
+}
+
+func (b *beaconBlockBody) GetBlobKzgCommitments() kzgCommitments {
+	return b.commitments
+}
+
+type beaconBlock struct {
+	slot math.Slot
+	body *beaconBlockBody
+}
+
+func (b *beaconBlock) GetSlot() math.Slot        { return b.slot }
+func (b *beaconBlock) GetBody() *beaconBlockBody { return b.body }
+
+func newTracker(size int) *blobtxs.Tracker[
+	*beaconBlock, *beaconBlockBody, *executionPayload,
+] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			DepositEth1ChainID: chainID,
+		},
+	)
+	return blobtxs.NewTracker[
+		*beaconBlock, *beaconBlockBody, *executionPayload,
+	](noop.NewLogger[any](), cs, nil, size)
+}
+
+// commitment returns a distinct KZG commitment for the given seed.
+func commitment(seed byte) eip4844.KZGCommitment {
+	var c eip4844.KZGCommitment
+	c[0] = seed
+	return c
+}
+
+// signTx signs the given transaction data with the key.
+func signTx(
+	t *testing.T, key *ecdsa.PrivateKey, data coretypes.TxData,
+) []byte {
+	t.Helper()
+	tx, err := coretypes.SignNewTx(
+		key, coretypes.LatestSignerForChainID(big.NewInt(chainID)), data,
+	)
+	require.NoError(t, err)
+	enc, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return enc
+}
+
+// blobTx returns a signed blob transaction referencing the given
+// commitments.
+func blobTx(
+	t *testing.T,
+	key *ecdsa.PrivateKey,
+	nonce uint64,
+	to gethcommon.Address,
+	commitments ...eip4844.KZGCommitment,
+) []byte {
+	t.Helper()
+	hashes := make([]gethcommon.Hash, len(commitments))
+	for i, c := range commitments {
+		hashes[i] = c.ToVersionedHash()
+	}
+	return signTx(t, key, &coretypes.BlobTx{
+		ChainID:    uint256.NewInt(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1),
+		Gas:        21000,
+		To:         to,
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: hashes,
+	})
+}
+
+func TestLink(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var (
+		from        = crypto.PubkeyToAddress(key.PublicKey)
+		inbox       = gethcommon.Address{0x1}
+		commitments = kzgCommitments{
+			commitment(1), commitment(2), commitment(3),
+		}
+		txs = [][]byte{
+			signTx(t, key, &coretypes.LegacyTx{
+				Nonce: 0, Gas: 21000, GasPrice: big.NewInt(1), To: &inbox,
+			}),
+			blobTx(t, key, 1, inbox, commitments[0]),
+			blobTx(t, key, 2, inbox, commitments[1], commitments[2]),
+		}
+	)
+
+	linked, err := blobtxs.Link(
+		coretypes.LatestSignerForChainID(big.NewInt(chainID)),
+		txs, commitments,
+	)
+	require.NoError(t, err)
+	require.Len(t, linked, 2)
+	for i, tx := range linked {
+		require.Equal(t, uint64(i+1), tx.Index)
+		require.Equal(t, common.ExecutionAddress(from), tx.From)
+		require.Equal(t, common.ExecutionAddress(inbox), tx.To)
+	}
+	require.Len(t, linked[0].Blobs, 1)
+	require.Len(t, linked[1].Blobs, 2)
+	for i, blob := range append(linked[0].Blobs, linked[1].Blobs...) {
+		require.Equal(t, uint64(i), blob.Index)
+		require.Equal(t, commitments[i], blob.KZGCommitment)
+		require.Equal(t,
+			common.ExecutionHash(commitments[i].ToVersionedHash()),
+			blob.VersionedHash,
+		)
+	}
+}
+
+func TestLink_Mismatch(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := coretypes.LatestSignerForChainID(big.NewInt(chainID))
+	txs := [][]byte{blobTx(t, key, 0, gethcommon.Address{}, commitment(1))}
+
+	_, err = blobtxs.Link(signer, txs, kzgCommitments{commitment(2)})
+	require.ErrorIs(t, err, blobtxs.ErrVersionedHashMismatch)
+
+	_, err = blobtxs.Link(
+		signer, txs, kzgCommitments{commitment(1), commitment(2)},
+	)
+	require.ErrorIs(t, err, blobtxs.ErrVersionedHashMismatch)
+}
+
+func TestTracker_Lookup(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tracker := newTracker(2)
+
+	// Blocks at even slots carry a blob.
+	for slot := math.Slot(3); slot < 10; slot++ {
+		body := &beaconBlockBody{payload: &executionPayload{}}
+		if slot%2 == 0 {
+			c := commitment(byte(slot))
+			body.payload.txs = [][]byte{
+				blobTx(t, key, slot.Unwrap(), gethcommon.Address{}, c),
+			}
+			body.commitments = kzgCommitments{c}
+		}
+		_, err = tracker.Observe(slot, body)
+		require.NoError(t, err)
+	}
+
+	// The record of slot 4 was evicted, so only slots from 5 are known.
+	for _, slot := range []math.Slot{2, 3, 4, 10} {
+		_, ok := tracker.Lookup(slot)
+		require.False(t, ok, "slot %d", slot)
+	}
+	record, ok := tracker.Lookup(5)
+	require.True(t, ok)
+	require.Empty(t, record.Transactions)
+	record, ok = tracker.Lookup(8)
+	require.True(t, ok)
+	require.Len(t, record.Transactions, 1)
+	require.Equal(t, math.Slot(8), record.Slot)
+}
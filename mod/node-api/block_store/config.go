@@ -20,8 +20,12 @@
 
 package blockstore
 
+import "time"
+
 const (
 	DefaultAvailabilityWindow = 8192
+	DefaultBatchSize          = 1
+	DefaultFlushInterval      = time.Second
 )
 
 // Config is the configuration for the block service.
@@ -30,6 +34,12 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow int `mapstructure:"availability-window"`
+	// BatchSize is the number of finalized blocks grouped into a single
+	// write. A value of 1 writes every block as soon as it is finalized.
+	BatchSize int `mapstructure:"batch-size"`
+	// FlushInterval is the longest a partial batch is held before it is
+	// written. It only applies when BatchSize is greater than 1.
+	FlushInterval time.Duration `mapstructure:"flush-interval"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -37,5 +47,7 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:            false,
 		AvailabilityWindow: DefaultAvailabilityWindow,
+		BatchSize:          DefaultBatchSize,
+		FlushInterval:      DefaultFlushInterval,
 	}
 }
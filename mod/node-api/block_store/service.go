@@ -22,6 +22,7 @@ package blockstore
 
 import (
 	"context"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -55,12 +56,15 @@ func NewService[
 	dispatcher asynctypes.EventDispatcher,
 	store BlockStoreT,
 ) *Service[BeaconBlockT, BlockStoreT] {
+	// Buffer up to a batch of events so that finalization is not held up
+	// while a batch is being written.
+	capacity := max(config.BatchSize, 1)
 	return &Service[BeaconBlockT, BlockStoreT]{
 		config:                config,
 		logger:                logger,
 		dispatcher:            dispatcher,
 		store:                 store,
-		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT], capacity),
 	}
 }
 
@@ -91,13 +95,35 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 }
 
 // eventLoop is the main event loop for the block service.
-func (s *Service[_, _]) eventLoop(ctx context.Context) {
+func (s *Service[BeaconBlockT, _]) eventLoop(ctx context.Context) {
+	if s.config.BatchSize <= 1 {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-s.subFinalizedBlkEvents:
+				s.onFinalizeBlock(event)
+			}
+		}
+	}
+
+	// Group finalized blocks into batches, flushing whenever the batch is
+	// full or the flush interval elapses.
+	batch := make([]BeaconBlockT, 0, s.config.BatchSize)
+	ticker := time.NewTicker(s.config.FlushInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
+			s.flush(batch)
 			return
 		case event := <-s.subFinalizedBlkEvents:
-			s.onFinalizeBlock(event)
+			batch = append(batch, event.Data())
+			if len(batch) >= s.config.BatchSize {
+				batch = s.flush(batch)
+			}
+		case <-ticker.C:
+			batch = s.flush(batch)
 		}
 	}
 }
@@ -114,3 +140,21 @@ func (s *Service[BeaconBlockT, _]) onFinalizeBlock(
 		)
 	}
 }
+
+// flush writes the batch to the store and returns it emptied for reuse.
+func (s *Service[BeaconBlockT, _]) flush(
+	batch []BeaconBlockT,
+) []BeaconBlockT {
+	if len(batch) == 0 {
+		return batch
+	}
+	if err := s.store.SetBatch(batch); err != nil {
+		s.logger.Error(
+			"failed to store block batch",
+			"first_slot", batch[0].GetSlot(),
+			"last_slot", batch[len(batch)-1].GetSlot(),
+			"error", err,
+		)
+	}
+	return batch[:0]
+}
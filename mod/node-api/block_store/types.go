@@ -37,6 +37,8 @@ type BeaconBlock interface {
 type BlockStore[BeaconBlockT BeaconBlock] interface {
 	// Set sets a block at a given index.
 	Set(blk BeaconBlockT) error
+	// SetBatch sets a group of blocks, in order.
+	SetBatch(blks []BeaconBlockT) error
 }
 
 // Event is an interface for block events.
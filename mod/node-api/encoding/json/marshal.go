@@ -0,0 +1,410 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package json implements the canonical JSON encoding of the Beacon API, in
+// which the node API serves every response.
+package json
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+var (
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	decimalType       = reflect.TypeFor[interface{ Dec() string }]()
+)
+
+// Marshal returns the encoding of v as specified by the Beacon API. It
+// differs from encoding/json, and from the encodings the consensus types
+// define for other purposes, as follows:
+//
+//   - struct fields are named after their json tag in snake_case, so that
+//     the types tagged in camelCase after the execution API are served in
+//     the casing of the spec;
+//   - integers with a text encoding, such as math.U64 or math.Slot, and 256
+//     bit integers are quoted decimal strings, as are integers tagged with
+//     the string option, including the elements of lists;
+//   - byte slices and arrays without an encoding of their own are 0x
+//     prefixed hex strings rather than base64.
+//
+// Structs are always encoded field by field, ignoring their MarshalJSON
+// method, so that the generated encoders of the execution types do not leak
+// into responses. Other values with a JSON or text encoding keep it.
+func Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := encode(&buf, addressable(reflect.ValueOf(v)), false); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encode writes the encoding of v to buf. quoted is true if integers must be
+// encoded as strings.
+//
+//nolint:gocognit,cyclop // mirrors the kinds of reflect.
+func encode(buf *bytes.Buffer, v reflect.Value, quoted bool) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) &&
+		v.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+
+	if ok, err := encodeMarshaler(buf, v); ok {
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return encode(buf, v.Elem(), quoted)
+	case reflect.Interface:
+		return encode(buf, addressable(v.Elem()), quoted)
+	case reflect.Bool:
+		writeScalar(buf, strconv.FormatBool(v.Bool()), quoted)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		writeScalar(
+			buf, strconv.FormatInt(v.Int(), 10),
+			quoted || v.Type().Implements(textMarshalerType),
+		)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		writeScalar(
+			buf, strconv.FormatUint(v.Uint(), 10),
+			quoted || v.Type().Implements(textMarshalerType),
+		)
+	case reflect.Float32:
+		return writeJSON(buf, float32(v.Float()), quoted)
+	case reflect.Float64:
+		return writeJSON(buf, v.Float(), quoted)
+	case reflect.String:
+		return writeJSON(buf, v.String(), false)
+	case reflect.Slice:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		fallthrough
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			writeHex(buf, v)
+			return nil
+		}
+		return encodeList(buf, v, quoted)
+	case reflect.Map:
+		return encodeMap(buf, v)
+	case reflect.Struct:
+		return encodeStruct(buf, v)
+	default:
+		return errors.Wrap(ErrUnsupportedType, v.Type().String())
+	}
+	return nil
+}
+
+// encodeMarshaler writes the encoding of v if its type defines one, and
+// returns false otherwise. Integers are left to encode, which formats them in
+// decimal.
+func encodeMarshaler(buf *bytes.Buffer, v reflect.Value) (bool, error) {
+	// Fields promoted through unexported embedded structs cannot be
+	// interfaced, and are encoded by kind.
+	if !v.CanInterface() {
+		return false, nil
+	}
+	t := v.Type()
+	if t.Implements(decimalType) {
+		writeScalar(buf, v.Interface().(interface{ Dec() string }).Dec(), true)
+		return true, nil
+	}
+	if isInteger(t.Kind()) ||
+		(t.Kind() == reflect.Pointer && isInteger(t.Elem().Kind())) {
+		return false, nil
+	}
+	if v.CanAddr() && t.Kind() != reflect.Pointer {
+		if ok, err := encodeMarshaler(buf, v.Addr()); ok {
+			return true, err
+		}
+	}
+
+	elem := t
+	if elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	switch {
+	case elem.Kind() != reflect.Struct && t.Implements(jsonMarshalerType):
+		bz, err := v.Interface().(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return true, err
+		}
+		buf.Write(bz)
+		return true, nil
+	case t.Implements(textMarshalerType):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return true, err
+		}
+		return true, writeJSON(buf, string(text), false)
+	default:
+		return false, nil
+	}
+}
+
+// encodeList writes the elements of the slice or array v as a JSON array.
+func encodeList(buf *bytes.Buffer, v reflect.Value, quoted bool) error {
+	buf.WriteByte('[')
+	for i := range v.Len() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encode(buf, addressable(v.Index(i)), quoted); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+// encodeMap writes the map v as a JSON object, sorted by key. Keys are kept
+// as they are, since they are data rather than field names.
+func encodeMap(buf *bytes.Buffer, v reflect.Value) error {
+	if v.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+	keys := make([]string, 0, v.Len())
+	values := make(map[string]reflect.Value, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		key, err := mapKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+		values[key] = iter.Value()
+	}
+	slices.Sort(keys)
+
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeKey(buf, key); err != nil {
+			return err
+		}
+		if err := encode(buf, addressable(values[key]), false); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// mapKey returns the string form of a map key, following encoding/json.
+func mapKey(k reflect.Value) (string, error) {
+	switch {
+	case k.Kind() == reflect.String:
+		return k.String(), nil
+	case k.Type().Implements(textMarshalerType):
+		text, err := k.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	case isInteger(k.Kind()) && k.CanInt():
+		return strconv.FormatInt(k.Int(), 10), nil
+	case isInteger(k.Kind()):
+		return strconv.FormatUint(k.Uint(), 10), nil
+	default:
+		return "", errors.Wrap(ErrUnsupportedType, k.Type().String())
+	}
+}
+
+// encodeStruct writes the exported fields of the struct v as a JSON object,
+// flattening the embedded structs as encoding/json does.
+func encodeStruct(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteByte('{')
+	first := true
+	var nested [][]int
+	for _, field := range reflect.VisibleFields(v.Type()) {
+		if !field.IsExported() || hasPrefix(field.Index, nested) {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if field.Anonymous {
+			t := field.Type
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			// Embedded structs without a name have their fields promoted,
+			// which are visited on their own.
+			if name == "" && t.Kind() == reflect.Struct {
+				continue
+			}
+			nested = append(nested, field.Index)
+		}
+
+		fv, err := v.FieldByIndexErr(field.Index)
+		if err != nil {
+			// The field is promoted through a nil embedded pointer.
+			continue
+		}
+		optList := strings.Split(opts, ",")
+		if slices.Contains(optList, "omitempty") && isEmpty(fv) {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		if err = writeKey(buf, snakeCase(name)); err != nil {
+			return err
+		}
+		if err = encode(
+			buf, fv, slices.Contains(optList, "string"),
+		); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// snakeCase converts a camelCase or PascalCase name to snake_case. Runs of
+// capitals are treated as a single word, so that chainID becomes chain_id.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) ||
+				unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) &&
+					i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// writeKey writes the object key followed by a colon.
+func writeKey(buf *bytes.Buffer, key string) error {
+	if err := writeJSON(buf, key, false); err != nil {
+		return err
+	}
+	buf.WriteByte(':')
+	return nil
+}
+
+// writeJSON writes the encoding/json encoding of the scalar v, within quotes
+// if quoted.
+func writeJSON(buf *bytes.Buffer, v any, quoted bool) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	writeScalar(buf, string(bz), quoted)
+	return nil
+}
+
+// writeScalar writes the encoded scalar s, within quotes if quoted.
+func writeScalar(buf *bytes.Buffer, s string, quoted bool) {
+	if quoted {
+		buf.WriteByte('"')
+	}
+	buf.WriteString(s)
+	if quoted {
+		buf.WriteByte('"')
+	}
+}
+
+// writeHex writes the byte slice or array v as a 0x prefixed hex string.
+func writeHex(buf *bytes.Buffer, v reflect.Value) {
+	bz := make([]byte, v.Len())
+	for i := range bz {
+		bz[i] = byte(v.Index(i).Uint())
+	}
+	buf.WriteString(`"0x`)
+	buf.WriteString(hex.EncodeToString(bz))
+	buf.WriteByte('"')
+}
+
+// addressable returns an addressable copy of v, so that the methods with a
+// pointer receiver are found on values held by interfaces and maps.
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	return ptr.Elem()
+}
+
+// hasPrefix returns true if index is nested in any of the given fields.
+func hasPrefix(index []int, fields [][]int) bool {
+	for _, field := range fields {
+		if len(index) > len(field) &&
+			slices.Equal(index[:len(field)], field) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInteger returns true if k is a signed or unsigned integer kind.
+func isInteger(k reflect.Kind) bool {
+	return reflect.Int <= k && k <= reflect.Uintptr
+}
+
+// isEmpty returns true if v is omitted by the omitempty option.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	default:
+		return false
+	}
+}
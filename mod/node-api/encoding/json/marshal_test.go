@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	stdjson "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// header is tagged in camelCase, as the execution types are.
+type header struct {
+	BlockNumber   math.U64       `json:"blockNumber"`
+	ForkVersion   common.Version `json:"forkVersion"`
+	BaseFeePerGas *math.U256     `json:"baseFeePerGas"`
+	ExtraData     []byte         `json:"extraData"`
+}
+
+// MarshalJSON is ignored by Marshal, as generated encoders are.
+func (h *header) MarshalJSON() ([]byte, error) {
+	return []byte(`{"generated":true}`), nil
+}
+
+type envelope struct {
+	Finalized bool `json:"finalized"`
+	body
+}
+
+type body struct {
+	ChainID    uint64   `json:"chainID,string"`
+	Code       int      `json:"code"`
+	Validators []uint64 `json:"validators,string"`
+	Missing    []uint64 `json:"missing"`
+	Skipped    string   `json:"skipped,omitempty"`
+	Hidden     string   `json:"-"`
+	Data       any      `json:"data"`
+}
+
+func TestMarshal(t *testing.T) {
+	bz, err := json.Marshal(envelope{
+		Finalized: true,
+		body: body{
+			ChainID:    80087,
+			Code:       200,
+			Validators: []uint64{1, 0},
+			Hidden:     "hidden",
+			Data: map[string]any{
+				"DEPOSIT_CONTRACT_ADDRESS": "0x00",
+				"header": &header{
+					BlockNumber:   16,
+					ForkVersion:   common.Version{0x04},
+					BaseFeePerGas: math.NewU256(1_000_000_007),
+					ExtraData:     []byte{0xbe, 0xef},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"finalized": true,
+		"chain_id": "80087",
+		"code": 200,
+		"validators": ["1", "0"],
+		"missing": null,
+		"data": {
+			"DEPOSIT_CONTRACT_ADDRESS": "0x00",
+			"header": {
+				"block_number": "16",
+				"fork_version": "0x04000000",
+				"base_fee_per_gas": "1000000007",
+				"extra_data": "0xbeef"
+			}
+		}
+	}`, string(bz))
+}
+
+func TestMarshalKeepsRawMessages(t *testing.T) {
+	bz, err := json.Marshal(stdjson.RawMessage(`{"operationId":"x"}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"operationId":"x"}`, string(bz))
+}
+
+func TestMarshalUnsupportedType(t *testing.T) {
+	_, err := json.Marshal(struct{ C chan int }{})
+	require.ErrorIs(t, err, json.ErrUnsupportedType)
+}
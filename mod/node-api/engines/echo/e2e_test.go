@@ -0,0 +1,540 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/config"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gochecknoglobals // test flag.
+var update = flag.Bool("update", false, "rewrite the golden responses")
+
+const (
+	// goldenDir holds a golden response for every case of the suite.
+	goldenDir = "testdata/golden"
+	// notImplementedGolden is the golden response of every route that has
+	// no case of its own, i.e. of every route that is not implemented.
+	notImplementedGolden = "not_implemented"
+)
+
+//nolint:lll // keys and roots.
+const (
+	pubkeyA  = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	pubkeyC  = "0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+	stateID5 = "0x1515151515151515151515151515151515151515151515151515151515151515"
+	blockID3 = "0x2323232323232323232323232323232323232323232323232323232323232323"
+)
+
+// goldenHeaders are the response headers recorded in the golden responses,
+// if they are set.
+//
+//nolint:gochecknoglobals // test fixture.
+var goldenHeaders = []string{"Content-Type", "Eth-Consensus-Version"}
+
+// apiCase is a request made against the node API, whose response must match
+// the golden response of the same name.
+type apiCase struct {
+	golden string
+	method string
+	// route is the path of the route serving the request, as registered.
+	route string
+	// path is the path and query of the request.
+	path string
+	body string
+	// accept is the Accept header of the request, if any.
+	accept string
+}
+
+// golden is a recorded response of the node API.
+type golden struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+//nolint:funlen // table.
+func apiCases() []apiCase {
+	return []apiCase{
+		// beacon
+		{
+			golden: "beacon_genesis",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/genesis",
+			path:   "/eth/v1/beacon/genesis",
+		},
+		{
+			golden: "beacon_state_root",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/root",
+			path:   "/eth/v1/beacon/states/head/root",
+		},
+		{
+			golden: "beacon_state_root_by_root",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/root",
+			path:   "/eth/v1/beacon/states/" + stateID5 + "/root",
+		},
+		{
+			golden: "beacon_state_root_unknown_slot",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/root",
+			path:   "/eth/v1/beacon/states/100/root",
+		},
+		{
+			golden: "beacon_state_root_invalid_state_id",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/root",
+			path:   "/eth/v1/beacon/states/latest/root",
+		},
+		{
+			golden: "beacon_state_fork",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/fork",
+			path:   "/eth/v1/beacon/states/finalized/fork",
+		},
+		{
+			golden: "beacon_state_validators",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path:   "/eth/v1/beacon/states/head/validators",
+		},
+		{
+			golden: "beacon_state_validators_by_id",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path:   "/eth/v1/beacon/states/head/validators?id=1",
+		},
+		{
+			golden: "beacon_state_validators_unknown_id",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path:   "/eth/v1/beacon/states/head/validators?id=7",
+		},
+		{
+			golden: "beacon_state_validators_by_status",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path: "/eth/v1/beacon/states/head/validators" +
+				"?status=active_ongoing",
+		},
+		{
+			golden: "beacon_state_validators_invalid_status",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path:   "/eth/v1/beacon/states/head/validators?status=awake",
+		},
+		{
+			golden: "beacon_post_state_validators",
+			method: http.MethodPost,
+			route:  "/eth/v1/beacon/states/:state_id/validators",
+			path:   "/eth/v1/beacon/states/head/validators",
+			body:   `{"ids":["` + pubkeyA + `"]}`,
+		},
+		{
+			golden: "beacon_state_validator",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators/:validator_id",
+			path:   "/eth/v1/beacon/states/head/validators/1",
+		},
+		{
+			golden: "beacon_state_validator_unknown",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validators/:validator_id",
+			path:   "/eth/v1/beacon/states/head/validators/" + pubkeyC,
+		},
+		{
+			golden: "beacon_state_validator_balances",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/validator_balances",
+			path:   "/eth/v1/beacon/states/head/validator_balances?id=0",
+		},
+		{
+			golden: "beacon_post_state_validator_balances",
+			method: http.MethodPost,
+			route:  "/eth/v1/beacon/states/:state_id/validator_balances",
+			path:   "/eth/v1/beacon/states/head/validator_balances",
+			body:   `{"ids":["0","1"]}`,
+		},
+		{
+			golden: "beacon_post_state_validator_balances_array",
+			method: http.MethodPost,
+			route:  "/eth/v1/beacon/states/:state_id/validator_balances",
+			path:   "/eth/v1/beacon/states/head/validator_balances",
+			body:   `["1","` + pubkeyA + `","1"]`,
+		},
+		{
+			golden: "beacon_validator_queue",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/states/:state_id/validator_queue",
+			path:   "/bkit/v1/beacon/states/head/validator_queue",
+		},
+		{
+			golden: "beacon_state_committees",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/committees",
+			path:   "/eth/v1/beacon/states/head/committees?epoch=1&slot=33",
+		},
+		// epoch 0 is requested, not inferred from the state.
+		{
+			golden: "beacon_state_committees_epoch_zero",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/committees",
+			path:   "/eth/v1/beacon/states/5/committees?epoch=0",
+		},
+		{
+			golden: "beacon_state_randao",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/states/:state_id/randao",
+			path:   "/eth/v1/beacon/states/head/randao?epoch=2",
+		},
+		{
+			golden: "beacon_headers",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/headers",
+			path:   "/eth/v1/beacon/headers?slot=5",
+		},
+		{
+			golden: "beacon_headers_missing_slot",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/headers",
+			path:   "/eth/v1/beacon/headers",
+		},
+		{
+			golden: "beacon_header",
+			method: http.MethodGet,
+			route:  "/eth/v1/beacon/headers/:block_id",
+			path:   "/eth/v1/beacon/headers/" + blockID3,
+		},
+		{
+			golden: "beacon_execution_payload",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/blocks/:block_id/execution_payload",
+			path:   "/bkit/v1/beacon/blocks/2/execution_payload",
+		},
+		{
+			// The payload has no SSZ encoding, so JSON is served instead.
+			golden: "beacon_execution_payload_accept_ssz",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/blocks/:block_id/execution_payload",
+			path:   "/bkit/v1/beacon/blocks/2/execution_payload",
+			accept: "application/octet-stream;q=1.0,application/json;q=0.9",
+		},
+		{
+			golden: "beacon_blob_transactions",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/blocks/:block_id/blob_transactions",
+			path:   "/bkit/v1/beacon/blocks/2/blob_transactions",
+		},
+		{
+			golden: "beacon_blob_fees",
+			method: http.MethodGet,
+			route:  "/bkit/v1/blob_fees",
+			path:   "/bkit/v1/blob_fees?limit=1",
+		},
+		{
+			golden: "beacon_blob_fees_invalid_limit",
+			method: http.MethodGet,
+			route:  "/bkit/v1/blob_fees",
+			path:   "/bkit/v1/blob_fees?limit=all",
+		},
+		{
+			golden: "beacon_headers_range",
+			method: http.MethodGet,
+			route:  "/bkit/v1/headers",
+			path:   "/bkit/v1/headers?from_slot=5&to_slot=7",
+		},
+		{
+			golden: "beacon_headers_range_reversed",
+			method: http.MethodGet,
+			route:  "/bkit/v1/headers",
+			path:   "/bkit/v1/headers?from_slot=7&to_slot=5",
+		},
+		{
+			golden: "beacon_deposit_watermark",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/deposits/watermark",
+			path:   "/bkit/v1/beacon/deposits/watermark",
+		},
+		{
+			golden: "beacon_proposer_timeliness",
+			method: http.MethodGet,
+			route:  "/bkit/v1/beacon/proposer_timeliness",
+			path:   "/bkit/v1/beacon/proposer_timeliness",
+		},
+		// config
+		{
+			golden: "config_deposit_contract",
+			method: http.MethodGet,
+			route:  "/eth/v1/config/deposit_contract",
+			path:   "/eth/v1/config/deposit_contract",
+		},
+		{
+			golden: "config_network",
+			method: http.MethodGet,
+			route:  "/bkit/v1/config/network",
+			path:   "/bkit/v1/config/network",
+		},
+		// keymanager
+		{
+			golden: "keymanager_graffiti",
+			method: http.MethodGet,
+			route:  "/eth/v1/validator/:pubkey/graffiti",
+			path:   "/eth/v1/validator/" + pubkeyA + "/graffiti",
+		},
+		{
+			golden: "keymanager_graffiti_invalid_pubkey",
+			method: http.MethodGet,
+			route:  "/eth/v1/validator/:pubkey/graffiti",
+			path:   "/eth/v1/validator/0xaaaa/graffiti",
+		},
+		{
+			golden: "keymanager_set_graffiti",
+			method: http.MethodPost,
+			route:  "/eth/v1/validator/:pubkey/graffiti",
+			path:   "/eth/v1/validator/" + pubkeyC + "/graffiti",
+			body:   `{"graffiti":"golden"}`,
+		},
+		{
+			golden: "keymanager_delete_graffiti",
+			method: http.MethodDelete,
+			route:  "/eth/v1/validator/:pubkey/graffiti",
+			path:   "/eth/v1/validator/" + pubkeyC + "/graffiti",
+		},
+		// node
+		{
+			golden: "node_version",
+			method: http.MethodGet,
+			route:  "/eth/v1/node/version",
+			path:   "/eth/v1/node/version",
+		},
+		{
+			golden: "node_syncing",
+			method: http.MethodGet,
+			route:  "/eth/v1/node/syncing",
+			path:   "/eth/v1/node/syncing",
+		},
+		// validator
+		{
+			golden: "validator_attestation_data",
+			method: http.MethodGet,
+			route:  "/eth/v1/validator/attestation_data",
+			path: "/eth/v1/validator/attestation_data" +
+				"?slot=4&committee_index=0",
+		},
+		{
+			golden: "validator_attestation_data_missing_index",
+			method: http.MethodGet,
+			route:  "/eth/v1/validator/attestation_data",
+			path:   "/eth/v1/validator/attestation_data?slot=4",
+		},
+		{
+			golden: "validator_liveness",
+			method: http.MethodPost,
+			route:  "/eth/v1/validator/liveness/:epoch",
+			path:   "/eth/v1/validator/liveness/3",
+			body:   `["0","1"]`,
+		},
+		// unknown routes are served by the engine.
+		{
+			golden: "unknown_route",
+			method: http.MethodGet,
+			path:   "/eth/v1/beacon/unknown",
+		},
+	}
+}
+
+// newTestServer serves the handlers backed by the fixture backend on a new
+// test server, and returns it along with the registered routes.
+func newTestServer(
+	t *testing.T,
+) (*httptest.Server, []*handlers.Route[echo.Context]) {
+	t.Helper()
+	var (
+		backend = newFixtureBackend()
+		logger  = noop.NewLogger[log.Logger]()
+		engine  = echo.NewEngineFactory("").NewEngine(server.ProfileConfig{})
+		routes  []*handlers.Route[echo.Context]
+	)
+	for _, h := range []handlers.Handlers[echo.Context]{
+		beacon.NewHandler[
+			*fixtureHeader, echo.Context, *fixtureFork, *fixtureValidator,
+		](backend),
+		builder.NewHandler[echo.Context](),
+		config.NewHandler[echo.Context](backend),
+		keymanager.NewHandler[echo.Context](backend),
+		node.NewHandler[echo.Context](),
+		validator.NewHandler[echo.Context](backend),
+	} {
+		h.RegisterRoutes(logger)
+		engine.RegisterRoutes(h.RouteSet(), logger)
+		routes = append(routes, h.RouteSet().Routes...)
+	}
+
+	e, ok := engine.(*echo.Engine)
+	require.True(t, ok)
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	return srv, routes
+}
+
+// routeKey identifies a route by its method and its path, which is
+// normalized to have a leading slash.
+func routeKey(method, path string) string {
+	return method + " /" + strings.TrimPrefix(path, "/")
+}
+
+// samplePath returns a request path for a route, with every path parameter
+// set to a valid value.
+func samplePath(route string) string {
+	return strings.NewReplacer(
+		":state_id", "head",
+		":block_id", "head",
+		":block_root", blockID3,
+		":epoch", "1",
+		":peer_id", "peer",
+	).Replace("/" + strings.TrimPrefix(route, "/"))
+}
+
+// TestGoldenResponses requests every route of the node API and compares the
+// responses, status codes and headers included, to the golden responses. A
+// route without a case of its own must not be implemented. Run the test with
+// -update to rewrite the golden responses after an intended change.
+func TestGoldenResponses(t *testing.T) {
+	srv, routes := newTestServer(t)
+
+	cases := apiCases()
+	covered := make(map[string]bool)
+	for _, tc := range cases {
+		if tc.route != "" {
+			covered[routeKey(tc.method, tc.route)] = true
+		}
+	}
+
+	registered := make(map[string]bool)
+	for _, route := range routes {
+		key := routeKey(route.Method, route.Path)
+		registered[key] = true
+		if !covered[key] {
+			cases = append(cases, apiCase{
+				golden: notImplementedGolden,
+				method: route.Method,
+				route:  route.Path,
+				path:   samplePath(route.Path),
+			})
+		}
+	}
+	for key := range covered {
+		require.True(t, registered[key], "no route serves case %s", key)
+	}
+
+	for _, tc := range cases {
+		t.Run(routeKey(tc.method, tc.path), func(t *testing.T) {
+			got := doRequest(t, srv, tc)
+			path := filepath.Join(goldenDir, tc.golden+".json")
+			if *update && tc.golden != notImplementedGolden {
+				writeGolden(t, path, got)
+			}
+			assertGolden(t, readGolden(t, path), got)
+		})
+	}
+}
+
+// doRequest makes the request of the case and records its response.
+func doRequest(t *testing.T, srv *httptest.Server, tc apiCase) *golden {
+	t.Helper()
+	var body io.Reader
+	if tc.body != "" {
+		body = strings.NewReader(tc.body)
+	}
+	req, err := http.NewRequest(tc.method, srv.URL+tc.path, body)
+	require.NoError(t, err)
+	if tc.body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if tc.accept != "" {
+		req.Header.Set("Accept", tc.accept)
+	}
+
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	bz, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	got := &golden{
+		Status:  resp.StatusCode,
+		Headers: make(map[string]string, len(goldenHeaders)),
+		Body:    bz,
+	}
+	for _, header := range goldenHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			got.Headers[header] = value
+		}
+	}
+	return got
+}
+
+// assertGolden compares a response to its golden response. The bodies are
+// compared as JSON, so that whitespace does not matter.
+func assertGolden(t *testing.T, want, got *golden) {
+	t.Helper()
+	require.Equal(t, want.Status, got.Status, "status code")
+	require.Equal(t, want.Headers, got.Headers, "headers")
+	require.JSONEq(t, string(want.Body), string(got.Body), "body")
+}
+
+func readGolden(t *testing.T, path string) *golden {
+	t.Helper()
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var g golden
+	require.NoError(t, json.Unmarshal(bz, &g))
+	return &g
+}
+
+func writeGolden(t *testing.T, path string, g *golden) {
+	t.Helper()
+	bz, err := json.MarshalIndent(g, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, append(bz, '\n'), 0o600))
+}
@@ -23,6 +23,7 @@ package echo
 import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
@@ -30,7 +31,8 @@ import (
 // Engine is an implementation of the API engine interface using Echo.
 type Engine struct {
 	*echo.Echo
-	logger log.Logger
+	logger    log.Logger
+	authToken string
 }
 
 // New initializes a new API engine with the given Echo instance.
@@ -42,10 +44,14 @@ func New(e *echo.Echo) *Engine {
 
 // NewDefaultEngine returns a new default Echo Engine instance.
 func NewDefaultEngine() *Engine {
+	return newEngine(middleware.DefaultCORSConfig)
+}
+
+// newEngine returns a new Echo Engine instance using the given CORS config.
+func newEngine(corsConfig middleware.CORSConfig) *Engine {
 	engine := echo.New()
-	engine.Use(middleware.CORSWithConfig(
-		middleware.DefaultCORSConfig,
-	))
+	engine.Use(middleware.CORSWithConfig(corsConfig))
+	engine.Use(requestIDMiddleware())
 	engine.Validator = &CustomValidator{
 		Validator: ConstructValidator(),
 	}
@@ -53,6 +59,33 @@ func NewDefaultEngine() *Engine {
 	return New(engine)
 }
 
+// EngineFactory creates a new Echo engine for every listener profile of the
+// node API server.
+type EngineFactory struct {
+	// authToken is the bearer token of the authenticated routes.
+	authToken string
+}
+
+// NewEngineFactory returns a new Echo EngineFactory. The auth token is
+// required by the authenticated routes of every engine it creates.
+func NewEngineFactory(authToken string) *EngineFactory {
+	return &EngineFactory{authToken: authToken}
+}
+
+// NewEngine returns a new Echo Engine with the middleware configured by the
+// given listener profile.
+func (f *EngineFactory) NewEngine(
+	profile server.ProfileConfig,
+) server.Engine[Context] {
+	corsConfig := middleware.DefaultCORSConfig
+	if len(profile.AllowOrigins) > 0 {
+		corsConfig.AllowOrigins = profile.AllowOrigins
+	}
+	engine := newEngine(corsConfig)
+	engine.authToken = f.authToken
+	return engine
+}
+
 // Run starts the Echo engine at the given address.
 func (e *Engine) Run(addr string) error {
 	return e.Echo.Start(addr)
@@ -67,10 +100,15 @@ func (e *Engine) RegisterRoutes(
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
+		var mws []echo.MiddlewareFunc
+		if route.Authenticated {
+			mws = append(mws, authMiddleware(e.authToken))
+		}
 		group.Add(
 			route.Method,
 			route.Path,
 			responseMiddleware(route),
+			mws...,
 		)
 	}
 }
@@ -0,0 +1,487 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// fixtureSlots is the number of slots, starting from zero, the fixture
+// backend holds data for. Slot zero is the head, as resolved by the
+// handlers.
+const fixtureSlots = 8
+
+// fill returns a 32 byte root with every byte set to b.
+func fill(b byte) common.Root {
+	var root common.Root
+	for i := range root {
+		root[i] = b
+	}
+	return root
+}
+
+// fillPubkey returns a public key with every byte set to b.
+func fillPubkey(b byte) crypto.BLSPubkey {
+	var pubkey crypto.BLSPubkey
+	for i := range pubkey {
+		pubkey[i] = b
+	}
+	return pubkey
+}
+
+// The roots of the fixture chain are derived from the slot, so that every
+// golden response can be checked by eye.
+func stateRoot(slot math.Slot) common.Root { return fill(0x10 + byte(slot)) }
+func blockRoot(slot math.Slot) common.Root { return fill(0x20 + byte(slot)) }
+func bodyRoot(slot math.Slot) common.Root  { return fill(0x30 + byte(slot)) }
+
+// fixtureHeader is the beacon block header served by the fixture backend.
+type fixtureHeader struct {
+	Slot     uint64      `json:"slot,string"`
+	BodyRoot common.Root `json:"body_root"`
+}
+
+func (h *fixtureHeader) GetBodyRoot() common.Root { return h.BodyRoot }
+
+// fixtureFork is the fork served by the fixture backend.
+type fixtureFork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           uint64 `json:"epoch,string"`
+}
+
+// fixtureValidator is the validator served by the fixture backend.
+type fixtureValidator struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance,string"`
+}
+
+// fixturePayloadHeader is the execution payload header served by the
+// fixture backend.
+type fixturePayloadHeader struct {
+	BlockNumber uint64      `json:"block_number,string"`
+	BlockHash   common.Root `json:"block_hash"`
+}
+
+// fixtureBackend is a deterministic backend for the handlers served by the
+// e2e suite. It holds fixtureSlots slots of data and two validators.
+type fixtureBackend struct {
+	spec       common.ChainSpec
+	validators []*beacontypes.ValidatorData[*fixtureValidator]
+	graffiti   map[crypto.BLSPubkey]string
+}
+
+// newFixtureBackend returns a new fixtureBackend.
+func newFixtureBackend() *fixtureBackend {
+	return &fixtureBackend{
+		spec: chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			DepositContractAddress: common.NewExecutionAddressFromHex(
+				"0x4242424242424242424242424242424242424242",
+			),
+			DepositEth1ChainID: 80087,
+		}),
+		validators: []*beacontypes.ValidatorData[*fixtureValidator]{
+			{
+				ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+					Index:   0,
+					Balance: 32e9,
+				},
+				Status: "active_ongoing",
+				Validator: &fixtureValidator{
+					Pubkey:           fillPubkey(0xaa),
+					EffectiveBalance: 32e9,
+				},
+			},
+			{
+				ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+					Index:   1,
+					Balance: 16e9,
+				},
+				Status: "pending_queued",
+				Validator: &fixtureValidator{
+					Pubkey:           fillPubkey(0xbb),
+					EffectiveBalance: 16e9,
+				},
+			},
+		},
+		graffiti: map[crypto.BLSPubkey]string{
+			fillPubkey(0xaa): "fixture",
+		},
+	}
+}
+
+// checkSlot returns types.ErrNotFound for slots the fixture does not hold.
+func checkSlot(slot math.Slot) error {
+	if slot >= fixtureSlots {
+		return types.ErrNotFound
+	}
+	return nil
+}
+
+// slotByRoot returns the slot whose root, as given by rootAt, is root.
+func slotByRoot(
+	root common.Root, rootAt func(math.Slot) common.Root,
+) (math.Slot, error) {
+	for slot := range math.Slot(fixtureSlots) {
+		if rootAt(slot) == root {
+			return slot, nil
+		}
+	}
+	return 0, types.ErrNotFound
+}
+
+// matchesID reports whether the validator is identified by one of the ids,
+// or whether no ids are given.
+func matchesID(
+	val *beacontypes.ValidatorData[*fixtureValidator], ids []string,
+) bool {
+	return len(ids) == 0 ||
+		slices.Contains(ids, strconv.FormatUint(val.Index, 10)) ||
+		slices.Contains(ids, val.Validator.Pubkey.String())
+}
+
+func (b *fixtureBackend) GetSlotByBlockRoot(
+	root common.Root,
+) (math.Slot, error) {
+	return slotByRoot(root, blockRoot)
+}
+
+func (b *fixtureBackend) GetSlotByStateRoot(
+	root common.Root,
+) (math.Slot, error) {
+	return slotByRoot(root, stateRoot)
+}
+
+func (b *fixtureBackend) GenesisValidatorsRoot(math.Slot) (common.Root, error) {
+	return fill(0x0a), nil
+}
+
+func (b *fixtureBackend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	return blockRoot(slot), checkSlot(slot)
+}
+
+func (b *fixtureBackend) BlockRewardsAtSlot(
+	slot math.Slot,
+) (*beacontypes.BlockRewardsData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &beacontypes.BlockRewardsData{Total: 1e9}, nil
+}
+
+func (b *fixtureBackend) BlockHeaderAtSlot(
+	slot math.Slot,
+) (*fixtureHeader, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &fixtureHeader{Slot: slot.Unwrap(), BodyRoot: bodyRoot(slot)}, nil
+}
+
+func (b *fixtureBackend) ExecutionPayloadAtSlot(
+	slot math.Slot,
+) (*beacontypes.ExecutionPayloadData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &beacontypes.ExecutionPayloadData{
+		Header: &fixturePayloadHeader{
+			BlockNumber: slot.Unwrap(),
+			BlockHash:   fill(0x50 + byte(slot)),
+		},
+		Transactions: []bytes.Bytes{{0x02, 0xf8}},
+	}, nil
+}
+
+func (b *fixtureBackend) BlobTransactionsAtSlot(
+	slot math.Slot,
+) ([]*beacontypes.BlobTransactionData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	var commitment eip4844.KZGCommitment
+	commitment[0] = byte(slot)
+	return []*beacontypes.BlobTransactionData{
+		{
+			Index: 1,
+			Hash:  common.ExecutionHash(fill(0x60 + byte(slot))),
+			From:  common.ExecutionAddress{0x70},
+			To:    common.ExecutionAddress{0x71},
+			Blobs: []*beacontypes.BlobTransactionBlob{
+				{
+					Index: 0,
+					VersionedHash: common.ExecutionHash(
+						commitment.ToVersionedHash(),
+					),
+					KZGCommitment: commitment,
+					Stored:        true,
+				},
+			},
+		},
+	}, nil
+}
+
+func (b *fixtureBackend) ForkVersionAtSlot(slot math.Slot) (uint32, error) {
+	return version.Deneb, checkSlot(slot)
+}
+
+func (b *fixtureBackend) RandaoAtEpoch(
+	slot math.Slot, epoch math.Epoch,
+) (common.Bytes32, error) {
+	return common.Bytes32(fill(0x40 + byte(epoch))), checkSlot(slot)
+}
+
+func (b *fixtureBackend) CommitteesAtEpoch(
+	slot math.Slot, epoch *math.Epoch,
+) ([]*beacontypes.CommitteeData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	// Without an epoch, the committees of the slot are returned.
+	first := slot.Unwrap()
+	if epoch != nil {
+		first = epoch.Unwrap() * 32
+	}
+	return []*beacontypes.CommitteeData{
+		{Index: 0, Slot: first, Validators: []uint64{0, 1}},
+		{Index: 0, Slot: first + 1, Validators: []uint64{1, 0}},
+	}, nil
+}
+
+func (b *fixtureBackend) StateRootAtSlot(slot math.Slot) (common.Root, error) {
+	return stateRoot(slot), checkSlot(slot)
+}
+
+func (b *fixtureBackend) StateForkAtSlot(
+	slot math.Slot,
+) (*fixtureFork, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &fixtureFork{
+		PreviousVersion: "0x04000000",
+		CurrentVersion:  "0x04000000",
+	}, nil
+}
+
+func (b *fixtureBackend) ValidatorByID(
+	slot math.Slot, id string,
+) (*beacontypes.ValidatorData[*fixtureValidator], error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	for _, val := range b.validators {
+		if matchesID(val, []string{id}) {
+			return val, nil
+		}
+	}
+	return nil, types.ErrNotFound
+}
+
+func (b *fixtureBackend) ValidatorsByIDs(
+	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData[*fixtureValidator], error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	vals := make([]*beacontypes.ValidatorData[*fixtureValidator], 0)
+	for _, val := range b.validators {
+		if matchesID(val, ids) &&
+			utils.MatchesStatuses(val.Status, statuses) {
+			vals = append(vals, val)
+		}
+	}
+	return vals, nil
+}
+
+func (b *fixtureBackend) ValidatorBalancesByIDs(
+	slot math.Slot, ids []string,
+) ([]*beacontypes.ValidatorBalanceData, error) {
+	vals, err := b.ValidatorsByIDs(slot, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]*beacontypes.ValidatorBalanceData, len(vals))
+	for i, val := range vals {
+		balances[i] = &val.ValidatorBalanceData
+	}
+	return balances, nil
+}
+
+func (b *fixtureBackend) ValidatorQueue(
+	slot math.Slot,
+) (*beacontypes.ValidatorQueueData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &beacontypes.ValidatorQueueData{
+		ActivationChurnLimit: 4,
+		ExitChurnLimit:       4,
+		ActivationQueue: []*beacontypes.ValidatorQueueEntry{
+			{Index: 1, Position: 0, Epoch: 2},
+		},
+		ExitQueue: []*beacontypes.ValidatorQueueEntry{},
+	}, nil
+}
+
+func (b *fixtureBackend) BlobFees(limit int) []*beacontypes.BlobFeeData {
+	fees := []*beacontypes.BlobFeeData{
+		{
+			Slot:            6,
+			BlockNumber:     6,
+			BlobGasUsed:     393216,
+			ExcessBlobGas:   0,
+			Utilization:     0.5,
+			BlobBaseFee:     "1",
+			NextBlobBaseFee: "1",
+		},
+		{
+			Slot:            7,
+			BlockNumber:     7,
+			BlobGasUsed:     786432,
+			ExcessBlobGas:   0,
+			Utilization:     1,
+			BlobBaseFee:     "1",
+			NextBlobBaseFee: "2",
+		},
+	}
+	if limit > 0 && limit < len(fees) {
+		return fees[len(fees)-limit:]
+	}
+	return fees
+}
+
+func (b *fixtureBackend) BlockSummaries(
+	from, to math.Slot,
+) []*beacontypes.BlockSummaryData {
+	summaries := make([]*beacontypes.BlockSummaryData, 0, 2)
+	for _, slot := range []math.Slot{6, 7} {
+		if slot < from || slot > to {
+			continue
+		}
+		summaries = append(summaries, &beacontypes.BlockSummaryData{
+			Slot:          slot.Unwrap(),
+			BlockRoot:     blockRoot(slot),
+			ParentRoot:    blockRoot(slot - 1),
+			StateRoot:     stateRoot(slot),
+			ProposerIndex: slot.Unwrap() % 2,
+		})
+	}
+	return summaries
+}
+
+func (b *fixtureBackend) DepositWatermark() (
+	*beacontypes.DepositWatermarkData, error,
+) {
+	return &beacontypes.DepositWatermarkData{
+		FinalizedDepositIndex: 4,
+		PrunedDepositIndex:    2,
+	}, nil
+}
+
+func (b *fixtureBackend) ProposerTimeliness() (
+	data []*beacontypes.ProposerTimelinessData,
+) {
+	return []*beacontypes.ProposerTimelinessData{
+		{
+			ValidatorIndex: 0,
+			Blocks:         3,
+			LateBlocks:     0,
+			AvgLatenessMs:  250,
+			MaxLatenessMs:  400,
+			LastLatenessMs: 100,
+			LastSlot:       3,
+		},
+		{
+			ValidatorIndex: 1,
+			Blocks:         2,
+			LateBlocks:     2,
+			LateStreak:     2,
+			AvgLatenessMs:  2750,
+			MaxLatenessMs:  3000,
+			LastLatenessMs: 2500,
+			LastSlot:       4,
+		},
+	}
+}
+
+func (b *fixtureBackend) ChainSpec() common.ChainSpec {
+	return b.spec
+}
+
+func (b *fixtureBackend) Template(pubkey crypto.BLSPubkey) string {
+	return b.graffiti[pubkey]
+}
+
+func (b *fixtureBackend) SetTemplate(
+	pubkey crypto.BLSPubkey, graffiti string,
+) error {
+	b.graffiti[pubkey] = graffiti
+	return nil
+}
+
+func (b *fixtureBackend) DeleteTemplate(pubkey crypto.BLSPubkey) {
+	delete(b.graffiti, pubkey)
+}
+
+func (b *fixtureBackend) AttestationDataAtSlot(
+	slot math.Slot, committeeIndex uint64,
+) (*validatortypes.AttestationData, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+	return &validatortypes.AttestationData{
+		Slot:            slot.Unwrap(),
+		Index:           committeeIndex,
+		BeaconBlockRoot: blockRoot(slot),
+		Source:          validatortypes.Checkpoint{Root: blockRoot(0)},
+		Target:          validatortypes.Checkpoint{Root: blockRoot(0)},
+	}, nil
+}
+
+func (b *fixtureBackend) ValidatorLiveness(
+	_ math.Epoch, indices []math.ValidatorIndex,
+) ([]*validatortypes.ValidatorLiveness, error) {
+	liveness := make([]*validatortypes.ValidatorLiveness, len(indices))
+	for i, index := range indices {
+		liveness[i] = &validatortypes.ValidatorLiveness{
+			Index:  index.Unwrap(),
+			IsLive: index == 0,
+		}
+	}
+	return liveness, nil
+}
@@ -21,18 +21,25 @@
 package echo
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/encoding/json"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 	"github.com/labstack/echo/v4"
 )
 
 // ErrorResponse is a response that is returned when an error occurs.
+// Failures lists the offending fields of a request that failed validation.
 type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code     int                `json:"code"`
+	Message  string             `json:"message"`
+	Failures []types.FieldError `json:"failures,omitempty"`
 }
 
 // responseMiddleware is a middleware that converts errors to an HTTP status
@@ -42,8 +49,139 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if stream, ok := data.(types.Stream); ok && err == nil {
+			return stream.ServeStream(
+				c.Request().Context(), c.Response(),
+			)
+		}
+		if err == nil {
+			return writeResponse(c, data)
+		}
 		code, response := responseFromError(data, err)
-		return c.JSON(code, response)
+		return writeJSON(c, code, response)
+	}
+}
+
+// writeJSON writes the response in the canonical JSON encoding of the beacon
+// API.
+func writeJSON(c Context, code int, response any) error {
+	bz, err := json.Marshal(response)
+	if err != nil {
+		code, response = responseFromError(nil, err)
+		if bz, err = json.Marshal(response); err != nil {
+			return err
+		}
+	}
+	return c.JSONBlob(code, bz)
+}
+
+// writeResponse writes the response of a handler. The fork of a versioned
+// response is named in the Eth-Consensus-Version header, and responses with
+// an SSZ encoding are served SSZ encoded if the client prefers it to JSON.
+func writeResponse(c Context, response any) error {
+	if v, ok := response.(types.Versioned); ok {
+		c.Response().Header().Set(
+			types.ConsensusVersionHeader, v.ConsensusVersion(),
+		)
+	}
+	m, ok := response.(types.SSZMarshaler)
+	if !ok || !prefersSSZ(c.Request().Header.Get(echo.HeaderAccept)) {
+		return writeJSON(c, http.StatusOK, response)
+	}
+	bz, err := m.MarshalSSZ()
+	switch {
+	case errors.Is(err, types.ErrSSZNotSupported):
+		return writeJSON(c, http.StatusOK, response)
+	case err != nil:
+		code, errResponse := responseFromError(nil, err)
+		return writeJSON(c, code, errResponse)
+	default:
+		return c.Blob(http.StatusOK, types.SSZContentType, bz)
+	}
+}
+
+// prefersSSZ reports whether the media ranges of an Accept header rank SSZ
+// above JSON. Equal weights are broken by the order of the media ranges.
+func prefersSSZ(accept string) bool {
+	sszIndex, sszWeight := -1, 0.0
+	jsonIndex, jsonWeight := -1, 0.0
+	for i, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(mediaRange, ";")
+		switch strings.TrimSpace(mediaType) {
+		case types.SSZContentType:
+			if sszIndex < 0 {
+				sszIndex, sszWeight = i, mediaRangeWeight(params)
+			}
+		case echo.MIMEApplicationJSON, "*/*":
+			if jsonIndex < 0 {
+				jsonIndex, jsonWeight = i, mediaRangeWeight(params)
+			}
+		}
+	}
+	switch {
+	case sszIndex < 0 || sszWeight == 0:
+		return false
+	case jsonIndex < 0:
+		return true
+	case sszWeight != jsonWeight:
+		return sszWeight > jsonWeight
+	default:
+		return sszIndex < jsonIndex
+	}
+}
+
+// mediaRangeWeight returns the q parameter of a media range, one if it has
+// none.
+func mediaRangeWeight(params string) float64 {
+	for _, param := range strings.Split(params, ";") {
+		q, ok := strings.CutPrefix(strings.TrimSpace(param), "q=")
+		if !ok {
+			continue
+		}
+		if w, err := strconv.ParseFloat(q, 64); err == nil {
+			return w
+		}
+	}
+	return 1
+}
+
+// requestIDMiddleware is a middleware that attaches a correlation ID to every
+// request, reusing the one supplied by the client if it is valid, and echoes
+// it in the response headers.
+func requestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			id := correlation.OrNewID(
+				c.Request().Header.Get(correlation.Header),
+			)
+			c.SetRequest(c.Request().WithContext(
+				correlation.WithID(c.Request().Context(), id),
+			))
+			c.Response().Header().Set(correlation.Header, id)
+			return next(c)
+		}
+	}
+}
+
+// authMiddleware is a middleware that only passes on requests bearing the
+// given token in their Authorization header. Every request is refused if the
+// token is empty.
+func authMiddleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c Context) error {
+			bearer, ok := strings.CutPrefix(
+				c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ",
+			)
+			if token == "" || !ok || subtle.ConstantTimeCompare(
+				[]byte(bearer), []byte(token),
+			) != 1 {
+				code, response := responseFromError(
+					nil, types.ErrUnauthorized,
+				)
+				return writeJSON(c, code, response)
+			}
+			return next(c)
+		}
 	}
 }
 
@@ -59,10 +197,21 @@ func responseFromError(data any, err error) (int, any) {
 			Message: err.Error(),
 		}
 	case errors.Is(err, types.ErrInvalidRequest):
-		return http.StatusBadRequest, ErrorResponse{
+		response := ErrorResponse{
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+		var validationErr *types.ValidationError
+		if errors.As(err, &validationErr) {
+			response.Message = types.ErrInvalidRequest.Error()
+			response.Failures = validationErr.Failures
+		}
+		return http.StatusBadRequest, response
+	case errors.Is(err, types.ErrUnauthorized):
+		return http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
@@ -22,26 +22,21 @@ package echo
 
 import (
 	"errors"
-	"fmt"
-	"net/http"
-	"regexp"
-	"strconv"
+	"reflect"
+	"strings"
 
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 	"github.com/go-playground/validator/v10"
-	"github.com/labstack/echo/v4"
 )
 
-// TODO: these validators need to be un-janked to 1) not use `FieldLevel` for
-// repeated `.Field().String()` calls and 2) strongly type the allowed IDs,
-// putting validation logic on each type.
-
 // CustomValidator is a custom validator for the API.
 type CustomValidator struct {
 	Validator *validator.Validate
 }
 
-// Validate validates the given interface.
+// Validate validates the given interface, reporting every field that fails
+// validation along with the format it is expected to have.
 func (cv *CustomValidator) Validate(i interface{}) error {
 	if err := cv.Validator.Struct(i); err != nil {
 		var validationErrors validator.ValidationErrors
@@ -49,25 +44,35 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 		if !hasValidationErrors || len(validationErrors) == 0 {
 			return nil
 		}
-		firstError := validationErrors[0]
-		field := firstError.Field()
-		value := firstError.Value()
-		return echo.NewHTTPError(http.StatusBadRequest,
-			fmt.Sprintf("Invalid %s: %s", field, value))
+		failures := make([]types.FieldError, len(validationErrors))
+		for idx, fieldErr := range validationErrors {
+			failures[idx] = types.FieldError{
+				Field:   fieldErr.Field(),
+				Message: utils.FormatMessage(fieldErr.Tag()),
+			}
+		}
+		return types.NewValidationError(failures...)
 	}
 	return nil
 }
 
 func ConstructValidator() *validator.Validate {
 	validators := map[string](func(fl validator.FieldLevel) bool){
-		"state_id":     ValidateStateID,
-		"block_id":     ValidateBlockID,
-		"execution_id": ValidateExecutionID,
-		"validator_id": ValidateValidatorID,
-		"epoch":        ValidateUint64,
-		"slot":         ValidateUint64,
+		"state_id":         ValidateStateID,
+		"block_id":         ValidateBlockID,
+		"execution_id":     ValidateExecutionID,
+		"validator_id":     ValidateValidatorID,
+		"validator_status": ValidateValidatorStatus,
+		"pubkey":           ValidatePubkey,
+		"root":             ValidateRoot,
+		"epoch":            ValidateUint64,
+		"slot":             ValidateUint64,
+		"committee_index":  ValidateUint64,
+		"validator_index":  ValidateUint64,
+		"uint64":           ValidateUint64,
 	}
 	validate := validator.New()
+	validate.RegisterTagNameFunc(fieldName)
 	for tag, fn := range validators {
 		err := validate.RegisterValidation(tag, fn)
 		if err != nil {
@@ -77,131 +82,63 @@ func ConstructValidator() *validator.Validate {
 	return validate
 }
 
-func ValidateStateID(fl validator.FieldLevel) bool {
-	allowedValues := map[string]bool{
-		"head":      true,
-		"genesis":   true,
-		"finalized": true,
-		"justified": true,
+// fieldName reports fields by the name they are given in the request,
+// falling back to the Go field name for fields bound from the body.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"param", "query", "json"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
 	}
-	return validateStateBlockIDs(fl.Field().String(), allowedValues)
+	return field.Name
 }
 
-func ValidateBlockID(fl validator.FieldLevel) bool {
-	allowedValues := map[string]bool{
-		"head":      true,
-		"genesis":   true,
-		"finalized": true,
-	}
-	return validateStateBlockIDs(fl.Field().String(), allowedValues)
+func ValidateStateID(fl validator.FieldLevel) bool {
+	return validateOptional(fl, utils.IsStateID)
 }
 
-func ValidateExecutionID(fl validator.FieldLevel) bool {
-	allowedValues := map[string]bool{
-		utils.StateIDHead:      true,
-		utils.StateIDGenesis:   true,
-		utils.StateIDFinalized: true,
-		utils.StateIDJustified: true,
-	}
-
-	value := fl.Field().String()
-	if utils.IsExecutionNumberPrefix(value) {
-		return ValidateUint64Dec(value[1:])
-	}
-
-	return validateStateBlockIDs(value, allowedValues)
+func ValidateBlockID(fl validator.FieldLevel) bool {
+	return validateOptional(fl, utils.IsBlockID)
 }
 
-func ValidateUint64Dec(value string) bool {
-	if value == "" {
-		return true
-	}
-	if _, err := strconv.ParseUint(value, 10, 64); err == nil {
-		return true
-	}
-	return false
+func ValidateExecutionID(fl validator.FieldLevel) bool {
+	return validateOptional(fl, utils.IsExecutionID)
 }
 
 func ValidateUint64(fl validator.FieldLevel) bool {
-	return ValidateUint64Dec(fl.Field().String())
+	return validateOptional(fl, utils.IsUint64)
 }
 
 // ValidateValidatorID checks if the provided field is a valid
 // validator identifier. It validates against a hex-encoded public key
 // or a numeric validator index.
 func ValidateValidatorID(fl validator.FieldLevel) bool {
-	valid, err := validateRegex(fl.Field().String(), `^0x[0-9a-fA-F]{1,96}$`)
-	if err != nil {
-		return false
-	}
-	if valid {
-		return true
-	}
-	if ValidateUint64(fl) {
-		return true
-	}
-	return false
+	return validateOptional(fl, utils.IsValidatorID)
+}
+
+// ValidatePubkey checks if the provided field is a valid validator public
+// key. It validates against a 48 byte hex-encoded key with "0x" prefix.
+func ValidatePubkey(fl validator.FieldLevel) bool {
+	return validateOptional(fl, utils.IsPubkey)
 }
 
 // ValidateRoot checks if the provided field is a valid root.
 // It validates against a 32 byte hex-encoded root with "0x" prefix.
-func ValidateRoot(value string) bool {
-	valid, err := validateRegex(value, `^0x[0-9a-fA-F]{64}$`)
-	if err != nil {
-		return false
-	}
-	return valid
+func ValidateRoot(fl validator.FieldLevel) bool {
+	return validateOptional(fl, utils.IsRoot)
 }
 
 func ValidateValidatorStatus(fl validator.FieldLevel) bool {
-	// Eth Beacon Node API specs: https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
-	allowedStatuses := map[string]bool{
-		"pending_initialized": true,
-		"pending_queued":      true,
-		"active_ongoing":      true,
-		"active_exiting":      true,
-		"active_slashed":      true,
-		"exited_unslashed":    true,
-		"exited_slashed":      true,
-		"withdrawal_possible": true,
-		"withdrawal_done":     true,
-	}
-	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
+	return validateOptional(fl, utils.IsValidatorStatus)
 }
 
-func validateAllowedStrings(
-	value string,
-	allowedValues map[string]bool,
+// validateOptional accepts an empty field, leaving presence checks to the
+// "required" tag, and otherwise applies the given format check.
+func validateOptional(
+	fl validator.FieldLevel,
+	isValid func(string) bool,
 ) bool {
-	if value == "" {
-		return true
-	}
-	return allowedValues[value]
-}
-
-func validateRegex(value string, hexPattern string) (bool, error) {
-	if value == "" {
-		return true, nil
-	}
-	matched, err := regexp.MatchString(hexPattern, value)
-	if err != nil {
-		return false, err
-	}
-	return matched, nil
-}
-
-func validateStateBlockIDs(value string, allowedValues map[string]bool) bool {
-	// Check if value is one of the allowed values
-	if validateAllowedStrings(value, allowedValues) {
-		return true
-	}
-	// Check if value is a slot (unsigned 64-bit integer)
-	if ValidateUint64Dec(value) {
-		return true
-	}
-	// Check if value is a hex-encoded 32 byte root with "0x" prefix
-	if ValidateRoot(value) {
-		return true
-	}
-	return false
+	value := fl.Field().String()
+	return value == "" || isValid(value)
 }
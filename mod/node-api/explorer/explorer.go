@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package explorer serves a minimal block explorer, a single page rendering
+// the recent blocks, the validators, the deposits and the health of the node
+// by calling its own API.
+package explorer
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+)
+
+// Path is the path the explorer is served at.
+const Path = "/explorer"
+
+//go:embed index.html
+//nolint:gochecknoglobals // embedded page.
+var page []byte
+
+// RouteSet returns the route set serving the explorer.
+func RouteSet[ContextT any]() *handlers.RouteSet[ContextT] {
+	return handlers.NewRouteSet("", &handlers.Route[ContextT]{
+		Method: http.MethodGet,
+		Path:   Path,
+		Handler: func(ContextT) (any, error) {
+			return htmlPage(page), nil
+		},
+	})
+}
+
+// htmlPage is an HTML document, served as is rather than encoded as JSON.
+type htmlPage []byte
+
+// ServeStream implements types.Stream.
+func (p htmlPage) ServeStream(_ context.Context, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(p)
+	return err
+}
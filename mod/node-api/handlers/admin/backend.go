@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Backend is the interface for backend of the admin API.
+type Backend interface {
+	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// GetSlotByStateRoot retrieves the slot by a given root from the store.
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// SimulateBlock applies the SSZ encoded block on top of the state at the
+	// given slot without persisting the result.
+	SimulateBlock(
+		slot math.Slot, blockSSZ []byte, opts *types.SimulationOptions,
+	) (*types.SimulationResult, error)
+}
+
+// Maintenance is the interface for the maintenance mode of the node. While in
+// maintenance the node keeps following the chain but builds no payloads.
+type Maintenance interface {
+	// Enable puts the node into maintenance mode.
+	Enable()
+	// Disable takes the node out of maintenance mode.
+	Disable()
+	// Enabled reports whether the node is in maintenance mode.
+	Enabled() bool
+	// Since returns the time maintenance mode was enabled at.
+	Since() time.Time
+	// Proposing reports whether a block proposal is in flight.
+	Proposing() bool
+	// Drain puts the node into maintenance mode and blocks until no block
+	// proposal is in flight.
+	Drain(ctx context.Context) error
+}
+
+// EmptyPayloads is the override forcing the local builder to build empty
+// payloads for a number of slots.
+type EmptyPayloads interface {
+	// Set forces empty payloads for the given number of slots. Zero lifts
+	// the override.
+	Set(slots uint64)
+	// Remaining returns the number of slots the override still applies to.
+	Remaining() uint64
+}
+
+// SlotControl decides which slots the node builds blocks for on devnets.
+type SlotControl interface {
+	// Enabled reports whether the controls are enabled.
+	Enabled() bool
+	// Pause pauses block production.
+	Pause()
+	// Resume resumes block production.
+	Resume()
+	// Produce builds blocks for the given number of slots and pauses block
+	// production after them.
+	Produce(blocks uint64)
+	// Skip skips the given number of slots. Zero stops skipping slots.
+	Skip(slots uint64)
+	// Paused reports whether block production is paused.
+	Paused() bool
+	// RemainingBlocks returns the number of blocks left to build before
+	// block production pauses again.
+	RemainingBlocks() uint64
+	// RemainingSkips returns the number of slots left to skip.
+	RemainingSkips() uint64
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetEmptyPayloads returns the status of the empty payload override.
+func (h *Handler[ContextT]) GetEmptyPayloads(ContextT) (any, error) {
+	return types.EmptyPayloadsStatus{
+		RemainingSlots: h.emptyPayloads.Remaining(),
+	}, nil
+}
+
+// PostEmptyPayloads forces the local builder to build empty payloads for the
+// requested number of slots, e.g. while the mempool of the execution client
+// is poisoned.
+func (h *Handler[ContextT]) PostEmptyPayloads(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.SetEmptyPayloadsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.emptyPayloads.Set(req.Slots)
+	h.Logger().Warn(
+		"Empty payload override set by the admin API", "slots", req.Slots,
+	)
+	return types.EmptyPayloadsStatus{
+		RemainingSlots: h.emptyPayloads.Remaining(),
+	}, nil
+}
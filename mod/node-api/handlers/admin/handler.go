@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+)
+
+// Handler is the handler for the admin API. Its endpoints are meant for node
+// operators and should only be served on a private listener profile.
+type Handler[ContextT context.Context] struct {
+	*handlers.BaseHandler[ContextT]
+	backend       Backend
+	maintenance   Maintenance
+	emptyPayloads EmptyPayloads
+	slotControl   SlotControl
+}
+
+// NewHandler creates a new handler for the admin API.
+func NewHandler[ContextT context.Context](
+	backend Backend,
+	maintenance Maintenance,
+	emptyPayloads EmptyPayloads,
+	slotControl SlotControl,
+) *Handler[ContextT] {
+	h := &Handler[ContextT]{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet[ContextT](""),
+		),
+		backend:       backend,
+		maintenance:   maintenance,
+		emptyPayloads: emptyPayloads,
+		slotControl:   slotControl,
+	}
+	return h
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// drainTimeout is how long the drain and shutdown endpoints wait for an in
+// flight block proposal to complete.
+const drainTimeout = 30 * time.Second
+
+// GetMaintenance returns the maintenance status of the node.
+func (h *Handler[ContextT]) GetMaintenance(ContextT) (any, error) {
+	return h.maintenanceStatus(), nil
+}
+
+// PostMaintenance puts the node into or takes it out of maintenance mode.
+// While in maintenance the node keeps following the chain, but builds no
+// payloads.
+func (h *Handler[ContextT]) PostMaintenance(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.SetMaintenanceRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.Enabled {
+		h.maintenance.Enable()
+	} else {
+		h.maintenance.Disable()
+	}
+	return h.maintenanceStatus(), nil
+}
+
+// PostDrain puts the node into maintenance mode and waits for an in flight
+// block proposal to complete, so that the node can be taken out of rotation.
+func (h *Handler[ContextT]) PostDrain(ContextT) (any, error) {
+	if err := h.drain(); err != nil {
+		return nil, err
+	}
+	return h.maintenanceStatus(), nil
+}
+
+// PostShutdown drains the node and then gracefully shuts it down, as if it
+// had received a SIGTERM.
+func (h *Handler[ContextT]) PostShutdown(ContextT) (any, error) {
+	if err := h.drain(); err != nil {
+		return nil, err
+	}
+	h.Logger().Info("Shutting down node as requested by the admin API")
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		err = p.Signal(syscall.SIGTERM)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return h.maintenanceStatus(), nil
+}
+
+// drain waits up to drainTimeout for the node to be drained.
+func (h *Handler[ContextT]) drain() error {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	return h.maintenance.Drain(ctx)
+}
+
+// maintenanceStatus returns the current maintenance status of the node.
+func (h *Handler[ContextT]) maintenanceStatus() types.MaintenanceStatus {
+	status := types.MaintenanceStatus{
+		Enabled:   h.maintenance.Enabled(),
+		Proposing: h.maintenance.Proposing(),
+	}
+	if since := h.maintenance.Since(); !since.IsZero() {
+		status.Since = since.Format(time.RFC3339)
+	}
+	return status
+}
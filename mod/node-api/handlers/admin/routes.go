@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+)
+
+func (h *Handler[ContextT]) RegisterRoutes(logger log.Logger) {
+	h.SetLogger(logger)
+	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/simulate/:state_id",
+			Handler:       h.PostSimulateBlock,
+			Request:       types.SimulateBlockRequest{},
+			Response:      types.SimulationResult{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "bkit/v1/admin/maintenance",
+			Handler:       h.GetMaintenance,
+			Response:      types.MaintenanceStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/maintenance",
+			Handler:       h.PostMaintenance,
+			Request:       types.SetMaintenanceRequest{},
+			Response:      types.MaintenanceStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/drain",
+			Handler:       h.PostDrain,
+			Response:      types.MaintenanceStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/shutdown",
+			Handler:       h.PostShutdown,
+			Response:      types.MaintenanceStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "bkit/v1/admin/empty_payloads",
+			Handler:       h.GetEmptyPayloads,
+			Response:      types.EmptyPayloadsStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/empty_payloads",
+			Handler:       h.PostEmptyPayloads,
+			Request:       types.SetEmptyPayloadsRequest{},
+			Response:      types.EmptyPayloadsStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodGet,
+			Path:          "bkit/v1/admin/devnet/slots",
+			Handler:       h.GetSlotControl,
+			Response:      types.SlotControlStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/devnet/pause",
+			Handler:       h.PostPause,
+			Response:      types.SlotControlStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/devnet/resume",
+			Handler:       h.PostResume,
+			Response:      types.SlotControlStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/devnet/produce",
+			Handler:       h.PostProduce,
+			Request:       types.ProduceBlocksRequest{},
+			Response:      types.SlotControlStatus{},
+			Authenticated: true,
+		},
+		{
+			Method:        http.MethodPost,
+			Path:          "bkit/v1/admin/devnet/skip",
+			Handler:       h.PostSkip,
+			Request:       types.SkipSlotsRequest{},
+			Response:      types.SlotControlStatus{},
+			Authenticated: true,
+		},
+	})
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+)
+
+// PostSimulateBlock runs the state transition for the given block against the
+// requested state and reports the outcome. Nothing is persisted. The block is
+// decoded as a block of the fork named by the Eth-Consensus-Version header,
+// if the request has one.
+func (h *Handler[ContextT]) PostSimulateBlock(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.SimulateBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blockSSZ, err := hex.ToBytes(req.Block)
+	if err != nil {
+		return nil, utils.InvalidField("block", "hexadecimal")
+	}
+	opts := &types.SimulationOptions{
+		SkipPayloadVerification: req.SkipPayloadVerification,
+		SkipValidateRandao:      req.SkipValidateRandao,
+		SkipValidateResult:      req.SkipValidateResult,
+	}
+	forkVersion, ok, err := utils.ConsensusVersion(c)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		opts.ForkVersion = &forkVersion
+	}
+	return h.backend.SimulateBlock(slot, blockSSZ, opts)
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+func (h *Handler[ContextT]) GetSlotControl(ContextT) (any, error) {
+	if err := h.requireSlotControl(); err != nil {
+		return nil, err
+	}
+	return h.slotControlStatus(), nil
+}
+
+func (h *Handler[ContextT]) PostPause(ContextT) (any, error) {
+	if err := h.requireSlotControl(); err != nil {
+		return nil, err
+	}
+	h.slotControl.Pause()
+	h.Logger().Warn("Block production paused by the admin API")
+	return h.slotControlStatus(), nil
+}
+
+func (h *Handler[ContextT]) PostResume(ContextT) (any, error) {
+	if err := h.requireSlotControl(); err != nil {
+		return nil, err
+	}
+	h.slotControl.Resume()
+	h.Logger().Warn("Block production resumed by the admin API")
+	return h.slotControlStatus(), nil
+}
+
+func (h *Handler[ContextT]) PostProduce(c ContextT) (any, error) {
+	if err := h.requireSlotControl(); err != nil {
+		return nil, err
+	}
+	req, err := utils.BindAndValidate[types.ProduceBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.slotControl.Produce(req.Blocks)
+	h.Logger().Warn(
+		"Block production stepped by the admin API", "blocks", req.Blocks,
+	)
+	return h.slotControlStatus(), nil
+}
+
+func (h *Handler[ContextT]) PostSkip(c ContextT) (any, error) {
+	if err := h.requireSlotControl(); err != nil {
+		return nil, err
+	}
+	req, err := utils.BindAndValidate[types.SkipSlotsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	h.slotControl.Skip(req.Slots)
+	h.Logger().Warn("Slots skipped by the admin API", "slots", req.Slots)
+	return h.slotControlStatus(), nil
+}
+
+// requireSlotControl fails the request unless the devnet controls are
+// enabled in the configuration of the node.
+func (h *Handler[ContextT]) requireSlotControl() error {
+	if h.slotControl == nil || !h.slotControl.Enabled() {
+		return errors.Wrap(
+			handlertypes.ErrInvalidRequest, "devnet controls are disabled",
+		)
+	}
+	return nil
+}
+
+func (h *Handler[ContextT]) slotControlStatus() types.SlotControlStatus {
+	return types.SlotControlStatus{
+		Paused:          h.slotControl.Paused(),
+		RemainingBlocks: h.slotControl.RemainingBlocks(),
+		RemainingSkips:  h.slotControl.RemainingSkips(),
+	}
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+
+// SimulateBlockRequest is the request for the
+// `/bkit/v1/admin/simulate/{state_id}` endpoint. Block holds the 0x prefixed
+// hex encoding of the SSZ beacon block to apply on top of the state. The
+// remaining fields mirror the flags of the state transition context.
+//
+//nolint:lll // tags get long
+type SimulateBlockRequest struct {
+	types.StateIDRequest
+	Block                   string `json:"block"                     validate:"required,hexadecimal"`
+	SkipPayloadVerification bool   `json:"skip_payload_verification"`
+	SkipValidateRandao      bool   `json:"skip_validate_randao"`
+	SkipValidateResult      bool   `json:"skip_validate_result"`
+}
+
+// SimulationOptions are the state transition flags of a simulated block and
+// the fork it is decoded as.
+type SimulationOptions struct {
+	SkipPayloadVerification bool
+	SkipValidateRandao      bool
+	SkipValidateResult      bool
+	// ForkVersion is the version of the fork the block is decoded as. If
+	// nil, the fork active at the slot of the block is used.
+	ForkVersion *uint32
+}
+
+// SetMaintenanceRequest is the request for the
+// `POST /bkit/v1/admin/maintenance` endpoint. Enabled puts the node into or takes it out of maintenance mode.
+type SetMaintenanceRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// SetEmptyPayloadsRequest is the request for the
+// `POST /bkit/v1/admin/empty_payloads` endpoint. Slots is the number of
+// slots, starting with the next payload built, for which the local builder
+// builds empty payloads. Zero lifts the override.
+type SetEmptyPayloadsRequest struct {
+	Slots uint64 `json:"slots,string"`
+}
+
+// ProduceBlocksRequest is the request for the
+// `POST /bkit/v1/admin/devnet/produce` endpoint. Blocks is the number of
+// slots the node builds blocks for before block production pauses again.
+type ProduceBlocksRequest struct {
+	Blocks uint64 `json:"blocks,string"`
+}
+
+// SkipSlotsRequest is the request for the
+// `POST /bkit/v1/admin/devnet/skip` endpoint. Slots is the number of slots,
+// starting with the next one the node proposes for, it builds no block for.
+// Zero stops skipping slots.
+type SkipSlotsRequest struct {
+	Slots uint64 `json:"slots,string"`
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// SimulationResult is the response for the
+// `/bkit/v1/admin/simulate/{state_id}` endpoint.
+type SimulationResult struct {
+	// StateRoot is the root of the state after the transition. It is left
+	// empty if the transition failed.
+	StateRoot common.Root `json:"state_root"`
+	// ValidatorUpdates are the validator set updates produced by the
+	// transition.
+	ValidatorUpdates []*ValidatorUpdate `json:"validator_updates"`
+	// Error is the error returned by the transition, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// ValidatorUpdate is a validator set update produced by a simulated
+// transition.
+type ValidatorUpdate struct {
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance,string"`
+}
+
+// MaintenanceStatus is the response for the `/bkit/v1/admin/maintenance`,
+// `/bkit/v1/admin/drain` and `/bkit/v1/admin/shutdown` endpoints.
+type MaintenanceStatus struct {
+	// Enabled reports whether the node is in maintenance mode.
+	Enabled bool `json:"enabled"`
+	// Since is the RFC 3339 time maintenance mode was enabled at. It is
+	// left empty if the node is not in maintenance mode.
+	Since string `json:"since,omitempty"`
+	// Proposing reports whether a block proposal is in flight.
+	Proposing bool `json:"proposing"`
+}
+
+// EmptyPayloadsStatus is the response for the
+// `/bkit/v1/admin/empty_payloads` endpoint.
+type EmptyPayloadsStatus struct {
+	// RemainingSlots is the number of slots the empty payload override
+	// still applies to.
+	RemainingSlots uint64 `json:"remaining_slots,string"`
+}
+
+// SlotControlStatus is the response for the `/bkit/v1/admin/devnet/*`
+// endpoints.
+type SlotControlStatus struct {
+	// Paused reports whether block production is paused.
+	Paused bool `json:"paused"`
+	// RemainingBlocks is the number of blocks left to build before block
+	// production pauses again.
+	RemainingBlocks uint64 `json:"remaining_blocks,string"`
+	// RemainingSkips is the number of slots left to skip.
+	RemainingSkips uint64 `json:"remaining_skips,string"`
+}
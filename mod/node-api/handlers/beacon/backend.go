@@ -31,9 +31,14 @@ type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
 	GenesisBackend
 	BlockBackend[BlockHeaderT]
 	RandaoBackend
+	CommitteeBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	BlobFeeBackend
+	HeaderRangeBackend
+	DepositBackend
+	TimelinessBackend
 	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -49,14 +54,47 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type BlobFeeBackend interface {
+	BlobFees(limit int) []*types.BlobFeeData
+}
+
+type HeaderRangeBackend interface {
+	BlockSummaries(from, to math.Slot) []*types.BlockSummaryData
+}
+
+type DepositBackend interface {
+	DepositWatermark() (*types.DepositWatermarkData, error)
+}
+
+type TimelinessBackend interface {
+	ProposerTimeliness() []*types.ProposerTimelinessData
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
+type CommitteeBackend interface {
+	// CommitteesAtEpoch returns the committees of the given epoch, or of the
+	// epoch of the slot if epoch is nil.
+	CommitteesAtEpoch(
+		slot math.Slot, epoch *math.Epoch,
+	) ([]*types.CommitteeData, error)
+}
+
 type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+	ExecutionPayloadAtSlot(
+		slot math.Slot,
+	) (*types.ExecutionPayloadData, error)
+	BlobTransactionsAtSlot(
+		slot math.Slot,
+	) ([]*types.BlobTransactionData, error)
+	// ForkVersionAtSlot returns the version of the fork active at the given
+	// slot.
+	ForkVersionAtSlot(slot math.Slot) (uint32, error)
 }
 
 type StateBackend[ForkT any] interface {
@@ -77,4 +115,5 @@ type ValidatorBackend[ValidatorT any] interface {
 		slot math.Slot,
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
+	ValidatorQueue(slot math.Slot) (*types.ValidatorQueueData, error)
 }
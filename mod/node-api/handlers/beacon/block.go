@@ -22,7 +22,9 @@ package beacon
 
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
@@ -46,3 +48,63 @@ func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 		Data:                rewards,
 	}, nil
 }
+
+// GetExecutionPayload returns the execution payload of the given block,
+// reconstructed from the payload header kept in the beacon state and the
+// payload body held by the execution client.
+func (h *Handler[_, ContextT, _, _]) GetExecutionPayload(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetExecutionPayloadRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := h.backend.ExecutionPayloadAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := h.backend.ForkVersionAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return types.VersionedResponse{
+		Version:             version.Name(forkVersion),
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                payload,
+	}, nil
+}
+
+// GetBlobTransactions returns the blob carrying transactions of the execution
+// payload of the given block, each with the sidecar indices and versioned
+// hashes of its blobs, so that blobs are mapped to their transactions without
+// querying the execution client.
+func (h *Handler[_, ContextT, _, _]) GetBlobTransactions(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobTransactionsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	txs, err := h.backend.BlobTransactionsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           true,
+		Data:                txs,
+	}, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+func (h *Handler[_, ContextT, _, _]) GetStateCommittees(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetStateCommitteesRequest](
+		c,
+		h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	// The epoch is optional, and epoch 0 is a valid one.
+	var epoch *math.Epoch
+	if req.Epoch != "" {
+		var parsed math.Epoch
+		if parsed, err = utils.ParseU64("epoch", req.Epoch); err != nil {
+			return nil, err
+		}
+		epoch = &parsed
+	}
+	committees, err := h.backend.CommitteesAtEpoch(slot, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter the committees by the optional index and slot query params.
+	filtered := make([]*beacontypes.CommitteeData, 0, len(committees))
+	for _, committee := range committees {
+		if !matchesFilter(req.CommitteeIndex, committee.Index) ||
+			!matchesFilter(req.Slot, committee.Slot) {
+			continue
+		}
+		filtered = append(filtered, committee)
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                filtered,
+	}, nil
+}
+
+// matchesFilter returns true if the filter is unset or equal to the value.
+func matchesFilter(filter string, value uint64) bool {
+	if filter == "" {
+		return true
+	}
+	u64, err := utils.U64FromString(filter)
+	return err == nil && u64.Unwrap() == value
+}
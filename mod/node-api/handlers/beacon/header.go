@@ -35,7 +35,7 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.U64FromString(req.Slot)
+	slot, err := utils.ParseU64("slot", req.Slot)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"strconv"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// maxHeadersRange is the largest number of slots a single request for a
+// range of headers may span.
+const maxHeadersRange = 4096
+
+// GetHeadersRange returns the compact headers of the finalized blocks kept
+// by the block store in a range of slots, oldest first, so that a range is
+// backfilled with one request rather than one per slot.
+func (h *Handler[_, ContextT, _, _]) GetHeadersRange(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetHeadersRangeRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	from, err := utils.ParseU64("from_slot", req.FromSlot)
+	if err != nil {
+		return nil, err
+	}
+	to, err := utils.ParseU64("to_slot", req.ToSlot)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case to < from:
+		return nil, types.NewValidationError(types.FieldError{
+			Field:   "to_slot",
+			Message: "must not be below from_slot",
+		})
+	case to-from >= maxHeadersRange:
+		return nil, types.NewValidationError(types.FieldError{
+			Field: "to_slot",
+			Message: "must be within " +
+				strconv.Itoa(maxHeadersRange) + " slots of from_slot",
+		})
+	}
+	return types.NewListStream(
+		false, // stubbed
+		true,
+		h.backend.BlockSummaries(from, to),
+	), nil
+}
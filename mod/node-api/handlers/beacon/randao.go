@@ -40,7 +40,7 @@ func (h *Handler[_, ContextT, _, _]) GetRandao(c ContextT) (any, error) {
 	}
 	epoch := math.Epoch(0)
 	if req.Epoch != "" {
-		epoch, err = utils.U64FromString(req.Epoch)
+		epoch, err = utils.ParseU64("epoch", req.Epoch)
 		if err != nil {
 			return nil, err
 		}
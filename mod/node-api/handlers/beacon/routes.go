@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 )
 
 //nolint:funlen // routes are long
@@ -42,11 +43,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/root",
 			Handler: h.GetStateRoot,
+			Request: types.GetStateRootRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/fork",
 			Handler: h.GetStateFork,
+			Request: types.GetStateForkRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -57,31 +60,44 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators",
 			Handler: h.GetStateValidators,
+			Request: types.GetStateValidatorsRequest{},
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validators",
 			Handler: h.PostStateValidators,
+			Request: types.PostStateValidatorsRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validators/:validator_id",
 			Handler: h.GetStateValidator,
+			Request: types.GetStateValidatorRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.GetStateValidatorBalances,
+			Request: types.GetValidatorBalancesRequest{},
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/states/:state_id/validator_balances",
 			Handler: h.PostStateValidatorBalances,
+			Request: types.PostValidatorBalancesRequest{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/beacon/states/:state_id/validator_queue",
+			Handler:  h.GetValidatorQueue,
+			Request:  types.GetValidatorQueueRequest{},
+			Response: types.ValidatorQueueData{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/committees",
-			Handler: h.NotImplemented,
+			Handler: h.GetStateCommittees,
+			Request: types.GetStateCommitteesRequest{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -92,16 +108,19 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/states/:state_id/randao",
 			Handler: h.GetRandao,
+			Request: types.GetRandaoRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers",
 			Handler: h.GetBlockHeaders,
+			Request: types.GetBlockHeadersRequest{},
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/headers/:block_id",
 			Handler: h.GetBlockHeaderByID,
+			Request: types.GetBlockHeaderRequest{},
 		},
 		{
 			Method:  http.MethodPost,
@@ -133,6 +152,46 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 			Path:    "/eth/v1/beacon/blocks/:block_id/root",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/beacon/blocks/:block_id/execution_payload",
+			Handler:  h.GetExecutionPayload,
+			Request:  types.GetExecutionPayloadRequest{},
+			Response: types.ExecutionPayloadData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/beacon/blocks/:block_id/blob_transactions",
+			Handler:  h.GetBlobTransactions,
+			Request:  types.GetBlobTransactionsRequest{},
+			Response: []types.BlobTransactionData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/blob_fees",
+			Handler:  h.GetBlobFees,
+			Request:  types.GetBlobFeesRequest{},
+			Response: []types.BlobFeeData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/headers",
+			Handler:  h.GetHeadersRange,
+			Request:  types.GetHeadersRangeRequest{},
+			Response: []types.BlockSummaryData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/beacon/deposits/watermark",
+			Handler:  h.GetDepositWatermark,
+			Response: types.DepositWatermarkData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/beacon/proposer_timeliness",
+			Handler:  h.GetProposerTimeliness,
+			Response: []types.ProposerTimelinessData{},
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blocks/:block_id/attestations",
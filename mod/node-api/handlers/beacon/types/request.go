@@ -20,7 +20,12 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
 
 type GetGenesisRequest struct{}
 
@@ -50,7 +55,7 @@ type PostStateValidatorsRequest struct {
 
 type GetStateValidatorRequest struct {
 	types.StateIDRequest
-	ValidatorID string `query:"validator_id" validate:"required,validator_id"`
+	ValidatorID string `param:"validator_id" validate:"required,validator_id"`
 }
 
 type GetValidatorBalancesRequest struct {
@@ -58,11 +63,35 @@ type GetValidatorBalancesRequest struct {
 	IDs []string `query:"id" validate:"dive,validator_id"`
 }
 
+// PostValidatorBalancesRequest is the request for the balances of the
+// validators listed in the body, either as a JSON array of IDs, as in the
+// beacon API, or as an object with an "ids" array.
 type PostValidatorBalancesRequest struct {
 	types.StateIDRequest
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the IDs of the body, leaving the state ID bound from
+// the path untouched.
+func (r *PostValidatorBalancesRequest) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 &&
+		trimmed[0] == '[' {
+		return json.Unmarshal(data, &r.IDs)
+	}
+	var body struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	r.IDs = body.IDs
+	return nil
+}
+
+type GetValidatorQueueRequest struct {
+	types.StateIDRequest
+}
+
 type GetStateCommitteesRequest struct {
 	types.StateIDRequest
 	EpochOptionalRequest
@@ -82,13 +111,34 @@ type GetRandaoRequest struct {
 
 type GetBlockHeadersRequest struct {
 	SlotRequest
-	ParentRoot string `query:"parent_root" validate:"hex"`
+	ParentRoot string `query:"parent_root" validate:"omitempty,root"`
 }
 
 type GetBlockHeaderRequest struct {
 	types.BlockIDRequest
 }
 
+type GetExecutionPayloadRequest struct {
+	types.BlockIDRequest
+}
+
+type GetBlobTransactionsRequest struct {
+	types.BlockIDRequest
+}
+
+// GetBlobFeesRequest is the request for the `GET /bkit/v1/blob_fees`
+// endpoint. Limit caps the number of most recent blocks returned.
+type GetBlobFeesRequest struct {
+	Limit string `query:"limit" validate:"omitempty,number"`
+}
+
+// GetHeadersRangeRequest is the request for the `GET /bkit/v1/headers`
+// endpoint. FromSlot and ToSlot bound the range of slots, both included.
+type GetHeadersRangeRequest struct {
+	FromSlot string `query:"from_slot" validate:"required,uint64"`
+	ToSlot   string `query:"to_slot"   validate:"required,uint64"`
+}
+
 // TODO: body is big
 //
 //nolint:lll // tags get long
@@ -169,7 +219,7 @@ type SlotRequest struct {
 
 type HeadersRequest struct {
 	SlotRequest
-	ParentRoot string `query:"parent_root" validate:"hex"`
+	ParentRoot string `query:"parent_root" validate:"omitempty,root"`
 }
 
 type BlobSidecarRequest struct {
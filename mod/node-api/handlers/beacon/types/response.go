@@ -21,8 +21,10 @@
 package types
 
 import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -47,6 +49,59 @@ type BlockHeader[BlockHeaderT any] struct {
 	Signature bytes.B48    `json:"signature"`
 }
 
+// ExecutionPayloadData is the response data of the
+// `GET /bkit/v1/beacon/blocks/{block_id}/execution_payload` endpoint: the
+// payload header kept in the beacon state along with the transactions and
+// withdrawals of the payload body.
+type ExecutionPayloadData struct {
+	Header       any                            `json:"header"`
+	Transactions []bytes.Bytes                  `json:"transactions"`
+	Withdrawals  []*engineprimitives.Withdrawal `json:"withdrawals"`
+}
+
+// BlobFeeData is the blob market data of a finalized block, as returned by
+// the `GET /bkit/v1/blob_fees` endpoint. Fees are in wei.
+type BlobFeeData struct {
+	Slot            uint64  `json:"slot,string"`
+	BlockNumber     uint64  `json:"block_number,string"`
+	BlobGasUsed     uint64  `json:"blob_gas_used,string"`
+	ExcessBlobGas   uint64  `json:"excess_blob_gas,string"`
+	Utilization     float64 `json:"utilization"`
+	BlobBaseFee     string  `json:"blob_base_fee"`
+	NextBlobBaseFee string  `json:"next_blob_base_fee"`
+}
+
+// BlobTransactionData is a blob carrying transaction of the execution
+// payload of a block, as returned by the
+// `GET /bkit/v1/beacon/blocks/{block_id}/blob_transactions` endpoint.
+type BlobTransactionData struct {
+	Index uint64                  `json:"index,string"`
+	Hash  common.ExecutionHash    `json:"hash"`
+	From  common.ExecutionAddress `json:"from"`
+	To    common.ExecutionAddress `json:"to"`
+	Blobs []*BlobTransactionBlob  `json:"blobs"`
+}
+
+// BlobTransactionBlob is a blob of a blob carrying transaction. Index is the
+// index of its sidecar in the block, and Stored whether the sidecar is still
+// held by the node.
+type BlobTransactionBlob struct {
+	Index         uint64                `json:"index,string"`
+	VersionedHash common.ExecutionHash  `json:"versioned_hash"`
+	KZGCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	Stored        bool                  `json:"stored"`
+}
+
+// BlockSummaryData is the compact header of a finalized block, as returned
+// by the `GET /bkit/v1/headers` endpoint.
+type BlockSummaryData struct {
+	Slot          uint64      `json:"slot,string"`
+	BlockRoot     common.Root `json:"block_root"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+}
+
 type GenesisData struct {
 	GenesisTime           string      `json:"genesis_time"`
 	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
@@ -68,7 +123,23 @@ type ValidatorBalanceData struct {
 	Balance uint64 `json:"balance,string"`
 }
 
-//nolint:staticcheck // todo: figure this out.
+type ValidatorQueueData struct {
+	ActivationChurnLimit uint64                 `json:"activation_churn_limit,string"`
+	ExitChurnLimit       uint64                 `json:"exit_churn_limit,string"`
+	ActivationQueue      []*ValidatorQueueEntry `json:"activation_queue"`
+	ExitQueue            []*ValidatorQueueEntry `json:"exit_queue"`
+}
+
+type ValidatorQueueEntry struct {
+	Index    uint64 `json:"index,string"`
+	Position uint64 `json:"position,string"`
+	Epoch    uint64 `json:"epoch,string"`
+}
+
+// CommitteeData is a committee of the state. The string option of Validators
+// is unknown to encoding/json, but the node API encoding quotes every index.
+//
+//nolint:staticcheck // the string option applies to the elements.
 type CommitteeData struct {
 	Index      uint64   `json:"index,string"`
 	Slot       uint64   `json:"slot,string"`
@@ -83,3 +154,28 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// DepositWatermarkData is the response for the
+// `GET /bkit/v1/beacon/deposits/watermark` endpoint.
+type DepositWatermarkData struct {
+	// FinalizedDepositIndex is the index of the next deposit to be included,
+	// as of the latest finalized state.
+	FinalizedDepositIndex uint64 `json:"finalized_deposit_index,string"`
+	// PrunedDepositIndex is the index below which the deposits have been
+	// pruned from the deposit store.
+	PrunedDepositIndex uint64 `json:"pruned_deposit_index,string"`
+}
+
+// ProposerTimelinessData is the timeliness of the blocks of a proposer, as
+// returned by the `GET /bkit/v1/beacon/proposer_timeliness` endpoint.
+// Latenesses are in milliseconds since the start of the slot.
+type ProposerTimelinessData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Blocks         uint64 `json:"blocks,string"`
+	LateBlocks     uint64 `json:"late_blocks,string"`
+	LateStreak     uint64 `json:"late_streak,string"`
+	AvgLatenessMs  int64  `json:"avg_lateness_ms,string"`
+	MaxLatenessMs  int64  `json:"max_lateness_ms,string"`
+	LastLatenessMs int64  `json:"last_lateness_ms,string"`
+	LastSlot       uint64 `json:"last_slot,string"`
+}
@@ -35,10 +35,6 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -54,11 +50,11 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	if len(validators) == 0 {
 		return nil, types.ErrNotFound
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                validators,
-	}, nil
+	return types.NewListStream(
+		false, // stubbed
+		false, // stubbed
+		validators,
+	), nil
 }
 
 func (h *Handler[_, ContextT, _, _]) PostStateValidators(
@@ -70,10 +66,6 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -86,11 +78,11 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                validators,
-	}, nil
+	return types.NewListStream(
+		false, // stubbed
+		false, // stubbed
+		validators,
+	), nil
 }
 
 func (h *Handler[_, ContextT, _, _]) GetStateValidator(
@@ -136,11 +128,11 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data:                balances,
-	}, nil
+	return types.NewListStream(
+		false, // stubbed
+		false, // stubbed
+		balances,
+	), nil
 }
 
 func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
@@ -163,9 +155,35 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
 	if err != nil {
 		return nil, err
 	}
+	return types.NewListStream(
+		false, // stubbed
+		false, // stubbed
+		balances,
+	), nil
+}
+
+// GetValidatorQueue returns the positions of the validators waiting in the
+// activation and exit queues of the given state.
+func (h *Handler[_, ContextT, _, _]) GetValidatorQueue(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetValidatorQueueRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := h.backend.ValidatorQueue(slot)
+	if err != nil {
+		return nil, err
+	}
 	return beacontypes.ValidatorResponse{
 		ExecutionOptimistic: false, // stubbed
 		Finalized:           false, // stubbed
-		Data:                balances,
+		Data:                queue,
 	}, nil
 }
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// GetDepositContract returns the chain ID and the address of the deposit
+// contract on the execution network.
+func (h *Handler[ContextT]) GetDepositContract(ContextT) (any, error) {
+	cs := h.backend.ChainSpec()
+	return handlertypes.Wrap(types.DepositContractData{
+		ChainID: strconv.FormatUint(cs.DepositEth1ChainID(), 10),
+		Address: cs.DepositContractAddress(),
+	}), nil
+}
+
+// GetNetwork returns the chain and network IDs of the execution network. The
+// network ID of the execution networks beacon-kit runs against always equals
+// their chain ID.
+func (h *Handler[ContextT]) GetNetwork(ContextT) (any, error) {
+	id := strconv.FormatUint(h.backend.ChainSpec().DepositEth1ChainID(), 10)
+	return handlertypes.Wrap(types.NetworkData{
+		ChainID:   id,
+		NetworkID: id,
+	}), nil
+}
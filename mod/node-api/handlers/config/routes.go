@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(
@@ -43,9 +44,16 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Handler: h.NotImplemented,
 		},
 		{
-			Method:  http.MethodGet,
-			Path:    "/eth/v1/config/deposit_contract",
-			Handler: h.NotImplemented,
+			Method:   http.MethodGet,
+			Path:     "/eth/v1/config/deposit_contract",
+			Handler:  h.GetDepositContract,
+			Response: types.DepositContractData{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/config/network",
+			Handler:  h.GetNetwork,
+			Response: types.NetworkData{},
 		},
 	})
 }
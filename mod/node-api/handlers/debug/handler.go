@@ -22,18 +22,36 @@ package debug
 
 import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the debug API.
+type Handler[
+	BeaconStateT types.BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ContextT context.Context,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend[BeaconStateT]
+	hotKeys HotKeys
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the debug API.
+func NewHandler[
+	BeaconStateT types.BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	ContextT context.Context,
+](
+	backend Backend[BeaconStateT],
+	hotKeys HotKeys,
+) *Handler[BeaconStateT, BeaconStateMarshallableT, ContextT] {
+	h := &Handler[BeaconStateT, BeaconStateMarshallableT, ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
+		hotKeys: hotKeys,
 	}
 	return h
 }
@@ -25,17 +25,21 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 )
 
-func (h *Handler[ContextT]) RegisterRoutes(
+func (h *Handler[_, _, ContextT]) RegisterRoutes(
 	logger log.Logger,
 ) {
 	h.SetLogger(logger)
 	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
 		{
-			Method:  http.MethodGet,
-			Path:    "/eth/v2/debug/beacon/states/:state_id",
-			Handler: h.NotImplemented,
+			Method:   http.MethodGet,
+			Path:     "/eth/v2/debug/beacon/states/:state_id",
+			Handler:  h.GetState,
+			Request:  types.StateRequest{},
+			Response: handlertypes.VersionedResponse{},
 		},
 		{
 			Method:  http.MethodGet,
@@ -47,5 +51,18 @@ func (h *Handler[ContextT]) RegisterRoutes(
 			Path:    "/eth/v1/debug/fork_choice",
 			Handler: h.NotImplemented,
 		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/debug/states/:state_id/field_roots",
+			Handler:  h.GetStateFieldRoots,
+			Request:  types.StateFieldRootsRequest{},
+			Response: types.StateFieldRootsResponse{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/bkit/v1/debug/hot_keys",
+			Handler:  h.GetHotKeys,
+			Response: types.HotKeysResponse{},
+		},
 	})
 }
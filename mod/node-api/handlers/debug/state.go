@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetState returns the beacon state, served SSZ encoded to clients that
+// prefer it and named by the fork whose schema it follows.
+func (h *Handler[_, _, ContextT]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.StateRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	bsm, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	forkVersion, err := h.backend.ForkVersionAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return handlertypes.VersionedResponse{
+		Version:             version.Name(forkVersion),
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                bsm,
+	}, nil
+}
+
+// GetStateFieldRoots returns the hash tree root of each top-level field of
+// the beacon state, so that nodes disagreeing on a state root can find the
+// fields on which they diverge.
+func (h *Handler[_, _, ContextT]) GetStateFieldRoots(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[types.StateFieldRootsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	bsm, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	roots, err := bsm.FieldRoots()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]types.FieldRoot, len(roots))
+	for i, root := range roots {
+		fields[i] = types.FieldRoot{
+			Index: i,
+			Name:  consensustypes.BeaconStateFieldNames[i],
+			Root:  root,
+		}
+	}
+	return handlertypes.Wrap(types.StateFieldRootsResponse{
+		Slot:      slot,
+		StateRoot: bsm.HashTreeRoot(),
+		Fields:    fields,
+	}), nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// StateFieldRootsResponse is the response for the
+// `/bkit/v1/debug/states/{state_id}/field_roots` endpoint.
+type StateFieldRootsResponse struct {
+	// Slot is the slot of the beacon state.
+	Slot math.Slot `json:"slot"`
+
+	// StateRoot is the hash tree root of the beacon state.
+	StateRoot common.Root `json:"state_root"`
+
+	// Fields are the hash tree roots of the top-level fields of the beacon
+	// state, in the order in which they are merkleized.
+	Fields []FieldRoot `json:"fields"`
+}
+
+// FieldRoot is the hash tree root of a top-level field of the beacon state.
+type FieldRoot struct {
+	// Index is the index of the field in the beacon state.
+	Index int `json:"index"`
+
+	// Name is the name of the field.
+	Name string `json:"name"`
+
+	// Root is the hash tree root of the field.
+	Root common.Root `json:"root"`
+}
+
+// HotKeysResponse is the response for the `/bkit/v1/debug/hot_keys` endpoint.
+type HotKeysResponse struct {
+	// Enabled reports whether the tracing of hot keys is enabled.
+	Enabled bool `json:"enabled"`
+	// Start is the RFC 3339 start of the minute the reads were sampled
+	// over. It is left empty if the tracing is disabled.
+	Start string `json:"start,omitempty"`
+	// SampleRate is the fraction of reads that were sampled.
+	SampleRate float64 `json:"sample_rate"`
+	// Samples is the number of reads sampled.
+	Samples uint64 `json:"samples,string"`
+	// Collections are the collections read from, most read first.
+	Collections []HotKey `json:"collections"`
+	// Keys are the most read keys, most read first.
+	Keys []HotKey `json:"keys"`
+}
+
+// HotKey is the number of sampled reads of a collection, or of a key of it.
+type HotKey struct {
+	// Collection is the name of the collection.
+	Collection string `json:"collection"`
+	// Key is the hex encoded key. It is left empty for the entry of a whole
+	// collection.
+	Key string `json:"key,omitempty"`
+	// Samples is the number of sampled reads.
+	Samples uint64 `json:"samples,string"`
+}
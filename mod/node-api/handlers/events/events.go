@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/encoding/json"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// supportedTopics are the topics that can be subscribed to.
+//
+//nolint:gochecknoglobals // read-only.
+var supportedTopics = []string{
+	types.TopicBlobSidecar,
+	types.TopicDeposit,
+	types.TopicDepositProcessed,
+	types.TopicFinalizedCheckpoint,
+	types.TopicHead,
+}
+
+// GetEvents subscribes the caller to the requested topics and streams the
+// matching events as server-sent events until the client disconnects.
+func (h *Handler[ContextT]) GetEvents(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetEventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := parseTopics(req.Topics)
+	if err != nil {
+		return nil, err
+	}
+	var fromSlot uint64
+	if req.FromSlot != "" {
+		if fromSlot, err = strconv.ParseUint(req.FromSlot, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return &eventStream{
+		feed:     h.feed,
+		topics:   topics,
+		fromSlot: math.Slot(fromSlot),
+	}, nil
+}
+
+// GetEventsHistory returns a page of the events recorded in the journal
+// under the requested topics, starting at from_slot or at the cursor
+// returned by a previous page.
+func (h *Handler[ContextT]) GetEventsHistory(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetEventsHistoryRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if h.journal == nil {
+		return nil, errors.Wrap(
+			handlertypes.ErrNotFound, "event journal is disabled",
+		)
+	}
+	topics, err := parseTopics(req.Topics)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := historyLimit(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var fromSlot, fromSeq uint64
+	switch {
+	case req.Cursor != "":
+		if fromSlot, fromSeq, err = decodeCursor(req.Cursor); err != nil {
+			return nil, err
+		}
+	case req.FromSlot != "":
+		if fromSlot, err = strconv.ParseUint(req.FromSlot, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &types.EventsHistoryResponse{
+		Data: make([]types.HistoricalEvent, 0),
+	}
+	if err = h.journal.Walk(
+		fromSlot, fromSeq,
+		func(slot, seq uint64, topic string, data []byte) bool {
+			if len(resp.Data) == limit {
+				resp.NextCursor = encodeCursor(slot, seq)
+				return false
+			}
+			if slices.Contains(topics, topic) {
+				resp.Data = append(resp.Data, types.HistoricalEvent{
+					Topic: topic,
+					Slot:  strconv.FormatUint(slot, 10),
+					Data:  data,
+				})
+			}
+			return true
+		},
+	); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// parseTopics flattens the repeated and comma-separated topics of a request
+// and checks that each of them is supported.
+func parseTopics(lists []string) ([]string, error) {
+	topics := make([]string, 0, len(lists))
+	for _, list := range lists {
+		for _, topic := range strings.Split(list, ",") {
+			if !slices.Contains(supportedTopics, topic) {
+				return nil, errors.Wrapf(
+					handlertypes.ErrInvalidRequest,
+					"unsupported topic %q", topic,
+				)
+			}
+			topics = append(topics, topic)
+		}
+	}
+	return topics, nil
+}
+
+// eventStream serves the events of a Feed subscription as server-sent
+// events.
+type eventStream struct {
+	feed     *Feed
+	topics   []string
+	fromSlot math.Slot
+}
+
+// ServeStream implements handlertypes.Stream.
+func (s *eventStream) ServeStream(
+	ctx context.Context,
+	w http.ResponseWriter,
+) error {
+	events, cancel := s.feed.Subscribe(s.topics, s.fromSlot)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-events:
+			bz, err := json.Marshal(ev.Data)
+			if err != nil {
+				return err
+			}
+			if _, err = fmt.Fprintf(
+				w, "event: %s\ndata: %s\n\n", ev.Topic, bz,
+			); err != nil {
+				return err
+			}
+			if err = rc.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
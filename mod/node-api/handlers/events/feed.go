@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// subscriberBufferSize is the number of events buffered per subscriber.
+// Events published while the buffer is full are dropped for that
+// subscriber, so a slow client never delays the node.
+const subscriberBufferSize = 64
+
+// subscriber is a single consumer of the Feed.
+type subscriber struct {
+	topics   map[string]struct{}
+	fromSlot math.Slot
+	ch       chan types.Event
+}
+
+// Feed fans out node events to the event stream subscribers.
+type Feed struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+// NewFeed returns a new, empty Feed.
+func NewFeed() *Feed {
+	return &Feed{subscribers: make(map[*subscriber]struct{})}
+}
+
+// Publish sends data, emitted at slot, under topic to every subscriber of
+// the topic that is interested in the slot.
+func (f *Feed) Publish(slot math.Slot, topic string, data any) {
+	ev := types.Event{Topic: topic, Slot: slot, Data: data}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for sub := range f.subscribers {
+		if _, ok := sub.topics[topic]; !ok || slot < sub.fromSlot {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events published under any of
+// topics at or after fromSlot, and a function that cancels the
+// subscription. Events are filtered before being sent, so that the ones
+// the subscriber is not interested in are never serialized.
+func (f *Feed) Subscribe(
+	topics []string,
+	fromSlot math.Slot,
+) (<-chan types.Event, func()) {
+	sub := &subscriber{
+		topics:   make(map[string]struct{}, len(topics)),
+		fromSlot: fromSlot,
+		ch:       make(chan types.Event, subscriberBufferSize),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = struct{}{}
+	}
+
+	f.mu.Lock()
+	f.subscribers[sub] = struct{}{}
+	f.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			delete(f.subscribers, sub)
+			f.mu.Unlock()
+		})
+	}
+}
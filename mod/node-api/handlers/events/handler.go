@@ -27,13 +27,22 @@ import (
 
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	feed    *Feed
+	journal Journal
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler returns the events API handler. journal may be nil, in which
+// case the history endpoint is unavailable.
+func NewHandler[ContextT context.Context](
+	feed *Feed,
+	journal Journal,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		feed:    feed,
+		journal: journal,
 	}
 	return h
 }
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+const (
+	// defaultHistoryLimit is the number of events returned by a history
+	// query that does not set a limit.
+	defaultHistoryLimit = 100
+	// maxHistoryLimit caps the number of events returned by a single
+	// history query.
+	maxHistoryLimit = 1000
+	// cursorSeparator separates the slot and sequence number of a cursor.
+	cursorSeparator = "-"
+)
+
+// Journal is the persistent log of published events that backs the events
+// history endpoint.
+type Journal interface {
+	// Append records the JSON encoded data of an event published under
+	// topic at the given slot.
+	Append(slot uint64, topic string, data []byte) error
+	// Walk calls fn for every entry at or after the given slot and sequence
+	// number, in order, until fn returns false.
+	Walk(
+		fromSlot, fromSeq uint64,
+		fn func(slot, seq uint64, topic string, data []byte) bool,
+	) error
+	// Prune removes the entries of the slots in [start, end).
+	Prune(start, end uint64) error
+}
+
+// encodeCursor returns the opaque cursor pointing at a journal entry.
+func encodeCursor(slot, seq uint64) string {
+	return strconv.FormatUint(slot, 10) + cursorSeparator +
+		strconv.FormatUint(seq, 10)
+}
+
+// decodeCursor returns the slot and sequence number a cursor points at.
+func decodeCursor(cursor string) (uint64, uint64, error) {
+	slotStr, seqStr, ok := strings.Cut(cursor, cursorSeparator)
+	if !ok {
+		return 0, 0, errors.Wrapf(
+			handlertypes.ErrInvalidRequest, "malformed cursor %q", cursor,
+		)
+	}
+	slot, err := strconv.ParseUint(slotStr, 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrapf(
+			handlertypes.ErrInvalidRequest, "malformed cursor %q", cursor,
+		)
+	}
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrapf(
+			handlertypes.ErrInvalidRequest, "malformed cursor %q", cursor,
+		)
+	}
+	return slot, seq, nil
+}
+
+// historyLimit parses the page size of a history query.
+func historyLimit(limit string) (int, error) {
+	if limit == "" {
+		return defaultHistoryLimit, nil
+	}
+	n, err := strconv.ParseUint(limit, 10, 64)
+	if err != nil || n == 0 || n > maxHistoryLimit {
+		return 0, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"limit must be between 1 and %d", maxHistoryLimit,
+		)
+	}
+	return int(n), nil
+}
@@ -0,0 +1,296 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/encoding/json"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BeaconBlock is a finalized block published as head, finalized_checkpoint
+// and deposit events.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	GetSlot() math.Slot
+	GetBody() BeaconBlockBodyT
+	GetStateRoot() common.Root
+	HashTreeRoot() common.Root
+}
+
+// BeaconBlockBody is the body of a finalized block.
+type BeaconBlockBody[DepositT any] interface {
+	GetDeposits() []DepositT
+}
+
+// BeaconBlockHeader is the header the published sidecars belong to.
+type BeaconBlockHeader interface {
+	GetSlot() math.Slot
+	HashTreeRoot() common.Root
+}
+
+// BlobSidecar is a single sidecar published as a blob_sidecar event.
+type BlobSidecar[BeaconBlockHeaderT BeaconBlockHeader] interface {
+	GetIndex() uint64
+	GetKzgCommitment() eip4844.KZGCommitment
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+}
+
+// BlobSidecars is the set of sidecars carried by a SidecarsVerified event.
+type BlobSidecars[BlobSidecarT any] interface {
+	GetSidecars() []BlobSidecarT
+}
+
+// Deposit is a deposit published as a deposit event.
+type Deposit interface {
+	GetIndex() math.U64
+	GetAmount() math.Gwei
+	GetPubkey() crypto.BLSPubkey
+}
+
+// Publisher relays node events from the dispatcher to the event Feed, and
+// records them in the Journal when one is configured.
+type Publisher[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+	DepositT Deposit,
+] struct {
+	feed       *Feed
+	journal    Journal
+	chainSpec  common.ChainSpec
+	dispatcher asynctypes.EventDispatcher
+	logger     log.Logger
+	// historySlots is the number of slots kept in the journal, zero keeps
+	// every event.
+	historySlots uint64
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// subSidecarsVerified is a channel holding SidecarsVerified events.
+	subSidecarsVerified chan async.Event[BlobSidecarsT]
+	// subDepositProcessed is a channel holding DepositProcessed events.
+	subDepositProcessed chan async.Event[*transition.ProcessedDeposit]
+}
+
+// NewPublisher returns a new Publisher writing to feed and, if it is not
+// nil, to journal.
+func NewPublisher[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT],
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarT],
+	DepositT Deposit,
+](
+	feed *Feed,
+	journal Journal,
+	historySlots uint64,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+	logger log.Logger,
+) *Publisher[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BlobSidecarT, BlobSidecarsT, DepositT,
+] {
+	return &Publisher[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BlobSidecarT, BlobSidecarsT, DepositT,
+	]{
+		feed:                  feed,
+		journal:               journal,
+		historySlots:          historySlots,
+		chainSpec:             chainSpec,
+		dispatcher:            dispatcher,
+		logger:                logger,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		subSidecarsVerified:   make(chan async.Event[BlobSidecarsT]),
+		subDepositProcessed: make(
+			chan async.Event[*transition.ProcessedDeposit],
+		),
+	}
+}
+
+// Name returns the name of the service.
+func (p *Publisher[_, _, _, _, _, _]) Name() string {
+	return "event-publisher"
+}
+
+// Start subscribes the publisher to BeaconBlockFinalized, SidecarsVerified
+// and DepositProcessed events and starts relaying them to the feed.
+func (p *Publisher[_, _, _, _, _, _]) Start(ctx context.Context) error {
+	if err := p.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, p.subFinalizedBlkEvents,
+	); err != nil {
+		return err
+	}
+	if err := p.dispatcher.Subscribe(
+		async.SidecarsVerified, p.subSidecarsVerified,
+	); err != nil {
+		return err
+	}
+	if err := p.dispatcher.Subscribe(
+		async.DepositProcessed, p.subDepositProcessed,
+	); err != nil {
+		return err
+	}
+	go p.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop relays the subscribed events until ctx is cancelled.
+func (p *Publisher[_, _, _, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-p.subFinalizedBlkEvents:
+			p.handleBlockFinalized(event)
+		case event := <-p.subSidecarsVerified:
+			p.handleSidecarsVerified(event)
+		case event := <-p.subDepositProcessed:
+			p.handleDepositProcessed(event)
+		}
+	}
+}
+
+// handleBlockFinalized publishes the head, finalized_checkpoint and deposit
+// events of a finalized block. Blocks are final as soon as they are
+// committed, so the new head is also the latest finalized block.
+func (p *Publisher[BeaconBlockT, _, _, _, _, _]) handleBlockFinalized(
+	event async.Event[BeaconBlockT],
+) {
+	if event.Error() != nil {
+		return
+	}
+	blk := event.Data()
+	slot := blk.GetSlot()
+	blockRoot := blk.HashTreeRoot()
+	p.publish(slot, types.TopicHead, &types.HeadData{
+		Slot:  slot.Base10(),
+		Block: blockRoot,
+		State: blk.GetStateRoot(),
+	})
+	p.publish(slot, types.TopicFinalizedCheckpoint,
+		&types.FinalizedCheckpointData{
+			Block: blockRoot,
+			State: blk.GetStateRoot(),
+			Epoch: p.chainSpec.SlotToEpoch(slot).Base10(),
+		},
+	)
+	for _, deposit := range blk.GetBody().GetDeposits() {
+		p.publish(slot, types.TopicDeposit, &types.DepositData{
+			Index:  deposit.GetIndex().Base10(),
+			Pubkey: deposit.GetPubkey(),
+			Amount: deposit.GetAmount().Base10(),
+			Slot:   slot.Base10(),
+		})
+	}
+	p.pruneJournal(slot)
+}
+
+// handleSidecarsVerified publishes a blob_sidecar event for every sidecar
+// of a successful verification. Sidecars that failed verification are
+// never announced.
+func (p *Publisher[_, _, _, _, BlobSidecarsT, _]) handleSidecarsVerified(
+	event async.Event[BlobSidecarsT],
+) {
+	if event.Error() != nil {
+		return
+	}
+	for _, sidecar := range event.Data().GetSidecars() {
+		header := sidecar.GetBeaconBlockHeader()
+		commitment := sidecar.GetKzgCommitment()
+		p.publish(header.GetSlot(), types.TopicBlobSidecar,
+			&types.BlobSidecarData{
+				BlockRoot:     header.HashTreeRoot(),
+				Index:         math.U64(sidecar.GetIndex()).Base10(),
+				Slot:          header.GetSlot().Base10(),
+				KZGCommitment: commitment,
+				VersionedHash: commitment.ToVersionedHash(),
+			},
+		)
+	}
+}
+
+// handleDepositProcessed publishes a deposit_processed event for a deposit
+// applied by a finalized block.
+func (p *Publisher[_, _, _, _, _, _]) handleDepositProcessed(
+	event async.Event[*transition.ProcessedDeposit],
+) {
+	if event.Error() != nil {
+		return
+	}
+	deposit := event.Data()
+	p.publish(deposit.Slot, types.TopicDepositProcessed,
+		&types.DepositProcessedData{
+			Index:          deposit.Index.Base10(),
+			Pubkey:         deposit.Pubkey,
+			ValidatorIndex: deposit.ValidatorIndex.Base10(),
+			Amount:         deposit.Amount.Base10(),
+			Slot:           deposit.Slot.Base10(),
+		},
+	)
+}
+
+// publish sends an event to the live feed and records it in the journal.
+// A journal failure is logged but never holds back the live event.
+func (p *Publisher[_, _, _, _, _, _]) publish(
+	slot math.Slot,
+	topic string,
+	data any,
+) {
+	p.feed.Publish(slot, topic, data)
+	if p.journal == nil {
+		return
+	}
+	bz, err := json.Marshal(data)
+	if err == nil {
+		err = p.journal.Append(slot.Unwrap(), topic, bz)
+	}
+	if err != nil {
+		p.logger.Error(
+			"Failed to record event in journal",
+			"topic", topic, "slot", slot, "error", err,
+		)
+	}
+}
+
+// pruneJournal drops the journal entries that fell out of the history
+// window once slot is finalized.
+func (p *Publisher[_, _, _, _, _, _]) pruneJournal(slot math.Slot) {
+	if p.journal == nil || p.historySlots == 0 ||
+		slot.Unwrap() <= p.historySlots {
+		return
+	}
+	if err := p.journal.Prune(0, slot.Unwrap()-p.historySlots); err != nil {
+		p.logger.Error("Failed to prune event journal", "error", err)
+	}
+}
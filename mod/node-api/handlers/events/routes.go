@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
 )
 
 func (h *Handler[ContextT]) RegisterRoutes(
@@ -35,7 +36,14 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/events",
-			Handler: h.NotImplemented,
+			Handler: h.GetEvents,
+			Request: types.GetEventsRequest{},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/events/history",
+			Handler: h.GetEventsHistory,
+			Request: types.GetEventsHistoryRequest{},
 		},
 	})
 }
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// GetEventsRequest is the request for the `GET /eth/v1/events` endpoint.
+// Topics may be repeated or given as a comma-separated list. FromSlot, when
+// set, skips the events emitted before it.
+type GetEventsRequest struct {
+	Topics   []string `query:"topics"    validate:"required"`
+	FromSlot string   `query:"from_slot" validate:"slot"`
+}
+
+// GetEventsHistoryRequest is the request for the
+// `GET /bkit/v1/events/history` endpoint. Cursor, when set, takes precedence
+// over FromSlot and resumes a previous page.
+type GetEventsHistoryRequest struct {
+	Topics   []string `query:"topics"    validate:"required"`
+	FromSlot string   `query:"from_slot" validate:"slot"`
+	Cursor   string   `query:"cursor"`
+	Limit    string   `query:"limit"     validate:"omitempty,number"`
+}
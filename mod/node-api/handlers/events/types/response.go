@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// TopicBlobSidecar is the topic of the event published for every blob
+	// sidecar that passes verification.
+	TopicBlobSidecar = "blob_sidecar"
+	// TopicHead is the topic of the event published for every new head.
+	TopicHead = "head"
+	// TopicFinalizedCheckpoint is the topic of the event published when a
+	// block is finalized.
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	// TopicDeposit is the topic of the event published for every deposit
+	// included in a finalized block.
+	TopicDeposit = "deposit"
+	// TopicDepositProcessed is the topic of the event published for every
+	// deposit applied to the state, once its block is finalized.
+	TopicDepositProcessed = "deposit_processed"
+)
+
+// Event is a single event published on the node event feed.
+type Event struct {
+	// Topic is the topic the event is published under.
+	Topic string
+	// Slot is the slot the event was emitted at.
+	Slot math.Slot
+	// Data is the JSON encodable payload of the event.
+	Data any
+}
+
+// BlobSidecarData is the payload of a blob_sidecar event.
+type BlobSidecarData struct {
+	BlockRoot     common.Root           `json:"block_root"`
+	Index         string                `json:"index"`
+	Slot          string                `json:"slot"`
+	KZGCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+	VersionedHash common.ExecutionHash  `json:"versioned_hash"`
+}
+
+// HeadData is the payload of a head event.
+type HeadData struct {
+	Slot  string      `json:"slot"`
+	Block common.Root `json:"block"`
+	State common.Root `json:"state"`
+}
+
+// FinalizedCheckpointData is the payload of a finalized_checkpoint event.
+type FinalizedCheckpointData struct {
+	Block common.Root `json:"block"`
+	State common.Root `json:"state"`
+	Epoch string      `json:"epoch"`
+}
+
+// DepositData is the payload of a deposit event.
+type DepositData struct {
+	Index  string           `json:"index"`
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+	Amount string           `json:"amount"`
+	Slot   string           `json:"slot"`
+}
+
+// DepositProcessedData is the payload of a deposit_processed event.
+type DepositProcessedData struct {
+	Index          string           `json:"index"`
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex string           `json:"validator_index"`
+	Amount         string           `json:"amount"`
+	Slot           string           `json:"slot"`
+}
+
+// HistoricalEvent is an event read back from the event journal.
+type HistoricalEvent struct {
+	Topic string          `json:"topic"`
+	Slot  string          `json:"slot"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// EventsHistoryResponse is the response for the
+// `GET /bkit/v1/events/history` endpoint. NextCursor is set when more events
+// are available and is passed back as the cursor of the next request.
+type EventsHistoryResponse struct {
+	Data       []HistoricalEvent `json:"data"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
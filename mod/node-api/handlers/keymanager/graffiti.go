@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keymanager
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+func (h *Handler[ContextT]) GetGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetGraffitiRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return apitypes.Wrap(&types.GraffitiData{
+		Pubkey:   pubkey,
+		Graffiti: h.backend.Template(pubkey),
+	}), nil
+}
+
+func (h *Handler[ContextT]) SetGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.SetGraffitiRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.backend.SetTemplate(pubkey, req.Graffiti); err != nil {
+		return nil, errors.Wrap(apitypes.ErrInvalidRequest, err.Error())
+	}
+	return nil, nil
+}
+
+func (h *Handler[ContextT]) DeleteGraffiti(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.DeleteGraffitiRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := pubkeyFromString(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	h.backend.DeleteTemplate(pubkey)
+	return nil, nil
+}
+
+// pubkeyFromString decodes a 0x prefixed hex validator public key.
+func pubkeyFromString(s string) (crypto.BLSPubkey, error) {
+	var pubkey crypto.BLSPubkey
+	if err := pubkey.UnmarshalText([]byte(s)); err != nil {
+		return pubkey, utils.InvalidField("pubkey", "pubkey")
+	}
+	return pubkey, nil
+}
@@ -21,16 +21,28 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Backend is the interface for backend of the proof API.
-type Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT any] interface {
+type Backend[
+	BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ValidatorT any,
+] interface {
+	BlobBackend[BlobSidecarsT]
 	BlockBackend[BeaconBlockHeaderT]
 	StateBackend[BeaconStateT]
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }
 
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
+	BlobLocation(
+		versionedHash common.ExecutionHash,
+	) (math.Slot, uint64, error)
+}
+
 type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
 }
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetBlobCommitments returns the KZG commitments and versioned hashes of the
+// blobs of the given block id, along with the inclusion proof of every
+// commitment against the block body root. It lets the custody of blobs be
+// audited without downloading them.
+func (h *Handler[
+	BeaconBlockHeaderT, _, _, _, _, ContextT, _, _,
+]) GetBlobCommitments(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlobCommitmentsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(params.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blockHeader, err := h.backend.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// The block header resolves the head block id to the actual slot the
+	// sidecars are stored at.
+	sidecars, err := h.backend.BlobSidecarsAtSlot(blockHeader.GetSlot())
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]*types.BlobCommitment, 0)
+	for _, sidecar := range sidecars.GetSidecars() {
+		commitment := sidecar.GetKzgCommitment()
+		blobs = append(blobs, &types.BlobCommitment{
+			Index:         math.U64(sidecar.GetIndex()),
+			KZGCommitment: commitment,
+			VersionedHash: common.ExecutionHash(
+				commitment.ToVersionedHash(),
+			),
+			KZGCommitmentInclusionProof: sidecar.GetInclusionProof(),
+		})
+	}
+
+	return types.BlobCommitmentsResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   blockHeader.HashTreeRoot(),
+		BodyRoot:          blockHeader.GetBodyRoot(),
+		Blobs:             blobs,
+	}, nil
+}
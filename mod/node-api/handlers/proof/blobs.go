@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetBlob returns the stored blob whose KZG commitment has the given
+// versioned hash, along with the inclusion proof of the commitment, so that
+// a blob can be fetched with only the versioned hash referenced by its blob
+// transaction.
+func (h *Handler[
+	BeaconBlockHeaderT, _, _, _, _, ContextT, _, _,
+]) GetBlob(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlobRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var versionedHash common.ExecutionHash
+	if err = versionedHash.UnmarshalText(
+		[]byte(params.VersionedHash),
+	); err != nil {
+		return nil, errors.Wrapf(
+			handlertypes.ErrInvalidRequest,
+			"malformed versioned hash %q", params.VersionedHash,
+		)
+	}
+
+	slot, index, err := h.backend.BlobLocation(versionedHash)
+	if err != nil {
+		return nil, err
+	}
+	blockHeader, err := h.backend.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := h.backend.BlobSidecarsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sidecar := range sidecars.GetSidecars() {
+		if sidecar.GetIndex() != index {
+			continue
+		}
+		commitment := sidecar.GetKzgCommitment()
+		return types.BlobResponse[BeaconBlockHeaderT]{
+			BeaconBlockHeader: blockHeader,
+			BeaconBlockRoot:   blockHeader.HashTreeRoot(),
+			BlobCommitment: types.BlobCommitment{
+				Index:         math.U64(index),
+				KZGCommitment: commitment,
+				VersionedHash: common.ExecutionHash(
+					commitment.ToVersionedHash(),
+				),
+				KZGCommitmentInclusionProof: sidecar.GetInclusionProof(),
+			},
+			Blob:     sidecar.GetBlob(),
+			KZGProof: sidecar.GetKzgProof(),
+		}, nil
+	}
+
+	// The sidecars were pruned after the blob was looked up.
+	return nil, errors.Wrapf(
+		handlertypes.ErrNotFound,
+		"no stored blob with versioned hash %s", versionedHash,
+	)
+}
@@ -35,12 +35,16 @@ type Handler[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	BlobSidecarT types.BlobSidecar,
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
 	ValidatorT types.Validator,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT]
+	backend Backend[
+		BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ValidatorT,
+	]
 }
 
 // NewHandler creates a new handler for the proof API.
@@ -50,18 +54,24 @@ func NewHandler[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 	BeaconStateMarshallableT types.BeaconStateMarshallable,
+	BlobSidecarT types.BlobSidecar,
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
 	ContextT context.Context,
 	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
 	ValidatorT types.Validator,
 ](
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT],
+	backend Backend[
+		BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ValidatorT,
+	],
 ) *Handler[
 	BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-	ContextT, ExecutionPayloadHeaderT, ValidatorT,
+	BlobSidecarT, BlobSidecarsT, ContextT, ExecutionPayloadHeaderT,
+	ValidatorT,
 ] {
 	h := &Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-		ContextT, ExecutionPayloadHeaderT, ValidatorT,
+		BlobSidecarT, BlobSidecarsT, ContextT, ExecutionPayloadHeaderT,
+		ValidatorT,
 	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
@@ -74,7 +84,7 @@ func NewHandler[
 // Get the slot from the given input of execution id, beacon state, and beacon
 // block header for the resolved slot.
 func (h *Handler[
-	BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
+	BeaconBlockHeaderT, BeaconStateT, _, _, _, _, _, _,
 ]) resolveExecutionID(executionID string) (
 	math.Slot, BeaconStateT, BeaconBlockHeaderT, error,
 ) {
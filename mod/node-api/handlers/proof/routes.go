@@ -25,27 +25,48 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
 )
 
 func (
-	h *Handler[_, _, _, ContextT, _, _],
+	h *Handler[BeaconBlockHeaderT, _, _, _, _, ContextT, _, _],
 ) RegisterRoutes(logger log.Logger) {
 	h.SetLogger(logger)
 	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
 		{
-			Method:  http.MethodGet,
-			Path:    "bkit/v1/proof/block_proposer/:execution_id",
-			Handler: h.GetBlockProposer,
+			Method:   http.MethodGet,
+			Path:     "bkit/v1/proof/block_proposer/:execution_id",
+			Handler:  h.GetBlockProposer,
+			Request:  types.BlockProposerRequest{},
+			Response: types.BlockProposerResponse[BeaconBlockHeaderT]{},
 		},
 		{
-			Method:  http.MethodGet,
-			Path:    "bkit/v1/proof/execution_number/:execution_id",
-			Handler: h.GetExecutionNumber,
+			Method:   http.MethodGet,
+			Path:     "bkit/v1/proof/execution_number/:execution_id",
+			Handler:  h.GetExecutionNumber,
+			Request:  types.ExecutionNumberRequest{},
+			Response: types.ExecutionNumberResponse[BeaconBlockHeaderT]{},
 		},
 		{
-			Method:  http.MethodGet,
-			Path:    "bkit/v1/proof/execution_fee_recipient/:execution_id",
-			Handler: h.GetExecutionFeeRecipient,
+			Method:   http.MethodGet,
+			Path:     "bkit/v1/proof/execution_fee_recipient/:execution_id",
+			Handler:  h.GetExecutionFeeRecipient,
+			Request:  types.ExecutionFeeRecipientRequest{},
+			Response: types.ExecutionFeeRecipientResponse[BeaconBlockHeaderT]{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "bkit/v1/proof/blob_commitments/:block_id",
+			Handler:  h.GetBlobCommitments,
+			Request:  types.BlobCommitmentsRequest{},
+			Response: types.BlobCommitmentsResponse[BeaconBlockHeaderT]{},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "bkit/v1/blobs/:versioned_hash",
+			Handler:  h.GetBlob,
+			Request:  types.BlobRequest{},
+			Response: types.BlobResponse[BeaconBlockHeaderT]{},
 		},
 	})
 }
@@ -39,3 +39,14 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.ExecutionIDRequest
 }
+
+// BlobCommitmentsRequest is the request for the
+// `/proof/blob_commitments/{block_id}` endpoint.
+type BlobCommitmentsRequest struct {
+	types.BlockIDRequest
+}
+
+// BlobRequest is the request for the `/blobs/{versioned_hash}` endpoint.
+type BlobRequest struct {
+	VersionedHash string `param:"versioned_hash" validate:"required,root"`
+}
@@ -23,6 +23,7 @@ package types
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -83,3 +84,59 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	// using a Generalized Index of 5894 in the Deneb fork.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// BlobCommitmentsResponse is the response for the
+// `/proof/blob_commitments/{block_id}` endpoint.
+type BlobCommitmentsResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// BodyRoot is the root of the block body, which every commitment
+	// inclusion proof is verified against.
+	BodyRoot common.Root `json:"body_root"`
+
+	// Blobs holds an entry for every blob sidecar of the block, ordered by
+	// blob index. It is empty if the block has no blobs or its sidecars were
+	// pruned after the data availability period.
+	Blobs []*BlobCommitment `json:"blobs"`
+}
+
+// BlobCommitment is the commitment to a single blob of a block, without the
+// blob itself.
+type BlobCommitment struct {
+	// Index is the index of the blob in the block.
+	Index math.U64 `json:"index"`
+
+	// KZGCommitment is the KZG commitment to the blob.
+	KZGCommitment eip4844.KZGCommitment `json:"kzg_commitment"`
+
+	// VersionedHash is the versioned hash of the KZG commitment, as
+	// referenced by the blob transaction on the execution layer.
+	VersionedHash common.ExecutionHash `json:"versioned_hash"`
+
+	// KZGCommitmentInclusionProof can be verified against the body root
+	// with a depth of its length and an index of
+	// `26 * MAX_BLOB_COMMITMENTS_PER_BLOCK + Index` in the Deneb fork.
+	KZGCommitmentInclusionProof []common.Root `json:"kzg_commitment_inclusion_proof"`
+}
+
+// BlobResponse is the response for the `/blobs/{versioned_hash}` endpoint.
+type BlobResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the header of the block the blob was included in.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the root of the block the blob was included in.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	BlobCommitment
+
+	// Blob is the blob data.
+	Blob eip4844.Blob `json:"blob"`
+
+	// KZGProof is the KZG proof of the blob against its commitment.
+	KZGProof eip4844.KZGProof `json:"kzg_proof"`
+}
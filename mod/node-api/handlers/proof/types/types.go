@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	fastssz "github.com/ferranbt/fastssz"
 )
@@ -35,6 +36,31 @@ type BeaconBlockHeader interface {
 	GetTree() (*fastssz.Node, error)
 	// GetProposerIndex returns the proposer index.
 	GetProposerIndex() math.ValidatorIndex
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetBodyRoot returns the root of the block body.
+	GetBodyRoot() common.Root
+}
+
+// BlobSidecar is the interface for a blob sidecar.
+type BlobSidecar interface {
+	// GetIndex returns the index of the blob in the block.
+	GetIndex() uint64
+	// GetBlob returns the blob.
+	GetBlob() eip4844.Blob
+	// GetKzgProof returns the KZG proof of the blob against its commitment.
+	GetKzgProof() eip4844.KZGProof
+	// GetKzgCommitment returns the KZG commitment to the blob.
+	GetKzgCommitment() eip4844.KZGCommitment
+	// GetInclusionProof returns the inclusion proof of the KZG commitment
+	// in the block body.
+	GetInclusionProof() []common.Root
+}
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[BlobSidecarT any] interface {
+	// GetSidecars returns the sidecars.
+	GetSidecars() []BlobSidecarT
 }
 
 // BeaconState is the interface for a beacon state.
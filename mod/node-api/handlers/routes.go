@@ -21,14 +21,31 @@
 package handlers
 
 import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
 )
 
+// namespaceSegment is the index of the namespace in the segments of a route
+// path, e.g. "beacon" in "/eth/v1/beacon/genesis".
+const namespaceSegment = 2
+
 // Route is a route for the node API.
 type Route[ContextT any] struct {
 	Method  string
 	Path    string
 	Handler handlerFn[ContextT]
+	// Request and Response are optional values of the types the handler binds
+	// and returns, used to describe the route in the OpenAPI document.
+	Request  any
+	Response any
+	// Authenticated routes are only served to requests bearing the auth
+	// token of the node API.
+	Authenticated bool
 }
 
 // DecorateWithLogs adds logging to the route's handler function as soon as
@@ -36,16 +53,47 @@ type Route[ContextT any] struct {
 func (r *Route[ContextT]) DecorateWithLogs(logger log.Logger) {
 	handler := r.Handler
 	r.Handler = func(ctx ContextT) (any, error) {
-		logger.Info("received request", "method", r.Method, "path", r.Path)
+		reqCtx := requestContext(ctx)
+		logger.Info(
+			"received request",
+			correlation.LogFields(
+				reqCtx, "method", r.Method, "path", r.Path,
+			)...,
+		)
 		res, err := handler(ctx)
 		if err != nil {
-			logger.Error("error handling request", "error", err)
+			logger.Error(
+				"error handling request",
+				correlation.LogFields(reqCtx, "error", err)...,
+			)
 		}
-		logger.Info("request handled", "response", res)
+		logger.Info(
+			"request handled",
+			correlation.LogFields(reqCtx, "response", res)...,
+		)
 		return res, err
 	}
 }
 
+// requestContext returns the context of the HTTP request served with ctx, if
+// the engine exposes it.
+func requestContext(ctx any) context.Context {
+	if c, ok := ctx.(interface{ Request() *http.Request }); ok {
+		return c.Request().Context()
+	}
+	return context.Background()
+}
+
+// Namespace returns the API namespace of the route, which is the path segment
+// following the API version.
+func (r *Route[ContextT]) Namespace() string {
+	segments := strings.Split(strings.TrimPrefix(r.Path, "/"), "/")
+	if len(segments) <= namespaceSegment {
+		return ""
+	}
+	return segments[namespaceSegment]
+}
+
 // RouteSet is a set of routes for the node API.
 type RouteSet[ContextT any] struct {
 	BasePath string
@@ -61,3 +109,21 @@ func NewRouteSet[ContextT any](
 		Routes:   routes,
 	}
 }
+
+// Filter returns a new route set holding copies of the routes that belong to
+// one of the given namespaces. If no namespaces are given, all routes are kept.
+func (rs *RouteSet[ContextT]) Filter(
+	namespaces []string,
+) *RouteSet[ContextT] {
+	routes := make([]*Route[ContextT], 0, len(rs.Routes))
+	for _, route := range rs.Routes {
+		if len(namespaces) > 0 &&
+			!slices.Contains(namespaces, route.Namespace()) {
+			continue
+		}
+		// Copy the route so that decorating it does not affect other sets.
+		r := *route
+		routes = append(routes, &r)
+	}
+	return NewRouteSet(rs.BasePath, routes...)
+}
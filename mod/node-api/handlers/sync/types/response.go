@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// SyncDetail is the response for the `/bkit/v1/sync/detail` endpoint.
+type SyncDetail struct {
+	// HeadSlot is the slot of the latest block the node has processed.
+	HeadSlot uint64 `json:"head_slot,string"`
+	// IsSyncing reports whether the node is catching up with its peers.
+	IsSyncing bool `json:"is_syncing"`
+	// CheckpointSlot is the slot of the lowest block in the block store, at
+	// which the node started from a snapshot if it was state synced.
+	CheckpointSlot uint64 `json:"checkpoint_slot,string"`
+	// Backfill reports on the blocks below the checkpoint slot.
+	Backfill BackfillProgress `json:"backfill"`
+	// Optimistic reports on the blocks imported while the execution client
+	// was syncing.
+	Optimistic OptimisticStatus `json:"optimistic"`
+	// Execution reports on the sync distance of the execution client.
+	Execution ExecutionSync `json:"execution"`
+}
+
+// BackfillProgress is the progress of the node towards holding every block
+// since the initial slot of the chain.
+type BackfillProgress struct {
+	// EarliestSlot is the slot of the lowest block the node holds.
+	EarliestSlot uint64 `json:"earliest_slot,string"`
+	// TargetSlot is the initial slot of the chain.
+	TargetSlot uint64 `json:"target_slot,string"`
+	// RemainingSlots is the number of slots the node holds no block for.
+	RemainingSlots uint64 `json:"remaining_slots,string"`
+	// Complete reports whether the node holds every block.
+	Complete bool `json:"complete"`
+}
+
+// OptimisticStatus is the status of the blocks whose payloads the execution
+// client accepted without validating them.
+type OptimisticStatus struct {
+	// OutstandingBlocks is the number of blocks awaiting validation.
+	OutstandingBlocks uint64 `json:"outstanding_blocks,string"`
+	// LatestValidBlockNumber is the number of the latest execution block
+	// reported as VALID.
+	LatestValidBlockNumber uint64 `json:"latest_valid_block_number,string"`
+}
+
+// ExecutionSync is the distance between the execution head known to the
+// consensus layer and the head of the execution client.
+type ExecutionSync struct {
+	// HeadBlockNumber is the number of the execution block of the latest
+	// beacon block.
+	HeadBlockNumber uint64 `json:"head_block_number,string"`
+	// ClientBlockNumber is the number of the latest block of the execution
+	// client.
+	ClientBlockNumber uint64 `json:"client_block_number,string"`
+	// Distance is the number of blocks the execution client is behind.
+	Distance uint64 `json:"distance,string"`
+	// Client identifies the execution client, once it is known.
+	Client *ExecutionClient `json:"client,omitempty"`
+	// Error is the error returned by the execution client, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// ExecutionClient identifies the implementation and version of the
+// execution client.
+type ExecutionClient struct {
+	// Code is the two letter client code, e.g. "GE" for Geth. It is empty
+	// if the client does not implement engine_getClientVersionV1.
+	Code string `json:"code,omitempty"`
+	// Name is the name of the client.
+	Name string `json:"name"`
+	// Version is the version of the client.
+	Version string `json:"version"`
+	// Commit is the commit the client was built from, if reported.
+	Commit string `json:"commit,omitempty"`
+}
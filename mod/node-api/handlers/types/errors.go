@@ -20,10 +20,45 @@
 
 package types
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	ErrNotFound       = errors.New("not found")
 	ErrNotImplemented = errors.New("not implemented")
 	ErrInvalidRequest = errors.New("invalid request")
+	ErrUnauthorized   = errors.New("unauthorized")
 )
+
+// FieldError describes a single request field that failed validation.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError reports every request field that failed validation. It
+// unwraps to ErrInvalidRequest, so it is served as a 400 Bad Request.
+type ValidationError struct {
+	Failures []FieldError
+}
+
+// NewValidationError returns a ValidationError for the given field failures.
+func NewValidationError(failures ...FieldError) *ValidationError {
+	return &ValidationError{Failures: failures}
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		msgs[i] = failure.Field + " " + failure.Message
+	}
+	return ErrInvalidRequest.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns ErrInvalidRequest.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidRequest
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/encoding/json"
+)
+
+const (
+	// listStreamBufferSize is the size of the buffer a streamed list is
+	// written through.
+	listStreamBufferSize = 32 << 10
+	// listStreamFlushInterval is the number of elements written between two
+	// flushes of a streamed list.
+	listStreamFlushInterval = 256
+)
+
+// ListStream is a response whose data is a JSON array that is encoded and
+// written one element at a time, so that large lists start flowing
+// immediately and are never buffered whole. Writes block while the client
+// is slow to read, which bounds the memory held by the response.
+type ListStream[T any] struct {
+	executionOptimistic bool
+	finalized           bool
+	data                []T
+}
+
+// NewListStream creates a new stream of the given list, wrapped in the
+// execution_optimistic and finalized envelope of the beacon API.
+func NewListStream[T any](
+	executionOptimistic, finalized bool, data []T,
+) *ListStream[T] {
+	return &ListStream[T]{
+		executionOptimistic: executionOptimistic,
+		finalized:           finalized,
+		data:                data,
+	}
+}
+
+// ServeStream implements Stream.
+func (s *ListStream[T]) ServeStream(
+	ctx context.Context,
+	w http.ResponseWriter,
+) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	bw := bufio.NewWriterSize(w, listStreamBufferSize)
+	flush := func() error {
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+
+	if _, err := bw.WriteString(
+		`{"execution_optimistic":` +
+			strconv.FormatBool(s.executionOptimistic) +
+			`,"finalized":` + strconv.FormatBool(s.finalized) +
+			`,"data":[`,
+	); err != nil {
+		return err
+	} else if err = flush(); err != nil {
+		return err
+	}
+	for i, elem := range s.data {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		bz, err := json.Marshal(elem)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if err = bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err = bw.Write(bz); err != nil {
+			return err
+		}
+		if (i+1)%listStreamFlushInterval == 0 {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := bw.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return flush()
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "errors"
+
+const (
+	// ConsensusVersionHeader is the header naming the fork whose schema the
+	// body of a request or response follows.
+	ConsensusVersionHeader = "Eth-Consensus-Version"
+	// SSZContentType is the media type of SSZ encoded bodies.
+	SSZContentType = "application/octet-stream"
+)
+
+// ErrSSZNotSupported is returned by the MarshalSSZ method of a response whose
+// data has no SSZ encoding. The response is then served as JSON.
+var ErrSSZNotSupported = errors.New("response has no SSZ encoding")
+
+// Versioned is implemented by responses whose data follows the schema of a
+// fork. The engine names the fork in the Eth-Consensus-Version header.
+type Versioned interface {
+	ConsensusVersion() string
+}
+
+// SSZMarshaler is implemented by responses that can be served SSZ encoded to
+// clients that accept it.
+type SSZMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// VersionedResponse is a response whose data follows the schema of the fork
+// named by Version.
+type VersionedResponse struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                any    `json:"data"`
+}
+
+// ConsensusVersion returns the name of the fork of the data.
+func (r VersionedResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// MarshalSSZ returns the SSZ encoding of the data, without the envelope of
+// the JSON response, or ErrSSZNotSupported if the data has none.
+func (r VersionedResponse) MarshalSSZ() ([]byte, error) {
+	if m, ok := r.Data.(SSZMarshaler); ok {
+		return m.MarshalSSZ()
+	}
+	return nil, ErrSSZNotSupported
+}
@@ -21,6 +21,7 @@
 package utils
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
@@ -33,10 +34,14 @@ func BindAndValidate[RequestT any, ContextT context.Context](
 ) (RequestT, error) {
 	var req RequestT
 	if err := c.Bind(&req); err != nil {
-		return req, types.ErrInvalidRequest
+		return req, errors.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 	if err := c.Validate(&req); err != nil {
-		return req, types.ErrInvalidRequest
+		var validationErr *types.ValidationError
+		if errors.As(err, &validationErr) {
+			return req, validationErr
+		}
+		return req, errors.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 	logger.Info("Request validation successful", "params", req)
 	return req, nil
@@ -46,7 +46,7 @@ func SlotFromStateID[StorageBackendT interface {
 	// We assume that the state ID is a state hash.
 	root, err := common.NewRootFromHex(stateID)
 	if err != nil {
-		return 0, err
+		return 0, InvalidField("state_id", "state_id")
 	}
 	return storage.GetSlotByStateRoot(root)
 }
@@ -65,7 +65,7 @@ func SlotFromBlockID[StorageBackendT interface {
 	// We assume that the block ID is a block hash.
 	root, err := common.NewRootFromHex(blockID)
 	if err != nil {
-		return 0, err
+		return 0, InvalidField("block_id", "block_id")
 	}
 	return storage.GetSlotByBlockRoot(root)
 }
@@ -85,13 +85,17 @@ func SlotFromExecutionID[StorageBackendT interface {
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }](executionID string, storage StorageBackendT) (math.Slot, error) {
 	if !IsExecutionNumberPrefix(executionID) {
-		return slotFromStateID(executionID)
+		slot, err := slotFromStateID(executionID)
+		if err != nil {
+			return 0, InvalidField("execution_id", "execution_id")
+		}
+		return slot, nil
 	}
 
 	// Parse the execution number from the executionID.
 	executionNumber, err := U64FromString(executionID[1:])
 	if err != nil {
-		return 0, err
+		return 0, InvalidField("execution_id", "execution_id")
 	}
 	return storage.GetSlotByExecutionNumber(executionNumber)
 }
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+var (
+	// stateIDNames are the named states a state ID may refer to.
+	stateIDNames = map[string]bool{
+		StateIDHead:      true,
+		StateIDGenesis:   true,
+		StateIDFinalized: true,
+		StateIDJustified: true,
+	}
+	// blockIDNames are the named blocks a block ID may refer to.
+	blockIDNames = map[string]bool{
+		StateIDHead:      true,
+		StateIDGenesis:   true,
+		StateIDFinalized: true,
+	}
+	// validatorStatuses are the statuses defined by the Beacon Node API spec,
+	// including the generic statuses that match every status of their kind.
+	validatorStatuses = map[string]bool{
+		"pending":             true,
+		"active":              true,
+		"exited":              true,
+		"withdrawal":          true,
+		"pending_initialized": true,
+		"pending_queued":      true,
+		"active_ongoing":      true,
+		"active_exiting":      true,
+		"active_slashed":      true,
+		"exited_unslashed":    true,
+		"exited_slashed":      true,
+		"withdrawal_possible": true,
+		"withdrawal_done":     true,
+	}
+
+	rootPattern        = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+	pubkeyPattern      = regexp.MustCompile(`^0x[0-9a-fA-F]{96}$`)
+	validatorIDPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{1,96}$`)
+)
+
+// formatMessages maps each validation tag to the message reported for a
+// field that fails it.
+//
+//nolint:lll // messages read better unwrapped.
+var formatMessages = map[string]string{
+	"required":         "is required",
+	"state_id":         "must be one of head, genesis, finalized, justified, a decimal slot or a 0x-prefixed 32 byte state root",
+	"block_id":         "must be one of head, genesis, finalized, a decimal slot or a 0x-prefixed 32 byte block root",
+	"execution_id":     "must be one of head, genesis, finalized, justified, a decimal slot or an n-prefixed decimal execution number",
+	"validator_id":     "must be a decimal validator index or a 0x-prefixed hex public key",
+	"validator_status": "must be a validator status defined by the Beacon Node API",
+	"pubkey":           "must be a 0x-prefixed 48 byte hex public key",
+	"root":             "must be a 0x-prefixed 32 byte hex root",
+	"epoch":            "must be a decimal uint64",
+	"slot":             "must be a decimal uint64",
+	"committee_index":  "must be a decimal uint64",
+	"validator_index":  "must be a decimal uint64",
+	"uint64":           "must be a decimal uint64",
+	"number":           "must be a decimal number",
+	"hexadecimal":      "must be a hex string",
+	"fork":             "must be the name of a fork",
+}
+
+// FormatMessage returns the message reported for a request field that fails
+// the given validation tag.
+func FormatMessage(tag string) string {
+	if msg, ok := formatMessages[tag]; ok {
+		return msg
+	}
+	return "is malformed"
+}
+
+// InvalidField returns a validation error for a request field that fails
+// the given validation tag.
+func InvalidField(field, tag string) error {
+	return types.NewValidationError(types.FieldError{
+		Field:   field,
+		Message: FormatMessage(tag),
+	})
+}
+
+// ParseU64 parses the decimal value of a request field, returning a
+// field-level validation error if it is malformed.
+func ParseU64(field, value string) (math.U64, error) {
+	u64, err := U64FromString(value)
+	if err != nil {
+		return 0, InvalidField(field, "uint64")
+	}
+	return u64, nil
+}
+
+// IsStateID reports whether id is a named state, a slot or a state root.
+func IsStateID(id string) bool {
+	return stateIDNames[id] || IsUint64(id) || IsRoot(id)
+}
+
+// IsBlockID reports whether id is a named block, a slot or a block root.
+func IsBlockID(id string) bool {
+	return blockIDNames[id] || IsUint64(id) || IsRoot(id)
+}
+
+// IsExecutionID reports whether id is a named state, a slot or an execution
+// number prefixed with ExecutionIDPrefix.
+func IsExecutionID(id string) bool {
+	if IsExecutionNumberPrefix(id) {
+		return IsUint64(id[len(ExecutionIDPrefix):])
+	}
+	return stateIDNames[id] || IsUint64(id)
+}
+
+// IsValidatorID reports whether id is a validator index or a hex encoded
+// validator public key.
+func IsValidatorID(id string) bool {
+	return validatorIDPattern.MatchString(id) || IsUint64(id)
+}
+
+// IsValidatorStatus reports whether status is defined by the Beacon Node API.
+func IsValidatorStatus(status string) bool {
+	return validatorStatuses[status]
+}
+
+// IsUint64 reports whether value is a uint64 in decimal notation.
+func IsUint64(value string) bool {
+	_, err := strconv.ParseUint(value, 10, 64)
+	return err == nil
+}
+
+// IsRoot reports whether value is a 0x prefixed, hex encoded 32 byte root.
+func IsRoot(value string) bool {
+	return rootPattern.MatchString(value)
+}
+
+// IsPubkey reports whether value is a 0x prefixed, hex encoded 48 byte BLS
+// public key.
+func IsPubkey(value string) bool {
+	return pubkeyPattern.MatchString(value)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const root = "0x" +
+	"0000000000000000000000000000000000000000000000000000000000000001"
+
+func TestIsStateID(t *testing.T) {
+	for id, valid := range map[string]bool{
+		"head":      true,
+		"genesis":   true,
+		"finalized": true,
+		"justified": true,
+		"123":       true,
+		root:        true,
+		"":          false,
+		"latest":    false,
+		"-1":        false,
+		"0x1234":    false,
+	} {
+		require.Equal(t, valid, utils.IsStateID(id), id)
+	}
+}
+
+func TestIsBlockID(t *testing.T) {
+	require.True(t, utils.IsBlockID(root))
+	require.True(t, utils.IsBlockID("42"))
+	require.False(t, utils.IsBlockID("justified"))
+}
+
+func TestIsExecutionID(t *testing.T) {
+	require.True(t, utils.IsExecutionID("n1722463215"))
+	require.True(t, utils.IsExecutionID("head"))
+	require.False(t, utils.IsExecutionID("n"))
+	require.False(t, utils.IsExecutionID("nabc"))
+	require.False(t, utils.IsExecutionID(root))
+}
+
+func TestParseU64(t *testing.T) {
+	u64, err := utils.ParseU64("slot", "10")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), u64.Unwrap())
+
+	_, err = utils.ParseU64("slot", "ten")
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	var validationErr *types.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Len(t, validationErr.Failures, 1)
+	require.Equal(t, "slot", validationErr.Failures[0].Field)
+	require.Equal(t,
+		utils.FormatMessage("uint64"), validationErr.Failures[0].Message,
+	)
+}
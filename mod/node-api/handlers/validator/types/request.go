@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// AttestationDataRequest is the request for the
+// `/eth/v1/validator/attestation_data` endpoint.
+//
+//nolint:lll // tags get long
+type AttestationDataRequest struct {
+	Slot           string `query:"slot"            validate:"required,slot"`
+	CommitteeIndex string `query:"committee_index" validate:"required,committee_index"`
+}
+
+// LivenessRequest is the request for the `/eth/v1/validator/liveness/{epoch}`
+// endpoint. The body is a JSON array of validator indices, as in the beacon
+// API, or an object with an "indices" array.
+type LivenessRequest struct {
+	Epoch   string   `param:"epoch" validate:"required,epoch"`
+	Indices []string `validate:"dive,validator_index"`
+}
+
+// UnmarshalJSON decodes the indices of the body, leaving the epoch bound from
+// the path untouched.
+func (r *LivenessRequest) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 &&
+		trimmed[0] == '[' {
+		return json.Unmarshal(data, &r.Indices)
+	}
+	var body struct {
+		Indices []string `json:"indices"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	r.Indices = body.Indices
+	return nil
+}
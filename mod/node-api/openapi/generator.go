@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
+
+// Path is the path the OpenAPI document is served at.
+const Path = "/bkit/v1/openapi.json"
+
+const contentTypeJSON = "application/json"
+
+// Generate builds the OpenAPI document of the given route sets. Routes which
+// declare their request and response types get their parameters and bodies
+// described, the others only their path parameters.
+func Generate[ContextT any](
+	info Info, routeSets ...*handlers.RouteSet[ContextT],
+) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+	}
+	for _, rs := range routeSets {
+		for _, route := range rs.Routes {
+			path := toOpenAPIPath(rs.BasePath + route.Path)
+			item, ok := doc.Paths[path]
+			if !ok {
+				item = make(PathItem)
+				doc.Paths[path] = item
+			}
+			item[strings.ToLower(route.Method)] = operationOf(route)
+		}
+	}
+	return doc
+}
+
+// RouteSet returns the route set serving the OpenAPI document of the given
+// route sets.
+func RouteSet[ContextT any](
+	info Info, routeSets ...*handlers.RouteSet[ContextT],
+) *handlers.RouteSet[ContextT] {
+	// The document follows the casing of the OpenAPI specification rather
+	// than the one of the beacon API, so it is served pre-encoded.
+	doc, err := json.Marshal(Generate(info, routeSets...))
+	return handlers.NewRouteSet("", &handlers.Route[ContextT]{
+		Method: http.MethodGet,
+		Path:   Path,
+		Handler: func(ContextT) (any, error) {
+			return json.RawMessage(doc), err
+		},
+	})
+}
+
+// operationOf describes the route as an OpenAPI operation.
+func operationOf[ContextT any](route *handlers.Route[ContextT]) *Operation {
+	op := &Operation{
+		OperationID: operationID(route.Method, route.Path),
+		Responses: map[string]Response{
+			"200": {Description: "Success"},
+			"default": {
+				Description: "Error",
+				Content: map[string]MediaType{
+					contentTypeJSON: {Schema: errorSchema()},
+				},
+			},
+		},
+	}
+	if ns := route.Namespace(); ns != "" {
+		op.Tags = []string{ns}
+	}
+
+	params := make(map[string]*Parameter)
+	for _, name := range pathParams(route.Path) {
+		params[name] = &Parameter{
+			Name: name, In: "path", Required: true,
+			Schema: &Schema{Type: "string"},
+		}
+	}
+
+	if route.Request != nil {
+		reqType := reflect.TypeOf(route.Request)
+		for reqType.Kind() == reflect.Pointer {
+			reqType = reqType.Elem()
+		}
+		describeRequest(op, params, reqType, route.Method)
+	}
+
+	for _, name := range pathParams(route.Path) {
+		op.Parameters = append(op.Parameters, params[name])
+		delete(params, name)
+	}
+	for _, field := range sortedNames(params) {
+		op.Parameters = append(op.Parameters, params[field])
+	}
+
+	if route.Response != nil {
+		op.Responses["200"] = Response{
+			Description: "Success",
+			Content: map[string]MediaType{
+				contentTypeJSON: {
+					Schema: schemaOf(reflect.TypeOf(route.Response)),
+				},
+			},
+		}
+	}
+	return op
+}
+
+// describeRequest adds the path and query parameters, and the body, of the
+// request type to the operation.
+func describeRequest(
+	op *Operation,
+	params map[string]*Parameter,
+	reqType reflect.Type,
+	method string,
+) {
+	if reqType.Kind() != reflect.Struct {
+		return
+	}
+
+	for _, field := range reflect.VisibleFields(reqType) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		if name := field.Tag.Get("param"); name != "" {
+			params[name] = &Parameter{
+				Name: name, In: "path", Required: true,
+				Schema: schemaOf(field.Type),
+			}
+		}
+		if name := field.Tag.Get("query"); name != "" {
+			params[name] = &Parameter{
+				Name: name, In: "query", Required: isRequired(field),
+				Schema: schemaOf(field.Type),
+			}
+		}
+	}
+
+	if method != http.MethodPost && method != http.MethodPut &&
+		method != http.MethodPatch {
+		return
+	}
+	body := objectSchema(
+		reqType, make(map[reflect.Type]bool),
+		func(field reflect.StructField) bool {
+			return field.Tag.Get("param") == "" &&
+				field.Tag.Get("query") == "" &&
+				field.Tag.Get("header") == ""
+		},
+	)
+	if len(body.Properties) == 0 {
+		return
+	}
+	op.RequestBody = &RequestBody{
+		Required: len(body.Required) > 0,
+		Content:  map[string]MediaType{contentTypeJSON: {Schema: body}},
+	}
+}
+
+// errorSchema is the schema of the error responses of the API.
+func errorSchema() *Schema {
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"code":    {Type: "integer", Format: "int64"},
+			"message": {Type: "string"},
+		},
+	}
+}
+
+// toOpenAPIPath converts ":param" path segments to "{param}", and makes the
+// path absolute.
+func toOpenAPIPath(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// pathParams returns the names of the parameters of the path, in order.
+func pathParams(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// sortedNames returns the names of the parameters in sorted order.
+func sortedNames(params map[string]*Parameter) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// operationID derives a unique operation id from the method and path, e.g.
+// "getEthV1NodeVersion" for GET /eth/v1/node/version.
+func operationID(method, path string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		segment = strings.TrimPrefix(segment, ":")
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return r == '_' || r == '-' || r == '.'
+		}) {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/openapi"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedRequest struct {
+	StateID string `param:"state_id" validate:"required"`
+}
+
+type postRequest struct {
+	embeddedRequest
+	Flag    bool     `query:"flag"`
+	Indices []string `json:"indices" validate:"required"`
+}
+
+type response struct {
+	Root    common.Root       `json:"root"`
+	Balance uint64            `json:"balance,string"`
+	Labels  map[string]string `json:"labels"`
+	Skipped string            `json:"-"`
+}
+
+func TestGenerate(t *testing.T) {
+	noop := func(any) (any, error) { return nil, nil }
+	routes := handlers.NewRouteSet("",
+		&handlers.Route[any]{
+			Method:   http.MethodPost,
+			Path:     "bkit/v1/test/:state_id/items",
+			Handler:  noop,
+			Request:  postRequest{},
+			Response: response{},
+		},
+		&handlers.Route[any]{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/test/:id",
+			Handler: noop,
+		},
+	)
+
+	doc := openapi.Generate(openapi.Info{Title: "test", Version: "v1"}, routes)
+	require.Equal(t, openapi.Version, doc.OpenAPI)
+	require.Len(t, doc.Paths, 2)
+
+	op := doc.Paths["/bkit/v1/test/{state_id}/items"]["post"]
+	require.NotNil(t, op)
+	require.Equal(t, "postBkitV1TestStateIdItems", op.OperationID)
+	require.Equal(t, []string{"test"}, op.Tags)
+
+	// The path parameter comes first, then the query parameters.
+	require.Len(t, op.Parameters, 2)
+	require.Equal(t, "state_id", op.Parameters[0].Name)
+	require.Equal(t, "path", op.Parameters[0].In)
+	require.Equal(t, "flag", op.Parameters[1].Name)
+	require.Equal(t, "query", op.Parameters[1].In)
+	require.Equal(t, "boolean", op.Parameters[1].Schema.Type)
+
+	// Only the body fields make up the request body.
+	body := op.RequestBody.Content["application/json"].Schema
+	require.Len(t, body.Properties, 1)
+	require.Equal(t, "array", body.Properties["indices"].Type)
+	require.Equal(t, []string{"indices"}, body.Required)
+
+	res := op.Responses["200"].Content["application/json"].Schema
+	require.Len(t, res.Properties, 3)
+	require.Equal(t, "string", res.Properties["root"].Type)
+	require.Equal(t, "string", res.Properties["balance"].Type)
+	labels := res.Properties["labels"]
+	require.Equal(t, "string", labels.AdditionalProperties.Type)
+
+	// Routes without types still describe their path parameters.
+	op = doc.Paths["/eth/v1/test/{id}"]["get"]
+	require.NotNil(t, op)
+	require.Len(t, op.Parameters, 1)
+	require.Nil(t, op.RequestBody)
+
+	_, err := json.Marshal(doc)
+	require.NoError(t, err)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var (
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+)
+
+// schemaOf derives the schema of the JSON encoding of a value of type t.
+func schemaOf(t reflect.Type) *Schema {
+	return schemaFor(t, make(map[reflect.Type]bool))
+}
+
+// schemaFor derives the schema of t, using seen to stop at recursive types.
+func schemaFor(t reflect.Type, seen map[reflect.Type]bool) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Types with custom encodings are hex strings throughout the API, except
+	// for the JSON marshalers whose shape cannot be known.
+	ptr := reflect.PointerTo(t)
+	switch {
+	case t.Implements(textMarshalerType), ptr.Implements(textMarshalerType):
+		return &Schema{Type: "string"}
+	case t.Implements(jsonMarshalerType), ptr.Implements(jsonMarshalerType):
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Format: "uint64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: schemaFor(t.Elem(), seen)}
+	case reflect.Array:
+		return &Schema{Type: "array", Items: schemaFor(t.Elem(), seen)}
+	case reflect.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: schemaFor(t.Elem(), seen),
+		}
+	case reflect.Struct:
+		if seen[t] {
+			return &Schema{Type: "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		return objectSchema(t, seen, func(reflect.StructField) bool {
+			return true
+		})
+	default:
+		return &Schema{}
+	}
+}
+
+// objectSchema derives the schema of the struct t from the fields accepted
+// by include, flattening embedded structs as encoding/json does.
+func objectSchema(
+	t reflect.Type,
+	seen map[reflect.Type]bool,
+	include func(reflect.StructField) bool,
+) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, field := range reflect.VisibleFields(t) {
+		// Fields of embedded structs are visited on their own.
+		if !field.IsExported() || field.Anonymous || !include(field) {
+			continue
+		}
+
+		name, opts := parseTag(field.Tag.Get("json"))
+		if name == "-" && opts == "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fieldSchema := schemaFor(field.Type, seen)
+		if slices.Contains(strings.Split(opts, ","), "string") {
+			fieldSchema = &Schema{Type: "string"}
+		}
+		schema.Properties[name] = fieldSchema
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// parseTag splits a struct tag value into its name and options.
+func parseTag(tag string) (string, string) {
+	name, opts, _ := strings.Cut(tag, ",")
+	return name, opts
+}
+
+// isRequired returns true if the field is validated as required.
+func isRequired(field reflect.StructField) bool {
+	return slices.Contains(
+		strings.Split(field.Tag.Get("validate"), ","), "required",
+	)
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance
+
+import (
+	"context"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Tracker records the validators observed performing duties in the current
+// and the previous epoch. A validator performs a duty when it proposes a
+// block that gets finalized.
+type Tracker[BeaconBlockT BeaconBlock] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec is used to map slots to epochs.
+	chainSpec common.ChainSpec
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// epoch is the latest epoch a duty was observed in.
+	epoch math.Epoch
+	// current holds the validators observed in epoch.
+	current map[math.ValidatorIndex]struct{}
+	// previous holds the validators observed in the epoch before epoch.
+	previous map[math.ValidatorIndex]struct{}
+}
+
+// NewTracker creates a new performance tracker.
+func NewTracker[BeaconBlockT BeaconBlock](
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	dispatcher asynctypes.EventDispatcher,
+) *Tracker[BeaconBlockT] {
+	return &Tracker[BeaconBlockT]{
+		logger:                logger,
+		chainSpec:             chainSpec,
+		dispatcher:            dispatcher,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		current:               make(map[math.ValidatorIndex]struct{}),
+		previous:              make(map[math.ValidatorIndex]struct{}),
+	}
+}
+
+// Name returns the name of the service.
+func (t *Tracker[_]) Name() string {
+	return "performance-tracker"
+}
+
+// Start subscribes the tracker to BeaconBlockFinalized events and starts its
+// event loop.
+func (t *Tracker[_]) Start(ctx context.Context) error {
+	if err := t.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, t.subFinalizedBlkEvents,
+	); err != nil {
+		t.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+
+	go t.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the tracker.
+func (t *Tracker[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-t.subFinalizedBlkEvents:
+			blk := event.Data()
+			t.Observe(blk.GetSlot(), blk.GetProposerIndex())
+		}
+	}
+}
+
+// Observe records that the validator with the given index performed a duty
+// at the given slot. Observations older than the previous epoch are dropped.
+func (t *Tracker[_]) Observe(slot math.Slot, index math.ValidatorIndex) {
+	epoch := t.chainSpec.SlotToEpoch(slot)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch {
+	case epoch == t.epoch:
+	case epoch == t.epoch+1:
+		t.previous, t.current = t.current, make(
+			map[math.ValidatorIndex]struct{},
+		)
+		t.epoch = epoch
+	case epoch > t.epoch:
+		t.previous = make(map[math.ValidatorIndex]struct{})
+		t.current = make(map[math.ValidatorIndex]struct{})
+		t.epoch = epoch
+	case epoch+1 == t.epoch:
+		t.previous[index] = struct{}{}
+		return
+	default:
+		return
+	}
+	t.current[index] = struct{}{}
+}
+
+// IsLive returns whether the validator with the given index was observed
+// performing a duty in the given epoch, which must be either the current or
+// the previous epoch.
+func (t *Tracker[_]) IsLive(
+	epoch math.Epoch, index math.ValidatorIndex,
+) (bool, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	switch {
+	case epoch == t.epoch:
+		_, ok := t.current[index]
+		return ok, nil
+	case epoch+1 == t.epoch:
+		_, ok := t.previous[index]
+		return ok, nil
+	default:
+		return false, errors.Wrapf(
+			ErrEpochNotTracked,
+			"epoch %d, current epoch %d", epoch, t.epoch,
+		)
+	}
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package performance_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/performance"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type beaconBlock struct {
+	slot     math.Slot
+	proposer math.ValidatorIndex
+}
+
+func (b *beaconBlock) GetSlot() math.Slot { return b.slot }
+
+func (b *beaconBlock) GetProposerIndex() math.ValidatorIndex {
+	return b.proposer
+}
+
+const slotsPerEpoch = 4
+
+func newTracker() *performance.Tracker[*beaconBlock] {
+	cs := chain.NewChainSpec(
+		chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch: slotsPerEpoch,
+		},
+	)
+	return performance.NewTracker[*beaconBlock](
+		noop.NewLogger[any](), cs, nil,
+	)
+}
+
+func TestTracker_IsLive(t *testing.T) {
+	tracker := newTracker()
+	tracker.Observe(0, 1)
+	tracker.Observe(1, 2)
+	tracker.Observe(slotsPerEpoch, 3)
+
+	tests := []struct {
+		name     string
+		epoch    math.Epoch
+		index    math.ValidatorIndex
+		expected bool
+	}{
+		{name: "previous epoch proposer", epoch: 0, index: 1, expected: true},
+		{name: "previous epoch absent", epoch: 0, index: 3, expected: false},
+		{name: "current epoch proposer", epoch: 1, index: 3, expected: true},
+		{name: "current epoch absent", epoch: 1, index: 1, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isLive, err := tracker.IsLive(tt.epoch, tt.index)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, isLive)
+		})
+	}
+}
+
+func TestTracker_EpochNotTracked(t *testing.T) {
+	tracker := newTracker()
+	tracker.Observe(0, 1)
+	tracker.Observe(2*slotsPerEpoch, 2)
+
+	// Skipping an epoch discards all earlier observations.
+	isLive, err := tracker.IsLive(1, 1)
+	require.NoError(t, err)
+	require.False(t, isLive)
+
+	_, err = tracker.IsLive(0, 1)
+	require.True(t, errors.Is(err, performance.ErrEpochNotTracked))
+
+	_, err = tracker.IsLive(3, 2)
+	require.True(t, errors.Is(err, performance.ErrEpochNotTracked))
+}
+
+func TestTracker_LateObservation(t *testing.T) {
+	tracker := newTracker()
+	tracker.Observe(slotsPerEpoch, 1)
+	tracker.Observe(slotsPerEpoch-1, 2)
+
+	isLive, err := tracker.IsLive(0, 2)
+	require.NoError(t, err)
+	require.True(t, isLive)
+}
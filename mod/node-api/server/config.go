@@ -21,7 +21,9 @@
 package server
 
 const (
-	defaultAddress = "0.0.0.0:3500"
+	defaultAddress           = "0.0.0.0:3500"
+	defaultProfileName       = "default"
+	defaultEventHistorySlots = 100_000
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +34,60 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// EventHistorySlots is the number of slots of events kept in the event
+	// journal for the events history endpoint. Zero keeps every event.
+	EventHistorySlots uint64 `mapstructure:"event-history-slots"`
+	// AuthToken is the bearer token required by the authenticated routes,
+	// such as the maintenance routes of the admin API. Those routes are
+	// refused while it is empty.
+	AuthToken string `mapstructure:"auth-token"`
+	// Explorer is the flag to serve the block explorer page at /explorer on
+	// every listener. The page calls the beacon, node and config namespaces
+	// of the listener serving it.
+	Explorer bool `mapstructure:"explorer"`
+	// Profiles are the listener profiles to run. If empty, a single listener
+	// serving every namespace is bound to Address.
+	Profiles []ProfileConfig `mapstructure:"profiles"`
+}
+
+// ProfileConfig is the configuration of a single listener of the node API
+// server.
+type ProfileConfig struct {
+	// Name identifies the profile in logs.
+	Name string `mapstructure:"name"`
+	// Address is the address to bind the listener to.
+	Address string `mapstructure:"address"`
+	// Logging is the flag to enable logging of requests on this listener.
+	Logging bool `mapstructure:"logging"`
+	// Namespaces are the API namespaces served by the listener, e.g.
+	// "beacon" or "debug". If empty, all namespaces are served.
+	Namespaces []string `mapstructure:"namespaces"`
+	// AllowOrigins are the origins allowed by the CORS middleware of the
+	// listener. If empty, all origins are allowed.
+	AllowOrigins []string `mapstructure:"allow-origins"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:           false,
+		Address:           defaultAddress,
+		Logging:           false,
+		EventHistorySlots: defaultEventHistorySlots,
+		Explorer:          false,
+		Profiles:          []ProfileConfig{},
+	}
+}
+
+// ListenerProfiles returns the listener profiles to run, falling back to a
+// single profile built from the top level address and logging flag.
+func (c Config) ListenerProfiles() []ProfileConfig {
+	if len(c.Profiles) > 0 {
+		return c.Profiles
 	}
+	return []ProfileConfig{{
+		Name:    defaultProfileName,
+		Address: c.Address,
+		Logging: c.Logging,
+	}}
 }
@@ -25,63 +25,123 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/explorer"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/openapi"
 	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// apiInfo describes the API in its OpenAPI document.
+var apiInfo = openapi.Info{Title: "BeaconKit Node API", Version: "v1"}
+
 // Server is the API Server service.
 type Server[
 	ContextT apicontext.Context,
 ] struct {
-	engine Engine[ContextT]
-	config Config
-	logger log.Logger
+	listeners []*listener[ContextT]
+	config    Config
+	logger    log.Logger
+}
+
+// listener is an engine serving the routes of a single listener profile.
+type listener[
+	ContextT apicontext.Context,
+] struct {
+	profile ProfileConfig
+	engine  Engine[ContextT]
 }
 
-// New initializes a new API Server with the given config, engine, and logger.
-// It will inject a noop logger into the API handlers and engine if logging is
-// disabled.
+// New initializes a new API Server with the given config, engine factory, and
+// logger. An engine is created for every listener profile, serving only the
+// routes of the namespaces of that profile. It will inject a noop logger into
+// the API handlers and engines if logging is disabled.
 func New[
 	ContextT apicontext.Context,
 ](
 	config Config,
-	engine Engine[ContextT],
+	engines EngineFactory[ContextT],
 	logger log.Logger,
 	handlers ...handlers.Handlers[ContextT],
 ) *Server[ContextT] {
-	apiLogger := logger
-	if !config.Logging {
-		apiLogger = noop.NewLogger[log.Logger]()
+	var (
+		noopLogger = noop.NewLogger[log.Logger]()
+		profiles   = config.ListenerProfiles()
+		listeners  = make([]*listener[ContextT], 0, len(profiles))
+	)
+
+	// The handlers are shared across listeners, so they log if any does.
+	handlerLogger := log.Logger(noopLogger)
+	for _, profile := range profiles {
+		if profile.Logging {
+			handlerLogger = logger
+			break
+		}
 	}
 	for _, handler := range handlers {
-		handler.RegisterRoutes(apiLogger)
-		engine.RegisterRoutes(handler.RouteSet(), apiLogger)
+		handler.RegisterRoutes(handlerLogger)
+	}
+
+	for _, profile := range profiles {
+		apiLogger := log.Logger(noopLogger)
+		if profile.Logging {
+			apiLogger = logger
+		}
+		engine := engines.NewEngine(profile)
+		routeSets := filterRouteSets(handlers, profile.Namespaces)
+		for _, routeSet := range routeSets {
+			engine.RegisterRoutes(routeSet, apiLogger)
+		}
+		// Every listener describes the routes it serves.
+		engine.RegisterRoutes(
+			openapi.RouteSet(apiInfo, routeSets...), apiLogger,
+		)
+		if config.Explorer {
+			engine.RegisterRoutes(explorer.RouteSet[ContextT](), apiLogger)
+		}
+		listeners = append(listeners, &listener[ContextT]{
+			profile: profile,
+			engine:  engine,
+		})
 	}
 	return &Server[ContextT]{
-		engine: engine,
-		config: config,
-		logger: logger,
+		listeners: listeners,
+		config:    config,
+		logger:    logger,
 	}
 }
 
-// Start starts the API Server at the configured address.
+// filterRouteSets returns the route sets of the handlers, holding only the
+// routes of the given namespaces.
+func filterRouteSets[ContextT any](
+	hs []handlers.Handlers[ContextT], namespaces []string,
+) []*handlers.RouteSet[ContextT] {
+	routeSets := make([]*handlers.RouteSet[ContextT], 0, len(hs))
+	for _, h := range hs {
+		routeSets = append(routeSets, h.RouteSet().Filter(namespaces))
+	}
+	return routeSets
+}
+
+// Start starts a listener at the configured address of every profile.
 func (s *Server[_]) Start(ctx context.Context) error {
 	if !s.config.Enabled {
 		return nil
 	}
-	go s.start(ctx)
+	for _, l := range s.listeners {
+		go s.start(ctx, l)
+	}
 	return nil
 }
 
-func (s *Server[_]) start(ctx context.Context) {
+func (s *Server[ContextT]) start(ctx context.Context, l *listener[ContextT]) {
 	errCh := make(chan error)
 	go func() {
-		errCh <- s.engine.Run(s.config.Address)
+		errCh <- l.engine.Run(l.profile.Address)
 	}()
 	for {
 		select {
 		case err := <-errCh:
-			s.logger.Error(err.Error())
+			s.logger.Error(err.Error(), "profile", l.profile.Name)
 		case <-ctx.Done():
 			return
 		}
@@ -31,3 +31,8 @@ type Engine[ContextT context.Context] interface {
 	Run(addr string) error
 	RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
 }
+
+// EngineFactory creates a new engine for a listener profile.
+type EngineFactory[ContextT context.Context] interface {
+	NewEngine(profile ProfileConfig) Engine[ContextT]
+}
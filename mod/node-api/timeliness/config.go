@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package timeliness
+
+import "time"
+
+const (
+	DefaultLateThreshold = 2 * time.Second
+	DefaultChronicStreak = 3
+)
+
+// Config is the configuration for the tracking of block timeliness.
+type Config struct {
+	// LateThreshold is how long after the start of its slot a block may
+	// arrive before it is considered late.
+	LateThreshold time.Duration `mapstructure:"late-threshold"`
+	// ChronicStreak is the number of consecutive late blocks after which a
+	// proposer is reported as chronically late. Zero disables the warnings.
+	ChronicStreak uint64 `mapstructure:"chronic-streak"`
+}
+
+// DefaultConfig returns the default configuration for the tracking of block
+// timeliness.
+func DefaultConfig() Config {
+	return Config{
+		LateThreshold: DefaultLateThreshold,
+		ChronicStreak: DefaultChronicStreak,
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package timeliness
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Record is the timeliness of the blocks of a proposer, as observed by this
+// node since it started.
+type Record struct {
+	// ValidatorIndex is the index of the proposer.
+	ValidatorIndex math.ValidatorIndex
+	// Blocks is the number of blocks observed.
+	Blocks uint64
+	// LateBlocks is the number of blocks that arrived late.
+	LateBlocks uint64
+	// LateStreak is the number of consecutive late blocks up to the latest
+	// one.
+	LateStreak uint64
+	// TotalLateness is the sum of the lateness of every block, which with
+	// Blocks gives the average lateness.
+	TotalLateness time.Duration
+	// MaxLateness is the largest lateness observed.
+	MaxLateness time.Duration
+	// LastLateness is the lateness of the latest block.
+	LastLateness time.Duration
+	// LastSlot is the slot of the latest block.
+	LastSlot math.Slot
+}
+
+// Tracker records how long after the start of their slot the blocks of every
+// proposer arrive, and warns about the proposers whose blocks are late
+// repeatedly.
+type Tracker struct {
+	// logger is used to warn about chronically late proposers.
+	logger log.Logger
+	// cfg is the configuration of the tracker.
+	cfg Config
+
+	// mu protects records.
+	mu sync.RWMutex
+	// records holds the record of every proposer observed.
+	records map[math.ValidatorIndex]*Record
+}
+
+// NewTracker creates a new timeliness tracker.
+func NewTracker(logger log.Logger, cfg Config) *Tracker {
+	return &Tracker{
+		logger:  logger,
+		cfg:     cfg,
+		records: make(map[math.ValidatorIndex]*Record),
+	}
+}
+
+// Observe records that the block proposed by the given validator for the
+// given slot arrived lateness after the start of the slot, and returns true
+// if it is late. Only the first block observed for a slot counts, so that the
+// proposals of later rounds do not skew the record.
+func (t *Tracker) Observe(
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	lateness time.Duration,
+) bool {
+	late := lateness > t.cfg.LateThreshold
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	record, ok := t.records[proposer]
+	switch {
+	case !ok:
+		record = &Record{ValidatorIndex: proposer}
+		t.records[proposer] = record
+	case slot <= record.LastSlot:
+		return late
+	}
+
+	record.Blocks++
+	record.TotalLateness += lateness
+	record.MaxLateness = max(record.MaxLateness, lateness)
+	record.LastLateness = lateness
+	record.LastSlot = slot
+	if !late {
+		record.LateStreak = 0
+		return false
+	}
+	record.LateBlocks++
+	record.LateStreak++
+
+	if t.cfg.ChronicStreak > 0 && record.LateStreak >= t.cfg.ChronicStreak {
+		t.logger.Warn(
+			"Proposer is chronically late",
+			"validator_index", proposer,
+			"late_streak", record.LateStreak,
+			"late_blocks", record.LateBlocks,
+			"blocks", record.Blocks,
+			"lateness", lateness,
+		)
+	}
+	return true
+}
+
+// Records returns a copy of the record of every proposer observed, ordered by
+// validator index.
+func (t *Tracker) Records() []*Record {
+	t.mu.RLock()
+	records := make([]*Record, 0, len(t.records))
+	for _, record := range t.records {
+		cpy := *record
+		records = append(records, &cpy)
+	}
+	t.mu.RUnlock()
+
+	slices.SortFunc(records, func(a, b *Record) int {
+		return cmp.Compare(a.ValidatorIndex, b.ValidatorIndex)
+	})
+	return records
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package timeliness_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/timeliness"
+	"github.com/stretchr/testify/require"
+)
+
+func newTracker() *timeliness.Tracker {
+	return timeliness.NewTracker(
+		noop.NewLogger[any](),
+		timeliness.Config{LateThreshold: time.Second, ChronicStreak: 2},
+	)
+}
+
+func TestTracker_Observe(t *testing.T) {
+	tracker := newTracker()
+	require.False(t, tracker.Observe(1, 7, 500*time.Millisecond))
+	require.True(t, tracker.Observe(2, 7, 3*time.Second))
+	require.True(t, tracker.Observe(3, 7, 2*time.Second))
+	// A second round of an already observed slot is ignored.
+	require.False(t, tracker.Observe(3, 7, 0))
+
+	records := tracker.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, &timeliness.Record{
+		ValidatorIndex: 7,
+		Blocks:         3,
+		LateBlocks:     2,
+		LateStreak:     2,
+		TotalLateness:  5500 * time.Millisecond,
+		MaxLateness:    3 * time.Second,
+		LastLateness:   2 * time.Second,
+		LastSlot:       3,
+	}, records[0])
+}
+
+func TestTracker_TimelyBlockResetsStreak(t *testing.T) {
+	tracker := newTracker()
+	tracker.Observe(1, 1, 2*time.Second)
+	tracker.Observe(2, 1, time.Second)
+
+	records := tracker.Records()
+	require.Equal(t, uint64(1), records[0].LateBlocks)
+	require.Zero(t, records[0].LateStreak)
+}
+
+func TestTracker_RecordsOrderedByIndex(t *testing.T) {
+	tracker := newTracker()
+	tracker.Observe(1, 5, 0)
+	tracker.Observe(2, 2, 0)
+	tracker.Observe(3, 9, 0)
+
+	records := tracker.Records()
+	require.Len(t, records, 3)
+	for i, index := range []uint64{2, 5, 9} {
+		require.Equal(t, index, records[i].ValidatorIndex.Unwrap())
+	}
+}
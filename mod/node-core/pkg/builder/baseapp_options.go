@@ -31,7 +31,10 @@ import (
 	server "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
 	"github.com/berachain/beacon-kit/mod/log"
+	storagedb "github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -68,7 +71,7 @@ func DefaultServiceOptions[
 		}
 	}
 
-	return []func(*cometbft.Service[LoggerT]){
+	opts := []func(*cometbft.Service[LoggerT]){
 		cometbft.SetPruning[LoggerT](pruningOpts),
 		cometbft.SetMinRetainBlocks[LoggerT](
 			cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks)),
@@ -83,6 +86,88 @@ func DefaultServiceOptions[
 		),
 		cometbft.SetChainID[LoggerT](chainID),
 	}
+
+	if path := cast.ToString(
+		appOpts.Get(server.FlagABCIRecordPath),
+	); path != "" {
+		recorder, rErr := middleware.NewRecorder(path)
+		if rErr != nil {
+			panic(rErr)
+		}
+		opts = append(opts, cometbft.SetABCIRecorder[LoggerT](recorder))
+	}
+
+	if path := cast.ToString(
+		appOpts.Get(server.FlagABCIReplayPath),
+	); path != "" {
+		opts = append(opts, cometbft.SetABCIReplayPath[LoggerT](path))
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagVerifyChain)) {
+		opts = append(opts, cometbft.SetVerifyChain[LoggerT](
+			cometbft.VerifyChainOptions{
+				From:  cast.ToInt64(appOpts.Get(server.FlagVerifyChainFrom)),
+				To:    cast.ToInt64(appOpts.Get(server.FlagVerifyChainTo)),
+				Prune: cast.ToBool(appOpts.Get(server.FlagVerifyChainPrune)),
+			},
+		))
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagAuditRegistry)) {
+		opts = append(opts, cometbft.SetAuditRegistry[LoggerT]())
+	}
+
+	if cast.ToBool(appOpts.Get(server.FlagExportValidatorSet)) {
+		opts = append(opts, cometbft.SetExportValidatorSet[LoggerT](
+			cometbft.ExportValidatorSetOptions{
+				Slot: cast.ToInt64(
+					appOpts.Get(server.FlagExportValidatorSetSlot),
+				),
+				Format: cast.ToString(
+					appOpts.Get(server.FlagExportValidatorSetFormat),
+				),
+				Output: cast.ToString(
+					appOpts.Get(server.FlagExportValidatorSetOutput),
+				),
+			},
+		))
+	}
+
+	return opts
+}
+
+// ReplicaServiceOptions returns the Service options of a node taking part in
+// read replication of its application database, either as the primary
+// writing checkpoints or as a replica serving them.
+func ReplicaServiceOptions[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appOpts config.AppOptions,
+	db dbm.DB,
+) []func(*cometbft.Service[LoggerT]) {
+	if replica, ok := db.(*storagedb.ReplicaDB); ok {
+		return []func(*cometbft.Service[LoggerT]){
+			cometbft.SetReadReplica[LoggerT](
+				replica,
+				cast.ToDuration(appOpts.Get(server.FlagReplicaRefresh)),
+			),
+		}
+	}
+
+	dir := cast.ToString(appOpts.Get(server.FlagReplicaCheckpointDir))
+	if dir == "" {
+		return nil
+	}
+	checkpointer, err := storagedb.NewCheckpointer(
+		db, dir,
+		cast.ToUint64(appOpts.Get(server.FlagReplicaCheckpointInterval)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return []func(*cometbft.Service[LoggerT]){
+		cometbft.SetCheckpointer[LoggerT](checkpointer),
+	}
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
@@ -22,12 +22,14 @@ package builder
 
 import (
 	"io"
+	"reflect"
 
 	"cosmossdk.io/depinject"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/graph"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
@@ -120,3 +122,17 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 	apiBackend.AttachQueryBackend(cmtService)
 	return beaconNode
 }
+
+// Graph resolves the dependency graph of the node components, on top of the
+// values supplied by Build, without building the node.
+func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Graph() (
+	*graph.Graph, error,
+) {
+	return graph.New(
+		nb.components,
+		reflect.TypeFor[servertypes.AppOptions](),
+		reflect.TypeFor[LoggerT](),
+		reflect.TypeFor[dbm.DB](),
+		reflect.TypeFor[*cmtcfg.Config](),
+	)
+}
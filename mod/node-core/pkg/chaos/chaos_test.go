@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	// A node without a chaos file injects no failures.
+	cfg, err := chaos.LoadConfig(filepath.Join(dir, chaos.FileName))
+	require.NoError(t, err)
+	require.Empty(t, cfg.Rules)
+
+	path := filepath.Join(dir, chaos.FileName)
+	require.NoError(t, os.WriteFile(path, []byte(`
+seed = 7
+
+[[rules]]
+target = "engine"
+match = ["engine_newPayloadV3"]
+probability = 0.5
+delay = "2s"
+
+[[rules]]
+target = "broker"
+probability = 1
+`), 0o600))
+	cfg, err = chaos.LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), cfg.Seed)
+	require.Equal(t, []chaos.Rule{
+		{
+			Target:      chaos.Engine,
+			Match:       []string{"engine_newPayloadV3"},
+			Probability: 0.5,
+			Delay:       2 * time.Second,
+		},
+		{Target: chaos.Broker, Probability: 1},
+	}, cfg.Rules)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+[[rules]]
+target = "network"
+probability = 1
+`), 0o600))
+	_, err = chaos.LoadConfig(path)
+	require.ErrorIs(t, err, chaos.ErrUnknownTarget)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.ErrorIs(t, chaos.Config{Rules: []chaos.Rule{
+		{Target: chaos.Disk, Probability: 1.5},
+	}}.Validate(), chaos.ErrInvalidProbability)
+	require.ErrorIs(t, chaos.Config{Rules: []chaos.Rule{
+		{Target: chaos.Disk, Probability: 1, Delay: -time.Second},
+	}}.Validate(), chaos.ErrNegativeDelay)
+}
+
+func TestInjector(t *testing.T) {
+	injector := chaos.NewInjector(chaos.Config{Rules: []chaos.Rule{
+		{Target: chaos.Broker, Match: []string{"new_slot"}, Probability: 1},
+		{Target: chaos.Disk, Probability: 1, Delay: time.Hour},
+	}}, noop.NewLogger[any]())
+
+	require.True(t, injector.Drop(chaos.Broker, "new_slot"))
+	require.False(t, injector.Drop(chaos.Broker, "beacon_block_finalized"))
+	require.False(t, injector.Drop(chaos.Engine, "new_slot"))
+
+	// A delay is cut short by its context.
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 10*time.Millisecond,
+	)
+	defer cancel()
+	require.ErrorIs(
+		t, injector.Wait(ctx, chaos.Disk, "deposits"),
+		context.DeadlineExceeded,
+	)
+	require.NoError(t, injector.Wait(ctx, chaos.Engine, "engine_newPayloadV3"))
+}
+
+func TestInjector_Nil(t *testing.T) {
+	var injector *chaos.Injector
+	require.False(t, injector.Drop(chaos.Broker, "new_slot"))
+	require.NoError(
+		t, injector.Wait(context.Background(), chaos.Disk, "deposits"),
+	)
+}
+
+func TestInjector_Seed(t *testing.T) {
+	cfg := chaos.Config{Seed: 42, Rules: []chaos.Rule{
+		{Target: chaos.Broker, Probability: 0.5},
+	}}
+	draw := func() []bool {
+		injector := chaos.NewInjector(cfg, noop.NewLogger[any]())
+		drops := make([]bool, 64)
+		for i := range drops {
+			drops[i] = injector.Drop(chaos.Broker, "new_slot")
+		}
+		return drops
+	}
+	// The same seed replays the same failures.
+	require.Equal(t, draw(), draw())
+}
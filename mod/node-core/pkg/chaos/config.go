@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	"os"
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/spf13/viper"
+)
+
+// FileName is the name of the file, in the config directory of the node,
+// the failure injection rules are read from.
+const FileName = "chaos.toml"
+
+// Targets of the failure injection rules.
+const (
+	// Engine delays the responses of the execution client to the engine
+	// API calls whose method is matched.
+	Engine = "engine"
+	// Broker drops the events whose ID is matched instead of publishing
+	// them to their subscribers.
+	Broker = "broker"
+	// Disk delays the writes to the stores whose name is matched.
+	Disk = "disk"
+)
+
+// Config is the configuration for failure injection.
+type Config struct {
+	// Seed seeds the draws of the rules, so that a scenario can be
+	// replayed. Zero seeds them randomly.
+	Seed uint64 `mapstructure:"seed"`
+	// Rules are the failures to inject.
+	Rules []Rule `mapstructure:"rules"`
+}
+
+// Rule injects a failure into the operations of a target it matches, with
+// the given probability.
+type Rule struct {
+	// Target is the subsystem the failure is injected into.
+	Target string `mapstructure:"target"`
+	// Match restricts the rule to the engine methods, broker events or
+	// stores with the given names. An empty list matches every operation of
+	// the target.
+	Match []string `mapstructure:"match"`
+	// Probability is the chance, between zero and one, that a matched
+	// operation fails.
+	Probability float64 `mapstructure:"probability"`
+	// Delay is how long a failed engine call or disk write is delayed. It
+	// is ignored by broker rules, whose events are dropped.
+	Delay time.Duration `mapstructure:"delay"`
+}
+
+// LoadConfig reads the failure injection rules from the file at the given
+// path. A file that does not exist holds no rules.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return cfg, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return cfg, err
+	}
+	if err := v.Unmarshal(&cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that every rule has a known target and a probability
+// between zero and one.
+func (c Config) Validate() error {
+	for i, rule := range c.Rules {
+		switch {
+		case !slices.Contains([]string{Engine, Broker, Disk}, rule.Target):
+			return errors.Wrapf(ErrUnknownTarget, "rule %d: %q", i, rule.Target)
+		case rule.Probability < 0 || rule.Probability > 1:
+			return errors.Wrapf(
+				ErrInvalidProbability, "rule %d: %v", i, rule.Probability,
+			)
+		case rule.Delay < 0:
+			return errors.Wrapf(ErrNegativeDelay, "rule %d: %s", i, rule.Delay)
+		}
+	}
+	return nil
+}
+
+// matches reports whether the rule applies to the named operation of the
+// target.
+func (r Rule) matches(target, name string) bool {
+	return r.Target == target &&
+		(len(r.Match) == 0 || slices.Contains(r.Match, name))
+}
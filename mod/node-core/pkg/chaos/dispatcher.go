@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+)
+
+// Dispatcher wraps a dispatcher so that the events matched by the broker
+// rules of an Injector are dropped rather than published.
+type Dispatcher struct {
+	asynctypes.Dispatcher
+	injector *Injector
+}
+
+// NewDispatcher returns the given dispatcher, wrapped so that failures are
+// injected into it if the injector is not nil.
+func NewDispatcher(
+	d asynctypes.Dispatcher, injector *Injector,
+) asynctypes.Dispatcher {
+	if injector == nil {
+		return d
+	}
+	return &Dispatcher{Dispatcher: d, injector: injector}
+}
+
+// Publish publishes the event, unless a rule drops it. A dropped event is
+// reported as published to its publisher.
+func (d *Dispatcher) Publish(event async.BaseEvent) error {
+	if d.injector.Drop(Broker, string(event.ID())) {
+		return nil
+	}
+	return d.Dispatcher.Publish(event)
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// Injector draws the failures to inject from its rules. A nil Injector
+// injects no failures.
+type Injector struct {
+	logger log.Logger
+	rules  []Rule
+
+	// mu protects rand.
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector creates a new Injector drawing failures from the rules of the
+// given configuration.
+func NewInjector(cfg Config, logger log.Logger) *Injector {
+	seed := cfg.Seed
+	if seed == 0 {
+		//#nosec:G404 // failures need not be drawn securely.
+		seed = rand.Uint64()
+	}
+	return &Injector{
+		logger: logger,
+		rules:  cfg.Rules,
+		//#nosec:G404 // failures need not be drawn securely.
+		rand: rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// Wait delays the named operation of the target if a rule fails it. It
+// returns the error of the context if the context is done first.
+func (i *Injector) Wait(ctx context.Context, target, name string) error {
+	rule, ok := i.fail(target, name)
+	if !ok || rule.Delay == 0 {
+		return nil
+	}
+	i.logger.Warn(
+		"Injecting delay",
+		"target", target, "name", name, "delay", rule.Delay,
+	)
+
+	timer := time.NewTimer(rule.Delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Drop reports whether a rule fails the named operation of the target, which
+// must then be dropped.
+func (i *Injector) Drop(target, name string) bool {
+	if _, ok := i.fail(target, name); !ok {
+		return false
+	}
+	i.logger.Warn("Injecting drop", "target", target, "name", name)
+	return true
+}
+
+// fail draws the rules matching the named operation of the target in order,
+// and returns the first one that fails it.
+func (i *Injector) fail(target, name string) (Rule, bool) {
+	if i == nil {
+		return Rule{}, false
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, rule := range i.rules {
+		if rule.matches(target, name) && i.rand.Float64() < rule.Probability {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
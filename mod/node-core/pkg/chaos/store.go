@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chaos
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
+)
+
+// KVStore wraps a store.KVStoreWithBatch so that its writes are delayed by
+// the disk rules of an Injector.
+type KVStore struct {
+	store.KVStoreWithBatch
+	name     string
+	injector *Injector
+}
+
+// NewKVStore returns the given store, wrapped so that failures are injected
+// into its writes if the injector is not nil.
+func NewKVStore(
+	kvs store.KVStoreWithBatch, name string, injector *Injector,
+) store.KVStoreWithBatch {
+	if injector == nil {
+		return kvs
+	}
+	return &KVStore{KVStoreWithBatch: kvs, name: name, injector: injector}
+}
+
+// Set stores the value at the given key.
+func (s *KVStore) Set(key, value []byte) error {
+	s.wait()
+	return s.KVStoreWithBatch.Set(key, value)
+}
+
+// Delete removes the value stored at the given key.
+func (s *KVStore) Delete(key []byte) error {
+	s.wait()
+	return s.KVStoreWithBatch.Delete(key)
+}
+
+// NewBatch returns a batch whose writes are delayed.
+func (s *KVStore) NewBatch() store.Batch {
+	return &batch{Batch: s.KVStoreWithBatch.NewBatch(), store: s}
+}
+
+// NewBatchWithSize returns a batch with a pre-allocated size whose writes
+// are delayed.
+func (s *KVStore) NewBatchWithSize(size int) store.Batch {
+	return &batch{Batch: s.KVStoreWithBatch.NewBatchWithSize(size), store: s}
+}
+
+// wait delays a write to the store if a rule fails it.
+func (s *KVStore) wait() {
+	_ = s.injector.Wait(context.Background(), Disk, s.name)
+}
+
+// batch delays the writes of the underlying batch.
+type batch struct {
+	store.Batch
+	store *KVStore
+}
+
+// Write writes the batch to the store.
+func (b *batch) Write() error {
+	b.store.wait()
+	return b.Batch.Write()
+}
+
+// WriteSync writes the batch to the store and syncs it to disk.
+func (b *batch) WriteSync() error {
+	b.store.wait()
+	return b.Batch.WriteSync()
+}
+
+// DB wraps an interfaces.DB so that its writes are delayed by the disk rules
+// of an Injector.
+type DB struct {
+	interfaces.DB
+	name     string
+	injector *Injector
+}
+
+// NewDB returns the given database, wrapped so that failures are injected
+// into its writes if the injector is not nil.
+func NewDB(db interfaces.DB, name string, injector *Injector) interfaces.DB {
+	if injector == nil {
+		return db
+	}
+	return &DB{DB: db, name: name, injector: injector}
+}
+
+// Set stores the value at the given key.
+func (db *DB) Set(key []byte, value []byte) error {
+	db.wait()
+	return db.DB.Set(key, value)
+}
+
+// Delete removes the value stored at the given key.
+func (db *DB) Delete(key []byte) error {
+	db.wait()
+	return db.DB.Delete(key)
+}
+
+// Unwrap returns the wrapped database, so that callers depending on its
+// concrete type can reach it.
+func (db *DB) Unwrap() interfaces.DB {
+	return db.DB
+}
+
+// wait delays a write to the database if a rule fails it.
+func (db *DB) wait() {
+	_ = db.injector.Wait(context.Background(), Disk, db.name)
+}
@@ -33,8 +33,8 @@ import (
 )
 
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngineFactory(cfg *config.Config) *echo.EngineFactory {
+	return echo.NewEngineFactory(cfg.NodeAPI.AuthToken)
 }
 
 type NodeAPIBackendInput[
@@ -46,17 +46,24 @@ type NodeAPIBackendInput[
 ] struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor StateProcessor[
+	BlobFeeTracker     BlobFeeTracker
+	BlobTxTracker      BlobTxTracker
+	ChainSpec          common.ChainSpec
+	PayloadBodyFetcher PayloadBodyFetcher
+	PerformanceTracker PerformanceTracker
+	StateProcessor     StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
 		DepositT, ExecutionPayloadHeaderT,
 	]
-	StorageBackend StorageBackendT
+	StorageBackend    StorageBackendT
+	TimelinessTracker TimelinessTracker
 }
 
 func ProvideNodeAPIBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
@@ -116,6 +123,11 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.PerformanceTracker,
+		in.PayloadBodyFetcher,
+		in.BlobFeeTracker,
+		in.BlobTxTracker,
+		in.TimelinessTracker,
 	)
 }
 
@@ -125,10 +137,10 @@ type NodeAPIServerInput[
 ] struct {
 	depinject.In
 
-	Engine   NodeAPIEngine[NodeAPIContextT]
-	Config   *config.Config
-	Handlers []handlers.Handlers[NodeAPIContextT]
-	Logger   LoggerT
+	EngineFactory NodeAPIEngineFactory[NodeAPIContextT]
+	Config        *config.Config
+	Handlers      []handlers.Handlers[NodeAPIContextT]
+	Logger        LoggerT
 }
 
 func ProvideNodeAPIServer[
@@ -141,7 +153,7 @@ func ProvideNodeAPIServer[
 		log.Blue)
 	return server.New[NodeAPIContextT](
 		in.Config.NodeAPI,
-		in.Engine,
+		in.EngineFactory,
 		in.Logger.With("service", "node-api-server"),
 		in.Handlers...,
 	)
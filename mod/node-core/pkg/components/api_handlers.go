@@ -22,14 +22,21 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	adminapi "github.com/berachain/beacon-kit/mod/node-api/handlers/admin"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/mod/node-api/handlers/config"
 	debugapi "github.com/berachain/beacon-kit/mod/node-api/handlers/debug"
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	keymanagerapi "github.com/berachain/beacon-kit/mod/node-api/handlers/keymanager"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	syncapi "github.com/berachain/beacon-kit/mod/node-api/handlers/sync"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
 )
 
 type NodeAPIHandlersInput[
@@ -43,24 +50,33 @@ type NodeAPIHandlersInput[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ] struct {
 	depinject.In
+	AdminAPIHandler  *adminapi.Handler[NodeAPIContextT]
 	BeaconAPIHandler *beaconapi.Handler[
 		BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
 	]
 	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
 	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	DebugAPIHandler   *debugapi.Handler[
+		BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+	]
+	EventsAPIHandler     *eventsapi.Handler[NodeAPIContextT]
+	KeymanagerAPIHandler *keymanagerapi.Handler[NodeAPIContextT]
+	NodeAPIHandler       *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler      *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
+		BlobSidecarT, BlobSidecarsT, NodeAPIContextT,
+		ExecutionPayloadHeaderT, *Validator,
 	]
+	SyncAPIHandler      *syncapi.Handler[NodeAPIContextT]
+	ValidatorAPIHandler *validatorapi.Handler[NodeAPIContextT]
 }
 
 func ProvideNodeAPIHandlers[
@@ -74,28 +90,56 @@ func ProvideNodeAPIHandlers[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIHandlersInput[
-		BeaconBlockHeaderT, BeaconStateT,
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, KVStoreT,
+		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
+		BlobSidecarT, BlobSidecarsT, ExecutionPayloadHeaderT, KVStoreT,
 		NodeAPIContextT, WithdrawalT,
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
 	return []handlers.Handlers[NodeAPIContextT]{
+		in.AdminAPIHandler,
 		in.BeaconAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
 		in.DebugAPIHandler,
 		in.EventsAPIHandler,
+		in.KeymanagerAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.SyncAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
+func ProvideNodeAPIAdminHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](
+	b NodeAPIBackend[
+		BeaconBlockHeaderT,
+		BeaconStateT,
+		*Fork,
+		NodeT,
+		*Validator,
+	],
+	maintenance *validator.Maintenance,
+	emptyPayloads *attributes.EmptyPayloadOverride,
+	slotControl *validator.SlotControl,
+) *adminapi.Handler[NodeAPIContextT] {
+	return adminapi.NewHandler[NodeAPIContextT](
+		b, maintenance, emptyPayloads, slotControl,
+	)
+}
+
 func ProvideNodeAPIBeaconHandler[
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT any,
@@ -125,21 +169,61 @@ func ProvideNodeAPIBuilderHandler[
 }
 
 func ProvideNodeAPIConfigHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIDebugHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT Withdrawal[WithdrawalT],
+](
+	b NodeAPIDebugBackend[BeaconStateT],
+	tracer *hotkeys.Tracer,
+) *debugapi.Handler[
+	BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+] {
+	return debugapi.NewHandler[
+		BeaconStateT,
+		BeaconStateMarshallableT,
+		NodeAPIContextT,
+	](b, hotKeysReporter{tracer: tracer})
 }
 
 func ProvideNodeAPIEventsHandler[
 	NodeAPIContextT NodeAPIContext,
-]() *eventsapi.Handler[NodeAPIContextT] {
-	return eventsapi.NewHandler[NodeAPIContextT]()
+](
+	feed *eventsapi.Feed,
+	journal eventsapi.Journal,
+) *eventsapi.Handler[NodeAPIContextT] {
+	return eventsapi.NewHandler[NodeAPIContextT](feed, journal)
+}
+
+func ProvideNodeAPIKeymanagerHandler[
+	NodeAPIContextT NodeAPIContext,
+](graffiti *validator.Graffiti) *keymanagerapi.Handler[NodeAPIContextT] {
+	return keymanagerapi.NewHandler[NodeAPIContextT](graffiti)
 }
 
 func ProvideNodeAPINodeHandler[
@@ -159,27 +243,52 @@ func ProvideNodeAPIProofHandler[
 		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
 		ExecutionPayloadHeaderT, *Fork, *Validator,
 	],
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
-	NodeT any,
 	NodeAPIContextT NodeAPIContext,
 	WithdrawalT Withdrawal[WithdrawalT],
-](b NodeAPIBackend[
+](b NodeAPIProofBackend[
 	BeaconBlockHeaderT,
 	BeaconStateT,
+	BlobSidecarsT,
 	*Fork,
-	NodeT,
 	*Validator,
 ]) *proofapi.Handler[
 	BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-	NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
+	BlobSidecarT, BlobSidecarsT, NodeAPIContextT, ExecutionPayloadHeaderT,
+	*Validator,
 ] {
 	return proofapi.NewHandler[
 		BeaconBlockHeaderT,
 		BeaconStateT,
 		BeaconStateMarshallableT,
+		BlobSidecarT,
+		BlobSidecarsT,
 		NodeAPIContextT,
 		ExecutionPayloadHeaderT,
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPISyncHandler[
+	NodeAPIContextT NodeAPIContext,
+](b syncapi.Backend) *syncapi.Handler[NodeAPIContextT] {
+	return syncapi.NewHandler[NodeAPIContextT](b)
+}
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *validatorapi.Handler[NodeAPIContextT] {
+	return validatorapi.NewHandler[NodeAPIContextT](b)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
@@ -32,9 +33,11 @@ import (
 type AttributesFactoryInput[LoggerT any] struct {
 	depinject.In
 
-	ChainSpec common.ChainSpec
-	Config    *config.Config
-	Logger    LoggerT
+	ChainSpec     common.ChainSpec
+	Config        *config.Config
+	EmptyPayloads *attributes.EmptyPayloadOverride
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -63,5 +66,17 @@ func ProvideAttributesFactory[
 		in.ChainSpec,
 		in.Logger,
 		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.EmptyPayloads,
+		in.TelemetrySink,
 	), nil
 }
+
+// ProvideEmptyPayloadOverride provides the override forcing empty payloads,
+// set for the number of slots configured at startup.
+func ProvideEmptyPayloadOverride(
+	cfg *config.Config,
+) *attributes.EmptyPayloadOverride {
+	return attributes.NewEmptyPayloadOverride(
+		cfg.PayloadBuilder.EmptyPayloadSlots,
+	)
+}
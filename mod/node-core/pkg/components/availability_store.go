@@ -22,16 +22,22 @@ package components
 
 import (
 	"os"
+	"path/filepath"
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/metered"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cast"
@@ -42,8 +48,12 @@ import (
 type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts   config.AppOptions
+	Chaos     *chaos.Injector `optional:"true"`
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    LoggerT
+	// TelemetrySink is the sink the metrics of the store are exported to.
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideAvailibilityStore provides the availability store.
@@ -55,19 +65,33 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store[BeaconBlockBodyT], error) {
+	codec, err := compression.ParseCodec(in.Config.Compression.Codec)
+	if err != nil {
+		return nil, err
+	}
+	compressor, err := compression.NewCompressor(codec)
+	if err != nil {
+		return nil, err
+	}
+
+	var db interfaces.DB = filedb.NewDB(
+		// The sidecars of a namespaced node are kept in a directory of its
+		// own.
+		filedb.WithRootDirectory(filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"data", "blobs", in.Config.Namespace.Name,
+		)),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(in.Logger),
+		filedb.WithCompressor(compressor),
+	)
+	db = metered.NewDB(
+		chaos.NewDB(db, "blobs", in.Chaos), "blobs", in.TelemetrySink,
+	)
+
 	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
-		),
+		filedb.NewRangeDB(db),
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
 	), nil
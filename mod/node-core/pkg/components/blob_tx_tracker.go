@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/blobtxs"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// BlobTxTrackerInput is the input for the blob transaction tracker.
+type BlobTxTrackerInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	ChainSpec  common.ChainSpec
+	Dispatcher Dispatcher
+	Logger     LoggerT
+}
+
+// ProvideBlobTxTracker provides the tracker linking the blob transactions of
+// finalized blocks to their blob sidecars.
+func ProvideBlobTxTracker[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT blobtxs.BeaconBlockBody[ExecutionPayloadT],
+	BeaconBlockHeaderT any,
+	ExecutionPayloadT blobtxs.ExecutionPayload,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in BlobTxTrackerInput[LoggerT],
+) *blobtxs.Tracker[BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT] {
+	return blobtxs.NewTracker[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	](
+		in.Logger.With("service", "blob-tx-tracker"),
+		in.ChainSpec,
+		in.Dispatcher,
+		blobtxs.DefaultHistorySize,
+	)
+}
@@ -28,6 +28,7 @@ import (
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/sampling"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -120,6 +121,35 @@ func ProvideBlobProcessor[
 	)
 }
 
+// AvailabilitySamplerInput is the input for the dep inject framework.
+type AvailabilitySamplerInput struct {
+	depinject.In
+	Config *config.Config
+}
+
+// ProvideAvailabilitySampler is a function that provides the availability
+// sampler to the application. It provides nil if sampling is disabled.
+func ProvideAvailabilitySampler(
+	in AvailabilitySamplerInput,
+) (da.AvailabilitySampler, error) {
+	if !in.Config.Sampling.Enabled {
+		//nolint:nilnil // a nil sampler disables sampling.
+		return nil, nil
+	}
+
+	// No peer serves samples yet, so every sample falls back to full
+	// verification until a networked querier replaces the no-op one.
+	sampler, err := sampling.NewSampler(
+		in.Config.Sampling,
+		sampling.NewRandomSelector(),
+		sampling.NewNoopQuerier(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return sampler, nil
+}
+
 // DAServiceIn is the input for the BlobService.
 type DAServiceIn[
 	AvailabilityStoreT any,
@@ -135,6 +165,7 @@ type DAServiceIn[
 	]
 	Dispatcher Dispatcher
 	Logger     LoggerT
+	Sampler    da.AvailabilitySampler
 }
 
 // ProvideDAService is a function that provides the BlobService to the
@@ -156,6 +187,7 @@ func ProvideDAService[
 	](
 		in.AvailabilityStore,
 		in.BlobProcessor,
+		in.Sampler,
 		in.Dispatcher,
 		in.Logger.With("service", "da"),
 	)
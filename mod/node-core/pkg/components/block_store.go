@@ -25,6 +25,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 )
 
@@ -39,8 +41,10 @@ type BlockStoreInput[
 ] struct {
 	depinject.In
 
-	Config *config.Config
-	Logger LoggerT
+	CacheBudget *cache.Budget
+	Config      *config.Config
+	Logger      LoggerT
+	Tracer      *hotkeys.Tracer
 }
 
 // ProvideBlockStore is a function that provides the module to the
@@ -60,5 +64,7 @@ func ProvideBlockStore[
 	return block.NewStore[BeaconBlockT](
 		in.Logger.With("service", manager.BlockStoreName),
 		in.Config.BlockStoreService.AvailabilityWindow,
+		in.CacheBudget,
+		in.Tracer,
 	), nil
 }
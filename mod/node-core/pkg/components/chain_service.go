@@ -23,11 +23,13 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/timeliness"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -49,6 +51,7 @@ type ChainServiceInput[
 ] struct {
 	depinject.In
 
+	Brake        *validator.Brake
 	ChainSpec    common.ChainSpec
 	Cfg          *config.Config
 	EngineClient *client.EngineClient[
@@ -69,8 +72,10 @@ type ChainServiceInput[
 		BeaconBlockT, BeaconStateT, *Context,
 		DepositT, ExecutionPayloadHeaderT,
 	]
-	StorageBackend StorageBackendT
-	TelemetrySink  *metrics.TelemetrySink
+	StorageBackend    StorageBackendT
+	TelemetrySink     *metrics.TelemetrySink
+	TimelinessTracker *timeliness.Tracker
+	WitnessExporter   blockchain.WitnessExporter[BeaconStateT]
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -138,5 +143,8 @@ func ProvideChainService[
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.WitnessExporter,
+		in.TimelinessTracker,
+		in.Brake,
 	)
 }
@@ -23,8 +23,12 @@ package components
 import (
 	"os"
 
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 const (
@@ -33,20 +37,52 @@ const (
 	BetnetChainSpecType = "betnet"
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() common.ChainSpec {
+// ChainSpecInput is the input for the chain spec.
+type ChainSpecInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	Logger LoggerT
+}
+
+// ProvideChainSpec provides the chain spec based on the environment variable,
+// with the parameters overridden by the environment applied.
+func ProvideChainSpec[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ChainSpecInput[LoggerT],
+) (common.ChainSpec, error) {
 	// TODO: This is hood as fuck needs to be improved
 	// but for now we ball to get CI unblocked.
 	specType := os.Getenv(ChainSpecTypeEnvVar)
-	var chainSpec common.ChainSpec
+	var data chain.SpecData[
+		common.DomainType,
+		math.Epoch,
+		common.ExecutionAddress,
+		math.Slot,
+		any,
+	]
 	switch specType {
 	case DevnetChainSpecType:
-		chainSpec = spec.DevnetChainSpec()
+		data = spec.DevnetSpec()
 	case BetnetChainSpecType:
-		chainSpec = spec.BetnetChainSpec()
+		data = spec.BetnetSpec()
 	default:
-		chainSpec = spec.TestnetChainSpec()
+		data = spec.TestnetSpec()
+	}
+
+	overrides, err := spec.ApplyEnvOverrides(&data, os.Environ())
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range overrides {
+		in.Logger.Info(
+			"Overriding chain spec parameter",
+			"env", override.Env,
+			"value", override.Value,
+		)
 	}
 
-	return chainSpec
+	return chain.NewChainSpec(data), nil
 }
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ChainVerifierInput is the input for the chain verifier provider.
+type ChainVerifierInput[StorageBackendT any] struct {
+	depinject.In
+
+	ChainSpec      common.ChainSpec
+	StorageBackend StorageBackendT
+}
+
+// ProvideChainVerifier provides the beacon checks `verify-chain` runs
+// against the blocks of the CometBFT block store, and the reads of a stored
+// state `audit-registry` and `export-validator-set` run.
+func ProvideChainVerifier[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	BeaconStateT interface {
+		HashTreeRoot() common.Root
+		AuditRegistry() ([]string, error)
+		GetSlot() (math.Slot, error)
+		GetValidators() (Validators, error)
+		GetBalances() ([]uint64, error)
+	},
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
+](
+	in ChainVerifierInput[StorageBackendT],
+) cometbft.ChainVerifier {
+	return &chainVerifier[BeaconBlockT, BeaconStateT, StorageBackendT]{
+		chainSpec:      in.ChainSpec,
+		storageBackend: in.StorageBackend,
+	}
+}
+
+// chainVerifier decodes stored beacon blocks with the fork active at their
+// slot and reads stored states through the storage backend.
+type chainVerifier[
+	BeaconBlockT interface {
+		NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+		HashTreeRoot() common.Root
+		GetParentBlockRoot() common.Root
+		GetStateRoot() common.Root
+	},
+	BeaconStateT interface {
+		HashTreeRoot() common.Root
+		AuditRegistry() ([]string, error)
+		GetSlot() (math.Slot, error)
+		GetValidators() (Validators, error)
+		GetBalances() ([]uint64, error)
+	},
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
+] struct {
+	chainSpec      common.ChainSpec
+	storageBackend StorageBackendT
+}
+
+// BlockRoots implements cometbft.ChainVerifier.
+func (v *chainVerifier[BeaconBlockT, _, _]) BlockRoots(
+	height int64, bz []byte,
+) (common.Root, common.Root, common.Root, error) {
+	var blk BeaconBlockT
+	//#nosec:G701 // heights are never negative.
+	forkVersion := v.chainSpec.ActiveForkVersionForSlot(math.Slot(height))
+	blk, err := blk.NewFromSSZ(bz, forkVersion)
+	if err != nil {
+		return common.Root{}, common.Root{}, common.Root{}, err
+	}
+	return blk.HashTreeRoot(), blk.GetParentBlockRoot(),
+		blk.GetStateRoot(), nil
+}
+
+// StateRoot implements cometbft.ChainVerifier.
+func (v *chainVerifier[_, _, _]) StateRoot(ctx context.Context) common.Root {
+	return v.storageBackend.StateFromContext(ctx).HashTreeRoot()
+}
+
+// AuditRegistry implements cometbft.ChainVerifier.
+func (v *chainVerifier[_, _, _]) AuditRegistry(
+	ctx context.Context,
+) ([]string, error) {
+	return v.storageBackend.StateFromContext(ctx).AuditRegistry()
+}
+
+// ExportValidatorSet implements cometbft.ChainVerifier.
+func (v *chainVerifier[_, _, _]) ExportValidatorSet(
+	ctx context.Context,
+	format string,
+) ([]byte, error) {
+	st := v.storageBackend.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	balances, err := st.GetBalances()
+	if err != nil {
+		return nil, err
+	}
+	if len(balances) != len(validators) {
+		return nil, fmt.Errorf(
+			"%d balances for %d validators", len(balances), len(validators),
+		)
+	}
+
+	// Before the registry updates, every validator is part of the validator
+	// set.
+	epoch := v.chainSpec.SlotToEpoch(slot)
+	registryUpdates := v.chainSpec.ActiveForkForEpoch(epoch).RegistryUpdates
+	switch format {
+	case cometbft.ValidatorSetFormatGenesis:
+		deposits := make([]*types.Deposit, 0, len(validators))
+		for i, val := range validators {
+			if registryUpdates && !val.IsActive(epoch) {
+				continue
+			}
+			//#nosec:G701 // won't overflow in practice.
+			deposits = append(deposits, &types.Deposit{
+				Pubkey:      val.GetPubkey(),
+				Credentials: val.GetWithdrawalCredentials(),
+				Amount:      math.Gwei(balances[i]),
+				Index:       uint64(len(deposits)),
+			})
+		}
+		return json.MarshalIndent(deposits, "", "  ")
+	case cometbft.ValidatorSetFormatJSON:
+		exported := make([]exportedValidator, 0, len(validators))
+		for i, val := range validators {
+			if registryUpdates && !val.IsActive(epoch) {
+				continue
+			}
+			//#nosec:G701 // won't overflow in practice.
+			exported = append(exported, exportedValidator{
+				Index:                 math.ValidatorIndex(i),
+				Pubkey:                val.GetPubkey(),
+				WithdrawalCredentials: val.GetWithdrawalCredentials(),
+				EffectiveBalance:      val.GetEffectiveBalance(),
+				Balance:               math.Gwei(balances[i]),
+			})
+		}
+		return json.MarshalIndent(exported, "", "  ")
+	default:
+		return nil, errors.Wrapf(
+			cometbft.ErrUnsupportedValidatorSetFormat, "%q", format,
+		)
+	}
+}
+
+// exportedValidator is a validator of the json format of
+// `export-validator-set`.
+type exportedValidator struct {
+	Index                 math.ValidatorIndex         `json:"index"`
+	Pubkey                crypto.BLSPubkey            `json:"pubkey"`
+	WithdrawalCredentials types.WithdrawalCredentials `json:"withdrawal_credentials"`
+	EffectiveBalance      math.Gwei                   `json:"effective_balance"`
+	Balance               math.Gwei                   `json:"balance"`
+}
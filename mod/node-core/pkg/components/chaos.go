@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ChaosInjectorInput is the input for the dep inject framework.
+type ChaosInjectorInput[LoggerT any] struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Logger  LoggerT
+}
+
+// ProvideChaosInjector provides the injector of the failures configured in
+// the chaos file of the node. It is only provided by builds with the chaos
+// tag, every other build injects no failures.
+func ProvideChaosInjector[LoggerT log.AdvancedLogger[LoggerT]](
+	in ChaosInjectorInput[LoggerT],
+) (*chaos.Injector, error) {
+	cfg, err := chaos.LoadConfig(filepath.Join(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+		"config",
+		chaos.FileName,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	logger := in.Logger.With("service", "chaos")
+	logger.Warn(
+		"Failure injection is enabled, do not run this build in production",
+		"rules", len(cfg.Rules),
+	)
+	return chaos.NewInjector(cfg, logger), nil
+}
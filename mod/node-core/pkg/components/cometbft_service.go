@@ -24,10 +24,16 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/config"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/reqresp"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/upgrade"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/namespace"
 	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 )
 
@@ -41,8 +47,36 @@ func ProvideCometBFTService[
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
+	cfg *config.Config,
 	chainSpec common.ChainSpec,
-) *cometbft.Service[LoggerT] {
+	reqRespReactor *reqresp.Reactor,
+	chainVerifier cometbft.ChainVerifier,
+	upgrades *upgrade.Manager,
+	blsSigner crypto.BLSSigner,
+	cipher *encryption.Cipher,
+) (*cometbft.Service[LoggerT], error) {
+	opts := append(
+		builder.DefaultServiceOptions[LoggerT](appOpts),
+		cometbft.SetReqRespReactor[LoggerT](reqRespReactor),
+		cometbft.SetChainVerifier[LoggerT](chainVerifier),
+		cometbft.SetUpgradeManager[LoggerT](upgrades),
+	)
+	opts = append(
+		opts, builder.ReplicaServiceOptions[LoggerT](appOpts, db)...,
+	)
+	// A node signing through a remote validator client shares the
+	// connection to it between beacon and CometBFT signing. So does a node
+	// with an encrypted key file, which CometBFT cannot read on its own.
+	if pv, ok := blsSigner.(cmttypes.PrivValidator); ok &&
+		(cmtCfg.PrivValidatorListenAddr != "" || cipher != nil) {
+		opts = append(opts, cometbft.SetPrivValidator[LoggerT](pv))
+	}
+	// The application state of a namespaced node is kept under the prefix
+	// of its namespace, so that other chains can share the database.
+	db, err := namespace.NewDB(db, cfg.Namespace.Name)
+	if err != nil {
+		return nil, err
+	}
 	return cometbft.NewService(
 		storeKey,
 		logger,
@@ -50,6 +84,6 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
-	)
+		opts...,
+	), nil
 }
@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -36,19 +37,43 @@ type DBManagerInput[
 	depinject.In
 	AvailabilityPruner pruner.Pruner[AvailabilityStoreT]
 	DepositPruner      pruner.Pruner[DepositStoreT]
+	Dispatcher         Dispatcher
 	Logger             LoggerT
 }
 
 // ProvideDBManager provides a DBManager for the depinject framework.
 func ProvideDBManager[
 	AvailabilityStoreT pruner.Prunable,
+	BeaconBlockT manager.BeaconBlock,
 	DepositStoreT pruner.Prunable,
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	in DBManagerInput[AvailabilityStoreT, DepositStoreT, LoggerT],
 ) (*manager.DBManager, error) {
+	// pruning is paused while the node syncs or proposes, which the
+	// manager learns from the consensus events.
+	var (
+		subNewSlot        = make(chan SlotEvent)
+		subBlockReceived  = make(chan async.Event[BeaconBlockT])
+		subBlockFinalized = make(chan async.Event[BeaconBlockT])
+	)
+	for eventID, ch := range map[async.EventID]any{
+		async.NewSlot:              subNewSlot,
+		async.BeaconBlockReceived:  subBlockReceived,
+		async.BeaconBlockFinalized: subBlockFinalized,
+	} {
+		if err := in.Dispatcher.Subscribe(eventID, ch); err != nil {
+			in.Logger.Error("failed to subscribe to event", "event",
+				eventID, "err", err)
+			return nil, err
+		}
+	}
+
 	return manager.NewDBManager(
 		in.Logger.With("service", "db-manager"),
+		manager.NewActivityTracker(
+			subNewSlot, subBlockReceived, subBlockFinalized,
+		),
 		in.DepositPruner,
 		in.AvailabilityPruner,
 	)
@@ -25,6 +25,11 @@ import (
 
 	"cosmossdk.io/core/store"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/metered"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/witness"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,9 +42,19 @@ func ProvideKVStoreKey() *storetypes.KVStoreKey {
 
 func ProvideKVStoreService(
 	storeKey *storetypes.KVStoreKey,
+	tracer *hotkeys.Tracer,
+	sink *metrics.TelemetrySink,
 ) store.KVStoreService {
 	// skips modules that have no store
-	return kvStoreService{key: storeKey}
+	return witness.NewKVStoreService(
+		hotkeys.NewKVStoreService(
+			metered.NewKVStoreService(
+				kvStoreService{key: storeKey}, "beacondb", sink,
+			),
+			tracer,
+			keys.HumanReadable,
+		),
+	)
 }
 
 func NewKVStoreService(storeKey *storetypes.KVStoreKey) store.KVStoreService {
@@ -21,6 +21,8 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
@@ -41,6 +43,7 @@ type DepositServiceIn[
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	LoggerT any,
+	StorageBackendT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
@@ -53,8 +56,9 @@ type DepositServiceIn[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	Logger        LoggerT
-	TelemetrySink *metrics.TelemetrySink
+	Logger         LoggerT
+	StorageBackend StorageBackendT
+	TelemetrySink  *metrics.TelemetrySink
 }
 
 // ProvideDepositService provides the deposit service to the depinject
@@ -68,6 +72,9 @@ func ProvideDepositService[
 		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT any,
+	BeaconStateT interface {
+		GetEth1DepositIndex() (uint64, error)
+	},
 	DepositT Deposit[
 		DepositT, *ForkData, WithdrawalCredentials,
 	],
@@ -78,12 +85,16 @@ func ProvideDepositService[
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in DepositServiceIn[
 		BeaconBlockT, DepositContractT, DepositStoreT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, LoggerT, WithdrawalT, WithdrawalsT,
+		ExecutionPayloadHeaderT, LoggerT, StorageBackendT, WithdrawalT,
+		WithdrawalsT,
 	],
 ) (*deposit.Service[
 	BeaconBlockT, BeaconBlockBodyT, DepositT,
@@ -101,6 +112,10 @@ func ProvideDepositService[
 		in.TelemetrySink,
 		in.DepositStore,
 		in.BeaconDepositContract,
+		func(ctx context.Context) (uint64, error) {
+			return in.StorageBackend.StateFromContext(ctx).
+				GetEth1DepositIndex()
+		},
 		in.Dispatcher,
 	), nil
 }
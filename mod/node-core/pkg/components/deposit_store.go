@@ -22,14 +22,16 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
-	storev2 "cosmossdk.io/store/v2/db"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -37,9 +39,15 @@ import (
 )
 
 // DepositStoreInput is the input for the dep inject framework.
-type DepositStoreInput struct {
+type DepositStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts config.AppOptions
+	Chaos   *chaos.Injector `optional:"true"`
+	Cipher  *encryption.Cipher
+	Config  *config.Config
+	Logger  LoggerT
+	// TelemetrySink is the sink the metrics of the store are exported to.
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -48,15 +56,27 @@ func ProvideDepositStore[
 	DepositT Deposit[
 		DepositT, *ForkData, WithdrawalCredentials,
 	],
+	LoggerT log.AdvancedLogger[LoggerT],
 ](
-	in DepositStoreInput,
+	in DepositStoreInput[LoggerT],
 ) (*depositstore.KVStore[DepositT], error) {
-	name := "deposits"
-	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
-	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	db := DepositStoreDB()
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	kvp, err := db.Open(homeDir, StoreDBOptions{
+		Namespace:     in.Config.Namespace.Name,
+		Chaos:         in.Chaos,
+		TelemetrySink: in.TelemetrySink,
+		Cipher:        in.Cipher,
+	})
 	if err != nil {
 		return nil, err
 	}
+	if err = migrateStore(
+		in.Logger.With("service", "deposit-store"),
+		db.Name, db.Dir(homeDir), kvp, db.Migrations,
+	); err != nil {
+		return nil, err
+	}
 
 	return depositstore.NewStore[DepositT](storage.NewKVStoreProvider(kvp)), nil
 }
@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	dp "github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 )
 
@@ -32,19 +33,21 @@ type DispatcherInput[
 	LoggerT any,
 ] struct {
 	depinject.In
+	Chaos  *chaos.Injector `optional:"true"`
 	Logger LoggerT
 }
 
 // ProvideDispatcher provides a new Dispatcher.
 func ProvideDispatcher[
 	BeaconBlockT any,
+	BeaconStateT any,
 	BlobSidecarsT any,
 	GenesisT any,
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
 	in DispatcherInput[LoggerT],
 ) (Dispatcher, error) {
-	return dp.New(
+	d, err := dp.New(
 		in.Logger.With("service", "dispatcher"),
 		dp.WithEvent[async.Event[GenesisT]](async.GenesisDataReceived),
 		dp.WithEvent[ValidatorUpdateEvent](async.GenesisDataProcessed),
@@ -61,5 +64,12 @@ func ProvideDispatcher[
 			async.FinalValidatorUpdatesProcessed,
 		),
 		dp.WithEvent[async.Event[BeaconBlockT]](async.BeaconBlockFinalized),
+		dp.WithEvent[async.Event[BeaconStateT]](async.BeaconStateFinalized),
+		dp.WithEvent[DepositProcessedEvent](async.DepositProcessed),
+		dp.WithEvent[ForkActivatedEvent](async.ForkActivated),
 	)
+	if err != nil {
+		return nil, err
+	}
+	return chaos.NewDispatcher(d, in.Chaos), nil
 }
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// EncryptionCipherInput is the input for the dep inject framework.
+type EncryptionCipherInput struct {
+	depinject.In
+	AppOpts     config.AppOptions
+	Config      *config.Config
+	KeyProvider encryption.KeyProvider `optional:"true"`
+}
+
+// ProvideEncryptionCipher provides the cipher used to encrypt sensitive data
+// at rest. If encryption is disabled, a nil cipher is returned. A custom
+// KeyProvider (e.g. backed by a KMS) takes precedence over the passphrase
+// configured in the app config.
+func ProvideEncryptionCipher(
+	in EncryptionCipherInput,
+) (*encryption.Cipher, error) {
+	if !in.Config.Encryption.Enabled {
+		//nolint:nilnil // a nil cipher disables encryption.
+		return nil, nil
+	}
+
+	provider := in.KeyProvider
+	if provider == nil {
+		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		provider = encryption.NewPassphraseKeyProvider(
+			resolvePath(homeDir, in.Config.Encryption.PassphraseFile),
+			resolvePath(homeDir, in.Config.Encryption.SaltFile),
+		)
+	}
+	return encryption.NewCipherFromProvider(provider)
+}
+
+// resolvePath joins the given path with the home directory if it is not
+// absolute.
+func resolvePath(homeDir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(homeDir, path)
+}
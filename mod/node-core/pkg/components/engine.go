@@ -21,22 +21,31 @@
 package components
 
 import (
+	"context"
 	"math/big"
+	"path/filepath"
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client/ledger"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 )
 
 // EngineClientInputs is the input for the EngineClient.
 type EngineClientInputs[LoggerT any] struct {
 	depinject.In
+	AppOpts   config.AppOptions
+	Chaos     *chaos.Injector `optional:"true"`
 	ChainSpec common.ChainSpec
 	Config    *config.Config
 	// TODO: this feels like a hood way to handle it.
@@ -56,10 +65,34 @@ func ProvideEngineClient[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in EngineClientInputs[LoggerT],
-) *client.EngineClient[
+) (*client.EngineClient[
 	ExecutionPayloadT,
 	*engineprimitives.PayloadAttributes[WithdrawalT],
-] {
+], error) {
+	var (
+		fcuLedger *ledger.Ledger
+		err       error
+	)
+	if size := in.Config.Engine.ForkchoiceLedgerSize; size != 0 {
+		if fcuLedger, err = ledger.Open(
+			filepath.Join(
+				cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+				"data",
+				ledger.FileName,
+			),
+			size,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	var callHook ethclientrpc.CallHook
+	if in.Chaos != nil {
+		callHook = func(ctx context.Context, method string) error {
+			return in.Chaos.Wait(ctx, chaos.Engine, method)
+		}
+	}
+
 	return client.New[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
@@ -69,7 +102,9 @@ func ProvideEngineClient[
 		in.JWTSecret,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+		fcuLedger,
+		callHook,
+	), nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
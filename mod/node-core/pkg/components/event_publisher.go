@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/journal"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// ProvideEventFeed provides the feed shared by the event publisher and the
+// events API handler.
+func ProvideEventFeed() *eventsapi.Feed {
+	return eventsapi.NewFeed()
+}
+
+// EventJournalInput is the input for the event journal.
+type EventJournalInput[LoggerT any] struct {
+	depinject.In
+
+	AppOpts config.AppOptions
+	Config  *config.Config
+	Logger  LoggerT
+}
+
+// ProvideEventJournal provides the persistent journal backing the events
+// history endpoint. No journal is kept while the node API is disabled.
+func ProvideEventJournal[
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in EventJournalInput[LoggerT],
+) (eventsapi.Journal, error) {
+	if !in.Config.NodeAPI.Enabled {
+		//nolint:nilnil // a nil journal disables the history endpoint.
+		return nil, nil
+	}
+	db := EventJournalDB()
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	kvp, err := db.Open(homeDir, StoreDBOptions{
+		Namespace: in.Config.Namespace.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = migrateStore(
+		in.Logger.With("service", "event-journal"),
+		db.Name, db.Dir(homeDir), kvp, db.Migrations,
+	); err != nil {
+		return nil, err
+	}
+	return journal.NewStore(storage.NewKVStoreProvider(kvp)), nil
+}
+
+// EventPublisherInput is the input for the event publisher.
+type EventPublisherInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	ChainSpec  common.ChainSpec
+	Config     *config.Config
+	Dispatcher Dispatcher
+	Feed       *eventsapi.Feed
+	Journal    eventsapi.Journal
+	Logger     LoggerT
+}
+
+// ProvideEventPublisher provides the service relaying node events to the
+// events API.
+func ProvideEventPublisher[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
+	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	ExecutionPayloadT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in EventPublisherInput[LoggerT],
+) *eventsapi.Publisher[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BlobSidecarT, BlobSidecarsT, DepositT,
+] {
+	return eventsapi.NewPublisher[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BlobSidecarT, BlobSidecarsT, DepositT,
+	](
+		in.Feed,
+		in.Journal,
+		in.Config.NodeAPI.EventHistorySlots,
+		in.ChainSpec,
+		in.Dispatcher,
+		in.Logger.With("service", "event-publisher"),
+	)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/config"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+)
+
+// GraffitiInput is the input for the graffiti provider.
+type GraffitiInput struct {
+	depinject.In
+	Cfg             *config.Config
+	CmtCfg          *cmtcfg.Config
+	ExecutionClient validator.ExecutionClient
+}
+
+// ProvideGraffiti provides the graffiti of the blocks proposed by the node.
+func ProvideGraffiti(in GraffitiInput) (*validator.Graffiti, error) {
+	return validator.NewGraffiti(
+		&in.Cfg.Validator,
+		sdkversion.Version,
+		in.CmtCfg.Moniker,
+		in.ExecutionClient,
+	)
+}
@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package graph resolves the dependency graph of a list of depinject
+// providers without invoking them, so that missing or ambiguous providers
+// can be reported before a node is built.
+package graph
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Supplied is the name of the provider of the supplied types.
+const Supplied = "<supplied>"
+
+//nolint:gochecknoglobals // reflected marker types.
+var (
+	inType   = reflect.TypeFor[depinject.In]()
+	outType  = reflect.TypeFor[depinject.Out]()
+	errType  = reflect.TypeFor[error]()
+	manyType = reflect.TypeFor[depinject.ManyPerContainerType]()
+	// pkgPath matches the package paths in function and type names.
+	pkgPath = regexp.MustCompile(`[\w.\-]+/`)
+)
+
+// Dependency is a type that a component consumes.
+type Dependency struct {
+	// Type is the consumed type.
+	Type reflect.Type
+	// Optional reports whether the component may go without the type.
+	Optional bool
+	// Providers are the names of the components providing the type.
+	Providers []string
+}
+
+// Component is a provider along with the types it consumes and provides.
+type Component struct {
+	// Name is the name of the provider function.
+	Name string
+	// Provides are the types the component provides.
+	Provides []reflect.Type
+	// Consumes are the types the component consumes.
+	Consumes []*Dependency
+}
+
+// Problem is a dependency that depinject would fail to resolve.
+type Problem struct {
+	// Component is the name of the component with the dependency.
+	Component string
+	// Type is the type that cannot be resolved.
+	Type reflect.Type
+	// Err is the reason the type cannot be resolved.
+	Err error
+	// Candidates are the names of the components that provide the type, if
+	// there are too many of them.
+	Candidates []string
+}
+
+// Error implements error.
+func (p Problem) Error() string {
+	msg := fmt.Sprintf("%s: %s", p.Err, TypeName(p.Type))
+	if p.Component != "" {
+		msg = fmt.Sprintf("%s: %s", p.Component, msg)
+	}
+	if len(p.Candidates) > 0 {
+		msg = fmt.Sprintf(
+			"%s (candidates: %s)", msg, strings.Join(p.Candidates, ", "),
+		)
+	}
+	return msg
+}
+
+// Unwrap returns the reason the type cannot be resolved.
+func (p Problem) Unwrap() error {
+	return p.Err
+}
+
+// Graph is the resolved dependency graph of a list of providers.
+type Graph struct {
+	// Components are the providers, in the order they were given.
+	Components []*Component
+	// Problems are the dependencies that cannot be resolved.
+	Problems []Problem
+}
+
+// New resolves the dependency graph of the given providers, on top of the
+// given supplied types, the way depinject does: a type is resolved to its
+// provider, and an interface that no one provides to the only provided type
+// implementing it.
+func New(providers []any, supplied ...reflect.Type) (*Graph, error) {
+	g := &Graph{Components: make([]*Component, 0, len(providers))}
+	providedBy := make(map[reflect.Type][]string)
+	for _, t := range supplied {
+		providedBy[t] = append(providedBy[t], Supplied)
+	}
+
+	for _, provider := range providers {
+		c, err := newComponent(provider)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range c.Provides {
+			providedBy[t] = append(providedBy[t], c.Name)
+		}
+		g.Components = append(g.Components, c)
+	}
+
+	provided := make([]reflect.Type, 0, len(providedBy))
+	for t, names := range providedBy {
+		provided = append(provided, t)
+		if len(names) > 1 && !t.Implements(manyType) {
+			g.Problems = append(g.Problems, Problem{
+				Type: t, Err: ErrDuplicateProvider, Candidates: names,
+			})
+		}
+	}
+	slices.SortFunc(provided, func(a, b reflect.Type) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	for _, c := range g.Components {
+		for _, dep := range c.Consumes {
+			if err := g.resolve(dep, providedBy, provided); err != nil {
+				g.Problems = append(g.Problems, Problem{
+					Component:  c.Name,
+					Type:       dep.Type,
+					Err:        err,
+					Candidates: dep.Providers,
+				})
+				dep.Providers = nil
+			}
+		}
+	}
+	slices.SortStableFunc(g.Problems, func(a, b Problem) int {
+		return strings.Compare(a.Component, b.Component)
+	})
+	return g, nil
+}
+
+// Err returns the problems of the graph joined in a single error, or nil if
+// every dependency resolves.
+func (g *Graph) Err() error {
+	errs := make([]error, len(g.Problems))
+	for i, p := range g.Problems {
+		errs[i] = p
+	}
+	return errors.Join(errs...)
+}
+
+// resolve sets the providers of the dependency.
+func (g *Graph) resolve(
+	dep *Dependency,
+	providedBy map[reflect.Type][]string,
+	provided []reflect.Type,
+) error {
+	t := dep.Type
+	// Types that may be provided many times are collected into a slice.
+	if t.Kind() == reflect.Slice && t.Elem().Implements(manyType) {
+		dep.Providers = providedBy[t.Elem()]
+		return nil
+	}
+	if names, ok := providedBy[t]; ok {
+		dep.Providers = names
+		return nil
+	}
+
+	if t.Kind() == reflect.Interface {
+		for _, candidate := range provided {
+			if candidate.Implements(t) {
+				dep.Providers = append(dep.Providers, providedBy[candidate]...)
+			}
+		}
+	}
+	switch {
+	case len(dep.Providers) > 1:
+		return ErrAmbiguousProvider
+	case len(dep.Providers) == 0 && !dep.Optional:
+		return ErrMissingProvider
+	default:
+		return nil
+	}
+}
+
+// newComponent reflects the types a provider consumes and provides.
+func newComponent(provider any) (*Component, error) {
+	v := reflect.ValueOf(provider)
+	if v.Kind() != reflect.Func {
+		return nil, errors.Wrapf(ErrNotAProvider, "%T", provider)
+	}
+
+	c := &Component{Name: funcName(v)}
+	t := v.Type()
+	for i := range t.NumIn() {
+		in := t.In(i)
+		if !embeds(in, inType) {
+			c.Consumes = append(c.Consumes, &Dependency{Type: in})
+			continue
+		}
+		for _, f := range reflect.VisibleFields(in) {
+			if !f.IsExported() || f.Anonymous {
+				continue
+			}
+			optional, _ := strconv.ParseBool(f.Tag.Get("optional"))
+			c.Consumes = append(c.Consumes, &Dependency{
+				Type: f.Type, Optional: optional,
+			})
+		}
+	}
+	for i := range t.NumOut() {
+		out := t.Out(i)
+		switch {
+		case out == errType:
+		case embeds(out, outType):
+			for _, f := range reflect.VisibleFields(out) {
+				if f.IsExported() && !f.Anonymous {
+					c.Provides = append(c.Provides, f.Type)
+				}
+			}
+		default:
+			c.Provides = append(c.Provides, out)
+		}
+	}
+	return c, nil
+}
+
+// embeds reports whether t is a struct embedding the given marker type.
+func embeds(t, marker reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range t.NumField() {
+		if f := t.Field(i); f.Anonymous && f.Type == marker {
+			return true
+		}
+	}
+	return false
+}
+
+// funcName returns the name of a function without its package path.
+func funcName(v reflect.Value) string {
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return v.Type().String()
+	}
+	return pkgPath.ReplaceAllString(fn.Name(), "")
+}
+
+// TypeName returns the name of a type without package paths.
+func TypeName(t reflect.Type) string {
+	return pkgPath.ReplaceAllString(t.String(), "")
+}
+
+// WriteText writes, for every component, the types it provides and the
+// types it consumes along with their providers, followed by the problems of
+// the graph.
+func (g *Graph) WriteText(w io.Writer) error {
+	var sb strings.Builder
+	for _, c := range g.Components {
+		sb.WriteString(c.Name + "\n")
+		for _, t := range c.Provides {
+			sb.WriteString("  provides " + TypeName(t) + "\n")
+		}
+		for _, dep := range c.Consumes {
+			sb.WriteString("  consumes " + TypeName(dep.Type))
+			if dep.Optional {
+				sb.WriteString(" (optional)")
+			}
+			if len(dep.Providers) > 0 {
+				sb.WriteString(" <- " + strings.Join(dep.Providers, ", "))
+			}
+			sb.WriteString("\n")
+		}
+	}
+	if len(g.Problems) > 0 {
+		sb.WriteString("\nproblems:\n")
+		for _, p := range g.Problems {
+			sb.WriteString("  " + p.Error() + "\n")
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteDOT writes the graph in the DOT language, with an edge from the
+// provider to the consumer of every resolved dependency.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("digraph components {\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, c := range g.Components {
+		sb.WriteString("  " + strconv.Quote(c.Name) + ";\n")
+	}
+	for _, c := range g.Components {
+		for _, dep := range c.Consumes {
+			for _, provider := range dep.Providers {
+				fmt.Fprintf(
+					&sb, "  %s -> %s [label=%s];\n",
+					strconv.Quote(provider), strconv.Quote(c.Name),
+					strconv.Quote(TypeName(dep.Type)),
+				)
+			}
+		}
+	}
+	for _, p := range g.Problems {
+		if p.Component == "" {
+			continue
+		}
+		fmt.Fprintf(
+			&sb, "  %s [color=red, xlabel=%s];\n",
+			strconv.Quote(p.Component), strconv.Quote(p.Error()),
+		)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
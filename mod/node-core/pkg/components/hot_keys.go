@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/config"
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
+)
+
+// ProvideHotKeysTracer provides the tracer of the keys most read from the
+// storage. It provides nil unless the tracing is enabled.
+func ProvideHotKeysTracer(cfg *config.Config) *hotkeys.Tracer {
+	return hotkeys.NewTracer(cfg.HotKeys)
+}
+
+// hotKeysReporter serves the ranked view of a hot keys tracer to the debug
+// API.
+type hotKeysReporter struct {
+	tracer *hotkeys.Tracer
+}
+
+// HotKeys returns the ranked view of the reads sampled over the last complete
+// minute.
+func (r hotKeysReporter) HotKeys() *debugtypes.HotKeysResponse {
+	report := r.tracer.Report()
+	res := &debugtypes.HotKeysResponse{
+		Enabled:     report.Enabled,
+		SampleRate:  report.SampleRate,
+		Samples:     report.Samples,
+		Collections: toHotKeys(report.Collections),
+		Keys:        toHotKeys(report.Keys),
+	}
+	if report.Enabled {
+		res.Start = report.Start.Format(time.RFC3339)
+	}
+	return res
+}
+
+// toHotKeys converts the entries of a hot keys report to their API type.
+func toHotKeys(entries []hotkeys.Entry) []debugtypes.HotKey {
+	res := make([]debugtypes.HotKey, len(entries))
+	for i, e := range entries {
+		res[i] = debugtypes.HotKey{
+			Collection: e.Collection,
+			Key:        e.Key,
+			Samples:    e.Samples,
+		}
+	}
+	return res
+}
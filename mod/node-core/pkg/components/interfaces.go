@@ -26,9 +26,13 @@ import (
 	"encoding/json"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
-	"github.com/berachain/beacon-kit/mod/log"
-	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/blobfees"
+	"github.com/berachain/beacon-kit/mod/node-api/blobtxs"
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-api/timeliness"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -72,6 +76,11 @@ type (
 	// AvailabilityStore is the interface for the availability store.
 	AvailabilityStore[BeaconBlockBodyT any, BlobSidecarsT any] interface {
 		IndexDB
+		// BlobLocation returns the slot and the index in its block of the
+		// stored blob with the given versioned hash.
+		BlobLocation(common.ExecutionHash) (math.Slot, uint64, bool)
+		// GetBlobSidecars returns the sidecars stored for the given slot.
+		GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 		// IsDataAvailable ensures that all blobs referenced in the block are
 		// securely stored before it returns without an error.
 		IsDataAvailable(context.Context, math.Slot, BeaconBlockBodyT) bool
@@ -139,6 +148,9 @@ type (
 		GetDeposits() []DepositT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+		// GetExecutionRequests returns the requests triggered on the
+		// execution layer, nil before the fork that introduces them.
+		GetExecutionRequests() *engineprimitives.ExecutionRequests
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
 		SetRandaoReveal(crypto.BLSSignature)
 		// SetEth1Data sets the Eth1 data of the beacon block body.
@@ -190,6 +202,9 @@ type (
 	] interface {
 		constraints.SSZMarshallableRootable
 		GetTree() (*fastssz.Node, error)
+		// FieldRoots returns the hash tree roots of the top-level fields of
+		// the BeaconStateMarshallable.
+		FieldRoots() ([]common.Root, error)
 		// New returns a new instance of the BeaconStateMarshallable.
 		New(
 			forkVersion uint32,
@@ -232,9 +247,11 @@ type (
 
 	BlobSidecar[BeaconBlockHeaderT any] interface {
 		GetBeaconBlockHeader() BeaconBlockHeaderT
+		GetIndex() uint64
 		GetBlob() eip4844.Blob
 		GetKzgProof() eip4844.KZGProof
 		GetKzgCommitment() eip4844.KZGCommitment
+		GetInclusionProof() []common.Root
 	}
 
 	// BlobSidecars is the interface for blobs sidecars.
@@ -243,10 +260,14 @@ type (
 		constraints.SSZMarshallable
 		constraints.Empty[T]
 		Len() int
+		BlockRoot() common.Root
 		Get(index int) BlobSidecarT
 		GetSidecars() []BlobSidecarT
 		ValidateBlockRoots() error
 		VerifyInclusionProofs(kzgOffset uint64) error
+		// UnmarshalSSZReuse unmarshals the sidecars into the memory they
+		// hold.
+		UnmarshalSSZReuse([]byte) error
 	}
 
 	BlobVerifier[BlobSidecarsT any] interface {
@@ -289,6 +310,8 @@ type (
 	// BlockStore is the interface for block storage.
 	BlockStore[BeaconBlockT any] interface {
 		Set(blk BeaconBlockT) error
+		// SetBatch sets a group of blocks, in order.
+		SetBatch(blks []BeaconBlockT) error
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
@@ -297,6 +320,9 @@ type (
 		// number
 		// from the store.
 		GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+		// GetSummariesInRange returns the summaries of the blocks stored in
+		// the given range of slots, both included.
+		GetSummariesInRange(from, to math.Slot) []common.BlockSummary
 	}
 
 	ConsensusEngine interface {
@@ -384,6 +410,9 @@ type (
 		) ([]DepositT, error)
 		// Prune prunes the deposit store of [start, end)
 		Prune(start, end uint64) error
+		// PruneWatermark returns the index below which all deposits have
+		// been pruned.
+		PruneWatermark() (uint64, error)
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []DepositT) error
 	}
@@ -510,6 +539,10 @@ type (
 		GetBlockHash() common.ExecutionHash
 		// GetParentHash returns the parent hash.
 		GetParentHash() common.ExecutionHash
+		// GetTransactionsRoot returns the root of the transactions.
+		GetTransactionsRoot() common.Root
+		// GetWithdrawalsRoot returns the root of the withdrawals.
+		GetWithdrawalsRoot() common.Root
 	}
 
 	// 	Fork[T any] interface {
@@ -536,12 +569,16 @@ type (
 		GetForkVersion() common.Version
 		// GetDeposits returns the deposits.
 		GetDeposits() []DepositT
+		// GetValidators returns the customized genesis validators.
+		GetValidators() []*transition.GenesisValidator
 		// GetExecutionPayloadHeader returns the execution payload header.
 		GetExecutionPayloadHeader() ExecutionPayloadHeaderT
 	}
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
+		GetByIndex(index uint64) ([][]byte, error)
+		Indexes() ([]uint64, error)
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
 		Prune(start uint64, end uint64) error
@@ -622,6 +659,45 @@ type (
 	// 		GetSlashingInfo() []SlashingInfoT
 	// 	}
 
+	// PayloadBodyFetcher fetches execution payload bodies from the
+	// execution client.
+	PayloadBodyFetcher interface {
+		// GetPayloadBodiesByHash returns the bodies of the payloads with the
+		// given block hashes.
+		GetPayloadBodiesByHash(
+			ctx context.Context, hashes []common.ExecutionHash,
+		) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
+	}
+
+	// BlobFeeTracker is the interface for the tracker of the blob market.
+	BlobFeeTracker interface {
+		// History returns up to limit of the latest blob market records.
+		History(limit int) []*blobfees.Record
+	}
+
+	// BlobTxTracker is the interface for the tracker of the blob
+	// transactions of finalized blocks.
+	BlobTxTracker interface {
+		// Lookup returns the record of the block at the given slot, and
+		// whether the blob transactions of that slot are known.
+		Lookup(slot math.Slot) (*blobtxs.Record, bool)
+	}
+
+	// TimelinessTracker is the interface for the tracker of the lateness of
+	// the blocks of every proposer.
+	TimelinessTracker interface {
+		// Records returns the record of every proposer observed.
+		Records() []*timeliness.Record
+	}
+
+	// PerformanceTracker is the interface for the validator performance
+	// tracker.
+	PerformanceTracker interface {
+		// IsLive returns whether the validator with the given index
+		// performed a duty in the given epoch.
+		IsLive(epoch math.Epoch, index math.ValidatorIndex) (bool, error)
+	}
+
 	// StateProcessor defines the interface for processing the state.
 	StateProcessor[
 		BeaconBlockT any,
@@ -636,6 +712,7 @@ type (
 		InitializePreminedBeaconStateFromEth1(
 			BeaconStateT,
 			[]DepositT,
+			[]*transition.GenesisValidator,
 			ExecutionPayloadHeaderT,
 			common.Version,
 		) (transition.ValidatorUpdates, error)
@@ -649,6 +726,11 @@ type (
 			st BeaconStateT,
 			blk BeaconBlockT,
 		) (transition.ValidatorUpdates, error)
+		// ExpectedProposer returns the validator expected to propose at the
+		// given slot, if the chain restricts who may propose.
+		ExpectedProposer(
+			st BeaconStateT, slot math.Slot,
+		) (math.ValidatorIndex, bool, error)
 	}
 
 	SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
@@ -933,6 +1015,14 @@ type (
 		// GetValidatorsByEffectiveBalance retrieves validators by effective
 		// balance.
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+		// TombstoneValidator records the exit of the validator at the given
+		// index.
+		TombstoneValidator(index math.ValidatorIndex) error
+		// IsValidatorTombstoned reports whether the validator at the given
+		// index has been tombstoned.
+		IsValidatorTombstoned(index math.ValidatorIndex) (bool, error)
+		// AuditRegistry returns the index anomalies found in the registry.
+		AuditRegistry() ([]string, error)
 	}
 
 	// ReadOnlyBeaconState is the interface for a read-only beacon state.
@@ -984,6 +1074,7 @@ type (
 		SetLatestBlockHeader(BeaconBlockHeaderT) error
 		IncreaseBalance(math.ValidatorIndex, math.Gwei) error
 		DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+		SetBalance(math.ValidatorIndex, math.Gwei) error
 		UpdateSlashingAtIndex(uint64, math.Gwei) error
 		SetNextWithdrawalIndex(uint64) error
 		SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
@@ -1025,6 +1116,7 @@ type (
 
 		AddValidator(ValidatorT) error
 		AddValidatorBartio(ValidatorT) error
+		TombstoneValidator(math.ValidatorIndex) error
 	}
 
 	// ReadOnlyValidators has read access to validator methods.
@@ -1036,6 +1128,8 @@ type (
 		ValidatorByIndex(
 			math.ValidatorIndex,
 		) (ValidatorT, error)
+
+		IsValidatorTombstoned(math.ValidatorIndex) (bool, error)
 	}
 
 	// WriteOnlyEth1Data has write access to eth1 data.
@@ -1073,10 +1167,10 @@ type (
 		Validate(any) error
 	}
 
-	// Engine is a generic interface for an API engine.
-	NodeAPIEngine[ContextT NodeAPIContext] interface {
-		Run(addr string) error
-		RegisterRoutes(*handlers.RouteSet[ContextT], log.Logger)
+	// NodeAPIEngineFactory creates an API engine for every listener profile
+	// of the node API server.
+	NodeAPIEngineFactory[ContextT NodeAPIContext] interface {
+		NewEngine(profile server.ProfileConfig) server.Engine[ContextT]
 	}
 
 	NodeAPIBackend[
@@ -1092,12 +1186,22 @@ type (
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 		GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 
+		NodeAPIAdminBackend
 		NodeAPIBeaconBackend[
 			BeaconStateT, BeaconBlockHeaderT, ForkT, ValidatorT,
 		]
-		NodeAPIProofBackend[
-			BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT,
-		]
+		NodeAPIValidatorBackend
+	}
+
+	// NodeAPIAdminBackend is the interface for backend of the admin API.
+	NodeAPIAdminBackend interface {
+		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+		SimulateBlock(
+			slot math.Slot,
+			blockSSZ []byte,
+			opts *admintypes.SimulationOptions,
+		) (*admintypes.SimulationResult, error)
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -1107,24 +1211,52 @@ type (
 		GenesisBackend
 		BlockBackend[BeaconBlockHeaderT]
 		RandaoBackend
+		CommitteeBackend
 		StateBackend[BeaconStateT, ForkT]
 		ValidatorBackend[ValidatorT]
 		HistoricalBackend[ForkT]
+		BlobFeeBackend
+		DepositBackend
+		HeaderRangeBackend
+		TimelinessBackend
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	}
 
+	// NodeAPIDebugBackend is the interface for backend of the debug API.
+	NodeAPIDebugBackend[BeaconStateT any] interface {
+		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+		StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+		ForkVersionAtSlot(slot math.Slot) (uint32, error)
+	}
+
 	// NodeAPIProofBackend is the interface for backend of the proof API.
 	NodeAPIProofBackend[
-		BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT any,
+		BeaconBlockHeaderT, BeaconStateT, BlobSidecarsT, ForkT, ValidatorT any,
 	] interface {
 		BlockBackend[BeaconBlockHeaderT]
 		StateBackend[BeaconStateT, ForkT]
+		BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
+		BlobLocation(
+			versionedHash common.ExecutionHash,
+		) (math.Slot, uint64, error)
+		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 	}
 
+	// NodeAPIValidatorBackend is the interface for backend of the validator
+	// API.
+	NodeAPIValidatorBackend interface {
+		AttestationDataAtSlot(
+			slot math.Slot, committeeIndex uint64,
+		) (*validatortypes.AttestationData, error)
+		ValidatorLiveness(
+			epoch math.Epoch, indices []math.ValidatorIndex,
+		) ([]*validatortypes.ValidatorLiveness, error)
+	}
+
 	GenesisBackend interface {
 		GenesisValidatorsRoot(slot math.Slot) (common.Root, error)
 	}
@@ -1134,14 +1266,43 @@ type (
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
 	}
 
+	BlobFeeBackend interface {
+		BlobFees(limit int) []*types.BlobFeeData
+	}
+
+	DepositBackend interface {
+		DepositWatermark() (*types.DepositWatermarkData, error)
+	}
+
+	HeaderRangeBackend interface {
+		BlockSummaries(from, to math.Slot) []*types.BlockSummaryData
+	}
+
+	TimelinessBackend interface {
+		ProposerTimeliness() []*types.ProposerTimelinessData
+	}
+
 	RandaoBackend interface {
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
+	CommitteeBackend interface {
+		CommitteesAtEpoch(
+			slot math.Slot, epoch *math.Epoch,
+		) ([]*types.CommitteeData, error)
+	}
+
 	BlockBackend[BeaconBlockHeaderT any] interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
+		ExecutionPayloadAtSlot(
+			slot math.Slot,
+		) (*types.ExecutionPayloadData, error)
+		BlobTransactionsAtSlot(
+			slot math.Slot,
+		) ([]*types.BlobTransactionData, error)
+		ForkVersionAtSlot(slot math.Slot) (uint32, error)
 	}
 
 	StateBackend[BeaconStateT, ForkT any] interface {
@@ -1163,5 +1324,6 @@ type (
 			slot math.Slot,
 			ids []string,
 		) ([]*types.ValidatorBalanceData, error)
+		ValidatorQueue(slot math.Slot) (*types.ValidatorQueueData, error)
 	}
 )
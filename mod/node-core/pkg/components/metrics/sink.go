@@ -65,6 +65,16 @@ func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
 	)
 }
 
+// AddSample adds a sample to the histogram metric identified by the provided
+// key.
+func (TelemetrySink) AddSample(key string, value float32, args ...string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels([]string{key}, value, argsToLabels(args...))
+}
+
 // argsToLabels converts a list of key-value pairs to a list of metrics labels.
 //
 //nolint:mnd // its okay.
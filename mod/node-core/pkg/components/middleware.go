@@ -22,10 +22,15 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 )
 
 // ABCIMiddlewareInput is the input for the validator middleware provider.
@@ -58,7 +63,7 @@ func ProvideABCIMiddleware[
 ) (*middleware.ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, GenesisT, *SlotData,
 ], error) {
-	return middleware.NewABCIMiddleware[
+	m := middleware.NewABCIMiddleware[
 		BeaconBlockT,
 		BlobSidecarsT,
 		GenesisT,
@@ -68,5 +73,28 @@ func ProvideABCIMiddleware[
 		in.Dispatcher,
 		in.Logger,
 		in.TelemetrySink,
-	), nil
+	)
+
+	// Report the verification failures a proposer can be blamed for under
+	// their own reason.
+	m.RegisterRejectReason(
+		middleware.RejectInvalidSignature, signer.ErrInvalidSignature,
+	)
+	m.RegisterRejectReason(
+		middleware.RejectDataAvailability,
+		datypes.ErrInvalidInclusionProof,
+		datypes.ErrInvalidInclusionProofDepth,
+		datypes.ErrSidecarContainsDifferingBlockRoots,
+		datypes.ErrSidecarIndicesOutOfOrder,
+	)
+	m.RegisterRejectReason(
+		middleware.RejectExecutionInvalid,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+		blockchain.ErrInvalidAncestor,
+	)
+	m.RegisterRejectReason(
+		middleware.RejectStateRootMismatch, core.ErrStateRootMismatch,
+	)
+	return m, nil
 }
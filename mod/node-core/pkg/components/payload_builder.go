@@ -100,3 +100,86 @@ func ProvideLocalBuilder[
 		in.AttributesFactory,
 	)
 }
+
+// PayloadSchedulerInput is an input for the dep inject framework.
+type PayloadSchedulerInput[
+	BeaconBlockT any,
+	BeaconBlockHeaderT any,
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT, *Validator,
+		Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	LoggerT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+	Cfg          *config.Config
+	ChainSpec    common.ChainSpec
+	Dispatcher   Dispatcher
+	LocalBuilder *payloadbuilder.PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID,
+		WithdrawalT,
+	]
+	Logger         LoggerT
+	StateProcessor StateProcessor[
+		BeaconBlockT, BeaconStateT, *Context,
+		DepositT, ExecutionPayloadHeaderT,
+	]
+}
+
+// ProvidePayloadScheduler provides the service that schedules payload
+// builds off of finalized block states for the depinject framework.
+func ProvidePayloadScheduler[
+	BeaconBlockT any,
+	BeaconBlockHeaderT any,
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT, *Validator,
+		Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	DepositT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in PayloadSchedulerInput[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, DepositT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, KVStoreT, LoggerT, WithdrawalT,
+		WithdrawalsT,
+	],
+) *payloadbuilder.Scheduler[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID, WithdrawalT,
+] {
+	return payloadbuilder.NewScheduler[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID, WithdrawalT,
+	](
+		in.ChainSpec,
+		in.Logger.With("service", "payload-scheduler"),
+		in.LocalBuilder,
+		in.Dispatcher,
+		func(st BeaconStateT, slot math.Slot) error {
+			_, err := in.StateProcessor.ProcessSlots(st, slot)
+			return err
+		},
+		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+	)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/reqresp"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ReqRespReactorInput is the input for the request/response reactor.
+type ReqRespReactorInput[
+	BlockStoreT interface {
+		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+] struct {
+	depinject.In
+
+	BlockStore BlockStoreT
+	Logger     LoggerT
+}
+
+// ProvideReqRespReactor provides the p2p reactor serving historical blocks
+// and blob sidecars to peers.
+func ProvideReqRespReactor[
+	BlockStoreT interface {
+		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	},
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in ReqRespReactorInput[BlockStoreT, LoggerT],
+) *reqresp.Reactor {
+	return reqresp.NewReactor(
+		in.Logger.With("service", "reqresp"),
+		in.BlockStore,
+	)
+}
@@ -23,6 +23,8 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/forks"
+	"github.com/berachain/beacon-kit/mod/beacon/sink"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
@@ -31,11 +33,16 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/blobfees"
+	"github.com/berachain/beacon-kit/mod/node-api/blobtxs"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
+	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/mod/node-api/performance"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
 	"github.com/berachain/beacon-kit/mod/observability/pkg/telemetry"
+	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -54,7 +61,7 @@ type ServiceRegistryInput[
 		*Validator, Validators, WithdrawalT,
 	],
 	BeaconStateMarshallableT any,
-	BlobSidecarT any,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
 	DepositStoreT DepositStore[DepositT],
@@ -73,9 +80,18 @@ type ServiceRegistryInput[
 	ABCIService *middleware.ABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, *SlotData,
 	]
+	BlobFeeTracker *blobfees.Tracker[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	]
+	BlobTxTracker *blobtxs.Tracker[
+		BeaconBlockT, BeaconBlockBodyT, ExecutionPayloadT,
+	]
+	// BlockStoreService, EventPublisher, NodeAPIServer and
+	// PayloadScheduler are optional, their providers can be compiled out
+	// of the node binary.
 	BlockStoreService *blockstore.Service[
 		BeaconBlockT, BeaconBlockStoreT,
-	]
+	] `optional:"true"`
 	ChainService *blockchain.Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
 		BeaconBlockHeaderT, BeaconStateT, DepositT, ExecutionPayloadT,
@@ -84,18 +100,32 @@ type ServiceRegistryInput[
 	]
 	DAService      *da.Service[AvailabilityStoreT, BlobSidecarsT]
 	DBManager      *DBManager
+	EventPublisher *eventsapi.Publisher[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BlobSidecarT, BlobSidecarsT, DepositT,
+	] `optional:"true"`
 	DepositService *deposit.Service[
 		BeaconBlockT, BeaconBlockBodyT, DepositT,
 		ExecutionPayloadT, WithdrawalCredentials,
 	]
 	Dispatcher   Dispatcher
+	ForkManager  *forks.Manager[BeaconBlockT]
 	EngineClient *client.EngineClient[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
 	Logger           LoggerT
-	NodeAPIServer    *server.Server[NodeAPIContextT]
-	ReportingService *ReportingService
+	NodeAPIServer    *server.Server[NodeAPIContextT] `optional:"true"`
+	PayloadScheduler *payloadbuilder.Scheduler[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		*engineprimitives.PayloadAttributes[WithdrawalT], PayloadID,
+		WithdrawalT,
+	] `optional:"true"`
+	PerformanceTracker *performance.Tracker[BeaconBlockT]
+	ReportingService   *ReportingService
+	SinkService        *sink.Service[
+		BeaconBlockT, BeaconBlockBodyT, DepositT, WithdrawalCredentials,
+	]
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
 	ValidatorService *validator.Service[
@@ -123,7 +153,7 @@ func ProvideServiceRegistry[
 		*Validator, Validators, WithdrawalT,
 	],
 	BeaconStateMarshallableT any,
-	BlobSidecarT any,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
 	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
 	DepositStoreT DepositStore[DepositT],
@@ -152,10 +182,17 @@ func ProvideServiceRegistry[
 		service.WithService(in.ValidatorService),
 		service.WithService(in.BlockStoreService),
 		service.WithService(in.ChainService),
+		service.WithService(in.ForkManager),
 		service.WithService(in.DAService),
 		service.WithService(in.DepositService),
+		service.WithService(in.EventPublisher),
 		service.WithService(in.NodeAPIServer),
+		service.WithService(in.PayloadScheduler),
+		service.WithService(in.PerformanceTracker),
+		service.WithService(in.BlobFeeTracker),
+		service.WithService(in.BlobTxTracker),
 		service.WithService(in.ReportingService),
+		service.WithService(in.SinkService),
 		service.WithService(in.DBManager),
 		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
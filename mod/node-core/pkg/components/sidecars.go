@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
@@ -31,6 +32,7 @@ import (
 type SidecarFactoryInput struct {
 	depinject.In
 	ChainSpec     common.ChainSpec
+	Config        *config.Config
 	TelemetrySink *metrics.TelemetrySink
 }
 
@@ -50,16 +52,17 @@ func ProvideSidecarFactory[
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	WithdrawalsT Withdrawals[WithdrawalT],
 	WithdrawalT Withdrawal[WithdrawalT],
-](in SidecarFactoryInput) *dablob.SidecarFactory[
+](in SidecarFactoryInput) *dablob.BlobSidecarFactory[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 ] {
-	return dablob.NewSidecarFactory[
+	return dablob.NewBlobSidecarFactory[
 		BeaconBlockT,
 		BeaconBlockBodyT,
 		BeaconBlockHeaderT,
 	](
 		in.ChainSpec,
 		types.KZGPositionDeneb,
+		in.Config.KZG.ProofWorkers,
 		in.TelemetrySink,
 	)
 }
@@ -21,26 +21,53 @@
 package components
 
 import (
+	"os"
 	"path/filepath"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
 )
 
+// remoteSignerTimeout is how long the node waits for a remote validator
+// client to connect.
+const remoteSignerTimeout = time.Minute
+
 // BlsSignerInput is the input for the dep inject framework.
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts config.AppOptions
+	Cipher  *encryption.Cipher
 	PrivKey LegacyKey `optional:"true"`
+	// CmtCfg, when it sets a priv_validator_laddr, makes the node sign
+	// through a remote validator client instead of its key files.
+	CmtCfg *cmtcfg.Config `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	if in.CmtCfg != nil && in.CmtCfg.PrivValidatorListenAddr != "" {
+		chainID, err := chainIDFromGenesis(in.CmtCfg.GenesisFile())
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewRemoteBLSSigner(
+			in.CmtCfg.PrivValidatorListenAddr,
+			chainID,
+			remoteSignerTimeout,
+			cmtlog.NewNopLogger(),
+		)
+	}
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
@@ -50,15 +77,28 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 		privValStateFile := cast.ToString(
 			in.AppOpts.Get("priv_validator_state_file"),
 		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
-		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
+		// If the files are not absolute paths, join with homeDir
+		privValKeyFile = resolvePath(homeDir, privValKeyFile)
+		privValStateFile = resolvePath(homeDir, privValStateFile)
+		if in.Cipher != nil {
+			return signer.NewEncryptedBLSSigner(
+				privValKeyFile, privValStateFile, in.Cipher,
+			)
 		}
 		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
 	}
 	return signer.NewLegacySigner(in.PrivKey)
 }
+
+// chainIDFromGenesis reads the chain ID from the genesis file at path.
+func chainIDFromGenesis(path string) (string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	chainID, err := genutiltypes.ParseChainIDFromGenesis(f)
+	if err != nil {
+		return "", errors.Join(f.Close(), err)
+	}
+	return chainID, f.Close()
+}
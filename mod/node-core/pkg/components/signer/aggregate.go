@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// dst is the domain separation tag of the proof of possession scheme used by
+// the Ethereum consensus specification.
+//
+//nolint:gochecknoglobals // passed by reference to blst.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// AggregatePubkeys returns the aggregate of the given public keys, as per
+// eth_aggregate_pubkeys. Each key must be in the G1 subgroup and must not be
+// the point at infinity.
+func AggregatePubkeys(pubkeys []crypto.BLSPubkey) (crypto.BLSPubkey, error) {
+	points, err := decodePubkeys(pubkeys)
+	if err != nil {
+		return crypto.BLSPubkey{}, err
+	}
+
+	// The keys were validated while decoding.
+	agg := new(blst.P1Aggregate)
+	if !agg.Aggregate(points, false) {
+		return crypto.BLSPubkey{}, ErrInvalidPubkey
+	}
+	return crypto.BLSPubkey(agg.ToAffine().Compress()), nil
+}
+
+// FastAggregateVerify verifies a signature of the same message by all of the
+// given public keys, as per FastAggregateVerify of the IETF BLS signature
+// draft. Keys and signature are checked to be in their subgroups and not to be
+// the point at infinity.
+func FastAggregateVerify(
+	pubkeys []crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	points, err := decodePubkeys(pubkeys)
+	if err != nil {
+		return err
+	}
+
+	sig := new(blst.P2Affine).Uncompress(signature[:])
+	if sig == nil || !sig.SigValidate(true) {
+		return ErrInvalidSignatureEncoding
+	}
+
+	// The signature was validated above.
+	if !sig.FastAggregateVerify(false, points, msg, dst) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// decodePubkeys decodes the given public keys, rejecting any that is not in
+// the G1 subgroup or is the point at infinity.
+func decodePubkeys(pubkeys []crypto.BLSPubkey) ([]*blst.P1Affine, error) {
+	if len(pubkeys) == 0 {
+		return nil, ErrNoPubkeys
+	}
+
+	points := make([]*blst.P1Affine, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		points[i] = new(blst.P1Affine).Uncompress(pubkey[:])
+		// KeyValidate checks both subgroup membership and infinity.
+		if points[i] == nil || !points[i].KeyValidate() {
+			return nil, errors.Wrapf(ErrInvalidPubkey, "index %d", i)
+		}
+	}
+	return points, nil
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+
+// NewEncryptedBLSSigner creates a new BLSSigner from a key file that is
+// encrypted at rest with the given cipher. The sign state file is left in
+// plaintext as it holds no secrets. If the key file is still in plaintext, it
+// is sealed in place before the signer is created.
+func NewEncryptedBLSSigner(
+	keyFilePath string,
+	stateFilePath string,
+	cipher *encryption.Cipher,
+) (*BLSSigner, error) {
+	filePV, err := encryption.LoadFilePV(keyFilePath, stateFilePath, cipher)
+	if err != nil {
+		return nil, err
+	}
+	return &BLSSigner{PrivValidator: filePV}, nil
+}
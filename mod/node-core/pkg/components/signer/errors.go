@@ -38,4 +38,18 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrNoPubkeys is returned when aggregating or verifying against an empty
+	// set of public keys.
+	ErrNoPubkeys = errors.New("no public keys to aggregate")
+	// ErrInvalidPubkey is returned when a public key does not decode to a
+	// point of the G1 subgroup, or decodes to the point at infinity.
+	ErrInvalidPubkey = errors.New("invalid BLS public key")
+	// ErrInvalidSignatureEncoding is returned when a signature does not decode
+	// to a point of the G2 subgroup, or decodes to the point at infinity.
+	ErrInvalidSignatureEncoding = errors.New("invalid BLS signature encoding")
+
+	// ErrRemoteSignerUnavailable is returned when no validator client
+	// connected to the node to sign on its behalf.
+	ErrRemoteSignerUnavailable = errors.New("remote signer unavailable")
 )
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// NewRemoteBLSSigner creates a BLSSigner whose key is held by a validator
+// client, which connects to the node on listenAddr. It waits up to timeout
+// for the validator client to connect.
+func NewRemoteBLSSigner(
+	listenAddr string,
+	chainID string,
+	timeout time.Duration,
+	logger cmtlog.Logger,
+) (*BLSSigner, error) {
+	endpoint, err := privval.NewSignerListener(listenAddr, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := privval.NewSignerClient(endpoint, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = client.WaitForConnection(timeout); err != nil {
+		return nil, errors.Wrapf(
+			ErrRemoteSignerUnavailable, "%s: %v", listenAddr, err,
+		)
+	}
+	return &BLSSigner{PrivValidator: client}, nil
+}
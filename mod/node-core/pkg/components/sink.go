@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+	"database/sql"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/sink"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	// Registers the postgres driver used by the PostgreSQL sink.
+	_ "github.com/lib/pq"
+)
+
+// SinkInput is the input for the providers of the built-in sinks.
+type SinkInput struct {
+	depinject.In
+	Config *config.Config
+}
+
+// ProvidePostgresSink provides the built-in PostgreSQL sink, if enabled.
+func ProvidePostgresSink(in SinkInput) (sink.Registration, error) {
+	cfg := in.Config.Sinks.Postgres
+	if !cfg.Enabled {
+		return sink.Registration{}, nil
+	}
+	db, err := sql.Open("postgres", cfg.DSN)
+	if err != nil {
+		return sink.Registration{}, err
+	}
+	s, err := sink.NewPostgresSink(context.Background(), db)
+	if err != nil {
+		return sink.Registration{}, err
+	}
+	return sink.Registration{Sink: s}, nil
+}
+
+// ProvideKafkaSink provides the built-in Kafka sink, if enabled.
+func ProvideKafkaSink(in SinkInput) (sink.Registration, error) {
+	cfg := in.Config.Sinks.Kafka
+	if !cfg.Enabled {
+		return sink.Registration{}, nil
+	}
+	s, err := sink.NewKafkaSink(cfg)
+	if err != nil {
+		return sink.Registration{}, err
+	}
+	return sink.Registration{Sink: s}, nil
+}
+
+// SinkServiceInput is the input for the sink service.
+type SinkServiceInput[LoggerT any] struct {
+	depinject.In
+	Config     *config.Config
+	Dispatcher Dispatcher
+	Logger     LoggerT
+	// Sinks are the built-in sinks and those provided by the operator.
+	Sinks []sink.Registration
+}
+
+// ProvideSinkService provides the service feeding the finalized data to the
+// registered sinks.
+func ProvideSinkService[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT sink.BeaconBlockBody[DepositT],
+	BeaconBlockHeaderT any,
+	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in SinkServiceInput[LoggerT],
+) *sink.Service[
+	BeaconBlockT, BeaconBlockBodyT, DepositT, WithdrawalCredentials,
+] {
+	sinks := make([]sink.Sink, 0, len(in.Sinks))
+	for _, r := range in.Sinks {
+		if r.Sink != nil {
+			sinks = append(sinks, r.Sink)
+		}
+	}
+	return sink.NewService[
+		BeaconBlockT, BeaconBlockBodyT, DepositT, WithdrawalCredentials,
+	](
+		in.Config.Sinks,
+		in.Logger.With("service", "sink"),
+		in.Dispatcher,
+		sinks...,
+	)
+}
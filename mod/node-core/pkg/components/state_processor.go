@@ -48,6 +48,8 @@ type StateProcessorInput[
 		WithdrawalsT,
 	]
 	Signer crypto.BLSSigner
+	// DepositPolicy replaces the deposit policy configured by the chain spec.
+	DepositPolicy core.DepositPolicy[WithdrawalCredentials] `optional:"true"`
 }
 
 // ProvideStateProcessor provides the state processor to the depinject
@@ -80,12 +82,24 @@ func ProvideStateProcessor[
 	in StateProcessorInput[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalT, WithdrawalsT,
 	],
-) *core.StateProcessor[
+) (*core.StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, *Context, DepositT, *Eth1Data, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, *Fork, *ForkData, KVStoreT, *Validator,
 	Validators, WithdrawalT, WithdrawalsT, WithdrawalCredentials,
-] {
+], error) {
+	proposers, err := core.NewProposerSelector[BeaconStateT](in.ChainSpec)
+	if err != nil {
+		return nil, err
+	}
+	deposits := in.DepositPolicy
+	if deposits == nil {
+		if deposits, err = core.NewDepositPolicy[WithdrawalCredentials](
+			in.ChainSpec,
+		); err != nil {
+			return nil, err
+		}
+	}
 	return core.NewStateProcessor[
 		BeaconBlockT,
 		BeaconBlockBodyT,
@@ -108,5 +122,7 @@ func ProvideStateProcessor[
 		in.ChainSpec,
 		in.ExecutionEngine,
 		in.Signer,
-	)
+		proposers,
+		deposits,
+	), nil
 }
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/chaos"
+	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/journal"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/metered"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/namespace"
+)
+
+// StoreDB describes the database of a store the node keeps outside of
+// consensus, whose schema is versioned.
+type StoreDB struct {
+	// Name is the name of the database in the data directory.
+	Name string
+	// Encrypted is set if the values of the store are encrypted at rest
+	// when encryption is enabled.
+	Encrypted bool
+	// Migrations upgrade the store to the latest version of its schema.
+	Migrations []manager.Migration
+}
+
+// DepositStoreDB describes the database of the deposit store.
+func DepositStoreDB() StoreDB {
+	return StoreDB{
+		Name:       "deposits",
+		Encrypted:  true,
+		Migrations: depositstore.Migrations(),
+	}
+}
+
+// EventJournalDB describes the database of the event journal.
+func EventJournalDB() StoreDB {
+	return StoreDB{
+		Name:       "events",
+		Migrations: journal.Migrations(),
+	}
+}
+
+// StoreDBs returns the databases of every versioned store of the node.
+func StoreDBs() []StoreDB {
+	return []StoreDB{DepositStoreDB(), EventJournalDB()}
+}
+
+// Dir returns the directory holding the database under the node home.
+func (s StoreDB) Dir(homeDir string) string {
+	return homeDir + "/data"
+}
+
+// Exists reports whether the database was created under the node home.
+func (s StoreDB) Exists(homeDir string) (bool, error) {
+	_, err := os.Stat(
+		filepath.Join(s.Dir(homeDir), s.Name+storev2.DBFileSuffix),
+	)
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// StoreDBOptions are the layers a StoreDB is opened with.
+type StoreDBOptions struct {
+	// Namespace is the namespace the keys of the store are kept under.
+	Namespace string
+	// Chaos injects failures into the writes of the store, if set.
+	Chaos *chaos.Injector
+	// TelemetrySink is the sink the metrics of the store are exported to,
+	// if set.
+	TelemetrySink metered.TelemetrySink
+	// Cipher encrypts the values of an encrypted store, if set.
+	Cipher *encryption.Cipher
+}
+
+// Open opens the database under the node home, creating it if needed.
+func (s StoreDB) Open(
+	homeDir string,
+	opts StoreDBOptions,
+) (store.KVStoreWithBatch, error) {
+	kvp, err := storev2.NewDB(
+		storev2.DBTypePebbleDB, s.Name, s.Dir(homeDir), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	nskvp, err := namespace.NewKVStore(kvp, opts.Namespace)
+	if err != nil {
+		return nil, errors.Join(err, kvp.Close())
+	}
+	kvp = chaos.NewKVStore(nskvp, s.Name, opts.Chaos)
+	if opts.TelemetrySink != nil {
+		// The store is metered beneath the encryption so that its size is
+		// the one taken up on disk.
+		kvp = metered.NewKVStore(kvp, s.Name, opts.TelemetrySink)
+	}
+	if s.Encrypted && opts.Cipher != nil {
+		kvp = encryption.NewKVStore(kvp, opts.Cipher)
+	}
+	return kvp, nil
+}
+
+// migrateStore brings the store called name up to the latest version of its
+// schema, keeping the pre-migration backup in dir until it succeeds.
+//
+// Only the stores kept outside of consensus are versioned this way. The
+// beacon state in beacondb is part of the application state, so changing
+// its layout changes the app hash and must happen in a coordinated upgrade
+// at a fork, not on the startup of a single node. The block store is an
+// in-memory index rebuilt from the blocks finalized after startup, so it
+// holds nothing on disk to migrate.
+func migrateStore(
+	logger log.Logger,
+	name string,
+	dir string,
+	db store.KVStoreWithBatch,
+	migrations []manager.Migration,
+) error {
+	migrator, err := manager.NewMigrator(logger, name, dir, migrations...)
+	if err != nil {
+		return err
+	}
+	return migrator.Migrate(db)
+}
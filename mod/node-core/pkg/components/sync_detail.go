@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/engine"
+	"github.com/berachain/beacon-kit/mod/log"
+	syncapi "github.com/berachain/beacon-kit/mod/node-api/handlers/sync"
+	synctypes "github.com/berachain/beacon-kit/mod/node-api/handlers/sync/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SyncDetailInput is the input for the sync detail provider.
+type SyncDetailInput[
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+
+	CometBFTService *cometbft.Service[LoggerT]
+	ExecutionEngine *engine.Engine[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+		PayloadID,
+		WithdrawalsT,
+	]
+	StorageBackend StorageBackendT
+}
+
+// ProvideSyncDetail provides the backend of the sync API, which aggregates
+// the sync progress of the CometBFT node, the latest beacon state and the
+// execution engine.
+func ProvideSyncDetail[
+	BeaconStateT interface {
+		GetSlot() (math.Slot, error)
+		GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	},
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in SyncDetailInput[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, LoggerT, StorageBackendT,
+		WithdrawalT, WithdrawalsT,
+	],
+) syncapi.Backend {
+	return &syncDetail[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT, LoggerT,
+		StorageBackendT, WithdrawalT, WithdrawalsT,
+	]{
+		node:           in.CometBFTService,
+		engine:         in.ExecutionEngine,
+		storageBackend: in.StorageBackend,
+	}
+}
+
+// syncDetail reads the block store range from the CometBFT node, the heads
+// from the latest beacon state and the optimistic payloads from the
+// execution engine. Slots and CometBFT heights are interchangeable.
+type syncDetail[
+	BeaconStateT interface {
+		GetSlot() (math.Slot, error)
+		GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	},
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT interface {
+		StateFromContext(context.Context) BeaconStateT
+	},
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	node   *cometbft.Service[LoggerT]
+	engine *engine.Engine[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+		PayloadID,
+		WithdrawalsT,
+	]
+	storageBackend StorageBackendT
+}
+
+// SyncDetail implements syncapi.Backend.
+func (s *syncDetail[_, _, _, _, _, _, _]) SyncDetail(
+	ctx context.Context,
+) (*synctypes.SyncDetail, error) {
+	queryCtx, err := s.node.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, err
+	}
+	st := s.storageBackend.StateFromContext(queryCtx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &synctypes.SyncDetail{
+		HeadSlot:  slot.Unwrap(),
+		IsSyncing: s.node.IsCatchingUp(),
+	}
+
+	//#nosec:G701 // heights are never negative.
+	if base, _, ok := s.node.BlockStoreRange(); ok && base > 0 {
+		target := uint64(s.node.InitialHeight())
+		detail.CheckpointSlot = uint64(base)
+		detail.Backfill = synctypes.BackfillProgress{
+			EarliestSlot:   uint64(base),
+			TargetSlot:     target,
+			RemainingSlots: uint64(base) - min(uint64(base), target),
+			Complete:       uint64(base) <= target,
+		}
+	}
+
+	outstanding, latestValid := s.engine.OptimisticPayloads()
+	detail.Optimistic = synctypes.OptimisticStatus{
+		OutstandingBlocks:      outstanding,
+		LatestValidBlockNumber: latestValid.Unwrap(),
+	}
+
+	detail.Execution.HeadBlockNumber = lph.GetNumber().Unwrap()
+	if version, ok := s.engine.ExecutionClientVersion(); ok {
+		detail.Execution.Client = &synctypes.ExecutionClient{
+			Code:    version.Code,
+			Name:    version.Name,
+			Version: version.Version,
+			Commit:  version.Commit,
+		}
+	}
+	number, err := s.engine.ExecutionBlockNumber(ctx)
+	if err != nil {
+		detail.Execution.Error = err.Error()
+		return detail, nil
+	}
+	detail.Execution.ClientBlockNumber = number.Unwrap()
+	detail.Execution.Distance = detail.Execution.HeadBlockNumber -
+		min(detail.Execution.HeadBlockNumber, number.Unwrap())
+	return detail, nil
+}
@@ -23,12 +23,14 @@ package components
 import (
 	"cosmossdk.io/core/appmodule/v2"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	consruntimetypes "github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 )
@@ -122,6 +124,12 @@ type (
 	// FinalValidatorUpdatesProcessedEvent is a type alias for the final
 	// validator updates processed event.
 	ValidatorUpdateEvent = async.Event[transition.ValidatorUpdates]
+
+	// DepositProcessedEvent is a type alias for the deposit processed event.
+	DepositProcessedEvent = async.Event[*transition.ProcessedDeposit]
+
+	// ForkActivatedEvent is a type alias for the fork activated event.
+	ForkActivatedEvent = async.Event[chain.Fork[math.Epoch]]
 )
 
 /* -------------------------------------------------------------------------- */
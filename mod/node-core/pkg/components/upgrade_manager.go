@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/upgrade"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/spf13/cast"
+)
+
+// UpgradeManagerInput is the input for the upgrade manager.
+type UpgradeManagerInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	CmtCfg  *cmtcfg.Config
+	// Upgrades lists the upgrades implemented by the binary. A binary
+	// implementing the scheduled upgrade keeps running past its height.
+	Upgrades upgrade.Names `optional:"true"`
+}
+
+// ProvideUpgradeManager provides the manager halting the node at the height
+// of a scheduled binary upgrade.
+func ProvideUpgradeManager(
+	in UpgradeManagerInput,
+) (*upgrade.Manager, error) {
+	return upgrade.NewManager(
+		upgrade.Plan{
+			Name:   cast.ToString(in.AppOpts.Get(server.FlagUpgradeName)),
+			Height: cast.ToInt64(in.AppOpts.Get(server.FlagUpgradeHeight)),
+		},
+		in.Upgrades,
+		in.CmtCfg.DBDir(),
+	)
+}
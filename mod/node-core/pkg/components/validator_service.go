@@ -47,17 +47,22 @@ type ValidatorServiceInput[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ] struct {
 	depinject.In
+	Brake          *validator.Brake
 	Cfg            *config.Config
 	ChainSpec      common.ChainSpec
 	Dispatcher     Dispatcher
+	Doppelganger   *validator.Doppelganger
+	Graffiti       *validator.Graffiti
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	Maintenance    *validator.Maintenance
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
 	StorageBackend StorageBackendT
 	Signer         crypto.BLSSigner
 	SidecarFactory SidecarFactory[BeaconBlockT, BlobSidecarsT]
+	SlotControl    *validator.SlotControl
 	TelemetrySink  *metrics.TelemetrySink
 }
 
@@ -127,6 +132,11 @@ func ProvideValidatorService[
 		in.StorageBackend,
 		in.StateProcessor,
 		in.Signer,
+		in.Graffiti,
+		in.Maintenance,
+		in.Brake,
+		in.Doppelganger,
+		in.SlotControl,
 		in.SidecarFactory,
 		in.LocalBuilder,
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
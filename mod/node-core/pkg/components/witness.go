@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/witness"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+)
+
+// WitnessExporterInput is the input for the dep inject framework.
+type WitnessExporterInput struct {
+	depinject.In
+	AppOpts config.AppOptions
+	Config  *config.Config
+}
+
+// ProvideWitnessExporter provides the exporter of block witnesses. It
+// provides nil unless witness export is enabled.
+func ProvideWitnessExporter[
+	BeaconStateT interface {
+		GetMarshallable() (BeaconStateMarshallableT, error)
+	},
+	BeaconStateMarshallableT witness.Tree,
+](
+	in WitnessExporterInput,
+) (blockchain.WitnessExporter[BeaconStateT], error) {
+	cfg := in.Config.Witness
+	if !cfg.Enabled {
+		//nolint:nilnil // a nil exporter disables witness export.
+		return nil, nil
+	}
+
+	dir := cfg.Dir
+	if dir == "" {
+		dir = filepath.Join(
+			cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+			"data", "witnesses",
+		)
+	}
+	exporter, err := witness.NewExporter[
+		BeaconStateT, BeaconStateMarshallableT,
+	](dir)
+	if err != nil {
+		return nil, err
+	}
+	return exporter, nil
+}
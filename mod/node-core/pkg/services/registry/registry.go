@@ -86,8 +86,12 @@ func (s *Registry) StartAll(ctx context.Context) error {
 }
 
 // RegisterService appends a service constructor function to the service
-// registry.
+// registry. A nil service is ignored, which lets optional components that
+// were left out of the build be passed through unconditionally.
 func (s *Registry) RegisterService(service Basic) error {
+	if isNil(service) {
+		return nil
+	}
 	typeName := service.Name()
 	if _, exists := s.services[typeName]; exists {
 		return errServiceAlreadyExists
@@ -129,3 +133,12 @@ func (s *Registry) FetchService(service interface{}) error {
 	}
 	return errUnknownService
 }
+
+// isNil reports whether the service is nil or a typed nil pointer.
+func isNil(service Basic) bool {
+	if service == nil {
+		return true
+	}
+	v := reflect.ValueOf(service)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
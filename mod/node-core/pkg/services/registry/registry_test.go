@@ -81,3 +81,16 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+func TestRegistry_RegisterNilService(t *testing.T) {
+	logger := noop.NewLogger[any]()
+	registry := service.NewRegistry(service.WithLogger(logger))
+
+	var missing *mocks.Basic
+	require.NoError(t, registry.RegisterService(missing))
+	require.NoError(t, registry.RegisterService(nil))
+	require.NoError(t, registry.StartAll(context.Background()))
+
+	var fetchedService *mocks.Basic
+	require.Error(t, registry.FetchService(&fetchedService))
+}
@@ -39,6 +39,10 @@ type Factory[
 	// suggestedFeeRecipient is the suggested fee recipient sent to
 	// the execution client for the payload build.
 	suggestedFeeRecipient common.ExecutionAddress
+	// emptyPayloads forces the payloads built for some slots to be empty.
+	emptyPayloads *EmptyPayloadOverride
+	// metrics is the metrics for the attributes factory.
+	metrics *metrics
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -50,11 +54,15 @@ func NewAttributesFactory[
 	chainSpec common.ChainSpec,
 	logger log.Logger,
 	suggestedFeeRecipient common.ExecutionAddress,
+	emptyPayloads *EmptyPayloadOverride,
+	telemetrySink TelemetrySink,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
 	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
 		chainSpec:             chainSpec,
 		logger:                logger,
 		suggestedFeeRecipient: suggestedFeeRecipient,
+		emptyPayloads:         emptyPayloads,
+		metrics:               newMetrics(telemetrySink),
 	}
 }
 
@@ -93,12 +101,27 @@ func (f *Factory[
 		return attributes, err
 	}
 
-	return attributes.New(
+	if attributes, err = attributes.New(
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
 		f.suggestedFeeRecipient,
 		withdrawals,
 		prevHeadRoot,
-	)
+	); err != nil {
+		return attributes, err
+	}
+
+	// Withdrawals are still included in an empty payload, so that the
+	// withdrawal queue keeps draining.
+	if f.emptyPayloads != nil && f.emptyPayloads.apply(slot) {
+		f.logger.Warn(
+			"Requesting empty payload; empty payload override is active",
+			"for_slot", slot.Base10(),
+			"remaining_slots", f.emptyPayloads.Remaining(),
+		)
+		f.metrics.markEmptyPayloadOverride()
+		attributes.SetNoTxPool(true)
+	}
+	return attributes, nil
 }
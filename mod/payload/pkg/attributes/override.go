@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// EmptyPayloadOverride forces the payloads built for a number of slots to
+// be empty, e.g. while the mempool of the execution client is poisoned. The
+// window starts at the first slot a payload is built for after it is set.
+type EmptyPayloadOverride struct {
+	mu sync.Mutex
+	// pending is the number of slots set, until the window starts.
+	pending uint64
+	// started is true once the window has started.
+	started bool
+	// first and last are the first and last slots of the window, once it
+	// has started.
+	first, last math.Slot
+	// current is the latest slot a payload was built for since the window
+	// started.
+	current math.Slot
+}
+
+// NewEmptyPayloadOverride returns an override forcing empty payloads for the
+// given number of slots.
+func NewEmptyPayloadOverride(slots uint64) *EmptyPayloadOverride {
+	o := &EmptyPayloadOverride{}
+	o.Set(slots)
+	return o
+}
+
+// Set forces empty payloads for the given number of slots, replacing the
+// current window. Zero lifts the override.
+func (o *EmptyPayloadOverride) Set(slots uint64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.pending = slots
+	o.started = false
+}
+
+// Remaining returns the number of slots the override still applies to,
+// including the slot a payload is being built for.
+func (o *EmptyPayloadOverride) Remaining() uint64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.started {
+		return o.pending
+	} else if o.current > o.last {
+		return 0
+	}
+	return (o.last - o.current).Unwrap() + 1
+}
+
+// apply reports whether the payload built for the given slot must be empty.
+func (o *EmptyPayloadOverride) apply(slot math.Slot) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.started {
+		if o.pending == 0 {
+			return false
+		}
+		o.started = true
+		o.first = slot
+		o.last = slot + math.Slot(o.pending) - 1
+		o.current = slot
+	}
+	o.current = max(o.current, slot)
+	return slot >= o.first && slot <= o.last
+}
@@ -45,4 +45,14 @@ type PayloadAttributes[SelfT any, WithdrawalT any] interface {
 		[]WithdrawalT,
 		common.Root,
 	) (SelfT, error)
+	// SetNoTxPool sets whether the payload is built without the transactions
+	// of the mempool.
+	SetNoTxPool(bool)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
 }
@@ -21,6 +21,9 @@
 package builder
 
 import (
+	"sync"
+	"sync/atomic"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -50,6 +53,13 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// fcuMu serializes forkchoice updates with attributes, so that
+	// concurrent triggers for the same slot result in a single build.
+	fcuMu sync.Mutex
+	// health gates optimistic builds on the health of the node.
+	health *healthGate
+	// paused is true while the builder is paused, e.g. during maintenance.
+	paused atomic.Bool
 }
 
 // New creates a new service.
@@ -81,6 +91,7 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		health:            newHealthGate(cfg),
 	}
 }
 
@@ -91,3 +102,24 @@ func (pb *PayloadBuilder[
 ]) Enabled() bool {
 	return pb.cfg.Enabled
 }
+
+// Pause stops the builder from building payloads until Resume is called.
+// Builds already requested from the execution client are left to complete.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) Pause() {
+	if !pb.paused.Swap(true) {
+		pb.logger.Info("Payload builder paused")
+	}
+}
+
+// Resume resumes building payloads after a Pause.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) Resume() {
+	if pb.paused.Swap(false) {
+		pb.logger.Info("Payload builder resumed")
+	}
+}
@@ -30,6 +30,15 @@ const (
 	// defaultPayloadTimeout is the default value for local build
 	// payload timeout.
 	defaultPayloadTimeout = 1200 * time.Millisecond
+	// defaultMaxFCUFailures is the default number of consecutive failed
+	// forkchoice updates after which optimistic builds are skipped.
+	defaultMaxFCUFailures = 3
+	// defaultMaxHeadLag is the default lag of the head behind the wall
+	// clock after which optimistic builds are skipped.
+	defaultMaxHeadLag = time.Minute
+	// defaultPayloadUpgradeInterval is the default interval between polls
+	// of the execution client for a higher value payload.
+	defaultPayloadUpgradeInterval = 100 * time.Millisecond
 )
 
 // Config is the configuration for the payload builder.
@@ -46,13 +55,38 @@ type Config struct {
 	// timeout on your execution client. It also must be less than
 	// timeout_proposal in the CometBFT configuration.
 	PayloadTimeout time.Duration `mapstructure:"payload-timeout"`
+	// MaxFCUFailures is the number of consecutive failed forkchoice updates
+	// after which optimistic builds are skipped. Zero disables the check.
+	MaxFCUFailures uint64 `mapstructure:"max-fcu-failures"`
+	// MaxHeadLag is how far the latest execution payload may lag behind the
+	// wall clock before optimistic builds are skipped. Zero disables the
+	// check.
+	MaxHeadLag time.Duration `mapstructure:"max-head-lag"`
+	// ForceBuild builds optimistic payloads regardless of the health of the
+	// node. Intended for testing.
+	ForceBuild bool `mapstructure:"force-build"`
+	// PayloadUpgradeWindow is how long the execution client is polled for a
+	// higher value payload after the first one is retrieved. It is bounded
+	// by the proposal deadline. Zero disables payload upgrades.
+	PayloadUpgradeWindow time.Duration `mapstructure:"payload-upgrade-window"`
+	// PayloadUpgradeInterval is the interval between polls of the execution
+	// client during the payload upgrade window.
+	PayloadUpgradeInterval time.Duration `mapstructure:"payload-upgrade-interval"`
+	// EmptyPayloadSlots is the number of slots, starting with the first
+	// payload built after startup, for which payloads are built without the
+	// transactions of the mempool. It is meant for incidents where the
+	// mempool of the execution client is poisoned.
+	EmptyPayloadSlots uint64 `mapstructure:"empty-payload-slots"`
 }
 
 // DefaultConfig returns the default fork configuration.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:               true,
-		SuggestedFeeRecipient: common.ExecutionAddress{},
-		PayloadTimeout:        defaultPayloadTimeout,
+		Enabled:                true,
+		SuggestedFeeRecipient:  common.ExecutionAddress{},
+		PayloadTimeout:         defaultPayloadTimeout,
+		MaxFCUFailures:         defaultMaxFCUFailures,
+		MaxHeadLag:             defaultMaxHeadLag,
+		PayloadUpgradeInterval: defaultPayloadUpgradeInterval,
 	}
 }
@@ -27,6 +27,9 @@ var (
 	// disabled.
 	ErrPayloadBuilderDisabled = errors.New("payload builder is disabled")
 
+	// ErrPayloadBuilderPaused is returned when the payload builder is paused.
+	ErrPayloadBuilderPaused = errors.New("payload builder is paused")
+
 	// ErrNilPayloadID is returned when a nil payload ID is received.
 	ErrNilPayloadID = errors.New("received nil payload ID")
 
@@ -37,4 +40,16 @@ var (
 	// ErrNilPayloadEnvelope is returned when a nil payload envelope is
 	// received.
 	ErrNilPayloadEnvelope = errors.New("received nil payload envelope")
+
+	// ErrExecutionClientSyncing is returned when the execution client
+	// reports that it is syncing.
+	ErrExecutionClientSyncing = errors.New("execution client is syncing")
+
+	// ErrForkchoiceUpdatesFailing is returned when the execution client
+	// keeps failing forkchoice updates.
+	ErrForkchoiceUpdatesFailing = errors.New("forkchoice updates are failing")
+
+	// ErrNodeBehind is returned when the head of the node lags too far
+	// behind the wall clock.
+	ErrNodeBehind = errors.New("node is behind the chain")
 )
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"sync"
+	"time"
+
+	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// healthGate tracks the signals that tell whether an optimistic payload
+// build is worth the execution client's effort. While the execution client
+// is syncing, keeps rejecting forkchoice updates, or the node itself lags
+// behind the chain, optimistic builds are skipped; payloads requested for a
+// proposal are always built.
+type healthGate struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// maxFCUFailures is the number of consecutive failed forkchoice updates
+	// after which the gate closes.
+	maxFCUFailures uint64
+	// maxHeadLag is how far the timestamp of the latest execution payload
+	// may lag behind the wall clock before the node is considered behind.
+	maxHeadLag time.Duration
+	// elSyncing is true if the last forkchoice update reported that the
+	// execution client is syncing.
+	elSyncing bool
+	// fcuFailures is the number of consecutive failed forkchoice updates.
+	fcuFailures uint64
+}
+
+// newHealthGate returns a new healthGate for the given configuration.
+func newHealthGate(cfg *Config) *healthGate {
+	return &healthGate{
+		maxFCUFailures: cfg.MaxFCUFailures,
+		maxHeadLag:     cfg.MaxHeadLag,
+	}
+}
+
+// observeForkchoiceUpdate records the outcome of a forkchoice update.
+func (g *healthGate) observeForkchoiceUpdate(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.elSyncing = errors.Is(err, engineerrors.ErrSyncingPayloadStatus)
+	if err == nil {
+		g.fcuFailures = 0
+		return
+	}
+	g.fcuFailures++
+}
+
+// check returns a non-nil error describing why the node is unhealthy, given
+// the timestamp of the latest execution payload it has processed.
+func (g *healthGate) check(headTimestamp math.U64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	switch {
+	case g.elSyncing:
+		return ErrExecutionClientSyncing
+	case g.maxFCUFailures != 0 && g.fcuFailures >= g.maxFCUFailures:
+		return errors.Wrapf(
+			ErrForkchoiceUpdatesFailing,
+			"%d consecutive failures", g.fcuFailures,
+		)
+	}
+
+	//#nosec:G115 // timestamps fit in an int64.
+	lag := time.Since(time.Unix(int64(headTimestamp.Unwrap()), 0))
+	if g.maxHeadLag != 0 && lag > g.maxHeadLag {
+		return errors.Wrapf(
+			ErrNodeBehind, "head is %s behind", lag.Truncate(time.Second),
+		)
+	}
+	return nil
+}
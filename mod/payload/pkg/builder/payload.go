@@ -29,8 +29,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// RequestPayloadAsync builds a payload for the given slot and
-// returns the payload ID.
+// RequestPayloadAsync optimistically builds a payload for the given slot and
+// returns the payload ID. The build is skipped, returning a nil payload ID,
+// while the node is unhealthy unless builds are forced.
 func (pb *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -47,8 +48,59 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
+	if pb.paused.Load() {
+		pb.logger.Debug(
+			"Skipping optimistic payload build; builder is paused",
+			"for_slot", slot.Base10(),
+		)
+		//nolint:nilnil // a skipped build has no payload ID.
+		return nil, nil
+	}
+
+	if !pb.cfg.ForceBuild {
+		lph, err := st.GetLatestExecutionPayloadHeader()
+		if err != nil {
+			return nil, err
+		}
+		if err = pb.health.check(lph.GetTimestamp()); err != nil {
+			pb.logger.Warn(
+				"Skipping optimistic payload build; node is unhealthy",
+				"for_slot", slot.Base10(),
+				"reason", err,
+			)
+			//nolint:nilnil // a skipped build has no payload ID.
+			return nil, nil
+		}
+	}
+
+	return pb.requestPayload(
+		ctx, st, slot, timestamp,
+		parentBlockRoot, headEth1BlockHash, finalEth1BlockHash,
+	)
+}
+
+// requestPayload submits a forkchoice update with attributes for the given
+// slot, unless a payload was already requested for it, and returns the
+// payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) requestPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) (*PayloadIDT, error) {
+	// Hold the lock across the cache lookup and the forkchoice update, so
+	// that a second trigger for the same slot observes the first build.
+	pb.fcuMu.Lock()
+	defer pb.fcuMu.Unlock()
+
 	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
-		pb.logger.Warn(
+		pb.logger.Debug(
 			"aborting payload build; payload already exists in cache",
 			"for_slot",
 			slot.Base10(),
@@ -78,6 +130,7 @@ func (pb *PayloadBuilder[
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+	pb.health.observeForkchoiceUpdate(err)
 	if err != nil {
 		return nil, err
 	}
@@ -106,11 +159,14 @@ func (pb *PayloadBuilder[
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	if !pb.Enabled() {
 		return nil, ErrPayloadBuilderDisabled
+	} else if pb.paused.Load() {
+		return nil, ErrPayloadBuilderPaused
 	}
 
 	// Build the payload and wait for the execution client to
-	// return the payload ID.
-	payloadID, err := pb.RequestPayloadAsync(
+	// return the payload ID. The payload is needed for a proposal, so the
+	// health of the node is not consulted.
+	payloadID, err := pb.requestPayload(
 		ctx,
 		st,
 		slot,
@@ -140,13 +196,7 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	return pb.ee.GetPayload(
-		ctx,
-		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   *payloadID,
-			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
-	)
+	return pb.getPayload(ctx, slot, *payloadID)
 }
 
 // RetrievePayload attempts to pull a previously built payload
@@ -172,13 +222,7 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadIDNotFound
 	}
 
-	envelope, err := pb.ee.GetPayload(
-		ctx,
-		&engineprimitives.GetPayloadRequest[PayloadIDT]{
-			PayloadID:   payloadID,
-			ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
-		},
-	)
+	envelope, err := pb.getPayload(ctx, slot, payloadID)
 	if err != nil {
 		return nil, err
 	} else if envelope == nil {
@@ -258,5 +302,6 @@ func (pb *PayloadBuilder[
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
+	pb.health.observeForkchoiceUpdate(err)
 	return err
 }
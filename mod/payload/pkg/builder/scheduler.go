@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Scheduler drives the payload builder from chain events. It listens for
+// the post-states of finalized blocks on the dispatcher and, for each of
+// them, requests a payload for the following slot via a forkchoice update
+// with attributes.
+type Scheduler[
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT, WithdrawalT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
+	PayloadIDT ~[8]byte,
+	WithdrawalT any,
+] struct {
+	// chainSpec holds the chain specifications.
+	chainSpec common.ChainSpec
+	// logger is used for logging within the scheduler.
+	logger log.Logger
+	// builder is the payload builder that is being scheduled.
+	builder *PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		PayloadAttributesT, PayloadIDT, WithdrawalT,
+	]
+	// dispatcher is the dispatcher the scheduler subscribes to.
+	dispatcher Dispatcher
+	// processSlots advances the given state to the given slot.
+	processSlots func(BeaconStateT, math.Slot) error
+	// optimistic is true if payloads are built optimistically during
+	// block verification, in which case no builds are scheduled here.
+	optimistic bool
+	// subFinalizedStateEvents is a channel holding BeaconStateFinalized
+	// events.
+	subFinalizedStateEvents chan async.Event[BeaconStateT]
+
+	// mu protects lastSlot and lastRoot.
+	mu sync.Mutex
+	// lastSlot is the slot of the most recently scheduled build.
+	lastSlot math.Slot
+	// lastRoot is the parent block root of the most recently scheduled
+	// build.
+	lastRoot common.Root
+}
+
+// NewScheduler creates a new payload build scheduler.
+func NewScheduler[
+	BeaconStateT BeaconState[ExecutionPayloadHeaderT, WithdrawalT],
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
+	PayloadIDT ~[8]byte,
+	WithdrawalT any,
+](
+	chainSpec common.ChainSpec,
+	logger log.Logger,
+	builder *PayloadBuilder[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		PayloadAttributesT, PayloadIDT, WithdrawalT,
+	],
+	dispatcher Dispatcher,
+	processSlots func(BeaconStateT, math.Slot) error,
+	optimistic bool,
+) *Scheduler[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+] {
+	return &Scheduler[
+		BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		PayloadAttributesT, PayloadIDT, WithdrawalT,
+	]{
+		chainSpec:               chainSpec,
+		logger:                  logger,
+		builder:                 builder,
+		dispatcher:              dispatcher,
+		processSlots:            processSlots,
+		optimistic:              optimistic,
+		subFinalizedStateEvents: make(chan async.Event[BeaconStateT]),
+	}
+}
+
+// Name returns the name of the service.
+func (s *Scheduler[_, _, _, _, _, _]) Name() string {
+	return "payload-scheduler"
+}
+
+// Start subscribes the scheduler to BeaconStateFinalized events and starts
+// the event loop that handles them.
+func (s *Scheduler[_, _, _, _, _, _]) Start(ctx context.Context) error {
+	if !s.builder.Enabled() || s.optimistic {
+		return nil
+	}
+
+	if err := s.dispatcher.Subscribe(
+		async.BeaconStateFinalized, s.subFinalizedStateEvents,
+	); err != nil {
+		return err
+	}
+
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop of the scheduler.
+func (s *Scheduler[_, _, _, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalizedStateEvents:
+			if event.Error() != nil {
+				continue
+			}
+			s.onBeaconStateFinalized(ctx, event.Data())
+		}
+	}
+}
+
+// onBeaconStateFinalized requests a payload for the slot following the
+// block the given post-state belongs to, unless one has already been
+// requested on top of it. The state is a copy owned by the scheduler.
+func (s *Scheduler[BeaconStateT, _, _, _, _, _]) onBeaconStateFinalized(
+	ctx context.Context,
+	st BeaconStateT,
+) {
+	blkSlot, err := st.GetSlot()
+	if err != nil {
+		s.logger.Error("failed to get slot of finalized state", "error", err)
+		return
+	}
+
+	// Processing the next slot caches the root of the finalized block in
+	// the state, which is the parent root of the payload to build.
+	slot := blkSlot + 1
+	if err = s.processSlots(st, slot); err != nil {
+		s.logger.Error(
+			"failed to prepare state for payload build", "error", err,
+		)
+		return
+	}
+	parentRoot, err := st.GetBlockRootAtIndex(
+		blkSlot.Unwrap() % s.chainSpec.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		s.logger.Error(
+			"failed to get parent block root for payload build",
+			"error", err,
+		)
+		return
+	}
+
+	if !s.claim(slot, parentRoot) {
+		s.logger.Debug(
+			"skipping duplicate payload build trigger",
+			"for_slot", slot.Base10(),
+			"parent_block_root", parentRoot,
+		)
+		return
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		s.logger.Error(
+			"failed to get latest execution payload header", "error", err,
+		)
+		return
+	}
+
+	// The builder deduplicates against payloads that were already
+	// requested for this slot, e.g. by an optimistic build.
+	if _, err = s.builder.RequestPayloadAsync(
+		ctx,
+		st,
+		slot,
+		s.nextTimestamp(lph),
+		parentRoot,
+		lph.GetBlockHash(),
+		lph.GetParentHash(),
+	); err != nil {
+		s.logger.Error(
+			"failed to send forkchoice update with attributes",
+			"error", err,
+		)
+	}
+}
+
+// claim records that a build for the given slot and parent root has been
+// scheduled. It returns false if that build was already scheduled.
+func (s *Scheduler[_, _, _, _, _, _]) claim(
+	slot math.Slot,
+	parentRoot common.Root,
+) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastSlot == slot && s.lastRoot == parentRoot {
+		return false
+	}
+	s.lastSlot, s.lastRoot = slot, parentRoot
+	return true
+}
+
+// nextTimestamp calculates the timestamp for the next execution payload,
+// built on top of the given header.
+func (s *Scheduler[
+	_, _, ExecutionPayloadHeaderT, _, _, _,
+]) nextTimestamp(lph ExecutionPayloadHeaderT) uint64 {
+	//#nosec:G701 // not an issue in practice.
+	return max(
+		uint64(time.Now().Unix()+
+			int64(s.chainSpec.TargetSecondsPerEth1Block())),
+		lph.GetTimestamp().Unwrap()+1,
+	)
+}
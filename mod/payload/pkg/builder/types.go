@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -50,6 +51,8 @@ type BeaconState[
 	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
 	// GetBlockRootAtIndex retrieves the block root at a specified index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
+	// GetSlot retrieves the slot of the state.
+	GetSlot() (math.Slot, error)
 }
 
 type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
@@ -76,6 +79,15 @@ type ExecutionPayloadHeader interface {
 	GetBlockHash() common.ExecutionHash
 	// GetParentHash returns the parent hash.
 	GetParentHash() common.ExecutionHash
+	// GetTimestamp returns the timestamp.
+	GetTimestamp() math.U64
+}
+
+// Dispatcher is the interface for the event dispatcher.
+type Dispatcher interface {
+	// Subscribe subscribes the given channel to all events with the given
+	// event ID.
+	Subscribe(eventID async.EventID, ch any) error
 }
 
 // AttributesFactory is the interface for the attributes factory.
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// getPayload retrieves the payload for the given payload ID. If payload
+// upgrades are enabled, the execution client is polled until the upgrade
+// window closes, or the context deadline if it is earlier, and the envelope
+// with the highest block value is returned. This lets the proposer capture
+// transactions that arrive after the payload was first requested.
+func (pb *PayloadBuilder[
+	_, ExecutionPayloadT, _, _, PayloadIDT, _,
+]) getPayload(
+	ctx context.Context,
+	slot math.Slot,
+	payloadID PayloadIDT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	req := &engineprimitives.GetPayloadRequest[PayloadIDT]{
+		PayloadID:   payloadID,
+		ForkVersion: pb.chainSpec.ActiveForkVersionForSlot(slot),
+	}
+	best, err := pb.ee.GetPayload(ctx, req)
+	if err != nil || best == nil ||
+		pb.cfg.PayloadUpgradeWindow <= 0 || pb.cfg.PayloadUpgradeInterval <= 0 {
+		return best, err
+	}
+
+	deadline := time.Now().Add(pb.cfg.PayloadUpgradeWindow)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	ticker := time.NewTicker(pb.cfg.PayloadUpgradeInterval)
+	defer ticker.Stop()
+
+	upgrades := 0
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			pb.logUpgrades(slot, best, upgrades)
+			return best, nil
+		}
+
+		envelope, gErr := pb.ee.GetPayload(ctx, req)
+		if gErr != nil || envelope == nil {
+			// The best envelope seen so far is still valid, so a failed
+			// upgrade does not fail the proposal.
+			if ctx.Err() == nil {
+				pb.logger.Warn(
+					"Failed to upgrade payload; keeping best payload",
+					"for_slot", slot.Base10(),
+					"error", gErr,
+				)
+			}
+			pb.logUpgrades(slot, best, upgrades)
+			return best, nil
+		}
+
+		if isHigherValue(envelope.GetValue(), best.GetValue()) {
+			best = envelope
+			upgrades++
+		}
+	}
+}
+
+// logUpgrades logs the outcome of polling the execution client for payload
+// upgrades.
+func (pb *PayloadBuilder[
+	_, ExecutionPayloadT, _, _, _, _,
+]) logUpgrades(
+	slot math.Slot,
+	best engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	upgrades int,
+) {
+	args := []any{"for_slot", slot.Base10(), "upgrades", upgrades}
+	if value := best.GetValue(); value != nil {
+		args = append(args, "value", value.String())
+	}
+	pb.logger.Info("Finished polling for payload upgrades", args...)
+}
+
+// isHigherValue reports whether candidate is strictly greater than current.
+// A missing value never replaces a present one.
+func isHigherValue(candidate, current *math.U256) bool {
+	switch {
+	case candidate == nil:
+		return false
+	case current == nil:
+		return true
+	default:
+		return candidate.Gt(current)
+	}
+}
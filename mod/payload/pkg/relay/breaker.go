@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker skips the relay for a cooldown once it failed a number of times
+// in a row, so that a failing relay does not cost every proposal its
+// deadline.
+type breaker struct {
+	mu          sync.Mutex
+	maxFailures uint64
+	cooldown    time.Duration
+	failures    uint64
+	openUntil   time.Time
+}
+
+// newBreaker returns a breaker opening after maxFailures consecutive
+// failures. A zero maxFailures never opens.
+func newBreaker(maxFailures uint64, cooldown time.Duration) *breaker {
+	return &breaker{maxFailures: maxFailures, cooldown: cooldown}
+}
+
+// allow reports whether the relay may be called at the given time.
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+// success resets the count of consecutive failures.
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+}
+
+// failure records a failure at the given time and reports whether it opened
+// the breaker.
+func (b *breaker) failure(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.maxFailures == 0 || b.failures < b.maxFailures {
+		return false
+	}
+	b.failures = 0
+	b.openUntil = now.Add(b.cooldown)
+	return true
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	var (
+		b   = newBreaker(3, time.Minute)
+		now = time.Unix(1_700_000_000, 0)
+	)
+
+	require.True(t, b.allow(now))
+	require.False(t, b.failure(now))
+	require.False(t, b.failure(now))
+	b.success()
+
+	// The success reset the count, so two more failures keep it closed.
+	require.False(t, b.failure(now))
+	require.False(t, b.failure(now))
+	require.True(t, b.allow(now))
+
+	require.True(t, b.failure(now))
+	require.False(t, b.allow(now))
+	require.False(t, b.allow(now.Add(59*time.Second)))
+	require.True(t, b.allow(now.Add(time.Minute)))
+}
+
+func TestBreakerWithoutLimitNeverOpens(t *testing.T) {
+	var (
+		b   = newBreaker(0, time.Minute)
+		now = time.Unix(1_700_000_000, 0)
+	)
+	for range 10 {
+		require.False(t, b.failure(now))
+	}
+	require.True(t, b.allow(now))
+}
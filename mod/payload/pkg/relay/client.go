@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Client speaks the builder API to a relay or to mev-boost.
+type Client[HeaderT ExecutionPayloadHeader] struct {
+	client   *http.Client
+	endpoint *url.URL
+	// pubkey is the key the relay signs its bids with. It is taken from the
+	// user of the URL, as with mev-boost, and is zero if the URL has none.
+	pubkey crypto.BLSPubkey
+}
+
+// NewClient returns a client for the builder API at the given URL. A relay
+// URL of the form https://0x<pubkey>@host pins the key bids must be signed
+// with.
+func NewClient[HeaderT ExecutionPayloadHeader](
+	rawURL string,
+) (*Client[HeaderT], error) {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client[HeaderT]{client: &http.Client{}}
+	if endpoint.User != nil {
+		if err = c.pubkey.UnmarshalText(
+			[]byte(endpoint.User.Username()),
+		); err != nil {
+			return nil, errors.Wrap(err, "invalid relay pubkey")
+		}
+		endpoint.User = nil
+	}
+	c.endpoint = endpoint
+	return c, nil
+}
+
+// Pubkey returns the key the relay is pinned to, if any.
+func (c *Client[_]) Pubkey() crypto.BLSPubkey {
+	return c.pubkey
+}
+
+// Status checks that the relay is up.
+func (c *Client[_]) Status(ctx context.Context) error {
+	resp, err := c.do(ctx, http.MethodGet, nil, "status")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return drain(resp, http.StatusOK)
+}
+
+// GetHeader requests the best bid of the relay for the payload of slot
+// building on parentHash, for the proposer with the given key. It returns
+// ErrNoBid if the relay has none.
+func (c *Client[HeaderT]) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	proposer crypto.BLSPubkey,
+) (*SignedBuilderBid[HeaderT], error) {
+	resp, err := c.do(ctx, http.MethodGet, nil,
+		"header",
+		strconv.FormatUint(slot.Unwrap(), 10),
+		parentHash.Hex(),
+		proposer.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, ErrNoBid
+	} else if err = expectStatus(resp, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var body getHeaderResponse[HeaderT]
+	if err = json.Unmarshal(bz, &body); err != nil {
+		return nil, err
+	}
+	if body.Data == nil || body.Data.Message == nil {
+		return nil, ErrNoBid
+	}
+	return body.Data, nil
+}
+
+// SubmitBlindedBlock submits the signed blinded block to the relay, which
+// reveals the payload of the bid it was built from by decoding it into
+// payload.
+func (c *Client[_]) SubmitBlindedBlock(
+	ctx context.Context,
+	signedBlindedBlock any,
+	payload any,
+) error {
+	bz, err := json.Marshal(signedBlindedBlock)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, bz, "blinded_blocks")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err = expectStatus(resp, http.StatusOK); err != nil {
+		return err
+	}
+
+	if bz, err = io.ReadAll(resp.Body); err != nil {
+		return err
+	}
+	body := struct {
+		Data any `json:"data"`
+	}{Data: payload}
+	return json.Unmarshal(bz, &body)
+}
+
+// do sends a request to the builder API path made of the given elements.
+func (c *Client[_]) do(
+	ctx context.Context,
+	method string,
+	body []byte,
+	elem ...string,
+) (*http.Response, error) {
+	endpoint := c.endpoint.JoinPath(
+		append([]string{"eth", "v1", "builder"}, elem...)...,
+	)
+	req, err := http.NewRequestWithContext(
+		ctx, method, endpoint.String(), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.client.Do(req)
+}
+
+// expectStatus returns ErrUnexpectedStatus if the response does not carry
+// the given status.
+func expectStatus(resp *http.Response, status int) error {
+	if resp.StatusCode != status {
+		return errors.Wrapf(
+			ErrUnexpectedStatus, "relay responded with %s", resp.Status,
+		)
+	}
+	return nil
+}
+
+// drain reads the rest of the body so that the connection can be reused,
+// and checks the status of the response.
+func drain(resp *http.Response, status int) error {
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return err
+	}
+	return expectStatus(resp, status)
+}
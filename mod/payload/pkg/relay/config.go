@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultTimeout is the default deadline for a relay to return a bid,
+	// matching the getHeader timeout of mev-boost.
+	defaultTimeout = 950 * time.Millisecond
+	// defaultMaxFailures is the default number of consecutive relay
+	// failures after which the relay is skipped.
+	defaultMaxFailures = 3
+	// defaultCooldown is the default time a relay is skipped for once it
+	// kept failing.
+	defaultCooldown = time.Minute
+)
+
+// Config is the configuration for the builder-API relay proxy.
+type Config struct {
+	// Enabled determines if bids are requested from the relay.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the builder-API endpoint of the relay or of mev-boost.
+	URL string `mapstructure:"url"`
+	// Timeout is the hard deadline for the relay to return a bid. The local
+	// payload is used once it passes.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxFailures is the number of consecutive relay failures after which
+	// the relay is skipped for the cooldown. Zero disables circuit breaking.
+	MaxFailures uint64 `mapstructure:"max-failures"`
+	// Cooldown is how long the relay is skipped for once it kept failing.
+	Cooldown time.Duration `mapstructure:"cooldown"`
+}
+
+// DefaultConfig returns the default relay configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Timeout:     defaultTimeout,
+		MaxFailures: defaultMaxFailures,
+		Cooldown:    defaultCooldown,
+	}
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoBid is returned when the relay has no bid for the slot.
+	ErrNoBid = errors.New("relay has no bid")
+
+	// ErrRelayUnavailable is returned while the relay is skipped after
+	// consecutive failures.
+	ErrRelayUnavailable = errors.New("relay unavailable after failures")
+
+	// ErrUnexpectedStatus is returned when the relay responds with a status
+	// the builder API does not define for the request.
+	ErrUnexpectedStatus = errors.New("unexpected relay response status")
+
+	// ErrBidPubkeyMismatch is returned when a bid is signed by another key
+	// than the one the relay is expected to sign with.
+	ErrBidPubkeyMismatch = errors.New("bid pubkey does not match relay")
+
+	// ErrBidParentMismatch is returned when a bid builds on another parent
+	// than the one requested.
+	ErrBidParentMismatch = errors.New("bid parent hash does not match")
+
+	// ErrMissingBidValue is returned when a bid carries no value.
+	ErrMissingBidValue = errors.New("bid has no value")
+)
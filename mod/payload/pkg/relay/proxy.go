@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Envelope is the local payload a relay bid competes with.
+type Envelope interface {
+	// GetValue returns the value of the payload to the proposer, in wei.
+	GetValue() *math.U256
+}
+
+// Proxy races the getHeader of a relay against the local payload builder.
+// The relay has a hard deadline; a relay that times out, fails, or returns
+// a bid that does not verify leaves the proposal to the local payload.
+type Proxy[HeaderT ExecutionPayloadHeader, EnvelopeT Envelope] struct {
+	cfg     Config
+	logger  log.Logger
+	client  *Client[HeaderT]
+	breaker *breaker
+	// domain is the builder domain relays sign their bids in.
+	domain common.Domain
+	// verify verifies a BLS signature.
+	verify func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error
+}
+
+// NewProxy returns a proxy for the relay of the config. Bids must be signed
+// in the given builder domain.
+func NewProxy[HeaderT ExecutionPayloadHeader, EnvelopeT Envelope](
+	cfg Config,
+	logger log.Logger,
+	domain common.Domain,
+	verify func(crypto.BLSPubkey, []byte, crypto.BLSSignature) error,
+) (*Proxy[HeaderT, EnvelopeT], error) {
+	client, err := NewClient[HeaderT](cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	return &Proxy[HeaderT, EnvelopeT]{
+		cfg:     cfg,
+		logger:  logger,
+		client:  client,
+		breaker: newBreaker(cfg.MaxFailures, cfg.Cooldown),
+		domain:  domain,
+		verify:  verify,
+	}, nil
+}
+
+// Enabled reports whether bids are requested from the relay.
+func (p *Proxy[_, _]) Enabled() bool {
+	return p.cfg.Enabled
+}
+
+// Client returns the builder-API client of the relay, used to reveal the
+// payload of a winning bid.
+func (p *Proxy[HeaderT, _]) Client() *Client[HeaderT] {
+	return p.client
+}
+
+// relayResult is the outcome of a getHeader request.
+type relayResult[HeaderT ExecutionPayloadHeader] struct {
+	bid *SignedBuilderBid[HeaderT]
+	err error
+}
+
+// Race requests a bid from the relay while the local payload is built, and
+// returns the relay bid if it arrived before the deadline, verified, and
+// pays more than the local payload. Otherwise the bid is nil and the local
+// payload is returned, along with the error of the local builder if the
+// relay bid did not win either.
+func (p *Proxy[HeaderT, EnvelopeT]) Race(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	proposer crypto.BLSPubkey,
+	local func(context.Context) (EnvelopeT, error),
+) (*SignedBuilderBid[HeaderT], EnvelopeT, error) {
+	if !p.cfg.Enabled {
+		envelope, err := local(ctx)
+		return nil, envelope, err
+	}
+
+	relayCh := make(chan relayResult[HeaderT], 1)
+	go func() {
+		bid, err := p.getHeader(ctx, slot, parentHash, proposer)
+		relayCh <- relayResult[HeaderT]{bid: bid, err: err}
+	}()
+
+	envelope, localErr := local(ctx)
+	relay := <-relayCh
+
+	args := []any{"for_slot", slot.Base10()}
+	switch {
+	case relay.err != nil:
+		if !errors.Is(relay.err, ErrNoBid) {
+			p.logger.Warn("Falling back to local payload",
+				append(args, "reason", relay.err)...)
+		}
+		return nil, envelope, localErr
+	case localErr != nil:
+		p.logger.Warn("Local payload failed, using relay bid",
+			append(args, "error", localErr)...)
+		return relay.bid, envelope, nil
+	case !isHigherValue(relay.bid.Message.Value, envelope.GetValue()):
+		p.logger.Info("Local payload outbid the relay",
+			append(args, "bid_value", relay.bid.Message.Value.String())...)
+		return nil, envelope, nil
+	default:
+		p.logger.Info("Relay bid outbid the local payload",
+			append(args, "bid_value", relay.bid.Message.Value.String())...)
+		return relay.bid, envelope, nil
+	}
+}
+
+// getHeader requests and verifies the bid of the relay under the deadline
+// of the config, feeding the circuit breaker.
+func (p *Proxy[HeaderT, _]) getHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	proposer crypto.BLSPubkey,
+) (*SignedBuilderBid[HeaderT], error) {
+	if !p.breaker.allow(time.Now()) {
+		return nil, ErrRelayUnavailable
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	bid, err := p.client.GetHeader(ctx, slot, parentHash, proposer)
+	if err == nil {
+		err = p.verifyBid(bid, parentHash)
+	}
+	switch {
+	case err == nil, errors.Is(err, ErrNoBid):
+		p.breaker.success()
+	case p.breaker.failure(time.Now()):
+		p.logger.Error("Relay keeps failing, skipping it",
+			"cooldown", p.cfg.Cooldown.String(), "error", err,
+		)
+	}
+	return bid, err
+}
+
+// verifyBid checks that the bid builds on the requested parent, pays a
+// value, and is signed by the relay in the builder domain.
+func (p *Proxy[HeaderT, _]) verifyBid(
+	bid *SignedBuilderBid[HeaderT],
+	parentHash common.ExecutionHash,
+) error {
+	msg := bid.Message
+	if msg.Value == nil {
+		return ErrMissingBidValue
+	}
+	if got := msg.Header.GetParentHash(); got != parentHash {
+		return errors.Wrapf(ErrBidParentMismatch, "got %s", got)
+	}
+	if pinned := p.client.Pubkey(); pinned != (crypto.BLSPubkey{}) &&
+		msg.Pubkey != pinned {
+		return errors.Wrapf(ErrBidPubkeyMismatch, "got %s", msg.Pubkey)
+	}
+
+	root, err := msg.hashTreeRoot()
+	if err != nil {
+		return err
+	}
+	signingRoot := sha256.Hash(append(root[:], p.domain[:]...))
+	return p.verify(msg.Pubkey, signingRoot[:], bid.Signature)
+}
+
+// isHigherValue reports whether candidate is strictly greater than current.
+// A missing current value is outbid by any bid.
+func isHigherValue(candidate, current *math.U256) bool {
+	return current == nil || candidate.Gt(current)
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+const (
+	// maxBlobCommitmentsPerBlock is the limit of the blob commitments list
+	// of a bid.
+	maxBlobCommitmentsPerBlock = 4096
+	// builderBidFields is the number of fields of a builder bid.
+	builderBidFields = 4
+)
+
+// ExecutionPayloadHeader is the header of the payload a builder bids with.
+// It is decoded from the JSON of the relay.
+type ExecutionPayloadHeader interface {
+	// HashTreeRoot returns the hash tree root of the header.
+	HashTreeRoot() common.Root
+	// GetParentHash returns the parent hash of the payload.
+	GetParentHash() common.ExecutionHash
+	// GetBlockHash returns the block hash of the payload.
+	GetBlockHash() common.ExecutionHash
+}
+
+// BuilderBid is the bid of a builder for the payload of a slot, as defined
+// by the builder API.
+type BuilderBid[HeaderT ExecutionPayloadHeader] struct {
+	// Header is the header of the payload offered.
+	Header HeaderT `json:"header"`
+	// BlobKZGCommitments are the commitments to the blobs of the payload.
+	BlobKZGCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+	// Value is the payment to the proposer, in wei.
+	Value *math.U256 `json:"value"`
+	// Pubkey is the key of the relay signing the bid.
+	Pubkey crypto.BLSPubkey `json:"pubkey"`
+}
+
+// SignedBuilderBid is a builder bid signed by the relay.
+type SignedBuilderBid[HeaderT ExecutionPayloadHeader] struct {
+	// Message is the bid.
+	Message *BuilderBid[HeaderT] `json:"message"`
+	// Signature is the signature of the relay over the bid.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// getHeaderResponse is the response body of getHeader.
+type getHeaderResponse[HeaderT ExecutionPayloadHeader] struct {
+	Version string                     `json:"version"`
+	Data    *SignedBuilderBid[HeaderT] `json:"data"`
+}
+
+// hashTreeRoot computes the SSZ hash tree root of the bid.
+func (b *BuilderBid[HeaderT]) hashTreeRoot() (common.Root, error) {
+	rootHasher := merkle.NewRootHasher(
+		merkle.NewHasher[common.Root](sha256.Hash),
+		merkle.BuildParentTreeRoots[common.Root],
+	)
+
+	commitments, err := rootHasher.NewRootWithMaxLeaves(
+		eip4844.KZGCommitments[common.ExecutionHash](
+			b.BlobKZGCommitments,
+		).Leafify(),
+		maxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	// uint256 values are hashed as a single little-endian chunk.
+	value := common.Root(b.Value.Bytes32())
+	slices.Reverse(value[:])
+
+	return rootHasher.NewRootWithMaxLeaves(
+		[]common.Root{
+			b.Header.HashTreeRoot(),
+			rootHasher.MixIn(
+				commitments, uint64(len(b.BlobKZGCommitments)),
+			),
+			value,
+			common.Root(b.Pubkey.HashTreeRoot()),
+		},
+		builderBidFields,
+	)
+}
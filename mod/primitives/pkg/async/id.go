@@ -46,4 +46,9 @@ const (
 	FinalSidecarsReceived          = "final-blob-sidecars-received"
 	FinalValidatorUpdatesProcessed = "final-validator-updates"
 	BeaconBlockFinalized           = "beacon-block-finalized"
+	BeaconStateFinalized           = "beacon-state-finalized"
+	DepositProcessed               = "deposit-processed"
+
+	// fork events.
+	ForkActivated = "fork-activated"
 )
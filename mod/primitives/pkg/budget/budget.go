@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package budget derives the deadlines of the phases of block processing
+// from the consensus timeouts, so that no phase runs for longer than
+// consensus is willing to wait for it.
+package budget
+
+import (
+	"context"
+	"time"
+)
+
+// Timeouts are the consensus timeout parameters of a round.
+type Timeouts struct {
+	// Propose is how long validators wait for the proposal of a round.
+	Propose time.Duration
+	// Prevote is how long validators wait for prevotes once they have seen
+	// +2/3 of any.
+	Prevote time.Duration
+	// Precommit is how long validators wait for precommits once they have
+	// seen +2/3 of any.
+	Precommit time.Duration
+	// Commit is how long validators wait after committing a block before
+	// starting the next height.
+	Commit time.Duration
+}
+
+// Phase is a step of block processing bounded by the consensus timeouts.
+type Phase uint8
+
+const (
+	// BlockBuild is the building of a proposal, from the new slot to the
+	// signed block and its sidecars.
+	BlockBuild Phase = iota
+	// PayloadFetch is the retrieval of the execution payload of a proposal.
+	PayloadFetch
+	// BlockVerification is the verification of a received proposal.
+	BlockVerification
+	// StateTransition is the state transition of a received block,
+	// including the execution of its payload.
+	StateTransition
+	// BlobVerification is the verification of the blob sidecars of a
+	// received block.
+	BlobVerification
+)
+
+// Budget returns how long phase may take under the timeouts.
+//
+// A proposal has to be built and gossiped within the propose timeout, so
+// building takes at most half of it and fetching the payload half of that.
+// Received proposals are verified within the propose timeout, with the
+// state transition and blob verification, which run concurrently, each
+// capped at half of it so that a slow one still leaves time to vote.
+func (t Timeouts) Budget(phase Phase) time.Duration {
+	//nolint:mnd // shares of the propose timeout.
+	switch phase {
+	case BlockBuild:
+		return t.Propose / 2
+	case PayloadFetch:
+		return t.Propose / 4
+	case BlockVerification:
+		return t.Propose
+	case StateTransition, BlobVerification:
+		return t.Propose / 2
+	default:
+		return t.Propose
+	}
+}
+
+// timeoutsKey is the context key of the consensus timeouts.
+type timeoutsKey struct{}
+
+// WithTimeouts returns a copy of ctx carrying the consensus timeouts.
+func WithTimeouts(ctx context.Context, t Timeouts) context.Context {
+	return context.WithValue(ctx, timeoutsKey{}, t)
+}
+
+// TimeoutsFromContext returns the consensus timeouts carried by ctx, if any.
+func TimeoutsFromContext(ctx context.Context) (Timeouts, bool) {
+	t, ok := ctx.Value(timeoutsKey{}).(Timeouts)
+	return t, ok && t.Propose > 0
+}
+
+// WithPhase returns a copy of ctx that expires once the budget of phase,
+// counted from now, is spent. ctx is returned as is when it carries no
+// consensus timeouts.
+func WithPhase(
+	ctx context.Context,
+	phase Phase,
+) (context.Context, context.CancelFunc) {
+	t, ok := TimeoutsFromContext(ctx)
+	if !ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, t.Budget(phase))
+}
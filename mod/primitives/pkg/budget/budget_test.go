@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package budget_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/budget"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeouts_Budget(t *testing.T) {
+	timeouts := budget.Timeouts{Propose: 2 * time.Second}
+	require.Equal(t, time.Second, timeouts.Budget(budget.BlockBuild))
+	require.Equal(t, 500*time.Millisecond, timeouts.Budget(budget.PayloadFetch))
+	require.Equal(t, 2*time.Second, timeouts.Budget(budget.BlockVerification))
+	require.Equal(t, time.Second, timeouts.Budget(budget.StateTransition))
+	require.Equal(t, time.Second, timeouts.Budget(budget.BlobVerification))
+}
+
+func TestWithPhase(t *testing.T) {
+	t.Run("no timeouts", func(t *testing.T) {
+		ctx, cancel := budget.WithPhase(
+			context.Background(), budget.PayloadFetch,
+		)
+		defer cancel()
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("bounded by the phase budget", func(t *testing.T) {
+		ctx := budget.WithTimeouts(
+			context.Background(), budget.Timeouts{Propose: time.Second},
+		)
+		start := time.Now()
+		ctx, cancel := budget.WithPhase(ctx, budget.PayloadFetch)
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(
+			t, start.Add(250*time.Millisecond), deadline, 50*time.Millisecond,
+		)
+	})
+
+	t.Run("parent deadline wins when sooner", func(t *testing.T) {
+		parent, cancelParent := context.WithTimeout(
+			context.Background(), 10*time.Millisecond,
+		)
+		defer cancelParent()
+		ctx := budget.WithTimeouts(
+			parent, budget.Timeouts{Propose: time.Minute},
+		)
+		ctx, cancel := budget.WithPhase(ctx, budget.BlockVerification)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+		parentDeadline, _ := parent.Deadline()
+		require.Equal(t, parentDeadline, deadline)
+	})
+}
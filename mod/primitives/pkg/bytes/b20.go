@@ -22,8 +22,6 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 )
 
@@ -39,15 +37,7 @@ type B20 [20]byte
 // ToBytes20 is a utility function that transforms a byte slice into a fixed
 // 20-byte array. It errs if input has not the required size.
 func ToBytes20(input []byte) (B20, error) {
-	if len(input) != B20Size {
-		return B20{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B20Size,
-		)
-	}
-	return B20(input), nil
+	return ToFixed[B20](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -65,7 +55,7 @@ func (h *B20) UnmarshalText(text []byte) error {
 }
 
 // String returns the hex string representation of B20.
-func (h *B20) String() string {
+func (h B20) String() string {
 	return hex.EncodeBytes(h[:])
 }
 
@@ -89,5 +79,5 @@ func (h B20) MarshalSSZ() ([]byte, error) {
 
 // HashTreeRoot returns the hash tree root of the B20.
 func (h B20) HashTreeRoot() (B32, error) {
-	return ToBytes32(ExtendToSize(h[:], B32Size))
+	return merkleizeVector(h[:]), nil
 }
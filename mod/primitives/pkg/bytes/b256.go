@@ -22,10 +22,7 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
-	"github.com/prysmaticlabs/gohashtree"
 )
 
 const (
@@ -40,15 +37,7 @@ type B256 [256]byte
 // ToBytes256 is a utility function that transforms a byte slice into a fixed
 // 256-byte array. It errs if input has not the required size.
 func ToBytes256(input []byte) (B256, error) {
-	if len(input) != B256Size {
-		return B256{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B256Size,
-		)
-	}
-	return B256(input), nil
+	return ToFixed[B256](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -66,7 +55,7 @@ func (h *B256) UnmarshalText(text []byte) error {
 }
 
 // String returns the hex string representation of B256.
-func (h *B256) String() string {
+func (h B256) String() string {
 	return hex.EncodeBytes(h[:])
 }
 
@@ -95,18 +84,5 @@ func (h B256) MarshalSSZ() ([]byte, error) {
 
 // HashTreeRoot returns the hash tree root of the B256.
 func (h B256) HashTreeRoot() (B32, error) {
-	//nolint:mnd // for a tree height of 3 we need 8 working chunks.
-	result := make([][32]byte, 8)
-	copy(result[0][:], h[:32])
-	copy(result[1][:], h[32:64])
-	copy(result[2][:], h[64:96])
-	copy(result[3][:], h[96:128])
-	copy(result[4][:], h[128:160])
-	copy(result[5][:], h[160:192])
-	copy(result[6][:], h[192:224])
-	copy(result[7][:], h[224:256])
-	gohashtree.HashChunks(result, result)
-	gohashtree.HashChunks(result, result)
-	gohashtree.HashChunks(result, result)
-	return result[0], nil
+	return merkleizeVector(h[:]), nil
 }
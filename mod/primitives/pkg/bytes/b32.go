@@ -22,8 +22,6 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 )
 
@@ -39,15 +37,7 @@ type B32 [B32Size]byte
 // ToBytes32 is a utility function that transforms a byte slice into a fixed
 // 32-byte array It errs if input has not the required size.
 func ToBytes32(input []byte) (B32, error) {
-	if len(input) != B32Size {
-		return B32{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B32Size,
-		)
-	}
-	return B32(input), nil
+	return ToFixed[B32](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -22,8 +22,6 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 )
 
@@ -39,15 +37,7 @@ type B4 [4]byte
 // ToBytes4 is a utility function that transforms a byte slice into a fixed
 // 4-byte array. It errs if input has not the required size.
 func ToBytes4(input []byte) (B4, error) {
-	if len(input) != B4Size {
-		return B4{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B4Size,
-		)
-	}
-	return B4(input), nil
+	return ToFixed[B4](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -87,7 +77,7 @@ func (h B4) MarshalSSZ() ([]byte, error) {
 	return h[:], nil
 }
 
-// HashTreeRoot returns the hash tree root of the B8.
+// HashTreeRoot returns the hash tree root of the B4.
 func (h B4) HashTreeRoot() (B32, error) {
-	return ToBytes32(ExtendToSize(h[:], B32Size))
+	return merkleizeVector(h[:]), nil
 }
@@ -22,10 +22,7 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
-	"github.com/prysmaticlabs/gohashtree"
 )
 
 const (
@@ -40,15 +37,7 @@ type B48 [48]byte
 // ToBytes48 is a utility function that transforms a byte slice into a fixed
 // 48-byte array. It errs if input has not the required size.
 func ToBytes48(input []byte) (B48, error) {
-	if len(input) != B48Size {
-		return B48{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B48Size,
-		)
-	}
-	return B48(input), nil
+	return ToFixed[B48](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -88,11 +77,7 @@ func (h B48) MarshalSSZ() ([]byte, error) {
 	return h[:], nil
 }
 
+// HashTreeRoot returns the hash tree root of the B48.
 func (h B48) HashTreeRoot() B32 {
-	//nolint:mnd // for a tree height of 1 we need 2 working chunks.
-	result := make([][32]byte, 2)
-	copy(result[0][:], h[:32])
-	copy(result[1][:], h[32:48])
-	gohashtree.HashChunks(result, result)
-	return result[0]
+	return merkleizeVector(h[:])
 }
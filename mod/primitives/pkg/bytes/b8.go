@@ -22,8 +22,6 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 )
 
@@ -39,15 +37,7 @@ type B8 [8]byte
 // ToBytes8 is a utility function that transforms a byte slice into a fixed
 // 8-byte array. It errs if input has not the required size.
 func ToBytes8(input []byte) (B8, error) {
-	if len(input) != B8Size {
-		return B8{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B8Size,
-		)
-	}
-	return B8(input), nil
+	return ToFixed[B8](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -89,5 +79,5 @@ func (h B8) MarshalSSZ() ([]byte, error) {
 
 // HashTreeRoot returns the hash tree root of the B8.
 func (h B8) HashTreeRoot() (B32, error) {
-	return ToBytes32(ExtendToSize(h[:], B32Size))
+	return merkleizeVector(h[:]), nil
 }
@@ -22,10 +22,7 @@
 package bytes
 
 import (
-	"fmt"
-
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
-	"github.com/prysmaticlabs/gohashtree"
 )
 
 const (
@@ -40,15 +37,7 @@ type B96 [96]byte
 // ToBytes96 is a utility function that transforms a byte slice into a fixed
 // 96-byte array. It errs if input has not the required size.
 func ToBytes96(input []byte) (B96, error) {
-	if len(input) != B96Size {
-		return B96{}, fmt.Errorf(
-			"%w, got %d, expected %d",
-			ErrIncorrectLength,
-			len(input),
-			B96Size,
-		)
-	}
-	return B96(input), nil
+	return ToFixed[B96](input)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -66,7 +55,7 @@ func (h *B96) UnmarshalText(text []byte) error {
 }
 
 // String returns the hex string representation of B96.
-func (h *B96) String() string {
+func (h B96) String() string {
 	return hex.EncodeBytes(h[:])
 }
 
@@ -90,12 +79,5 @@ func (h B96) MarshalSSZ() ([]byte, error) {
 
 // HashTreeRoot returns the hash tree root of the B96.
 func (h B96) HashTreeRoot() B32 {
-	//nolint:mnd // for a tree height of 2 we need 4 working chunks.
-	result := make([][32]byte, 4)
-	copy(result[0][:], h[:32])
-	copy(result[1][:], h[32:64])
-	copy(result[2][:], h[64:96])
-	gohashtree.HashChunks(result, result)
-	gohashtree.HashChunks(result, result)
-	return result[0]
+	return merkleizeVector(h[:])
 }
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bytes
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/gohashtree"
+)
+
+// Fixed is the set of the fixed-size byte arrays, such as B4 through B256
+// and the types defined over them. It lets the conversions, comparisons and
+// merkleization be written once for every size.
+type Fixed interface {
+	~[B4Size]byte | ~[B8Size]byte | ~[B20Size]byte | ~[B32Size]byte |
+		~[B48Size]byte | ~[B96Size]byte | ~[B256Size]byte
+}
+
+// ToFixed transforms a byte slice into the fixed-size byte array T. It errs
+// if input has not the size of T.
+func ToFixed[T Fixed](input []byte) (T, error) {
+	var out T
+	if len(input) != len(out) {
+		return out, fmt.Errorf(
+			"%w, got %d, expected %d",
+			ErrIncorrectLength,
+			len(input),
+			len(out),
+		)
+	}
+	return T(input), nil
+}
+
+// MustToFixed transforms a byte slice into the fixed-size byte array T. It
+// panics if input has not the size of T.
+func MustToFixed[T Fixed](input []byte) T {
+	out, err := ToFixed[T](input)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// Compare returns an integer comparing a and b lexicographically. The result
+// is 0 if a == b, -1 if a < b, and +1 if a > b.
+func Compare[T Fixed](a, b T) int {
+	for i := range len(a) {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// IsZero returns whether every byte of h is zero.
+func IsZero[T Fixed](h T) bool {
+	var zero T
+	return h == zero
+}
+
+// merkleizeVector returns the hash tree root of a byte vector of at most
+// B256Size bytes, packed into chunks of B32Size bytes whose number is padded
+// to the next power of two.
+func merkleizeVector(bz []byte) B32 {
+	chunks := max((len(bz)+B32Size-1)/B32Size, 1)
+	width := 1
+	for width < chunks {
+		width <<= 1
+	}
+	result := make([][32]byte, width)
+	for i := range chunks {
+		copy(result[i][:], bz[i*B32Size:])
+	}
+	for ; width > 1; width >>= 1 {
+		gohashtree.HashChunks(result, result[:width])
+	}
+	return result[0]
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bytes_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFixed(t *testing.T) {
+	b4, err := bytes.ToFixed[bytes.B4]([]byte{1, 2, 3, 4})
+	require.NoError(t, err)
+	require.Equal(t, bytes.B4{1, 2, 3, 4}, b4)
+
+	_, err = bytes.ToFixed[bytes.B48](make([]byte, 47))
+	require.ErrorIs(t, err, bytes.ErrIncorrectLength)
+
+	require.Panics(t, func() {
+		bytes.MustToFixed[bytes.B20](make([]byte, 21))
+	})
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b bytes.B8
+		want int
+	}{
+		{
+			name: "equal",
+			a:    bytes.B8{1, 2, 3},
+			b:    bytes.B8{1, 2, 3},
+			want: 0,
+		},
+		{
+			name: "less",
+			a:    bytes.B8{1, 2, 3},
+			b:    bytes.B8{1, 2, 4},
+			want: -1,
+		},
+		{
+			name: "greater",
+			a:    bytes.B8{2},
+			b:    bytes.B8{1, 0xff},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, bytes.Compare(tt.a, tt.b))
+		})
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	require.True(t, bytes.IsZero(bytes.B96{}))
+	require.False(t, bytes.IsZero(bytes.B96{95: 1}))
+}
+
+func TestHashTreeRootPadding(t *testing.T) {
+	// Vectors of at most 32 bytes are their own padded chunk.
+	htr, err := bytes.B20{0xaa, 19: 0xbb}.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, bytes.B32{0xaa, 19: 0xbb}, htr)
+
+	// Larger vectors are split into chunks hashed pairwise.
+	var b48 bytes.B48
+	for i := range b48 {
+		b48[i] = byte(i + 1)
+	}
+	chunks := make([]byte, 2*bytes.B32Size)
+	copy(chunks, b48[:])
+	require.Equal(t, bytes.B32(sha256.Sum256(chunks)), b48.HashTreeRoot())
+}
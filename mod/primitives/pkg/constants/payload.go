@@ -21,6 +21,9 @@
 package constants
 
 const (
+	// ExecutionAddressLength is the length of an execution address in bytes.
+	ExecutionAddressLength = 20
+
 	// LogsBloomLength the length of a LogsBloom in bytes.
 	LogsBloomLength = 256
 
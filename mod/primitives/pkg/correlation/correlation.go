@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package correlation carries the correlation ID of a request through the
+// services handling it, so that their logs and their calls to the execution
+// client can be stitched together.
+package correlation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	// Header is the HTTP header carrying the correlation ID.
+	Header = "X-Request-Id"
+	// LogKey is the key of the correlation ID in log lines.
+	LogKey = "request_id"
+
+	// idLength is the number of random bytes of a generated ID.
+	idLength = 8
+	// maxLength is the length of the longest ID accepted from a client.
+	maxLength = 64
+)
+
+// NewID returns a new random correlation ID.
+func NewID() string {
+	bz := make([]byte, idLength)
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(bz)
+	return hex.EncodeToString(bz)
+}
+
+// OrNewID returns id if it is a valid correlation ID, e.g. one supplied by a
+// client, and a new random correlation ID otherwise.
+func OrNewID(id string) string {
+	if len(id) == 0 || len(id) > maxLength {
+		return NewID()
+	}
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return NewID()
+		}
+	}
+	return id
+}
+
+// idKey is the context key of the correlation ID.
+type idKey struct{}
+
+// WithID returns a copy of ctx carrying the correlation ID.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// IDFromContext returns the correlation ID carried by ctx, if any.
+func IDFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	id, ok := ctx.Value(idKey{}).(string)
+	return id, ok && id != ""
+}
+
+// LogFields returns the key value pairs of a log line with the correlation
+// ID carried by ctx appended, if any.
+func LogFields(ctx context.Context, keyVals ...any) []any {
+	if id, ok := IDFromContext(ctx); ok {
+		return append(keyVals, LogKey, id)
+	}
+	return keyVals
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package correlation_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewID(t *testing.T) {
+	id := correlation.NewID()
+	require.Len(t, id, 16)
+	require.NotEqual(t, id, correlation.NewID())
+}
+
+func TestOrNewID(t *testing.T) {
+	require.Equal(t, "client-id", correlation.OrNewID("client-id"))
+	require.Len(t, correlation.OrNewID(""), 16)
+	require.Len(t, correlation.OrNewID("bad id"), 16)
+	require.Len(t, correlation.OrNewID(strings.Repeat("a", 65)), 16)
+}
+
+func TestWithID(t *testing.T) {
+	_, ok := correlation.IDFromContext(context.Background())
+	require.False(t, ok)
+	require.Equal(
+		t, []any{"slot", 1},
+		correlation.LogFields(context.Background(), "slot", 1),
+	)
+
+	ctx := correlation.WithID(context.Background(), "abc")
+	id, ok := correlation.IDFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, "abc", id)
+	require.Equal(
+		t,
+		[]any{"slot", 1, correlation.LogKey, "abc"},
+		correlation.LogFields(ctx, "slot", 1),
+	)
+}
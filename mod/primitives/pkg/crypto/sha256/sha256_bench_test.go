@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package sha256_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+)
+
+// benchSizes are the input sizes hashed by the benchmarks: a merkle node
+// pair, a validator record sized chunk and a full blob.
+//
+//nolint:gochecknoglobals // shared by the benchmarks.
+var benchSizes = []int{64, 1024, 131072}
+
+func BenchmarkHash(b *testing.B) {
+	for _, size := range benchSizes {
+		data := make([]byte, size)
+		b.Run(fmt.Sprintf("bytes=%d", size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for range b.N {
+				sha256.Hash(data)
+			}
+		})
+	}
+}
+
+func BenchmarkCustomHashFn(b *testing.B) {
+	for _, size := range benchSizes {
+		data := make([]byte, size)
+		b.Run(fmt.Sprintf("bytes=%d", size), func(b *testing.B) {
+			hashFn := sha256.CustomHashFn()
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for range b.N {
+				hashFn(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package domains implements the signature domain helpers of the Ethereum 2.0
+// specification: the standard domain types, fork data roots, domains and the
+// signing roots of the objects signed within a domain.
+package domains
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+)
+
+// The domain types as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#domain-types
+//
+//nolint:gochecknoglobals,lll // arrays cannot be constants.
+var (
+	Proposer          = common.DomainType{0x00, 0x00, 0x00, 0x00}
+	Attester          = common.DomainType{0x01, 0x00, 0x00, 0x00}
+	Randao            = common.DomainType{0x02, 0x00, 0x00, 0x00}
+	Deposit           = common.DomainType{0x03, 0x00, 0x00, 0x00}
+	VoluntaryExit     = common.DomainType{0x04, 0x00, 0x00, 0x00}
+	SelectionProof    = common.DomainType{0x05, 0x00, 0x00, 0x00}
+	AggregateAndProof = common.DomainType{0x06, 0x00, 0x00, 0x00}
+	ApplicationMask   = common.DomainType{0x00, 0x00, 0x00, 0x01}
+)
+
+// forkDigestLength is the length of a fork digest in bytes.
+const forkDigestLength = 4
+
+// ComputeForkDataRoot returns the hash tree root of the ForkData container
+// for the given fork version and genesis validators root.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_data_root
+//
+//nolint:lll // link.
+func ComputeForkDataRoot(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Root {
+	// The version is right padded to a full chunk; the container holds two
+	// chunks, so its root is the hash of their concatenation.
+	var chunks [2 * constants.RootLength]byte
+	copy(chunks[:], currentVersion[:])
+	copy(chunks[constants.RootLength:], genesisValidatorsRoot[:])
+	return sha256.Hash(chunks[:])
+}
+
+// ComputeForkDigest returns the first four bytes of the fork data root.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_digest
+//
+//nolint:lll // link.
+func ComputeForkDigest(
+	currentVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.ForkDigest {
+	root := ComputeForkDataRoot(currentVersion, genesisValidatorsRoot)
+	return common.ForkDigest(root[:forkDigestLength])
+}
+
+// ComputeDomain returns the domain for the given domain type, fork version
+// and genesis validators root.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_domain
+//
+//nolint:lll // link.
+func ComputeDomain(
+	domainType common.DomainType,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) common.Domain {
+	var domain common.Domain
+	root := ComputeForkDataRoot(forkVersion, genesisValidatorsRoot)
+	copy(domain[:], domainType[:])
+	copy(domain[len(domainType):], root[:])
+	return domain
+}
+
+// ComputeSigningRoot returns the hash tree root of the SigningData container
+// for the given object root and domain.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+//
+//nolint:lll // link.
+func ComputeSigningRoot(
+	objectRoot common.Root,
+	domain common.Domain,
+) common.Root {
+	var chunks [2 * constants.RootLength]byte
+	copy(chunks[:], objectRoot[:])
+	copy(chunks[constants.RootLength:], domain[:])
+	return sha256.Hash(chunks[:])
+}
+
+// ComputeSigningRootOf returns the signing root of an SSZ object.
+func ComputeSigningRootOf(
+	sszObject interface{ HashTreeRoot() common.Root },
+	domain common.Domain,
+) common.Root {
+	return ComputeSigningRoot(sszObject.HashTreeRoot(), domain)
+}
+
+// ComputeSigningRootUInt64 returns the signing root of a uint64 value, such
+// as the epoch signed over by a randao reveal.
+func ComputeSigningRootUInt64(
+	value uint64,
+	domain common.Domain,
+) common.Root {
+	var objectRoot common.Root
+	binary.LittleEndian.PutUint64(objectRoot[:], value)
+	return ComputeSigningRoot(objectRoot, domain)
+}
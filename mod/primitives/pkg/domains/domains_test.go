@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/domains"
+	"github.com/stretchr/testify/require"
+)
+
+// mainnetGenesisValidatorsRoot is the genesis validators root of the
+// Ethereum mainnet beacon chain.
+const mainnetGenesisValidatorsRoot = "0x4b363db94e286120d76eb905340fdd4e" +
+	"54bfe9f06bf33ff6cf5ad27f511bfe95"
+
+// rootObject is an SSZ object whose hash tree root is the value itself.
+type rootObject common.Root
+
+func (o rootObject) HashTreeRoot() common.Root { return common.Root(o) }
+
+// mustRoot decodes a hex encoded root, failing the test on error.
+func mustRoot(t *testing.T, input string) common.Root {
+	t.Helper()
+	root, err := common.NewRootFromHex(input)
+	require.NoError(t, err)
+	return root
+}
+
+func TestComputeForkDataRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  common.Version
+		gvr      common.Root
+		expected string
+	}{
+		{
+			name:    "zero version and root",
+			version: common.Version{},
+			gvr:     common.Root{},
+			expected: "0xf5a5fd42d16a20302798ef6ed309979b" +
+				"43003d2320d9f0e8ea9831a92759fb4b",
+		},
+		{
+			name:    "mainnet phase0",
+			version: common.Version{0x00, 0x00, 0x00, 0x00},
+			gvr:     mustRoot(t, mainnetGenesisValidatorsRoot),
+			expected: "0xb5303f2ad2010d699a76c8e623509474" +
+				"21a3e4a979779642cfdb0f6668986b25",
+		},
+		{
+			name:    "mainnet altair",
+			version: common.Version{0x01, 0x00, 0x00, 0x00},
+			gvr:     mustRoot(t, mainnetGenesisValidatorsRoot),
+			expected: "0xafcaaba0efab1ca832a15152469bb09b" +
+				"b84641c405171dfa2d3fb45f2bd8ddb9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t,
+				mustRoot(t, tt.expected),
+				domains.ComputeForkDataRoot(tt.version, tt.gvr),
+			)
+		})
+	}
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	gvr := mustRoot(t, mainnetGenesisValidatorsRoot)
+	tests := []struct {
+		name     string
+		version  common.Version
+		expected common.ForkDigest
+	}{
+		{
+			name:     "mainnet phase0",
+			version:  common.Version{0x00, 0x00, 0x00, 0x00},
+			expected: common.ForkDigest{0xb5, 0x30, 0x3f, 0x2a},
+		},
+		{
+			name:     "mainnet altair",
+			version:  common.Version{0x01, 0x00, 0x00, 0x00},
+			expected: common.ForkDigest{0xaf, 0xca, 0xab, 0xa0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t,
+				tt.expected, domains.ComputeForkDigest(tt.version, gvr),
+			)
+		})
+	}
+}
+
+func TestComputeDomain(t *testing.T) {
+	tests := []struct {
+		name       string
+		domainType common.DomainType
+		version    common.Version
+		gvr        common.Root
+		expected   string
+	}{
+		{
+			name:       "mainnet deposit",
+			domainType: domains.Deposit,
+			version:    common.Version{},
+			gvr:        common.Root{},
+			expected: "0x03000000f5a5fd42d16a20302798ef6e" +
+				"d309979b43003d2320d9f0e8ea9831a9",
+		},
+		{
+			name:       "randao at genesis",
+			domainType: domains.Randao,
+			version:    common.Version{},
+			gvr:        common.Root{},
+			expected: "0x02000000f5a5fd42d16a20302798ef6e" +
+				"d309979b43003d2320d9f0e8ea9831a9",
+		},
+		{
+			name:       "mainnet altair proposer",
+			domainType: domains.Proposer,
+			version:    common.Version{0x01, 0x00, 0x00, 0x00},
+			gvr:        mustRoot(t, mainnetGenesisValidatorsRoot),
+			expected: "0x00000000afcaaba0efab1ca832a15152" +
+				"469bb09bb84641c405171dfa2d3fb45f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t,
+				common.Domain(mustRoot(t, tt.expected)),
+				domains.ComputeDomain(tt.domainType, tt.version, tt.gvr),
+			)
+		})
+	}
+}
+
+func TestComputeSigningRoot(t *testing.T) {
+	genesisDomain := func(domainType common.DomainType) common.Domain {
+		return domains.ComputeDomain(
+			domainType, common.Version{}, common.Root{},
+		)
+	}
+
+	tests := []struct {
+		name     string
+		compute  func() common.Root
+		expected string
+	}{
+		{
+			name: "zero root in the deposit domain",
+			compute: func() common.Root {
+				return domains.ComputeSigningRoot(
+					common.Root{}, genesisDomain(domains.Deposit),
+				)
+			},
+			expected: "0x2f46075466459957818ee33458b0cff1" +
+				"430dac652dd572cf80ba9a8358de2378",
+		},
+		{
+			name: "zero root object in the deposit domain",
+			compute: func() common.Root {
+				return domains.ComputeSigningRootOf(
+					rootObject{}, genesisDomain(domains.Deposit),
+				)
+			},
+			expected: "0x2f46075466459957818ee33458b0cff1" +
+				"430dac652dd572cf80ba9a8358de2378",
+		},
+		{
+			name: "epoch in the randao domain",
+			compute: func() common.Root {
+				return domains.ComputeSigningRootUInt64(
+					5, genesisDomain(domains.Randao),
+				)
+			},
+			expected: "0x99489744e3d41ee149ab44dbb9af6e03" +
+				"ef03492fb8e57cf1398c980a9e860696",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, mustRoot(t, tt.expected), tt.compute())
+		})
+	}
+}
@@ -23,19 +23,30 @@ package merkle
 import (
 	"encoding/binary"
 	"fmt"
+	"unsafe"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
 )
 
 const (
 	// 2^63 would overflow.
 	MaxTreeDepth = 62
+
+	// maxPooledLayerLen is the length of the largest tree layer that is
+	// returned to the layer pool when a tree is released.
+	maxPooledLayerLen = 1 << 16
 )
 
+// layerPool holds the backing arrays of the layers of released trees.
+//
+//nolint:gochecknoglobals // shared layer pool.
+var layerPool = pool.NewSlicePool[[32]byte](maxPooledLayerLen)
+
 // Tree[RootT] implements a Merkle tree that has been optimized to
 // handle leaves that are 32 bytes in size.
 type Tree[RootT ~[32]byte] struct {
@@ -87,7 +98,7 @@ func NewTreeFromLeavesWithDepth[RootT ~[32]byte](
 	// TODO: This should be done virtually....
 	for i := uint8(1); i <= depth; i++ {
 		layerSize := (len(leaves) + (1 << i) - 1) >> i
-		layers[i] = make([]RootT, layerSize)
+		layers[i] = getLayer[RootT](layerSize)
 	}
 
 	for d := range depth {
@@ -111,6 +122,20 @@ func NewTreeFromLeavesWithDepth[RootT ~[32]byte](
 	}, nil
 }
 
+// Release returns the layers of the tree built on top of its leaves to a
+// shared pool, so that they can be reused by the next tree. The tree must not
+// be used after it has been released. Proofs computed from the tree do not
+// share memory with it and remain valid.
+func (m *Tree[RootT]) Release() {
+	if len(m.branches) == 0 {
+		return
+	}
+	for _, layer := range m.branches[1:] {
+		putLayer(layer)
+	}
+	m.branches = nil
+}
+
 // Insert an item into the tree.
 func (m *Tree[RootT]) Insert(item [32]byte, index int) error {
 	if index < 0 {
@@ -223,3 +248,19 @@ func (m *Tree[RootT]) MerkleProofWithMixin(
 	binary.LittleEndian.PutUint64(mixin[:8], uint64(len(m.leaves)))
 	return append(proof, mixin), nil
 }
+
+// getLayer takes a layer of the given length from the layer pool.
+func getLayer[RootT ~[32]byte](n int) []RootT {
+	layer := *layerPool.Get(n)
+	//#nosec:G103 // RootT has the memory layout of [32]byte.
+	return unsafe.Slice((*RootT)(unsafe.Pointer(unsafe.SliceData(layer))), n)
+}
+
+// putLayer returns a layer to the layer pool.
+func putLayer[RootT ~[32]byte](layer []RootT) {
+	//#nosec:G103 // RootT has the memory layout of [32]byte.
+	roots := unsafe.Slice(
+		(*[32]byte)(unsafe.Pointer(unsafe.SliceData(layer))), cap(layer),
+	)
+	layerPool.Put(&roots)
+}
@@ -189,6 +189,38 @@ func TestMerkleTree_VerifyProof(t *testing.T) {
 	)
 }
 
+func TestMerkleTree_Release(t *testing.T) {
+	treeDepth := uint8(8)
+
+	items := make([][32]byte, 0)
+	for _, v := range []string{"A", "B", "C", "D", "E"} {
+		item, err := byteslib.ToBytes32(
+			byteslib.ExtendToSize([]byte(v), byteslib.B32Size),
+		)
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+
+	m, err := merkle.NewTreeFromLeavesWithDepth(items, treeDepth)
+	require.NoError(t, err)
+	root, treeRoot := m.HashTreeRoot(), m.Root()
+	proof, err := m.MerkleProof(1)
+	require.NoError(t, err)
+	m.Release()
+
+	// A tree built from released layers must match the original tree, and
+	// proofs computed before the release must remain intact.
+	for range 3 {
+		m, err = merkle.NewTreeFromLeavesWithDepth(items, treeDepth)
+		require.NoError(t, err)
+		require.Equal(t, root, m.HashTreeRoot())
+		m.Release()
+	}
+	require.True(
+		t, merkle.IsValidMerkleBranch(items[1], proof, treeDepth, 1, treeRoot),
+	)
+}
+
 func TestMerkleTree_NegativeIndexes(t *testing.T) {
 	treeDepth := uint8(32)
 	items := make([][32]byte, 0)
@@ -292,6 +324,30 @@ func BenchmarkNewTreeFromLeavesWithDepth(b *testing.B) {
 	}
 }
 
+func BenchmarkNewTreeFromLeavesWithDepth_Release(b *testing.B) {
+	treeDepth := uint8(32)
+	items := make([][32]byte, 0, 4096)
+	for i := range 4096 {
+		item, err := byteslib.ToBytes32(
+			byteslib.ExtendToSize(
+				[]byte(strconv.Itoa(i)), byteslib.B32Size,
+			),
+		)
+		require.NoError(b, err)
+		items = append(items, item)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m, err := merkle.NewTreeFromLeavesWithDepth(
+			items,
+			treeDepth,
+		)
+		require.NoError(b, err, "Could not generate Merkle tree from items")
+		m.Release()
+	}
+}
+
 func BenchmarkInsertTrie_Optimized(b *testing.B) {
 	treeDepth := uint8(32)
 	b.StopTimer()
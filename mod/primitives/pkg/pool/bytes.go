@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+// maxPooledBytes is the largest byte buffer kept by the shared byte pool.
+// It comfortably fits an execution payload with a full set of transactions.
+const maxPooledBytes = 1 << 24
+
+//nolint:gochecknoglobals // shared buffer pool.
+var bytesPool = NewSlicePool[byte](maxPooledBytes)
+
+// GetBytes returns a scratch byte buffer of length n from the shared byte
+// pool. The contents of the buffer are not zeroed.
+func GetBytes(n int) *[]byte {
+	return bytesPool.Get(n)
+}
+
+// PutBytes returns a scratch byte buffer to the shared byte pool.
+func PutBytes(buf *[]byte) {
+	bytesPool.Put(buf)
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package pool provides typed wrappers around sync.Pool for reusing objects
+// that are allocated on hot paths, e.g. while processing bursts of blocks.
+package pool
+
+import "sync"
+
+// Pool is a typed wrapper around a sync.Pool.
+type Pool[T any] struct {
+	pool sync.Pool
+}
+
+// New returns a new Pool that creates new items with the given function
+// whenever it is empty.
+func New[T any](newFn func() T) *Pool[T] {
+	return &Pool[T]{
+		pool: sync.Pool{
+			New: func() any { return newFn() },
+		},
+	}
+}
+
+// Get takes an item from the pool, creating one if the pool is empty.
+func (p *Pool[T]) Get() T {
+	//nolint:errcheck // the pool only ever holds items of type T.
+	return p.pool.Get().(T)
+}
+
+// Put returns an item to the pool. The item must not be used afterwards.
+func (p *Pool[T]) Put(item T) {
+	p.pool.Put(item)
+}
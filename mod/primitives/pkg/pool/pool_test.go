@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/pool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_GetPut(t *testing.T) {
+	var created int
+	p := pool.New(func() *[4]byte {
+		created++
+		return new([4]byte)
+	})
+
+	item := p.Get()
+	require.NotNil(t, item)
+	require.Equal(t, 1, created)
+	p.Put(item)
+}
+
+func TestSlicePool_Get(t *testing.T) {
+	p := pool.NewSlicePool[uint64](16)
+
+	for _, n := range []int{0, 1, 8, 16, 3, 32} {
+		s := p.Get(n)
+		require.Len(t, *s, n)
+		for i := range *s {
+			(*s)[i] = uint64(i)
+		}
+		p.Put(s)
+	}
+}
+
+func TestSlicePool_PutNil(t *testing.T) {
+	p := pool.NewSlicePool[byte](16)
+	require.NotPanics(t, func() { p.Put(nil) })
+}
+
+func TestGetBytes(t *testing.T) {
+	buf := pool.GetBytes(64)
+	require.Len(t, *buf, 64)
+	pool.PutBytes(buf)
+
+	buf = pool.GetBytes(128)
+	require.Len(t, *buf, 128)
+	pool.PutBytes(buf)
+}
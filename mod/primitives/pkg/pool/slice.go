@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+// SlicePool is a pool of slices whose backing arrays are reused across
+// requests for slices of varying lengths.
+type SlicePool[T any] struct {
+	pool *Pool[*[]T]
+	// maxCap is the largest capacity a slice may have to be kept in the
+	// pool, so that a single large request does not pin its memory forever.
+	maxCap int
+}
+
+// NewSlicePool returns a new SlicePool that retains slices with a capacity
+// of at most maxCap.
+func NewSlicePool[T any](maxCap int) *SlicePool[T] {
+	return &SlicePool[T]{
+		pool: New(func() *[]T {
+			s := make([]T, 0)
+			return &s
+		}),
+		maxCap: maxCap,
+	}
+}
+
+// Get returns a slice of length n. The contents of the slice are not zeroed
+// and must be overwritten by the caller.
+func (p *SlicePool[T]) Get(n int) *[]T {
+	s := p.pool.Get()
+	if cap(*s) < n {
+		*s = make([]T, n)
+	}
+	*s = (*s)[:n]
+	return s
+}
+
+// Put returns a slice to the pool. The slice must not be used afterwards.
+func (p *SlicePool[T]) Put(s *[]T) {
+	if s == nil || cap(*s) > p.maxCap {
+		return
+	}
+	*s = (*s)[:0]
+	p.pool.Put(s)
+}
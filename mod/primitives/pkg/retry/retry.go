@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package retry runs operations until they succeed, waiting between the
+// attempts for delays growing exponentially, with jitter, so that the
+// callers failing together do not retry in lockstep.
+package retry
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrBudgetExhausted is returned when an operation failed and the Budget it
+// retries under has no retry left.
+var ErrBudgetExhausted = errors.New("retry budget exhausted")
+
+// Policy decides how often and for how long a failing operation is retried.
+// The zero Policy retries immediately and forever.
+type Policy struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+	// Max caps the delay between two attempts, zero leaves it uncapped.
+	Max time.Duration
+	// Multiplier grows the delay after every retry. Values below 1 keep it
+	// constant.
+	Multiplier float64
+	// Jitter is the fraction of every delay that is randomized, from 0 for
+	// exact delays to 1 for delays anywhere from zero to twice their value.
+	Jitter float64
+	// MaxElapsed stops retrying once the next attempt would start after it
+	// elapsed since the first one, zero never stops.
+	MaxElapsed time.Duration
+	// MaxAttempts is the number of attempts after which retrying stops,
+	// zero never stops.
+	MaxAttempts uint64
+	// Budget, if set, is shared by the operations retried under the policy
+	// and bounds how many of their attempts are retries.
+	Budget *Budget
+	// OnRetry, if set, is called with the error of every failed attempt
+	// that is retried, along with the delay before the next one.
+	OnRetry func(attempt uint64, err error, delay time.Duration)
+}
+
+// Do runs op until it succeeds, returns an error wrapped by Permanent, ctx
+// is done, or the policy stops retrying. It returns the error of the last
+// attempt, unwrapped from Permanent.
+func (p Policy) Do(ctx context.Context, op func(context.Context) error) error {
+	var (
+		start = time.Now()
+		delay = p.Initial
+		timer *time.Timer
+	)
+	for attempt := uint64(1); ; attempt++ {
+		err := op(ctx)
+		if err == nil {
+			p.Budget.success()
+			return nil
+		}
+
+		var permanent *permanentError
+		switch {
+		case errors.As(err, &permanent):
+			return permanent.err
+		case ctx.Err() != nil:
+			return err
+		case p.MaxAttempts > 0 && attempt >= p.MaxAttempts:
+			return err
+		}
+
+		wait := p.jitter(delay)
+		if p.MaxElapsed > 0 && time.Since(start)+wait > p.MaxElapsed {
+			return err
+		}
+		if !p.Budget.withdraw() {
+			return errors.Join(err, ErrBudgetExhausted)
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(attempt, err, wait)
+		}
+
+		if timer == nil {
+			timer = time.NewTimer(wait)
+			defer timer.Stop()
+		} else {
+			timer.Reset(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-timer.C:
+		}
+		delay = p.next(delay)
+	}
+}
+
+// next returns the delay following delay.
+func (p Policy) next(delay time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		delay = time.Duration(float64(delay) * p.Multiplier)
+	}
+	if p.Max > 0 && delay > p.Max {
+		delay = p.Max
+	}
+	return delay
+}
+
+// jitter randomizes the Jitter fraction of delay.
+func (p Policy) jitter(delay time.Duration) time.Duration {
+	if p.Jitter <= 0 || delay <= 0 {
+		return delay
+	}
+	jitter := min(p.Jitter, 1)
+	//#nosec:G404 // jitter needs no cryptographic randomness.
+	factor := 1 - jitter + 2*jitter*rand.Float64()
+	return time.Duration(float64(delay) * factor)
+}
+
+// permanentError is an error that must not be retried.
+type permanentError struct {
+	err error
+}
+
+// Permanent wraps err so that Do returns it without retrying.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Error implements error.
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Budget bounds the share of retries among the attempts of the operations
+// sharing it, so that a failing dependency is not flooded with retries. Every
+// retry withdraws a token and every success deposits a fraction of one, up
+// to the capacity of the budget.
+type Budget struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	refill   float64
+}
+
+// NewBudget returns a full budget of capacity retries, refilled by refill
+// tokens on every success.
+func NewBudget(capacity uint64, refill float64) *Budget {
+	return &Budget{
+		tokens:   float64(capacity),
+		capacity: float64(capacity),
+		refill:   refill,
+	}
+}
+
+// withdraw takes a token for a retry, returning false if none is left. A nil
+// budget always allows the retry.
+func (b *Budget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// success refills the budget after a successful attempt.
+func (b *Budget) success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.tokens+b.refill, b.capacity)
+}
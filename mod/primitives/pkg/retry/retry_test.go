@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/retry"
+	"github.com/stretchr/testify/require"
+)
+
+var errTransient = errors.New("transient")
+
+func TestDo_RetriesUntilSuccess(t *testing.T) {
+	var (
+		calls  int
+		delays []time.Duration
+	)
+	err := retry.Policy{
+		Initial:    time.Millisecond,
+		Max:        3 * time.Millisecond,
+		Multiplier: 2,
+		OnRetry: func(_ uint64, err error, delay time.Duration) {
+			require.ErrorIs(t, err, errTransient)
+			delays = append(delays, delay)
+		},
+	}.Do(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 4 {
+			return errTransient
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, calls)
+	require.Equal(t, []time.Duration{
+		time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond,
+	}, delays)
+}
+
+func TestDo_MaxAttempts(t *testing.T) {
+	var calls int
+	err := retry.Policy{MaxAttempts: 3}.Do(
+		context.Background(),
+		func(context.Context) error {
+			calls++
+			return errTransient
+		},
+	)
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, 3, calls)
+}
+
+func TestDo_Permanent(t *testing.T) {
+	var calls int
+	err := retry.Policy{}.Do(
+		context.Background(),
+		func(context.Context) error {
+			calls++
+			return retry.Permanent(errTransient)
+		},
+	)
+	require.Equal(t, errTransient, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestDo_MaxElapsed(t *testing.T) {
+	var calls int
+	err := retry.Policy{
+		Initial:    20 * time.Millisecond,
+		MaxElapsed: 50 * time.Millisecond,
+	}.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errTransient
+	})
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, 3, calls)
+}
+
+func TestDo_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	err := retry.Policy{Initial: time.Hour}.Do(
+		ctx,
+		func(context.Context) error {
+			calls++
+			cancel()
+			return errTransient
+		},
+	)
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, 1, calls)
+}
+
+func TestDo_Jitter(t *testing.T) {
+	var delays []time.Duration
+	_ = retry.Policy{
+		Initial:     time.Millisecond,
+		Jitter:      0.5,
+		MaxAttempts: 20,
+		OnRetry: func(_ uint64, _ error, delay time.Duration) {
+			delays = append(delays, delay)
+		},
+	}.Do(context.Background(), func(context.Context) error {
+		return errTransient
+	})
+	require.Len(t, delays, 19)
+	for _, delay := range delays {
+		require.GreaterOrEqual(t, delay, time.Millisecond/2)
+		require.LessOrEqual(t, delay, 3*time.Millisecond/2)
+	}
+}
+
+func TestBudget(t *testing.T) {
+	budget := retry.NewBudget(2, 0.5)
+	policy := retry.Policy{Budget: budget}
+	failing := func(context.Context) error { return errTransient }
+
+	// Two retries are allowed before the budget is exhausted.
+	var calls int
+	err := policy.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return failing(ctx)
+	})
+	require.ErrorIs(t, err, retry.ErrBudgetExhausted)
+	require.ErrorIs(t, err, errTransient)
+	require.Equal(t, 3, calls)
+
+	// Two successes refill a single retry.
+	for range 2 {
+		require.NoError(t, policy.Do(
+			context.Background(),
+			func(context.Context) error { return nil },
+		))
+	}
+	calls = 0
+	err = policy.Do(context.Background(), func(ctx context.Context) error {
+		calls++
+		return failing(ctx)
+	})
+	require.ErrorIs(t, err, retry.ErrBudgetExhausted)
+	require.Equal(t, 2, calls)
+}
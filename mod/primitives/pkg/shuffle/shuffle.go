@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package shuffle implements the swap-or-not shuffle and the seeds derived
+// from the RANDAO mixes that drive it, as defined in the consensus specs.
+package shuffle
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// RoundCount is the number of rounds of the swap-or-not shuffle.
+	RoundCount = 90
+	// MinSeedLookahead is the number of epochs the seed is looked up ahead.
+	MinSeedLookahead = 1
+)
+
+// ComputeShuffledIndex returns the shuffled index of `index` in a list of
+// `indexCount` elements, using the swap-or-not shuffle as defined in the
+// consensus specs.
+func ComputeShuffledIndex(index, indexCount uint64, seed [32]byte) uint64 {
+	if indexCount <= 1 {
+		return index
+	}
+
+	// seed (32) + round (1) + position window (4).
+	buf := make([]byte, 37) //nolint:mnd // defined above.
+	copy(buf, seed[:])
+	for round := range uint8(RoundCount) {
+		buf[32] = round
+		pivotHash := sha256.Hash(buf[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := max(index, flip)
+
+		//#nosec:G115 // position / 256 always fits in a uint32.
+		binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+		source := sha256.Hash(buf)
+		if (source[(position%256)/8]>>(position%8))&1 == 1 {
+			index = flip
+		}
+	}
+	return index
+}
+
+// MixIndex returns the index, in a RANDAO mixes vector of the given length,
+// of the mix that seeds the given epoch.
+func MixIndex(epoch math.Epoch, epochsPerHistoricalVector uint64) uint64 {
+	return (epoch.Unwrap() + epochsPerHistoricalVector -
+		MinSeedLookahead - 1) % epochsPerHistoricalVector
+}
+
+// Seed returns get_seed(state, epoch, domain) as defined in the consensus
+// specs, given the RANDAO mix at MixIndex(epoch).
+func Seed(
+	domain common.DomainType,
+	epoch math.Epoch,
+	mix common.Bytes32,
+) [32]byte {
+	preimage := make([]byte, 0, len(domain)+8+len(mix))
+	preimage = append(preimage, domain[:]...)
+	preimage = binary.LittleEndian.AppendUint64(preimage, epoch.Unwrap())
+	preimage = append(preimage, mix[:]...)
+	return sha256.Hash(preimage)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package shuffle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/shuffle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMixIndexWrapsAround(t *testing.T) {
+	require.Equal(t, uint64(6), shuffle.MixIndex(0, 8))
+	require.Equal(t, uint64(7), shuffle.MixIndex(1, 8))
+	require.Equal(t, uint64(0), shuffle.MixIndex(2, 8))
+}
+
+func TestSeedDependsOnDomainAndEpoch(t *testing.T) {
+	mix := common.Bytes32{0x01}
+	seed := shuffle.Seed(common.DomainType{0x00}, math.Epoch(1), mix)
+	require.NotEqual(
+		t, seed, shuffle.Seed(common.DomainType{0x01}, math.Epoch(1), mix),
+	)
+	require.NotEqual(
+		t, seed, shuffle.Seed(common.DomainType{0x00}, math.Epoch(2), mix),
+	)
+}
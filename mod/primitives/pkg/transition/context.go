@@ -20,7 +20,11 @@
 
 package transition
 
-import "context"
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/correlation"
+)
 
 // Context is the context for the state transition.
 type Context struct {
@@ -38,6 +42,9 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// ProcessedDeposits are the deposits applied by the state transition,
+	// in the order they were processed.
+	ProcessedDeposits []*ProcessedDeposit
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +72,18 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetRequestID returns the correlation ID of the request the state transition
+// is run for, or an empty string if it has none.
+func (c *Context) GetRequestID() string {
+	id, _ := correlation.IDFromContext(c.Context)
+	return id
+}
+
+// RecordDeposit records a deposit applied by the state transition.
+func (c *Context) RecordDeposit(deposit *ProcessedDeposit) {
+	c.ProcessedDeposits = append(c.ProcessedDeposits, deposit)
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
@@ -22,6 +22,7 @@ package version
 
 import (
 	"encoding/binary"
+	"strings"
 )
 
 const (
@@ -34,6 +35,39 @@ const (
 	Electra
 )
 
+//nolint:gochecknoglobals // lookup table of fork names.
+var names = [...]string{
+	Phase0:    "phase0",
+	Altair:    "altair",
+	Bellatrix: "bellatrix",
+	Capella:   "capella",
+	Deneb:     "deneb",
+	DenebPlus: "denebplus",
+	Electra:   "electra",
+}
+
+// Name returns the lowercase name of the fork of the given version, as used
+// by the Eth-Consensus-Version header of the beacon API. It returns an empty
+// string if the version is unknown.
+func Name(version uint32) string {
+	if version >= uint32(len(names)) {
+		return ""
+	}
+	return names[version]
+}
+
+// FromName returns the version of the fork of the given name, matched case
+// insensitively. It reports false if no fork has the name.
+func FromName(name string) (uint32, bool) {
+	for version, n := range names {
+		if strings.EqualFold(n, name) {
+			//#nosec:G115 // there are only a handful of forks.
+			return uint32(version), true
+		}
+	}
+	return 0, false
+}
+
 // FromUint32 returns a Version from a uint32.
 func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 	versionBz := VersionT{}
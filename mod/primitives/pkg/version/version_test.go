@@ -149,3 +149,21 @@ func TestToUint32_CustomType(t *testing.T) {
 	result := version.ToUint32(input)
 	require.Equal(t, expected, result)
 }
+
+func TestName(t *testing.T) {
+	for v := version.Phase0; v <= version.Electra; v++ {
+		name := version.Name(v)
+		require.NotEmpty(t, name)
+		got, ok := version.FromName(name)
+		require.True(t, ok)
+		require.Equal(t, v, got)
+	}
+	require.Equal(t, "deneb", version.Name(version.Deneb))
+	require.Empty(t, version.Name(version.Electra+1))
+
+	got, ok := version.FromName("DENEB")
+	require.True(t, ok)
+	require.Equal(t, version.Deneb, got)
+	_, ok = version.FromName("fulu")
+	require.False(t, ok)
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// DepositPolicy decides which deposits may create a validator, letting
+// permissioned chains control validator entry. Deposits topping up an
+// existing validator are not subject to it.
+type DepositPolicy[WithdrawalCredentialsT ~[32]byte] interface {
+	// DomainType returns the domain the signature of a deposit creating a
+	// validator is verified with.
+	DomainType() common.DomainType
+	// Admit returns an error wrapping ErrDepositNotAdmitted if a deposit
+	// may not create a validator.
+	Admit(
+		pubkey crypto.BLSPubkey,
+		credentials WithdrawalCredentialsT,
+		amount math.Gwei,
+	) error
+}
+
+// SpecDepositPolicy is the DepositPolicy configured by the DepositAdmission
+// of the chain spec.
+type SpecDepositPolicy[WithdrawalCredentialsT ~[32]byte] struct {
+	// pubkeys are the public keys allowed to become validators, any if nil.
+	pubkeys map[crypto.BLSPubkey]struct{}
+	// credentials are the withdrawal credentials new validators must use,
+	// any if nil.
+	credentials map[WithdrawalCredentialsT]struct{}
+	// minAmount is the minimum amount of a deposit creating a validator.
+	minAmount math.Gwei
+	// domainType is the domain deposit signatures are verified with.
+	domainType common.DomainType
+}
+
+// NewDepositPolicy returns the deposit policy configured by the chain spec.
+func NewDepositPolicy[WithdrawalCredentialsT ~[32]byte](
+	cs common.ChainSpec,
+) (*SpecDepositPolicy[WithdrawalCredentialsT], error) {
+	admission := cs.DepositAdmission()
+	p := &SpecDepositPolicy[WithdrawalCredentialsT]{
+		minAmount:  math.Gwei(admission.MinAmount),
+		domainType: admission.DomainType,
+	}
+	if p.domainType == (common.DomainType{}) {
+		p.domainType = cs.DomainTypeDeposit()
+	}
+
+	if len(admission.Pubkeys) > 0 {
+		p.pubkeys = make(map[crypto.BLSPubkey]struct{})
+		for _, s := range admission.Pubkeys {
+			var pubkey crypto.BLSPubkey
+			if err := decodeHex(s, pubkey[:]); err != nil {
+				return nil, errors.Wrapf(err, "pubkey %s", s)
+			}
+			p.pubkeys[pubkey] = struct{}{}
+		}
+	}
+	if len(admission.WithdrawalCredentials) > 0 {
+		p.credentials = make(map[WithdrawalCredentialsT]struct{})
+		for _, s := range admission.WithdrawalCredentials {
+			var credentials WithdrawalCredentialsT
+			if err := decodeHex(s, credentials[:]); err != nil {
+				return nil, errors.Wrapf(err, "withdrawal credentials %s", s)
+			}
+			p.credentials[credentials] = struct{}{}
+		}
+	}
+	return p, nil
+}
+
+// DomainType returns the domain deposit signatures are verified with.
+func (p *SpecDepositPolicy[_]) DomainType() common.DomainType {
+	return p.domainType
+}
+
+// Admit checks the deposit against the allowlists and the minimum amount.
+func (p *SpecDepositPolicy[WithdrawalCredentialsT]) Admit(
+	pubkey crypto.BLSPubkey,
+	credentials WithdrawalCredentialsT,
+	amount math.Gwei,
+) error {
+	if amount < p.minAmount {
+		return errors.Wrapf(
+			ErrDepositNotAdmitted,
+			"amount %d below minimum %d", amount, p.minAmount,
+		)
+	}
+	if p.pubkeys != nil {
+		if _, ok := p.pubkeys[pubkey]; !ok {
+			return errors.Wrapf(
+				ErrDepositNotAdmitted, "pubkey %s not allowed", pubkey,
+			)
+		}
+	}
+	if p.credentials != nil {
+		if _, ok := p.credentials[credentials]; !ok {
+			return errors.Wrapf(
+				ErrDepositNotAdmitted,
+				"withdrawal credentials %#x not allowed", credentials[:],
+			)
+		}
+	}
+	return nil
+}
+
+// decodeHex decodes a hex string into out, which it must fill exactly.
+func decodeHex(s string, out []byte) error {
+	bz, err := hex.ToBytes(s)
+	if err != nil {
+		return err
+	}
+	if len(bz) != len(out) {
+		return errors.Wrapf(
+			ErrInvalidDepositAdmission,
+			"expected %d bytes, got %d", len(out), len(bz),
+		)
+	}
+	copy(out, bz)
+	return nil
+}
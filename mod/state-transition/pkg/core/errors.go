@@ -46,6 +46,12 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrExceedsBlockWithdrawalRequestLimit is returned when the block
+	// exceeds the withdrawal request limit.
+	ErrExceedsBlockWithdrawalRequestLimit = errors.New(
+		"block exceeds withdrawal request limit",
+	)
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -63,6 +69,14 @@ var (
 	ErrSlashedProposer = errors.New(
 		"attempted to process a block with a slashed proposer")
 
+	// ErrUnexpectedProposer is returned when a block is proposed by another
+	// validator than the one the proposer selector expects.
+	ErrUnexpectedProposer = errors.New("unexpected block proposer")
+
+	// ErrUnknownProposerSelection is returned when the chain spec configures
+	// a proposer selection rule that does not exist.
+	ErrUnknownProposerSelection = errors.New("unknown proposer selection")
+
 	// ErrStateRootMismatch is returned when the state root in a block header
 	// does not match the expected value.
 	ErrStateRootMismatch = errors.New("state root mismatch")
@@ -74,4 +88,21 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrExecutionRequestsNotActive is returned when a block carries
+	// execution requests before the fork that introduces them.
+	ErrExecutionRequestsNotActive = errors.New(
+		"execution requests are not active at this fork")
+
+	// ErrDepositNotAdmitted is returned when the deposit policy does not
+	// allow a deposit to create a validator.
+	ErrDepositNotAdmitted = errors.New("deposit not admitted")
+
+	// ErrInvalidDepositAdmission is returned when the deposit admission of
+	// the chain spec cannot be parsed.
+	ErrInvalidDepositAdmission = errors.New("invalid deposit admission")
+
+	// ErrUnknownGenesisValidator is returned when the genesis customizes a
+	// validator that none of the genesis deposits created.
+	ErrUnknownGenesisValidator = errors.New("unknown genesis validator")
 )
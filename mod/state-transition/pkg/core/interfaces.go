@@ -105,6 +105,7 @@ type WriteOnlyBeaconState[
 	SetLatestBlockHeader(BeaconBlockHeaderT) error
 	IncreaseBalance(math.ValidatorIndex, math.Gwei) error
 	DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+	SetBalance(math.ValidatorIndex, math.Gwei) error
 	UpdateSlashingAtIndex(uint64, math.Gwei) error
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
@@ -144,6 +145,7 @@ type WriteOnlyValidators[ValidatorT any] interface {
 
 	AddValidator(ValidatorT) error
 	AddValidatorBartio(ValidatorT) error
+	TombstoneValidator(math.ValidatorIndex) error
 }
 
 // ReadOnlyValidators has read access to validator methods.
@@ -155,6 +157,8 @@ type ReadOnlyValidators[ValidatorT any] interface {
 	ValidatorByIndex(
 		math.ValidatorIndex,
 	) (ValidatorT, error)
+
+	IsValidatorTombstoned(math.ValidatorIndex) (bool, error)
 }
 
 // WriteOnlyEth1Data has write access to eth1 data.
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ProposerSelector decides which validator is expected to propose the block
+// at a given slot.
+type ProposerSelector[BeaconStateT any] interface {
+	// ExpectedProposer returns the index of the validator expected to
+	// propose at the given slot. The boolean is false if any validator may
+	// propose, in which case the index must be ignored.
+	ExpectedProposer(
+		st BeaconStateT, slot math.Slot,
+	) (math.ValidatorIndex, bool, error)
+}
+
+// NewProposerSelector returns the proposer selector configured by the
+// ProposerSelection of the chain spec.
+func NewProposerSelector[BeaconStateT any](
+	cs common.ChainSpec,
+) (ProposerSelector[BeaconStateT], error) {
+	switch selection := cs.ProposerSelection(); selection {
+	case chain.ProposerSelectionConsensus:
+		return ConsensusProposerSelector[BeaconStateT]{}, nil
+	default:
+		return nil, errors.Wrapf(
+			ErrUnknownProposerSelection, "selection: %s", selection,
+		)
+	}
+}
+
+// ConsensusProposerSelector leaves proposer selection to the consensus
+// engine, which rotates proposers by voting power. Any validator may
+// propose a block.
+type ConsensusProposerSelector[BeaconStateT any] struct{}
+
+// ExpectedProposer always reports that no proposer is expected.
+func (ConsensusProposerSelector[BeaconStateT]) ExpectedProposer(
+	BeaconStateT, math.Slot,
+) (math.ValidatorIndex, bool, error) {
+	return 0, false, nil
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProcessBlockHeader_ConsensusProposers checks that with several
+// validators a block is produced at every height, whichever validator
+// CometBFT rotates in as proposer.
+func TestProcessBlockHeader_ConsensusProposers(t *testing.T) {
+	const numValidators = 4
+	sp := newTestStateProcessor(0)
+	validators := make([]*types.Validator, numValidators)
+	for i := range validators {
+		//#nosec:G115 // the number of validators is small.
+		validators[i] = newTestValidator(byte(i), 32e9, 0, 0)
+	}
+	st := newTestState(0, validators...)
+
+	produced := 0
+	for height := math.Slot(1); height <= 3*numValidators; height++ {
+		st.slot = height
+		proposer := math.ValidatorIndex(height.Unwrap() % numValidators)
+
+		// The validator chosen by CometBFT is never refused by the block
+		// builder.
+		expected, ok, err := sp.ExpectedProposer(st, height)
+		require.NoError(t, err)
+		require.False(t, ok && expected != proposer)
+
+		parent, err := st.GetLatestBlockHeader()
+		require.NoError(t, err)
+		blk := &types.BeaconBlock{
+			Slot:          height,
+			ProposerIndex: proposer,
+			ParentRoot:    parent.HashTreeRoot(),
+			Body:          (&types.BeaconBlockBody{}).Empty(version.Deneb),
+		}
+		require.NoError(t, sp.processBlockHeader(st, blk))
+		produced++
+	}
+	require.Equal(t, 3*numValidators, produced)
+}
+
+func TestNewProposerSelector_Unknown(t *testing.T) {
+	_, err := NewProposerSelector[*testState](chain.NewChainSpec(
+		testSpecData{ProposerSelection: "effective-balance"},
+	))
+	require.ErrorIs(t, err, ErrUnknownProposerSelection)
+}
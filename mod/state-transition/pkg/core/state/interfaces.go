@@ -143,4 +143,12 @@ type KVStore[
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	// TombstoneValidator records the exit of the validator at the given
+	// index.
+	TombstoneValidator(index math.ValidatorIndex) error
+	// IsValidatorTombstoned reports whether the validator at the given
+	// index has been tombstoned.
+	IsValidatorTombstoned(index math.ValidatorIndex) (bool, error)
+	// AuditRegistry returns the index anomalies found in the registry.
+	AuditRegistry() ([]string, error)
 }
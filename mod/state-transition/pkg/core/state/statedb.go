@@ -202,7 +202,12 @@ func (s *StateDB[
 		return nil, err
 	}
 
-	epoch := math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
+	var (
+		epoch               = math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
+		maxEffectiveBalance = math.Gwei(
+			s.cs.ActiveForkForSlot(slot).MaxEffectiveBalance,
+		)
+	)
 
 	withdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
@@ -250,9 +255,9 @@ func (s *StateDB[
 		if validator.IsFullyWithdrawable(balance, epoch) {
 			amount = balance
 		} else if validator.IsPartiallyWithdrawable(
-			balance, math.Gwei(s.cs.MaxEffectiveBalance()),
+			balance, maxEffectiveBalance,
 		) {
-			amount = balance - math.Gwei(s.cs.MaxEffectiveBalance())
+			amount = balance - maxEffectiveBalance
 		}
 		withdrawal = withdrawal.New(
 			math.U64(withdrawalIndex),
@@ -85,6 +85,10 @@ type StateProcessor[
 	executionEngine ExecutionEngine[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	]
+	// proposers selects the validator expected to propose each block.
+	proposers ProposerSelector[BeaconStateT]
+	// deposits decides which deposits may create a validator.
+	deposits DepositPolicy[WithdrawalCredentialsT]
 }
 
 // NewStateProcessor creates a new state processor.
@@ -137,6 +141,8 @@ func NewStateProcessor[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	signer crypto.BLSSigner,
+	proposers ProposerSelector[BeaconStateT],
+	deposits DepositPolicy[WithdrawalCredentialsT],
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
@@ -152,9 +158,21 @@ func NewStateProcessor[
 		cs:              cs,
 		executionEngine: executionEngine,
 		signer:          signer,
+		proposers:       proposers,
+		deposits:        deposits,
 	}
 }
 
+// ExpectedProposer returns the index of the validator expected to propose
+// the block at the given slot, if the chain restricts who may propose it.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ExpectedProposer(
+	st BeaconStateT, slot math.Slot,
+) (math.ValidatorIndex, bool, error) {
+	return sp.proposers.ExpectedProposer(st, slot)
+}
+
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
 	BeaconBlockT, _, _, BeaconStateT, ContextT,
@@ -305,7 +323,7 @@ func (sp *StateProcessor[
 	}
 
 	// process the deposits and ensure they match the local state.
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := sp.processOperations(ctx, st, blk); err != nil {
 		return err
 	}
 
@@ -329,14 +347,35 @@ func (sp *StateProcessor[
 }
 
 // processEpoch processes the epoch and ensures it matches the local state.
+// The registry and effective balance updates only run once the fork enabling
+// them is active, and the registry is upgraded in the epoch before it.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
+	slot, err := st.GetSlot()
+	if err != nil {
 		return nil, err
-	} else if err = sp.processSlashingsReset(st); err != nil {
+	}
+
+	epoch := sp.cs.SlotToEpoch(slot)
+	if err = sp.processRewardsAndPenalties(st); err != nil {
+		return nil, err
+	}
+	switch {
+	case sp.cs.ActiveForkForEpoch(epoch).RegistryUpdates:
+		if err = sp.processRegistryUpdates(st); err != nil {
+			return nil, err
+		} else if err = sp.processEffectiveBalanceUpdates(st); err != nil {
+			return nil, err
+		}
+	case sp.cs.ActiveForkForEpoch(epoch + 1).RegistryUpdates:
+		if err = sp.upgradeRegistry(st); err != nil {
+			return nil, err
+		}
+	}
+	if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
@@ -391,13 +430,8 @@ func (sp *StateProcessor[
 	}
 
 	// Ensure the block is within the acceptable range.
-	// TODO: move this is in the wrong spot.
-	deposits := blk.GetBody().GetDeposits()
-	if uint64(len(deposits)) > sp.cs.MaxDepositsPerBlock() {
-		return errors.Wrapf(ErrExceedsBlockDepositLimit,
-			"expected: %d, got: %d",
-			sp.cs.MaxDepositsPerBlock(), len(deposits),
-		)
+	if err = sp.validateOperationLimits(blk); err != nil {
+		return err
 	}
 
 	// Calculate the body root to place on the header.
@@ -425,6 +459,17 @@ func (sp *StateProcessor[
 			ErrSlashedProposer, "index: %d", blk.GetProposerIndex(),
 		)
 	}
+
+	// Ensure the block comes from the expected proposer, if any.
+	expected, ok, err := sp.proposers.ExpectedProposer(st, blk.GetSlot())
+	if err != nil {
+		return err
+	} else if ok && expected != blk.GetProposerIndex() {
+		return errors.Wrapf(
+			ErrUnexpectedProposer, "expected: %d, got: %d",
+			expected, blk.GetProposerIndex(),
+		)
+	}
 	return nil
 }
 
@@ -506,3 +551,32 @@ func (sp *StateProcessor[
 
 	return nil
 }
+
+// validateOperationLimits ensures the block does not carry more operations
+// than the active fork allows, which may be fewer than the SSZ list limits.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) validateOperationLimits(blk BeaconBlockT) error {
+	var (
+		fork = sp.cs.ActiveForkForSlot(blk.GetSlot())
+		body = blk.GetBody()
+	)
+	deposits := body.GetDeposits()
+	if uint64(len(deposits)) > fork.MaxDepositsPerBlock {
+		return errors.Wrapf(ErrExceedsBlockDepositLimit,
+			"expected: %d, got: %d",
+			fork.MaxDepositsPerBlock, len(deposits),
+		)
+	}
+
+	// Exits are requested on the execution layer, through withdrawal
+	// requests.
+	if requests := body.GetExecutionRequests(); requests != nil &&
+		uint64(len(requests.Withdrawals)) > fork.MaxWithdrawalRequestsPerBlock {
+		return errors.Wrapf(ErrExceedsBlockWithdrawalRequestLimit,
+			"expected: %d, got: %d",
+			fork.MaxWithdrawalRequestsPerBlock, len(requests.Withdrawals),
+		)
+	}
+	return nil
+}
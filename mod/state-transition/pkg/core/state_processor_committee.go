@@ -21,29 +21,48 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates processes the sync committee updates. Only
+// validators that are active in the next epoch are part of the set, and
+// validators that leave the active set are removed with a zero power update.
+// Before the registry updates, every validator is part of the set.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+
 	vals, err := st.GetValidatorsByEffectiveBalance()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
+	var (
+		epoch           = sp.cs.SlotToEpoch(slot)
+		nextEpoch       = epoch + 1
+		registryUpdates = sp.cs.ActiveForkForEpoch(nextEpoch).RegistryUpdates
+		updates         = make(transition.ValidatorUpdates, 0, len(vals))
 	)
+	for _, val := range vals {
+		switch {
+		case !registryUpdates, val.IsActive(nextEpoch):
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: val.GetEffectiveBalance(),
+			})
+		case val.IsActive(epoch):
+			updates = append(updates, &transition.ValidatorUpdate{
+				Pubkey:           val.GetPubkey(),
+				EffectiveBalance: math.Gwei(0),
+			})
+		}
+	}
+	return updates, nil
 }
@@ -44,6 +44,7 @@ func (sp *StateProcessor[
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
+	genesisValidators []*transition.GenesisValidator,
 	executionPayloadHeader ExecutionPayloadHeaderT,
 	genesisVersion common.Version,
 ) (transition.ValidatorUpdates, error) {
@@ -104,12 +105,17 @@ func (sp *StateProcessor[
 		}
 	}
 
-	// TODO: process activations.
 	validators, err := st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
 
+	if err = sp.activateGenesisValidators(
+		st, validators, genesisValidators,
+	); err != nil {
+		return nil, err
+	}
+
 	// Handle special case bartio genesis.
 	if sp.cs.DepositEth1ChainID() == bArtioChainID {
 		if err = st.SetGenesisValidatorsRoot(
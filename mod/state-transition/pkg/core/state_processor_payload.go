@@ -111,13 +111,14 @@ func (sp *StateProcessor[
 		)
 	}
 
-	// Verify the number of blobs.
+	// Verify the number of blobs against the limit of the active fork.
 	blobKzgCommitments := body.GetBlobKzgCommitments()
-	if uint64(len(blobKzgCommitments)) > sp.cs.MaxBlobsPerBlock() {
+	maxBlobs := sp.cs.ActiveForkForSlot(blk.GetSlot()).MaxBlobsPerBlock
+	if uint64(len(blobKzgCommitments)) > maxBlobs {
 		return errors.Wrapf(
 			ErrExceedsBlockBlobLimit,
 			"expected: %d, got: %d",
-			sp.cs.MaxBlobsPerBlock(), len(blobKzgCommitments),
+			maxBlobs, len(blobKzgCommitments),
 		)
 	}
 
@@ -153,13 +154,15 @@ func (sp *StateProcessor[
 	}
 
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
+	req := engineprimitives.BuildNewPayloadRequest(
+		payload,
+		body.GetBlobKzgCommitments().ToVersionedHashes(),
+		&parentBeaconBlockRoot,
+		optimisticEngine,
+	)
+	req.ExecutionRequests = body.GetExecutionRequests()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
-		ctx, engineprimitives.BuildNewPayloadRequest(
-			payload,
-			body.GetBlobKzgCommitments().ToVersionedHashes(),
-			&parentBeaconBlockRoot,
-			optimisticEngine,
-		),
+		ctx, req,
 	); err != nil {
 		return err
 	}
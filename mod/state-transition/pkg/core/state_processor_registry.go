@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// processRegistryUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#modified-process_registry_updates
+//
+// CometBFT provides single slot finality, hence the current epoch is used as
+// the finalized epoch.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) processRegistryUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		currentEpoch        = sp.cs.SlotToEpoch(slot)
+		finalizedEpoch      = currentEpoch
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+		ejectionBalance     = math.Gwei(sp.cs.EjectionBalance())
+		activationQueue     []math.ValidatorIndex
+	)
+
+	// Process activation eligibility and ejections.
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if val.IsEligibleForActivationQueue(maxEffectiveBalance) {
+			val.SetActivationEligibilityEpoch(currentEpoch + 1)
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+
+		if val.IsActive(currentEpoch) &&
+			val.GetEffectiveBalance() <= ejectionBalance {
+			if err = sp.initiateValidatorExit(
+				st, validators, idx, currentEpoch,
+			); err != nil {
+				return err
+			}
+		}
+
+		if val.IsEligibleForActivation(finalizedEpoch) {
+			activationQueue = append(activationQueue, idx)
+		}
+
+		if val.GetExitEpoch() <= currentEpoch {
+			if err = sp.tombstoneValidator(st, idx); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Queue validators eligible for activation and not yet dequeued for
+	// activation, ordered by eligibility epoch and then by index.
+	slices.SortStableFunc(activationQueue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			validators[a].GetActivationEligibilityEpoch(),
+			validators[b].GetActivationEligibilityEpoch(),
+		)
+	})
+
+	// Dequeue validators for activation up to the activation churn limit.
+	churnLimit := sp.cs.ActivationChurnLimit(
+		countActiveValidators([]ValidatorT(validators), currentEpoch),
+	)
+	activationEpoch := sp.cs.ActivationExitEpoch(currentEpoch)
+	for _, idx := range activationQueue[:min(
+		uint64(len(activationQueue)), churnLimit,
+	)] {
+		validators[idx].SetActivationEpoch(activationEpoch)
+		if err = st.UpdateValidatorAtIndex(idx, validators[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, ValidatorsT,
+	_, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	validators ValidatorsT,
+	idx math.ValidatorIndex,
+	currentEpoch math.Epoch,
+) error {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	val := validators[idx]
+	if val.GetExitEpoch() != farFutureEpoch {
+		return nil
+	}
+
+	// Compute the exit queue epoch.
+	exitQueueEpoch := sp.cs.ActivationExitEpoch(currentEpoch)
+	for _, v := range validators {
+		if exitEpoch := v.GetExitEpoch(); exitEpoch != farFutureEpoch {
+			exitQueueEpoch = max(exitQueueEpoch, exitEpoch)
+		}
+	}
+
+	var exitQueueChurn uint64
+	for _, v := range validators {
+		if v.GetExitEpoch() == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= sp.cs.ValidatorChurnLimit(
+		countActiveValidators([]ValidatorT(validators), currentEpoch),
+	) {
+		exitQueueEpoch++
+	}
+
+	// Set the validator exit and withdrawable epochs.
+	val.SetExitEpoch(exitQueueEpoch)
+	val.SetWithdrawableEpoch(
+		exitQueueEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// tombstoneValidator tombstones the exited validator at the given index, if
+// not done already, so that its index is never assigned to a new deposit.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) tombstoneValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	tombstoned, err := st.IsValidatorTombstoned(idx)
+	if err != nil || tombstoned {
+		return err
+	}
+	return st.TombstoneValidator(idx)
+}
+
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0
+// specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		balance                   math.Gwei
+		fork                      = sp.cs.ActiveForkForSlot(slot)
+		effectiveBalanceIncrement = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		activationBalance         = math.Gwei(sp.cs.MaxEffectiveBalance())
+		hysteresisIncrement       = effectiveBalanceIncrement /
+			math.Gwei(sp.cs.HysteresisQuotient())
+		downwardThreshold = hysteresisIncrement *
+			math.Gwei(sp.cs.HysteresisDownwardMultiplier())
+		upwardThreshold = hysteresisIncrement *
+			math.Gwei(sp.cs.HysteresisUpwardMultiplier())
+	)
+
+	// Update effective balances with hysteresis.
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if balance, err = st.GetBalance(idx); err != nil {
+			return err
+		}
+
+		// Validators that are not yet eligible for activation stay capped at
+		// the activation balance, which they must match exactly to enter the
+		// activation queue.
+		limit := activationBalance
+		if val.GetActivationEligibilityEpoch() !=
+			math.Epoch(constants.FarFutureEpoch) {
+			limit = sp.maxEffectiveBalance(val, fork)
+		}
+
+		effectiveBalance := val.GetEffectiveBalance()
+		if balance+downwardThreshold < effectiveBalance ||
+			effectiveBalance+upwardThreshold < balance {
+			val.SetEffectiveBalance(min(
+				balance-balance%effectiveBalanceIncrement,
+				limit,
+			))
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// maxEffectiveBalance as defined in the Ethereum 2.0 specification. Only
+// validators with compounding withdrawal credentials may exceed the
+// activation balance, once the given fork enables them.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_max_effective_balance
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) maxEffectiveBalance(
+	val ValidatorT,
+	fork chain.Fork[math.Epoch],
+) math.Gwei {
+	if fork.CompoundingCredentials &&
+		val.HasCompoundingWithdrawalCredentials() {
+		return math.Gwei(fork.MaxEffectiveBalance)
+	}
+	return math.Gwei(sp.cs.MaxEffectiveBalance())
+}
+
+// activateGenesisValidators sets the effective balances of the validators in
+// the genesis state from their balances, once the balances customized by the
+// genesis are applied. Validators customized by the genesis are activated at
+// their activation epoch, the others only if they have reached the maximum
+// effective balance. Before the registry updates, no validator is activated.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, ValidatorsT, _, _, _,
+]) activateGenesisValidators(
+	st BeaconStateT,
+	validators ValidatorsT,
+	genesisValidators []*transition.GenesisValidator,
+) error {
+	var (
+		genesisEpoch              = math.Epoch(constants.GenesisEpoch)
+		fork                      = sp.cs.ActiveForkForEpoch(genesisEpoch)
+		effectiveBalanceIncrement = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		activationBalance         = math.Gwei(sp.cs.MaxEffectiveBalance())
+		activationEpochs          = make(
+			map[math.ValidatorIndex]math.Epoch, len(genesisValidators),
+		)
+	)
+	for _, gv := range genesisValidators {
+		idx, err := st.ValidatorIndexByPubkey(gv.Pubkey)
+		if err != nil {
+			return errors.Wrapf(ErrUnknownGenesisValidator, "%s", gv.Pubkey)
+		}
+		if gv.Balance != 0 {
+			if err = st.SetBalance(idx, gv.Balance); err != nil {
+				return err
+			}
+		}
+		activationEpochs[idx] = gv.ActivationEpoch
+	}
+
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		balance, err := st.GetBalance(idx)
+		if err != nil {
+			return err
+		}
+
+		// As in processEffectiveBalanceUpdates, only validators eligible for
+		// activation may exceed the activation balance.
+		activationEpoch, custom := activationEpochs[idx]
+		limit := activationBalance
+		if custom {
+			limit = sp.maxEffectiveBalance(val, fork)
+		}
+		val.SetEffectiveBalance(
+			min(balance-balance%effectiveBalanceIncrement, limit),
+		)
+
+		switch {
+		case !fork.RegistryUpdates:
+		case custom:
+			val.SetActivationEligibilityEpoch(genesisEpoch)
+			val.SetActivationEpoch(activationEpoch)
+		case val.GetEffectiveBalance() == activationBalance:
+			val.SetActivationEligibilityEpoch(genesisEpoch)
+			val.SetActivationEpoch(genesisEpoch)
+		}
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeRegistry upgrades the registry in the epoch before the registry
+// updates are enabled. Every validator was part of the validator set until
+// then, so the validators that are not yet activated are activated in the
+// next epoch. Their balances are raised to their effective balances, which
+// absorbed the top ups before.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) upgradeRegistry(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		balance        math.Gwei
+		nextEpoch      = sp.cs.SlotToEpoch(slot) + 1
+		farFutureEpoch = math.Epoch(constants.FarFutureEpoch)
+	)
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if val.GetActivationEpoch() != farFutureEpoch {
+			continue
+		}
+
+		if balance, err = st.GetBalance(idx); err != nil {
+			return err
+		}
+		if effectiveBalance := val.GetEffectiveBalance(); balance <
+			effectiveBalance {
+			if err = st.SetBalance(idx, effectiveBalance); err != nil {
+				return err
+			}
+		}
+
+		val.SetActivationEligibilityEpoch(nextEpoch)
+		val.SetActivationEpoch(nextEpoch)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countActiveValidators returns the number of validators active at the
+// given epoch.
+func countActiveValidators[ValidatorT interface {
+	IsActive(math.Epoch) bool
+}](
+	validators []ValidatorT,
+	epoch math.Epoch,
+) uint64 {
+	var count uint64
+	for _, val := range validators {
+		if val.IsActive(epoch) {
+			count++
+		}
+	}
+	return count
+}
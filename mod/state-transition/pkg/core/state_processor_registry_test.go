@@ -0,0 +1,595 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlotsPerEpoch    = 4
+	testMaxSeedLookahead = 1
+	testChurnLimit       = 2
+)
+
+var (
+	errUnknownValidator = errors.New("unknown validator")
+	farFutureEpoch      = math.Epoch(constants.FarFutureEpoch)
+)
+
+// testState is an in-memory beacon state implementing the methods used by
+// the registry updates. Validators are returned by value, as from the
+// store, so updates are only visible once written back.
+type testState struct {
+	BeaconState[
+		*testState, *types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, any, *types.Validator,
+		types.Validators, *engineprimitives.Withdrawal,
+	]
+	slot         math.Slot
+	validators   types.Validators
+	balances     []math.Gwei
+	tombstoned   map[math.ValidatorIndex]bool
+	latestHeader *types.BeaconBlockHeader
+}
+
+func newTestState(slot math.Slot, validators ...*types.Validator) *testState {
+	st := &testState{
+		slot:       slot,
+		tombstoned: make(map[math.ValidatorIndex]bool),
+	}
+	for _, val := range validators {
+		st.validators = append(st.validators, val)
+		st.balances = append(st.balances, val.GetEffectiveBalance())
+	}
+	return st
+}
+
+func (s *testState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+func (s *testState) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	if s.latestHeader == nil {
+		return &types.BeaconBlockHeader{}, nil
+	}
+	return s.latestHeader, nil
+}
+
+func (s *testState) SetLatestBlockHeader(
+	header *types.BeaconBlockHeader,
+) error {
+	s.latestHeader = header
+	return nil
+}
+
+func (s *testState) GetValidators() (types.Validators, error) {
+	validators := make(types.Validators, len(s.validators))
+	for i, val := range s.validators {
+		v := *val
+		validators[i] = &v
+	}
+	return validators, nil
+}
+
+func (s *testState) ValidatorByIndex(
+	idx math.ValidatorIndex,
+) (*types.Validator, error) {
+	if idx.Unwrap() >= uint64(len(s.validators)) {
+		return nil, errUnknownValidator
+	}
+	v := *s.validators[idx]
+	return &v, nil
+}
+
+func (s *testState) ValidatorIndexByPubkey(
+	pubkey crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	for i, val := range s.validators {
+		if val.GetPubkey() == pubkey {
+			return math.ValidatorIndex(i), nil
+		}
+	}
+	return 0, errUnknownValidator
+}
+
+func (s *testState) UpdateValidatorAtIndex(
+	idx math.ValidatorIndex,
+	val *types.Validator,
+) error {
+	v := *val
+	s.validators[idx] = &v
+	return nil
+}
+
+func (s *testState) AddValidator(val *types.Validator) error {
+	s.validators = append(s.validators, val)
+	s.balances = append(s.balances, 0)
+	return nil
+}
+
+func (s *testState) IsValidatorTombstoned(
+	idx math.ValidatorIndex,
+) (bool, error) {
+	return s.tombstoned[idx], nil
+}
+
+func (s *testState) TombstoneValidator(idx math.ValidatorIndex) error {
+	s.tombstoned[idx] = true
+	return nil
+}
+
+func (s *testState) GetBalance(idx math.ValidatorIndex) (math.Gwei, error) {
+	return s.balances[idx], nil
+}
+
+func (s *testState) SetBalance(
+	idx math.ValidatorIndex,
+	balance math.Gwei,
+) error {
+	s.balances[idx] = balance
+	return nil
+}
+
+func (s *testState) IncreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] += delta
+	return nil
+}
+
+func (s *testState) DecreaseBalance(
+	idx math.ValidatorIndex,
+	delta math.Gwei,
+) error {
+	s.balances[idx] -= min(s.balances[idx], delta)
+	return nil
+}
+
+func (s *testState) GetValidatorsByEffectiveBalance() (
+	[]*types.Validator, error,
+) {
+	return s.GetValidators()
+}
+
+func (s *testState) UpdateSlashingAtIndex(uint64, math.Gwei) error {
+	return nil
+}
+
+func (s *testState) GetRandaoMixAtIndex(uint64) (common.Bytes32, error) {
+	return common.Bytes32{}, nil
+}
+
+func (s *testState) UpdateRandaoMixAtIndex(uint64, common.Bytes32) error {
+	return nil
+}
+
+// testSpecData is the chain spec data of the test state processor.
+type testSpecData = chain.SpecData[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+]
+
+// testSigner accepts every signature.
+type testSigner struct {
+	crypto.BLSSigner
+}
+
+func (testSigner) VerifySignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	return nil
+}
+
+// newTestStateProcessor returns a state processor whose registry updates
+// are enabled from the given epoch. The spec data may be adjusted by opts.
+func newTestStateProcessor(
+	registryUpdatesEpoch math.Epoch,
+	opts ...func(*testSpecData),
+) *StateProcessor[
+	*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	*testState, *transition.Context, *types.Deposit, *types.Eth1Data,
+	*types.ExecutionPayload, *types.ExecutionPayloadHeader, *types.Fork,
+	*types.ForkData, any, *types.Validator, types.Validators,
+	*engineprimitives.Withdrawal, engineprimitives.Withdrawals,
+	types.WithdrawalCredentials,
+] {
+	data := testSpecData{
+		MaxEffectiveBalance:              32e9,
+		EjectionBalance:                  16e9,
+		EffectiveBalanceIncrement:        1e9,
+		HysteresisQuotient:               4,
+		HysteresisDownwardMultiplier:     1,
+		HysteresisUpwardMultiplier:       5,
+		SlotsPerEpoch:                    testSlotsPerEpoch,
+		MaxSeedLookahead:                 testMaxSeedLookahead,
+		MinValidatorWithdrawabilityDelay: 8,
+		MinPerEpochChurnLimit:            testChurnLimit,
+		ChurnLimitQuotient:               65536,
+		MaxPerEpochActivationChurnLimit:  testChurnLimit,
+		EpochsPerHistoricalVector:        8,
+		EpochsPerSlashingsVector:         8,
+		DenebPlusForkEpoch:               registryUpdatesEpoch,
+		ElectraForkEpoch:                 farFutureEpoch,
+	}
+	for _, opt := range opts {
+		opt(&data)
+	}
+	cs := chain.NewChainSpec(data)
+	deposits, err := NewDepositPolicy[types.WithdrawalCredentials](cs)
+	if err != nil {
+		panic(err)
+	}
+	proposers, err := NewProposerSelector[*testState](cs)
+	if err != nil {
+		panic(err)
+	}
+	return NewStateProcessor[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+		*testState, *transition.Context, *types.Deposit, *types.Eth1Data,
+		*types.ExecutionPayload, *types.ExecutionPayloadHeader, *types.Fork,
+		*types.ForkData, any, *types.Validator, types.Validators,
+		*engineprimitives.Withdrawal, engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, nil, testSigner{}, proposers, deposits)
+}
+
+// newTestValidator returns a validator with the given effective balance,
+// eligible for activation from eligibilityEpoch and active from
+// activationEpoch.
+func newTestValidator(
+	id byte,
+	effectiveBalance math.Gwei,
+	eligibilityEpoch, activationEpoch math.Epoch,
+) *types.Validator {
+	return &types.Validator{
+		Pubkey:                     crypto.BLSPubkey{id},
+		EffectiveBalance:           effectiveBalance,
+		ActivationEligibilityEpoch: eligibilityEpoch,
+		ActivationEpoch:            activationEpoch,
+		ExitEpoch:                  farFutureEpoch,
+		WithdrawableEpoch:          farFutureEpoch,
+	}
+}
+
+func TestProcessRegistryUpdates_ChurnLimitsActivations(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	currentEpoch := math.Epoch(1)
+	st := newTestState(
+		math.Slot(currentEpoch*testSlotsPerEpoch),
+		newTestValidator(0, 32e9, 1, farFutureEpoch),
+		newTestValidator(1, 32e9, 0, farFutureEpoch),
+		newTestValidator(2, 32e9, 0, farFutureEpoch),
+		newTestValidator(3, 32e9, 1, farFutureEpoch),
+		newTestValidator(4, 32e9, farFutureEpoch, farFutureEpoch),
+	)
+	require.NoError(t, sp.processRegistryUpdates(st))
+
+	// The queue is ordered by eligibility epoch, then by index, and only
+	// the churn limit is dequeued.
+	activationEpoch := currentEpoch + 1 + testMaxSeedLookahead
+	for idx, expected := range []math.Epoch{
+		farFutureEpoch, activationEpoch, activationEpoch,
+		farFutureEpoch, farFutureEpoch,
+	} {
+		require.Equal(t, expected, st.validators[idx].GetActivationEpoch(),
+			"validator %d", idx)
+	}
+
+	// The validator at the activation balance enters the queue for the
+	// next epoch.
+	require.Equal(t, currentEpoch+1,
+		st.validators[4].GetActivationEligibilityEpoch())
+
+	// The remaining validators are dequeued on the next epoch.
+	st.slot += testSlotsPerEpoch
+	require.NoError(t, sp.processRegistryUpdates(st))
+	require.Equal(t, activationEpoch+1, st.validators[0].GetActivationEpoch())
+	require.Equal(t, activationEpoch+1, st.validators[3].GetActivationEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[4].GetActivationEpoch())
+}
+
+func TestProcessRegistryUpdates_EjectsAndTombstones(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	currentEpoch := math.Epoch(2)
+	st := newTestState(
+		math.Slot(currentEpoch*testSlotsPerEpoch),
+		newTestValidator(0, 32e9, 0, 0),
+		newTestValidator(1, 16e9, 0, 0),
+		newTestValidator(2, 17e9, 0, 0),
+	)
+	require.NoError(t, sp.processRegistryUpdates(st))
+
+	// Only the validator at the ejection balance is exited, through the
+	// exit queue.
+	exitEpoch := currentEpoch + 1 + testMaxSeedLookahead
+	require.Equal(t, exitEpoch, st.validators[1].GetExitEpoch())
+	require.Equal(t, exitEpoch+8, st.validators[1].GetWithdrawableEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[0].GetExitEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[2].GetExitEpoch())
+	require.False(t, st.tombstoned[1])
+
+	// Once exited, the validator is tombstoned.
+	st.slot = math.Slot(exitEpoch * testSlotsPerEpoch)
+	require.NoError(t, sp.processRegistryUpdates(st))
+	require.True(t, st.tombstoned[1])
+	require.False(t, st.tombstoned[0])
+}
+
+func TestProcessEffectiveBalanceUpdates_Hysteresis(t *testing.T) {
+	tests := []struct {
+		name     string
+		balance  math.Gwei
+		expected math.Gwei
+	}{
+		{
+			name:     "small decrease is ignored",
+			balance:  31.8e9,
+			expected: 32e9,
+		},
+		{
+			name:     "decrease past the downward threshold",
+			balance:  31.7e9,
+			expected: 31e9,
+		},
+		{
+			name:     "increase below the upward threshold",
+			balance:  33.2e9,
+			expected: 32e9,
+		},
+		{
+			name:     "increase is capped at the maximum",
+			balance:  40e9,
+			expected: 32e9,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := newTestStateProcessor(0)
+			st := newTestState(0, newTestValidator(0, 32e9, 0, 0))
+			st.balances[0] = tt.balance
+			require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+			require.Equal(t, tt.expected,
+				st.validators[0].GetEffectiveBalance())
+		})
+	}
+
+	t.Run("increase past the upward threshold", func(t *testing.T) {
+		sp := newTestStateProcessor(0)
+		st := newTestState(0, newTestValidator(0, 20e9, 0, 0))
+		st.balances[0] = 21.2e9
+		require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+		require.Equal(t, math.Gwei(20e9),
+			st.validators[0].GetEffectiveBalance())
+
+		st.balances[0] = 21.3e9
+		require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+		require.Equal(t, math.Gwei(21e9),
+			st.validators[0].GetEffectiveBalance())
+	})
+}
+
+func TestApplyDeposit_TopUpDeferredToEpoch(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	st := newTestState(1, newTestValidator(0, 20e9, 0, 0))
+
+	// A top-up only credits the balance.
+	require.NoError(t, sp.applyDeposit(st, &types.Deposit{
+		Pubkey: crypto.BLSPubkey{0},
+		Amount: 4e9,
+	}))
+	require.Equal(t, math.Gwei(24e9), st.balances[0])
+	require.Equal(t, math.Gwei(20e9), st.validators[0].GetEffectiveBalance())
+
+	// The effective balance follows at the epoch boundary.
+	require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+	require.Equal(t, math.Gwei(24e9), st.validators[0].GetEffectiveBalance())
+}
+
+func TestActivateGenesisValidators_ActivationThreshold(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	st := newTestState(0,
+		newTestValidator(0, 0, farFutureEpoch, farFutureEpoch),
+		newTestValidator(1, 0, farFutureEpoch, farFutureEpoch),
+		newTestValidator(2, 0, farFutureEpoch, farFutureEpoch),
+	)
+	st.balances = []math.Gwei{32e9, 31.5e9, 8e9}
+
+	validators, err := st.GetValidators()
+	require.NoError(t, err)
+	require.NoError(t, sp.activateGenesisValidators(
+		st, validators, []*transition.GenesisValidator{{
+			Pubkey:          crypto.BLSPubkey{2},
+			Balance:         40e9,
+			ActivationEpoch: 3,
+		}},
+	))
+
+	// Validators at the activation balance are active from genesis.
+	require.Equal(t, math.Gwei(32e9), st.validators[0].GetEffectiveBalance())
+	require.Equal(t, math.Epoch(0), st.validators[0].GetActivationEpoch())
+
+	// Validators below it wait to be topped up.
+	require.Equal(t, math.Gwei(31e9), st.validators[1].GetEffectiveBalance())
+	require.Equal(t, farFutureEpoch, st.validators[1].GetActivationEpoch())
+	require.Equal(t, farFutureEpoch,
+		st.validators[1].GetActivationEligibilityEpoch())
+
+	// Validators customized by the genesis take its balance and activation
+	// epoch.
+	require.Equal(t, math.Gwei(40e9), st.balances[2])
+	require.Equal(t, math.Gwei(32e9), st.validators[2].GetEffectiveBalance())
+	require.Equal(t, math.Epoch(3), st.validators[2].GetActivationEpoch())
+}
+
+func TestApplyDeposit_TopUpBeforeRegistryUpdates(t *testing.T) {
+	sp := newTestStateProcessor(farFutureEpoch)
+	st := newTestState(1,
+		newTestValidator(0, 20e9, farFutureEpoch, farFutureEpoch),
+	)
+
+	// Before the registry updates, a top-up is applied to the effective
+	// balance, capped at the maximum.
+	require.NoError(t, sp.applyDeposit(st, &types.Deposit{
+		Pubkey: crypto.BLSPubkey{0},
+		Amount: 16e9,
+	}))
+	require.Equal(t, math.Gwei(20e9), st.balances[0])
+	require.Equal(t, math.Gwei(32e9), st.validators[0].GetEffectiveBalance())
+}
+
+func TestActivateGenesisValidators_BeforeRegistryUpdates(t *testing.T) {
+	sp := newTestStateProcessor(farFutureEpoch)
+	st := newTestState(0,
+		newTestValidator(0, 0, farFutureEpoch, farFutureEpoch),
+	)
+	st.balances = []math.Gwei{32e9}
+
+	validators, err := st.GetValidators()
+	require.NoError(t, err)
+	require.NoError(t, sp.activateGenesisValidators(st, validators, nil))
+	require.Equal(t, math.Gwei(32e9), st.validators[0].GetEffectiveBalance())
+	require.Equal(t, farFutureEpoch, st.validators[0].GetActivationEpoch())
+}
+
+func TestProcessEpoch_UpgradesRegistry(t *testing.T) {
+	const forkEpoch = math.Epoch(2)
+	sp := newTestStateProcessor(forkEpoch)
+
+	// A registry as left by the processing before the registry updates: no
+	// validator is activated, and top-ups were only applied to the effective
+	// balances.
+	st := newTestState(0,
+		newTestValidator(0, 32e9, farFutureEpoch, farFutureEpoch),
+		newTestValidator(1, 20e9, farFutureEpoch, farFutureEpoch),
+		newTestValidator(2, 8e9, farFutureEpoch, farFutureEpoch),
+	)
+	st.balances[1] = 10e9
+
+	// processEpoch runs on the last slot of the given epoch.
+	processEpoch := func(epoch math.Epoch) map[byte]math.Gwei {
+		st.slot = math.Slot((epoch+1)*testSlotsPerEpoch - 1)
+		updates, err := sp.processEpoch(st)
+		require.NoError(t, err)
+		powers := make(map[byte]math.Gwei, len(updates))
+		for _, update := range updates {
+			powers[update.Pubkey[0]] = update.EffectiveBalance
+		}
+		return powers
+	}
+	baselineSet := map[byte]math.Gwei{0: 32e9, 1: 20e9, 2: 8e9}
+
+	// Before the fork, every validator is part of the set.
+	require.Equal(t, baselineSet, processEpoch(0))
+	require.Equal(t, farFutureEpoch, st.validators[0].GetActivationEpoch())
+
+	// In the epoch before the fork, the validators are activated for the
+	// fork epoch and their balances catch up with their effective balances.
+	require.Equal(t, baselineSet, processEpoch(forkEpoch-1))
+	for idx, val := range st.validators {
+		require.Equal(t, forkEpoch, val.GetActivationEpoch(),
+			"validator %d", idx)
+		require.Equal(t, forkEpoch, val.GetActivationEligibilityEpoch(),
+			"validator %d", idx)
+		require.Equal(t, val.GetEffectiveBalance(), st.balances[idx],
+			"validator %d", idx)
+	}
+
+	// From the fork, the registry updates eject the validator below the
+	// ejection balance, which then leaves the set.
+	exitEpoch := forkEpoch + 1 + testMaxSeedLookahead
+	require.Equal(t, baselineSet, processEpoch(forkEpoch))
+	require.Equal(t, exitEpoch, st.validators[2].GetExitEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[0].GetExitEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[1].GetExitEpoch())
+	require.Equal(t, map[byte]math.Gwei{0: 32e9, 1: 20e9, 2: 0},
+		processEpoch(exitEpoch-1))
+}
+
+func TestCreateValidator_AnyCredentials(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	st := newTestState(0)
+
+	// Deposits are never consumed without creating a validator because of
+	// their withdrawal credentials, whatever the fork.
+	unknownPrefix := types.WithdrawalCredentials{0x07}
+	padding := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0xde, 0xad},
+	)
+	padding[1] = 0x01
+	compounding := types.NewCompoundingCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0xbe, 0xef},
+	)
+	for i, credentials := range []types.WithdrawalCredentials{
+		unknownPrefix, padding, compounding,
+	} {
+		require.NoError(t, sp.createValidator(st, &types.Deposit{
+			Pubkey:      crypto.BLSPubkey{byte(i)},
+			Credentials: credentials,
+			Amount:      32e9,
+		}))
+		require.Equal(t, credentials,
+			st.validators[i].GetWithdrawalCredentials())
+		require.Equal(t, math.Gwei(32e9), st.balances[i])
+	}
+}
+
+func TestProcessEffectiveBalanceUpdates_Compounding(t *testing.T) {
+	const electraEpoch = math.Epoch(1)
+	sp := newTestStateProcessor(0, func(data *testSpecData) {
+		data.ElectraForkEpoch = electraEpoch
+		data.ElectraForkParams.MaxEffectiveBalance = 64e9
+	})
+	eth1 := newTestValidator(0, 32e9, 0, 0)
+	eth1.WithdrawalCredentials = types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0xde, 0xad},
+	)
+	compounding := newTestValidator(1, 32e9, 0, 0)
+	compounding.WithdrawalCredentials = types.
+		NewCompoundingCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0xbe, 0xef},
+		)
+	st := newTestState(0, eth1, compounding)
+	st.balances = []math.Gwei{50e9, 50e9}
+
+	// Before the fork, compounding validators are capped at the activation
+	// balance.
+	require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+	require.Equal(t, math.Gwei(32e9), st.validators[0].GetEffectiveBalance())
+	require.Equal(t, math.Gwei(32e9), st.validators[1].GetEffectiveBalance())
+
+	// From the fork, only compounding validators may exceed it.
+	st.slot = math.Slot(electraEpoch * testSlotsPerEpoch)
+	require.NoError(t, sp.processEffectiveBalanceUpdates(st))
+	require.Equal(t, math.Gwei(32e9), st.validators[0].GetEffectiveBalance())
+	require.Equal(t, math.Gwei(50e9), st.validators[1].GetEffectiveBalance())
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processExecutionRequests validates the execution requests of the block and
+// dispatches each of them to the handler of its type, in the order defined
+// by EIP-7685. As in the consensus specs, a request that cannot be applied
+// is skipped rather than invalidating the block.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processExecutionRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	requests := blk.GetBody().GetExecutionRequests()
+	if requests.IsEmpty() {
+		return nil
+	}
+
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return ErrExecutionRequestsNotActive
+	}
+
+	if err := requests.Validate(); err != nil {
+		return err
+	}
+
+	// Deposits are still processed from the deposit contract logs, which
+	// the deposit requests duplicate.
+	//
+	// TODO: apply once deposits are sourced from requests.
+	for _, req := range requests.Withdrawals {
+		if err := sp.processWithdrawalRequest(
+			st, blk.GetSlot(), req,
+		); err != nil {
+			return err
+		}
+	}
+	// TODO: apply consolidation requests once validator consolidations are
+	// supported.
+	return nil
+}
+
+// processWithdrawalRequest as defined in the Ethereum 2.0 specification.
+// Only full exits are applied.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+// TODO: apply partial withdrawals once pending partial withdrawals are
+// supported.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	slot math.Slot,
+	req *engineprimitives.WithdrawalRequest,
+) error {
+	if req.Amount != 0 {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(req.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // requests for unknown validators are skipped.
+		return nil
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		val          = validators[idx]
+		credentials  = [32]byte(val.GetWithdrawalCredentials())
+		currentEpoch = sp.cs.SlotToEpoch(slot)
+	)
+	switch {
+	case !val.HasExecutionWithdrawalCredentials(),
+		common.ExecutionAddress(credentials[12:]) != req.SourceAddress,
+		!val.IsActive(currentEpoch),
+		val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch):
+		return nil
+	default:
+		return sp.initiateValidatorExit(st, validators, idx, currentEpoch)
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessExecutionRequests_FullExits(t *testing.T) {
+	sp := newTestStateProcessor(0, func(data *testSpecData) {
+		data.ElectraForkEpoch = 0
+	})
+	address := common.ExecutionAddress{0xde, 0xad}
+	eth1 := newTestValidator(0, 32e9, 0, 0)
+	eth1.WithdrawalCredentials = types.NewCredentialsFromExecutionAddress(
+		address,
+	)
+	bls := newTestValidator(1, 32e9, 0, 0)
+	other := newTestValidator(2, 32e9, 0, 0)
+	other.WithdrawalCredentials = types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0xbe, 0xef},
+	)
+	currentEpoch := math.Epoch(2)
+	st := newTestState(
+		math.Slot(currentEpoch*testSlotsPerEpoch), eth1, bls, other,
+	)
+
+	exit := func(id byte) *engineprimitives.WithdrawalRequest {
+		return &engineprimitives.WithdrawalRequest{
+			SourceAddress:   address,
+			ValidatorPubkey: crypto.BLSPubkey{id},
+		}
+	}
+	partial := exit(0)
+	partial.Amount = 1e9
+	blk := &types.BeaconBlock{
+		Slot: st.slot,
+		Body: &types.BeaconBlockBody{
+			ExecutionRequests: &engineprimitives.ExecutionRequests{
+				Withdrawals: []*engineprimitives.WithdrawalRequest{
+					partial, exit(0), exit(1), exit(2), exit(3),
+				},
+			},
+		},
+	}
+	require.NoError(t, sp.processExecutionRequests(st, blk))
+
+	// Only the validator withdrawing to the source address exits, requests
+	// that cannot be applied are skipped.
+	exitEpoch := currentEpoch + 1 + testMaxSeedLookahead
+	require.Equal(t, exitEpoch, st.validators[0].GetExitEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[1].GetExitEpoch())
+	require.Equal(t, farFutureEpoch, st.validators[2].GetExitEpoch())
+}
+
+func TestProcessExecutionRequests_BeforeElectra(t *testing.T) {
+	sp := newTestStateProcessor(0)
+	st := newTestState(0, newTestValidator(0, 32e9, 0, 0))
+	blk := &types.BeaconBlock{
+		Body: &types.BeaconBlockBody{
+			ExecutionRequests: &engineprimitives.ExecutionRequests{
+				Withdrawals: []*engineprimitives.WithdrawalRequest{{}},
+			},
+		},
+	}
+	require.ErrorIs(t,
+		sp.processExecutionRequests(st, blk), ErrExecutionRequestsNotActive,
+	)
+}
+
+func TestValidateOperationLimits_WithdrawalRequests(t *testing.T) {
+	sp := newTestStateProcessor(0, func(data *testSpecData) {
+		data.MaxDepositsPerBlock = 16
+		data.MaxWithdrawalRequestsPerBlock = 2
+		data.ElectraForkEpoch = 0
+	})
+	blk := &types.BeaconBlock{
+		Body: &types.BeaconBlockBody{
+			ExecutionRequests: &engineprimitives.ExecutionRequests{
+				Withdrawals: []*engineprimitives.WithdrawalRequest{{}, {}},
+			},
+		},
+	}
+	require.NoError(t, sp.validateOperationLimits(blk))
+
+	blk.Body.ExecutionRequests.Withdrawals = append(
+		blk.Body.ExecutionRequests.Withdrawals,
+		&engineprimitives.WithdrawalRequest{},
+	)
+	require.ErrorIs(t,
+		sp.validateOperationLimits(blk), ErrExceedsBlockWithdrawalRequestLimit,
+	)
+}
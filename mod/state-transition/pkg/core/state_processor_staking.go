@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
@@ -31,8 +32,9 @@ import (
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processOperations(
+	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
@@ -48,7 +50,7 @@ func (sp *StateProcessor[
 		return err
 	}
 	depositCount := min(
-		sp.cs.MaxDepositsPerBlock(),
+		sp.cs.ActiveForkForSlot(blk.GetSlot()).MaxDepositsPerBlock,
 		eth1Data.GetDepositCount().Unwrap()-index,
 	)
 	_ = depositCount
@@ -56,22 +58,49 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+	if err = sp.processDeposits(ctx, st, deposits); err != nil {
+		return err
+	}
+	return sp.processExecutionRequests(st, blk)
 }
 
 // processDeposits processes the deposits and ensures  they match the
-// local state.
+// local state. The deposits that credit a validator are recorded in ctx.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconStateT, ContextT, DepositT, _, _, _, _, _, _, _, _, _, _, _,
 ]) processDeposits(
+	ctx ContextT,
 	st BeaconStateT,
 	deposits []DepositT,
 ) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
 	// Ensure the deposits match the local state.
 	for _, dep := range deposits {
-		if err := sp.processDeposit(st, dep); err != nil {
+		var depositIndex uint64
+		depositIndex, err = st.GetEth1DepositIndex()
+		if err != nil {
+			return err
+		}
+		if err = sp.processDeposit(st, dep); err != nil {
 			return err
 		}
+
+		// A deposit that was not admitted credits no validator.
+		idx, idxErr := st.ValidatorIndexByPubkey(dep.GetPubkey())
+		if idxErr != nil {
+			continue
+		}
+		ctx.RecordDeposit(&transition.ProcessedDeposit{
+			Pubkey:         dep.GetPubkey(),
+			Index:          math.U64(depositIndex),
+			ValidatorIndex: idx,
+			Amount:         dep.GetAmount(),
+			Slot:           slot,
+		})
 	}
 	return nil
 }
@@ -105,15 +134,23 @@ func (sp *StateProcessor[
 	dep DepositT,
 ) error {
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
-	// If the validator already exists, we update the balance.
+	// If the validator already exists, we update the balance. The effective
+	// balance follows once per epoch in processEffectiveBalanceUpdates.
 	if err == nil {
-		var val ValidatorT
-		val, err = st.ValidatorByIndex(idx)
-		if err != nil {
+		var slot math.Slot
+		if slot, err = st.GetSlot(); err != nil {
 			return err
 		}
+		if sp.cs.ActiveForkForSlot(slot).RegistryUpdates {
+			return st.IncreaseBalance(idx, dep.GetAmount())
+		}
 
-		// TODO: Modify balance here and then effective balance once per epoch.
+		// Before the registry updates, the top up is applied to the
+		// effective balance directly.
+		var val ValidatorT
+		if val, err = st.ValidatorByIndex(idx); err != nil {
+			return err
+		}
 		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
 			math.Gwei(sp.cs.MaxEffectiveBalance())))
 		return st.UpdateValidatorAtIndex(idx, val)
@@ -124,7 +161,9 @@ func (sp *StateProcessor[
 	return sp.createValidator(st, dep)
 }
 
-// createValidator creates a validator if the deposit is valid.
+// createValidator creates a validator if the deposit is valid. A deposit the
+// deposit policy does not admit is consumed without creating a validator, as
+// the deposits must be processed in order.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
 ]) createValidator(
@@ -165,12 +204,20 @@ func (sp *StateProcessor[
 				sp.cs.ActiveForkVersionForEpoch(epoch),
 			), genesisValidatorsRoot,
 		),
-		sp.cs.DomainTypeDeposit(),
+		sp.deposits.DomainType(),
 		sp.signer.VerifySignature,
 	); err != nil {
 		return err
 	}
 
+	if err = sp.deposits.Admit(
+		dep.GetPubkey(), dep.GetWithdrawalCredentials(), dep.GetAmount(),
+	); errors.Is(err, ErrDepositNotAdmitted) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
 	// Add the validator to the registry.
 	return sp.addValidatorToRegistry(st, dep)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // BeaconBlock represents a generic interface for a beacon block.
@@ -81,6 +82,9 @@ type BeaconBlockBody[
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetExecutionRequests returns the requests triggered on the execution
+	// layer, nil before the fork that introduces them.
+	GetExecutionRequests() *engineprimitives.ExecutionRequests
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -118,6 +122,8 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// RecordDeposit records a deposit applied by the state transition.
+	RecordDeposit(deposit *transition.ProcessedDeposit)
 }
 
 // Deposit is the interface for a deposit.
@@ -220,6 +226,15 @@ type Validator[
 	) ValidatorT
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// HasExecutionWithdrawalCredentials returns true if the validator
+	// withdraws to an execution address.
+	HasExecutionWithdrawalCredentials() bool
+	// HasCompoundingWithdrawalCredentials returns true if the validator has
+	// the withdrawal credentials of a compounding validator.
+	HasCompoundingWithdrawalCredentials() bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -227,8 +242,32 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(epoch math.Epoch) bool
+	// IsEligibleForActivation returns true if the validator is eligible for
+	// activation given the finalized epoch.
+	IsEligibleForActivation(finalizedEpoch math.Epoch) bool
+	// IsEligibleForActivationQueue returns true if the validator is eligible
+	// to be placed into the activation queue.
+	IsEligibleForActivationQueue(maxEffectiveBalance math.Gwei) bool
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// SetActivationEligibilityEpoch sets the epoch in which the validator
+	// became eligible for activation.
+	SetActivationEligibilityEpoch(math.Epoch)
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// SetActivationEpoch sets the epoch in which the validator activates.
+	SetActivationEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch in which the validator exits.
+	SetExitEpoch(math.Epoch)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
 }
 
 type Validators interface {
@@ -44,6 +44,7 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	ValidatorTombstonePrefix
 )
 
 //nolint:lll
@@ -71,4 +72,42 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	ValidatorTombstonePrefixHumanReadable               = "ValidatorTombstonePrefix"
 )
+
+//nolint:gochecknoglobals,lll // lookup table of the names above.
+var humanReadable = [...]string{
+	WithdrawalQueuePrefix:                  WithdrawalQueuePrefixHumanReadable,
+	RandaoMixPrefix:                        RandaoMixPrefixHumanReadable,
+	SlashingsPrefix:                        SlashingsPrefixHumanReadable,
+	TotalSlashingPrefix:                    TotalSlashingPrefixHumanReadable,
+	ValidatorIndexPrefix:                   ValidatorIndexPrefixHumanReadable,
+	BlockRootsPrefix:                       BlockRootsPrefixHumanReadable,
+	StateRootsPrefix:                       StateRootsPrefixHumanReadable,
+	ValidatorByIndexPrefix:                 ValidatorByIndexPrefixHumanReadable,
+	ValidatorPubkeyToIndexPrefix:           ValidatorPubkeyToIndexPrefixHumanReadable,
+	ValidatorConsAddrToIndexPrefix:         ValidatorConsAddrToIndexPrefixHumanReadable,
+	ValidatorEffectiveBalanceToIndexPrefix: ValidatorEffectiveBalanceToIndexPrefixHumanReadable,
+	LatestBeaconBlockHeaderPrefix:          LatestBeaconBlockHeaderPrefixHumanReadable,
+	SlotPrefix:                             SlotPrefixHumanReadable,
+	BalancesPrefix:                         BalancesPrefixHumanReadable,
+	Eth1BlockHashPrefix:                    Eth1BlockHashPrefixHumanReadable,
+	Eth1DataPrefix:                         Eth1DataPrefixHumanReadable,
+	Eth1DepositIndexPrefix:                 Eth1DepositIndexPrefixHumanReadable,
+	LatestExecutionPayloadHeaderPrefix:     LatestExecutionPayloadHeaderPrefixHumanReadable,
+	LatestExecutionPayloadVersionPrefix:    LatestExecutionPayloadVersionPrefixHumanReadable,
+	GenesisValidatorsRootPrefix:            GenesisValidatorsRootPrefixHumanReadable,
+	NextWithdrawalIndexPrefix:              NextWithdrawalIndexPrefixHumanReadable,
+	NextWithdrawalValidatorIndexPrefix:     NextWithdrawalValidatorIndexPrefixHumanReadable,
+	ForkPrefix:                             ForkPrefixHumanReadable,
+	ValidatorTombstonePrefix:               ValidatorTombstonePrefixHumanReadable,
+}
+
+// HumanReadable returns the human readable name of the collection stored under
+// prefix, or an empty string if prefix is unknown.
+func HumanReadable(prefix byte) string {
+	if int(prefix) >= len(humanReadable) {
+		return ""
+	}
+	return humanReadable[prefix]
+}
@@ -29,7 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/ephemeral"
 )
 
 // KVStore is a wrapper around an sdk.Context
@@ -93,6 +93,9 @@ type KVStore[
 	]
 	// balances stores the list of balances.
 	balances sdkcollections.Map[uint64, uint64]
+	// tombstones stores the pubkey of every exited validator by its index,
+	// so that the index is never handed out again.
+	tombstones sdkcollections.Map[uint64, []byte]
 	// nextWithdrawalIndex stores the next global withdrawal index.
 	nextWithdrawalIndex sdkcollections.Item[uint64]
 	// nextWithdrawalValidatorIndex stores the next withdrawal validator index
@@ -138,7 +141,10 @@ func New[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ] {
-	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
+	// The stores are opened as overlays in the contexts of the copies.
+	schemaBuilder := sdkcollections.NewSchemaBuilder(
+		ephemeral.NewKVStoreService(kss),
+	)
 	return &KVStore[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ForkT, ValidatorT, ValidatorsT,
@@ -225,6 +231,13 @@ func New[
 			sdkcollections.Uint64Key,
 			sdkcollections.Uint64Value,
 		),
+		tombstones: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ValidatorTombstonePrefix}),
+			keys.ValidatorTombstonePrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
 		randaoMix: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.RandaoMixPrefix}),
@@ -270,7 +283,10 @@ func New[
 	}
 }
 
-// Copy returns a copy of the Store.
+// Copy returns a copy of the Store backed by a copy-on-write overlay of the
+// current context. Reads fall through to the Store until a key is written
+// in the copy, writes never reach the Store, and the copy is discarded by
+// dropping it.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
@@ -278,10 +294,7 @@ func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ] {
-	// TODO: Decouple the KVStore type from the Cosmos-SDK.
-	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
-	ss := kv.WithContext(cctx)
-	return ss
+	return kv.WithContext(ephemeral.WithOverlay(kv.ctx))
 }
 
 // Context returns the context of the Store.
@@ -22,6 +22,7 @@ package beacondb
 
 import (
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -32,7 +33,7 @@ func (kv *KVStore[
 	ForkT, ValidatorT, ValidatorsT,
 ]) AddValidator(val ValidatorT) error {
 	// Get the next validator index from the sequence.
-	idx, err := kv.validatorIndex.Next(kv.ctx)
+	idx, err := kv.nextValidatorIndex()
 	if err != nil {
 		return err
 	}
@@ -50,8 +51,8 @@ func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ]) AddValidatorBartio(val ValidatorT) error {
-	// Get the ne
-	idx, err := kv.validatorIndex.Next(kv.ctx)
+	// Get the next validator index from the sequence.
+	idx, err := kv.nextValidatorIndex()
 	if err != nil {
 		return err
 	}
@@ -65,6 +66,33 @@ func (kv *KVStore[
 	return kv.balances.Set(kv.ctx, idx, val.GetEffectiveBalance().Unwrap())
 }
 
+// nextValidatorIndex takes the next index from the sequence. Indices are
+// assigned in order of registration and never reused, so the index must hold
+// neither a validator nor the tombstone of an exited one.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) nextValidatorIndex() (uint64, error) {
+	idx, err := kv.validatorIndex.Next(kv.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	taken, err := kv.validators.Has(kv.ctx, idx)
+	if err != nil {
+		return 0, err
+	} else if taken {
+		return 0, errors.Wrapf(ErrValidatorIndexInUse, "index %d", idx)
+	}
+
+	if taken, err = kv.tombstones.Has(kv.ctx, idx); err != nil {
+		return 0, err
+	} else if taken {
+		return 0, errors.Wrapf(ErrValidatorIndexTombstoned, "index %d", idx)
+	}
+	return idx, nil
+}
+
 // UpdateValidatorAtIndex updates a validator at a specific index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -76,7 +104,9 @@ func (kv *KVStore[
 	return kv.validators.Set(kv.ctx, index.Unwrap(), val)
 }
 
-// ValidatorIndexByPubkey returns the validator address by index.
+// ValidatorIndexByPubkey returns the index of the validator with the given
+// pubkey. The lookup is a single read of the persisted pubkey index, which
+// is kept up to date on every write to the registry.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
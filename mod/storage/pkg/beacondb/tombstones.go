@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// TombstoneValidator records that the validator at the given index has
+// exited. The tombstone keeps the pubkey of the validator, so that a later
+// write of another validator to the index can be detected.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) TombstoneValidator(index math.ValidatorIndex) error {
+	val, err := kv.validators.Get(kv.ctx, index.Unwrap())
+	if err != nil {
+		return err
+	}
+	if val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) {
+		return errors.Wrapf(ErrValidatorNotExited, "index %d", index)
+	}
+	pubkey := val.GetPubkey()
+	return kv.tombstones.Set(kv.ctx, index.Unwrap(), pubkey[:])
+}
+
+// IsValidatorTombstoned reports whether the validator at the given index
+// has been tombstoned.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) IsValidatorTombstoned(index math.ValidatorIndex) (bool, error) {
+	return kv.tombstones.Has(kv.ctx, index.Unwrap())
+}
+
+// AuditRegistry checks the validator registry for index anomalies and
+// returns a description of each one found. It checks that validators occupy
+// the indices handed out by the sequence without gaps, that the pubkey
+// index and the balances agree with the validators, and that every
+// tombstone still holds the exited validator it was written for.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AuditRegistry() ([]string, error) {
+	next, err := kv.validatorIndex.Peek(kv.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies, count, err := kv.auditValidators(next)
+	if err != nil {
+		return nil, err
+	}
+	if count != next {
+		anomalies = append(anomalies, fmt.Sprintf(
+			"registry holds %d validators, sequence handed out %d indices",
+			count, next,
+		))
+	}
+
+	tombstoned, err := kv.auditTombstones()
+	if err != nil {
+		return nil, err
+	}
+	return append(anomalies, tombstoned...), nil
+}
+
+// auditValidators checks every stored validator against the sequence, the
+// pubkey index and the balances, returning the anomalies found and the
+// number of validators.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) auditValidators(next uint64) ([]string, uint64, error) {
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer iter.Close()
+
+	var (
+		anomalies []string
+		count     uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		entry, eErr := iter.KeyValue()
+		if eErr != nil {
+			return nil, 0, eErr
+		}
+		idx, val := entry.Key, entry.Value
+
+		switch {
+		case idx >= next:
+			anomalies = append(anomalies, fmt.Sprintf(
+				"validator %d is beyond the next index %d", idx, next,
+			))
+		case idx != count:
+			anomalies = append(anomalies, fmt.Sprintf(
+				"validator %d follows a gap at index %d", idx, count,
+			))
+		}
+		count++
+
+		if problem, pErr := kv.auditPubkeyIndex(idx, val); pErr != nil {
+			return nil, 0, pErr
+		} else if problem != "" {
+			anomalies = append(anomalies, problem)
+		}
+
+		hasBalance, bErr := kv.balances.Has(kv.ctx, idx)
+		if bErr != nil {
+			return nil, 0, bErr
+		} else if !hasBalance {
+			anomalies = append(anomalies, fmt.Sprintf(
+				"validator %d has no balance", idx,
+			))
+		}
+	}
+	return anomalies, count, nil
+}
+
+// auditPubkeyIndex checks that the pubkey index maps the pubkey of the
+// validator back to its index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) auditPubkeyIndex(idx uint64, val ValidatorT) (string, error) {
+	pubkey := val.GetPubkey()
+	indexed, err := kv.validators.Indexes.Pubkey.MatchExact(
+		kv.ctx, pubkey[:],
+	)
+	switch {
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return fmt.Sprintf("validator %d is missing from the pubkey index",
+			idx), nil
+	case err != nil:
+		return "", err
+	case indexed != idx:
+		return fmt.Sprintf("validator %d is indexed by pubkey as %d",
+			idx, indexed), nil
+	default:
+		return "", nil
+	}
+}
+
+// auditTombstones checks that every tombstone is held by the exited
+// validator it was written for.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) auditTombstones() ([]string, error) {
+	iter, err := kv.tombstones.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var anomalies []string
+	for ; iter.Valid(); iter.Next() {
+		entry, eErr := iter.KeyValue()
+		if eErr != nil {
+			return nil, eErr
+		}
+
+		val, vErr := kv.validators.Get(kv.ctx, entry.Key)
+		switch {
+		case errors.Is(vErr, sdkcollections.ErrNotFound):
+			anomalies = append(anomalies, fmt.Sprintf(
+				"tombstone %d has no validator", entry.Key,
+			))
+			continue
+		case vErr != nil:
+			return nil, vErr
+		}
+
+		pubkey := val.GetPubkey()
+		if !bytes.Equal(pubkey[:], entry.Value) {
+			anomalies = append(anomalies, fmt.Sprintf(
+				"tombstone %d was reused by validator %s",
+				entry.Key, pubkey,
+			))
+		}
+		if val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) {
+			anomalies = append(anomalies, fmt.Sprintf(
+				"tombstone %d is held by a validator that has not exited",
+				entry.Key,
+			))
+		}
+	}
+	return anomalies, nil
+}
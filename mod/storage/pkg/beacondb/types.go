@@ -37,4 +37,6 @@ type Validator[SelfT any] interface {
 	GetEffectiveBalance() math.Gwei
 	// IsActive checks if the validator is active at the given epoch.
 	IsActive(epoch math.Epoch) bool
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
 }
@@ -21,38 +21,71 @@
 package block
 
 import (
+	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/hotkeys"
+)
+
+const (
+	// rootEntrySize is the size of an entry keyed by a root.
+	rootEntrySize = common.RootSize + 8
+	// numberEntrySize is the size of an entry keyed by an execution number.
+	numberEntrySize = 8 + 8
+	// summaryEntrySize is the size of a block summary keyed by its slot.
+	summaryEntrySize = 8 + 8 + 3*common.RootSize + 8
 )
 
 // KVStore is a simple memory store based implementation that stores metadata of
 // beacon blocks.
 type KVStore[BeaconBlockT BeaconBlock] struct {
-	blockRoots       *lru.Cache[common.Root, math.Slot]
-	executionNumbers *lru.Cache[math.U64, math.Slot]
-	stateRoots       *lru.Cache[common.Root, math.Slot]
+	blockRoots       *cache.LRU[common.Root, math.Slot]
+	executionNumbers *cache.LRU[math.U64, math.Slot]
+	stateRoots       *cache.LRU[common.Root, math.Slot]
+	summaries        *cache.LRU[math.Slot, common.BlockSummary]
+
+	// latest is the highest slot set in the store.
+	latest atomic.Uint64
 
 	logger log.Logger
+	tracer *hotkeys.Tracer
 }
 
-// NewStore creates a new block store.
+// NewStore creates a new block store, whose entries are charged to the given
+// budget. A nil budget leaves the store bounded by the availability window
+// only. The lookups made on the store are sampled by tracer, if not nil.
 func NewStore[BeaconBlockT BeaconBlock](
 	logger log.Logger,
 	availabilityWindow int,
+	budget *cache.Budget,
+	tracer *hotkeys.Tracer,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
+	blockRoots, err := cache.NewLRU(
+		budget, "block_roots", availabilityWindow, rootEntry,
+	)
+	if err != nil {
+		panic(err)
+	}
+	executionNumbers, err := cache.NewLRU(
+		budget, "execution_numbers", availabilityWindow, numberEntry,
+	)
 	if err != nil {
 		panic(err)
 	}
-	executionNumbers, err := lru.New[math.U64, math.Slot](availabilityWindow)
+	stateRoots, err := cache.NewLRU(
+		budget, "state_roots", availabilityWindow, rootEntry,
+	)
 	if err != nil {
 		panic(err)
 	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
+	summaries, err := cache.NewLRU(
+		budget, "block_summaries", availabilityWindow, summaryEntry,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -60,18 +93,46 @@ func NewStore[BeaconBlockT BeaconBlock](
 		blockRoots:       blockRoots,
 		executionNumbers: executionNumbers,
 		stateRoots:       stateRoots,
+		summaries:        summaries,
 		logger:           logger,
+		tracer:           tracer,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// execution number, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
+// execution number, state root and summary. Only this function may
+// potentially evict entries from the store if the availability window is
+// reached.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
+	var (
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+	kv.blockRoots.Add(blockRoot, slot)
 	kv.executionNumbers.Add(blk.GetExecutionNumber(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	kv.stateRoots.Add(stateRoot, slot)
+	kv.summaries.Add(slot, common.BlockSummary{
+		Slot:          slot,
+		BlockRoot:     blockRoot,
+		ParentRoot:    blk.GetParentBlockRoot(),
+		StateRoot:     stateRoot,
+		ProposerIndex: blk.GetProposerIndex(),
+	})
+	if slot.Unwrap() > kv.latest.Load() {
+		kv.latest.Store(slot.Unwrap())
+	}
+	return nil
+}
+
+// SetBatch stores a group of blocks in slot order. It is equivalent to calling
+// Set for each block, and lets callers amortise writes over several blocks.
+func (kv *KVStore[BeaconBlockT]) SetBatch(blks []BeaconBlockT) error {
+	for _, blk := range blks {
+		if err := kv.Set(blk); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -79,6 +140,7 @@ func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
+	kv.tracer.Record("block_roots", blockRoot[:])
 	slot, ok := kv.blockRoots.Peek(blockRoot)
 	if !ok {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
@@ -91,6 +153,9 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 	executionNumber math.U64,
 ) (math.Slot, error) {
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], executionNumber.Unwrap())
+	kv.tracer.Record("execution_numbers", key[:])
 	slot, ok := kv.executionNumbers.Peek(executionNumber)
 	if !ok {
 		return 0, fmt.Errorf(
@@ -105,9 +170,48 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
+	kv.tracer.Record("state_roots", stateRoot[:])
 	slot, ok := kv.stateRoots.Peek(stateRoot)
 	if !ok {
 		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
 	}
 	return slot, nil
 }
+
+// GetSummariesInRange returns the summaries of the blocks stored from slot
+// from to slot to, both included, in slot order. Slots without a block in
+// the store are left out. Every slot of the range up to the latest block is
+// looked up, so callers bound its length.
+func (kv *KVStore[BeaconBlockT]) GetSummariesInRange(
+	from, to math.Slot,
+) []common.BlockSummary {
+	to = min(to, math.Slot(kv.latest.Load()))
+	if from > to {
+		return nil
+	}
+	size := min((to-from).Unwrap()+1, uint64(kv.summaries.Len()))
+	summaries := make([]common.BlockSummary, 0, size)
+	for slot := from; ; slot++ {
+		if summary, ok := kv.summaries.Peek(slot); ok {
+			summaries = append(summaries, summary)
+		}
+		if slot == to {
+			return summaries
+		}
+	}
+}
+
+// rootEntry returns the size of an entry keyed by a root.
+func rootEntry(common.Root, math.Slot) uint64 {
+	return rootEntrySize
+}
+
+// numberEntry returns the size of an entry keyed by an execution number.
+func numberEntry(math.U64, math.Slot) uint64 {
+	return numberEntrySize
+}
+
+// summaryEntry returns the size of a block summary keyed by its slot.
+func summaryEntry(math.Slot, common.BlockSummary) uint64 {
+	return summaryEntrySize
+}
@@ -42,6 +42,14 @@ func (m MockBeaconBlock) HashTreeRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetParentBlockRoot() common.Root {
+	return [32]byte{byte(m.slot - 1)}
+}
+
+func (m MockBeaconBlock) GetProposerIndex() math.ValidatorIndex {
+	return m.slot % 2
+}
+
 func (m MockBeaconBlock) GetExecutionNumber() math.U64 {
 	return m.slot
 }
@@ -51,7 +59,9 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 }
 
 func TestBlockStore(t *testing.T) {
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	blockStore := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, nil, nil,
+	)
 
 	var (
 		slot math.Slot
@@ -86,3 +96,53 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetSlotByExecutionNumber(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStoreSetBatch(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 3, nil, nil,
+	)
+
+	blks := make([]*MockBeaconBlock, 0, 4)
+	for i := 1; i <= 4; i++ {
+		blks = append(blks, &MockBeaconBlock{slot: math.Slot(i)})
+	}
+	require.NoError(t, blockStore.SetBatch(blks))
+
+	// The batch evicts in order, as if the blocks were set one by one.
+	for i := math.Slot(2); i <= 4; i++ {
+		slot, err := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+	}
+	_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(1)})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStoreGetSummariesInRange(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](
+		noop.NewLogger[any](), 5, nil, nil,
+	)
+	for _, i := range []math.Slot{1, 2, 3, 5, 6, 7} {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: i}))
+	}
+
+	// Slot 1 was evicted and slot 4 has no block.
+	summaries := blockStore.GetSummariesInRange(0, 10)
+	slots := make([]math.Slot, 0, len(summaries))
+	for _, summary := range summaries {
+		slots = append(slots, summary.Slot)
+	}
+	require.Equal(t, []math.Slot{2, 3, 5, 6, 7}, slots)
+
+	require.Equal(t, common.BlockSummary{
+		Slot:          5,
+		BlockRoot:     [32]byte{5},
+		ParentRoot:    [32]byte{4},
+		StateRoot:     [32]byte{5},
+		ProposerIndex: 1,
+	}, summaries[2])
+
+	require.Len(t, blockStore.GetSummariesInRange(6, 6), 1)
+	require.Empty(t, blockStore.GetSummariesInRange(7, 6))
+	require.Len(t, blockStore.GetSummariesInRange(7, ^math.Slot(0)), 1)
+}
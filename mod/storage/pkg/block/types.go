@@ -26,10 +26,12 @@ import (
 )
 
 // BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), execution number, and state root.
+// tree root), parent root, proposer, execution number, and state root.
 type BeaconBlock interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
+	GetParentBlockRoot() common.Root
+	GetProposerIndex() math.ValidatorIndex
 	GetExecutionNumber() math.U64
 	GetStateRoot() common.Root
 }
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Budget caps the memory held by a set of caches. The caches charge the size
+// of their entries to the budget, and when an insertion takes them over the
+// cap, the least recently used entries of the largest cache are evicted until
+// they fit again. A nil Budget tracks nothing and never evicts.
+type Budget struct {
+	// mu protects the fields below.
+	mu sync.Mutex
+	// maxBytes is the cap, zero if there is none.
+	maxBytes uint64
+	// used is the number of bytes charged by all caches.
+	used uint64
+	// accounts are the registered caches, by name.
+	accounts map[string]*account
+	metrics  *metrics
+}
+
+// account tracks the bytes charged by a single cache.
+type account struct {
+	name  string
+	bytes uint64
+	cache evictable
+}
+
+// NewBudget returns a Budget capping the caches registered with it at
+// maxBytes, zero meaning no cap.
+func NewBudget(maxBytes uint64, sink TelemetrySink) *Budget {
+	return &Budget{
+		maxBytes: maxBytes,
+		accounts: make(map[string]*account),
+		metrics:  newMetrics(sink),
+	}
+}
+
+// Used returns the number of bytes held by all caches.
+func (b *Budget) Used() uint64 {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// register opens an account for the cache with the given name.
+func (b *Budget) register(name string, cache evictable) (*account, error) {
+	if b == nil {
+		return &account{name: name, cache: cache}, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.accounts[name]; ok {
+		return nil, errors.Wrap(ErrDuplicateCache, name)
+	}
+	a := &account{name: name, cache: cache}
+	b.accounts[name] = a
+	return a, nil
+}
+
+// charge adds n bytes to the account.
+func (b *Budget) charge(a *account, n uint64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	a.bytes += n
+	b.used += n
+	b.metrics.setBytes(a.name, a.bytes)
+	b.metrics.setTotalBytes(b.used)
+}
+
+// release removes n bytes, of an entry evicted or removed from the cache,
+// from the account.
+func (b *Budget) release(a *account, n uint64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// An entry can be released by another goroutine before it is charged.
+	n = min(n, a.bytes)
+	a.bytes -= n
+	b.used -= n
+	b.metrics.setBytes(a.name, a.bytes)
+	b.metrics.setTotalBytes(b.used)
+}
+
+// reclaim evicts entries from the largest cache until the caches are within
+// the budget again.
+func (b *Budget) reclaim() {
+	if b == nil {
+		return
+	}
+	for {
+		victim := b.overBudget()
+		if victim == nil || !victim.cache.removeOldest() {
+			return
+		}
+		b.metrics.markBudgetEviction(victim.name)
+	}
+}
+
+// overBudget returns the largest cache if the caches hold more than the cap,
+// nil otherwise.
+func (b *Budget) overBudget() *account {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.maxBytes == 0 || b.used <= b.maxBytes {
+		return nil
+	}
+	var largest *account
+	for _, a := range b.accounts {
+		if largest == nil || a.bytes > largest.bytes {
+			largest = a
+		}
+	}
+	return largest
+}
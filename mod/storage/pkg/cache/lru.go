@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// entryOverhead approximates the bookkeeping memory of an LRU entry, i.e.
+// its list element and map slot, on top of the key and value.
+const entryOverhead = 96
+
+// LRU is a least recently used cache of bounded length whose entries are
+// charged to a Budget. sizeOf returns the number of bytes held by an entry.
+type LRU[K comparable, V any] struct {
+	cache   *lru.Cache[K, V]
+	sizeOf  func(K, V) uint64
+	budget  *Budget
+	account *account
+}
+
+// NewLRU returns an LRU of at most size entries, registered under the given
+// name with the budget.
+func NewLRU[K comparable, V any](
+	budget *Budget,
+	name string,
+	size int,
+	sizeOf func(K, V) uint64,
+) (*LRU[K, V], error) {
+	var (
+		l   = &LRU[K, V]{sizeOf: sizeOf, budget: budget}
+		err error
+	)
+	if l.cache, err = lru.NewWithEvict(size, l.onEvict); err != nil {
+		return nil, err
+	}
+	if l.account, err = budget.register(name, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Add adds a value to the cache, evicting the least recently used entry if
+// the cache is full, and entries of the largest cache if the budget is
+// exceeded.
+func (l *LRU[K, V]) Add(key K, value V) {
+	// Replacing a value does not evict the previous one, which must be
+	// released explicitly.
+	if l.cache.Contains(key) {
+		l.cache.Remove(key)
+	}
+	l.budget.charge(l.account, l.entrySize(key, value))
+	l.cache.Add(key, value)
+	l.setEntries()
+	l.budget.reclaim()
+}
+
+// Get looks up the value of a key, marking it as recently used.
+func (l *LRU[K, V]) Get(key K) (V, bool) {
+	return l.cache.Get(key)
+}
+
+// Peek looks up the value of a key without marking it as recently used.
+func (l *LRU[K, V]) Peek(key K) (V, bool) {
+	return l.cache.Peek(key)
+}
+
+// Remove removes a key from the cache.
+func (l *LRU[K, V]) Remove(key K) {
+	l.cache.Remove(key)
+}
+
+// Len returns the number of entries in the cache.
+func (l *LRU[K, V]) Len() int {
+	return l.cache.Len()
+}
+
+// removeOldest implements evictable.
+func (l *LRU[K, V]) removeOldest() bool {
+	_, _, ok := l.cache.RemoveOldest()
+	return ok
+}
+
+// onEvict releases the bytes of an entry leaving the cache.
+func (l *LRU[K, V]) onEvict(key K, value V) {
+	l.budget.release(l.account, l.entrySize(key, value))
+	if l.budget != nil {
+		l.budget.metrics.markEviction(l.account.name)
+	}
+	l.setEntries()
+}
+
+// setEntries sets the gauge for the number of entries of the cache.
+func (l *LRU[K, V]) setEntries() {
+	if l.budget != nil {
+		l.budget.metrics.setEntries(l.account.name, l.cache.Len())
+	}
+}
+
+// entrySize returns the number of bytes charged for an entry.
+func (l *LRU[K, V]) entrySize(key K, value V) uint64 {
+	return l.sizeOf(key, value) + entryOverhead
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/cache"
+	"github.com/stretchr/testify/require"
+)
+
+// entrySize is the size charged per entry in the tests, including the
+// bookkeeping overhead of the LRU.
+const entrySize = 4 + 96
+
+func fixedSize(uint64, uint64) uint64 { return 4 }
+
+func TestLRU_ChargesBudget(t *testing.T) {
+	budget := cache.NewBudget(0, nil)
+	c, err := cache.NewLRU(budget, "a", 2, fixedSize)
+	require.NoError(t, err)
+
+	c.Add(1, 1)
+	c.Add(2, 2)
+	require.Equal(t, uint64(2*entrySize), budget.Used())
+
+	// Replacing a value does not double charge it.
+	c.Add(2, 3)
+	require.Equal(t, uint64(2*entrySize), budget.Used())
+
+	// Evicting an entry on length releases it.
+	c.Add(3, 3)
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, uint64(2*entrySize), budget.Used())
+
+	c.Remove(3)
+	require.Equal(t, uint64(entrySize), budget.Used())
+}
+
+func TestLRU_EvictsLargestCache(t *testing.T) {
+	budget := cache.NewBudget(3*entrySize, nil)
+	small, err := cache.NewLRU(budget, "small", 10, fixedSize)
+	require.NoError(t, err)
+	large, err := cache.NewLRU(budget, "large", 10, fixedSize)
+	require.NoError(t, err)
+
+	small.Add(1, 1)
+	large.Add(1, 1)
+	large.Add(2, 2)
+	require.Equal(t, uint64(3*entrySize), budget.Used())
+
+	// Going over the budget evicts the oldest entry of the largest cache.
+	large.Add(3, 3)
+	require.Equal(t, uint64(3*entrySize), budget.Used())
+	require.Equal(t, 1, small.Len())
+	_, ok := large.Peek(1)
+	require.False(t, ok)
+	_, ok = large.Peek(3)
+	require.True(t, ok)
+}
+
+func TestLRU_NilBudget(t *testing.T) {
+	c, err := cache.NewLRU[uint64, uint64](nil, "a", 1, fixedSize)
+	require.NoError(t, err)
+
+	c.Add(1, 1)
+	c.Add(2, 2)
+	require.Equal(t, 1, c.Len())
+	v, ok := c.Get(2)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), v)
+}
+
+func TestBudget_DuplicateName(t *testing.T) {
+	budget := cache.NewBudget(0, nil)
+	_, err := cache.NewLRU(budget, "a", 1, fixedSize)
+	require.NoError(t, err)
+	_, err = cache.NewLRU(budget, "a", 1, fixedSize)
+	require.True(t, errors.Is(err, cache.ErrDuplicateCache))
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cache
+
+// metrics is a struct that contains metrics for the cache budget.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink) *metrics {
+	return &metrics{
+		sink: sink,
+	}
+}
+
+// setBytes sets the gauge for the bytes held by the given cache.
+func (m *metrics) setBytes(cache string, bytes uint64) {
+	if m.sink == nil {
+		return
+	}
+	//#nosec:G115 // cache sizes fit in an int64.
+	m.sink.SetGauge("beacon_kit.cache.bytes", int64(bytes), "cache", cache)
+}
+
+// setEntries sets the gauge for the number of entries of the given cache.
+func (m *metrics) setEntries(cache string, entries int) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.SetGauge(
+		"beacon_kit.cache.entries", int64(entries), "cache", cache,
+	)
+}
+
+// setTotalBytes sets the gauge for the bytes held by all caches.
+func (m *metrics) setTotalBytes(bytes uint64) {
+	if m.sink == nil {
+		return
+	}
+	//#nosec:G115 // cache sizes fit in an int64.
+	m.sink.SetGauge("beacon_kit.cache.total_bytes", int64(bytes))
+}
+
+// markEviction increments the counter for entries evicted from the given
+// cache, whether to make room within the cache or within the budget.
+func (m *metrics) markEviction(cache string) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter("beacon_kit.cache.evictions", "cache", cache)
+}
+
+// markBudgetEviction increments the counter for entries evicted from the
+// given cache to bring the caches back within the memory budget.
+func (m *metrics) markBudgetEviction(cache string) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.cache.budget_evictions", "cache", cache,
+	)
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package compression
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// magic is the first byte of a compressed record. Records written before
+	// compression was introduced are SSZ blob sidecars, which begin with the
+	// little-endian blob index and therefore never start with this byte.
+	magic byte = 0xbc
+	// headerLength is the length of the magic byte and the format byte.
+	headerLength = 2
+)
+
+// Compressor encodes records with a configured codec and decodes records
+// written with any codec, including records written without a header.
+type Compressor struct {
+	codec   Codec
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// NewCompressor returns a compressor that encodes records with the given
+// codec.
+func NewCompressor(codec Codec) (*Compressor, error) {
+	if codec.String() == "unknown" {
+		return nil, ErrUnknownCodec
+	}
+
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Compressor{
+		codec:   codec,
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+// Codec returns the codec records are encoded with.
+func (c *Compressor) Codec() Codec {
+	return c.codec
+}
+
+// Encode compresses the record with the configured codec and prefixes it
+// with the record header. Records are stored as they are if the codec is
+// None.
+func (c *Compressor) Encode(record []byte) []byte {
+	header := []byte{magic, byte(c.codec)}
+	switch c.codec {
+	case Snappy:
+		bz := make([]byte, headerLength+snappy.MaxEncodedLen(len(record)))
+		copy(bz, header)
+		return bz[:headerLength+len(snappy.Encode(bz[headerLength:], record))]
+	case Zstd:
+		return c.encoder.EncodeAll(record, header)
+	default:
+		return record
+	}
+}
+
+// Decode returns the record without the compression applied by Encode.
+func (c *Compressor) Decode(bz []byte) ([]byte, error) {
+	switch FormatOf(bz) {
+	case None:
+		return bz, nil
+	case Snappy:
+		record, err := snappy.Decode(nil, bz[headerLength:])
+		if err != nil {
+			return nil, errors.Wrap(ErrCorruptRecord, err.Error())
+		}
+		return record, nil
+	case Zstd:
+		record, err := c.decoder.DecodeAll(bz[headerLength:], nil)
+		if err != nil {
+			return nil, errors.Wrap(ErrCorruptRecord, err.Error())
+		}
+		return record, nil
+	default:
+		return nil, ErrUnknownCodec
+	}
+}
+
+// FormatOf returns the codec the record was encoded with. Records without a
+// header are reported as None.
+func FormatOf(bz []byte) Codec {
+	if len(bz) < headerLength || bz[0] != magic {
+		return None
+	}
+	return Codec(bz[1])
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package compression_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressor_RoundTrip(t *testing.T) {
+	record := append([]byte{0x03}, bytes.Repeat([]byte("blob"), 1024)...)
+	for _, codec := range []compression.Codec{
+		compression.None, compression.Snappy, compression.Zstd,
+	} {
+		t.Run(codec.String(), func(t *testing.T) {
+			c, err := compression.NewCompressor(codec)
+			require.NoError(t, err)
+
+			encoded := c.Encode(record)
+			require.Equal(t, codec, compression.FormatOf(encoded))
+			if codec != compression.None {
+				require.Less(t, len(encoded), len(record))
+			}
+
+			decoded, err := c.Decode(encoded)
+			require.NoError(t, err)
+			require.Equal(t, record, decoded)
+		})
+	}
+}
+
+func TestCompressor_DecodesAnyFormat(t *testing.T) {
+	record := bytes.Repeat([]byte{0x01, 0x02}, 512)
+	snappyC, err := compression.NewCompressor(compression.Snappy)
+	require.NoError(t, err)
+	zstdC, err := compression.NewCompressor(compression.Zstd)
+	require.NoError(t, err)
+
+	// A record written with one codec is read by a compressor configured
+	// with another, and a record without a header is returned as is.
+	decoded, err := zstdC.Decode(snappyC.Encode(record))
+	require.NoError(t, err)
+	require.Equal(t, record, decoded)
+
+	decoded, err = snappyC.Decode(record)
+	require.NoError(t, err)
+	require.Equal(t, record, decoded)
+}
+
+func TestCompressor_DecodeErrors(t *testing.T) {
+	c, err := compression.NewCompressor(compression.Zstd)
+	require.NoError(t, err)
+
+	_, err = c.Decode([]byte{0xbc, 0x7f, 0x00})
+	require.ErrorIs(t, err, compression.ErrUnknownCodec)
+
+	_, err = c.Decode([]byte{0xbc, byte(compression.Zstd), 0xde, 0xad})
+	require.ErrorIs(t, err, compression.ErrCorruptRecord)
+}
+
+func TestParseCodec(t *testing.T) {
+	for name, want := range map[string]compression.Codec{
+		"":       compression.None,
+		"none":   compression.None,
+		"Snappy": compression.Snappy,
+		" zstd ": compression.Zstd,
+	} {
+		codec, err := compression.ParseCodec(name)
+		require.NoError(t, err)
+		require.Equal(t, want, codec)
+	}
+
+	_, err := compression.ParseCodec("lz4")
+	require.ErrorIs(t, err, compression.ErrUnknownCodec)
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build pebbledb
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// checkpointsKept is the number of checkpoints kept on disk, so that a
+// replica still serving an older checkpoint is not pulled from under it.
+const checkpointsKept = 3
+
+// Checkpointer writes checkpoints of the application database that read
+// replicas open in place of the live database. Checkpoints hard link the
+// files of the database where possible, so the checkpoint directory should
+// be on the same filesystem as the data directory.
+type Checkpointer struct {
+	// db is the database checkpoints are taken of.
+	db *dbm.PebbleDB
+	// dir is the directory the checkpoints are written to.
+	dir string
+	// interval is the number of blocks between two checkpoints.
+	interval int64
+}
+
+// NewCheckpointer returns a Checkpointer writing a checkpoint of the given
+// database to dir every interval blocks.
+func NewCheckpointer(
+	db dbm.DB, dir string, interval uint64,
+) (*Checkpointer, error) {
+	pdb, ok := db.(*dbm.PebbleDB)
+	if !ok {
+		return nil, ErrNotPebbleDB
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &Checkpointer{
+		db:  pdb,
+		dir: dir,
+		//#nosec:G115 // the interval is a block count.
+		interval: max(int64(interval), 1),
+	}, nil
+}
+
+// MaybeCheckpoint writes a checkpoint of the database if a checkpoint is due
+// at the given committed height, and points replicas at it.
+func (c *Checkpointer) MaybeCheckpoint(height int64) error {
+	if height%c.interval != 0 {
+		return nil
+	}
+
+	name := strconv.FormatInt(height, 10)
+	if err := os.RemoveAll(filepath.Join(c.dir, name)); err != nil {
+		return err
+	}
+	if err := c.db.DB().Checkpoint(
+		filepath.Join(c.dir, name, applicationDBName+".db"),
+	); err != nil {
+		return errors.Wrapf(err, "checkpoint at height %d", height)
+	}
+
+	// Replicas only ever see complete checkpoints, as the pointer to the
+	// latest one is swapped in with a rename.
+	tmp := filepath.Join(c.dir, latestCheckpointFile+".tmp")
+	if err := os.WriteFile(tmp, []byte(name), 0o600); err != nil {
+		return err
+	}
+	if err := os.Rename(
+		tmp, filepath.Join(c.dir, latestCheckpointFile),
+	); err != nil {
+		return err
+	}
+	return c.prune()
+}
+
+// prune removes all but the most recent checkpoints.
+func (c *Checkpointer) prune() error {
+	heights, err := checkpointHeights(c.dir)
+	if err != nil {
+		return err
+	}
+	for len(heights) > checkpointsKept {
+		if err = os.RemoveAll(filepath.Join(
+			c.dir, strconv.FormatInt(heights[0], 10),
+		)); err != nil {
+			return err
+		}
+		heights = heights[1:]
+	}
+	return nil
+}
+
+// checkpointHeights returns the heights of the checkpoints in dir, in
+// ascending order.
+func checkpointHeights(dir string) ([]int64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	heights := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		height, pErr := strconv.ParseInt(entry.Name(), 10, 64)
+		if pErr != nil {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+	return heights, nil
+}
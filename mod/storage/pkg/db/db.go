@@ -26,8 +26,11 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 )
 
+// applicationDBName is the name of the application database.
+const applicationDBName = "application"
+
 // OpenDB opens the application database using the appropriate driver.
 func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
-	return dbm.NewDB("application", backendType, dataDir)
+	return dbm.NewDB(applicationDBName, backendType, dataDir)
 }
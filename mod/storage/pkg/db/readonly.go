@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// readOnlyDB is a dbm.DB over a PebbleDB database opened read-only, which
+// cosmos-db has no option for. Writes fail with ErrReadOnly.
+type readOnlyDB struct {
+	db *pebble.DB
+}
+
+// openReadOnlyDB opens the PebbleDB database at path read-only.
+func openReadOnlyDB(path string) (*readOnlyDB, error) {
+	db, err := pebble.Open(path, &pebble.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	return &readOnlyDB{db: db}, nil
+}
+
+// Get implements dbm.DB.
+func (db *readOnlyDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, ErrKeyEmpty
+	}
+	res, closer, err := db.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(res), nil
+}
+
+// Has implements dbm.DB.
+func (db *readOnlyDB) Has(key []byte) (bool, error) {
+	bz, err := db.Get(key)
+	return bz != nil, err
+}
+
+// Set implements dbm.DB.
+func (db *readOnlyDB) Set([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+// SetSync implements dbm.DB.
+func (db *readOnlyDB) SetSync([]byte, []byte) error {
+	return ErrReadOnly
+}
+
+// Delete implements dbm.DB.
+func (db *readOnlyDB) Delete([]byte) error {
+	return ErrReadOnly
+}
+
+// DeleteSync implements dbm.DB.
+func (db *readOnlyDB) DeleteSync([]byte) error {
+	return ErrReadOnly
+}
+
+// Iterator implements dbm.DB.
+func (db *readOnlyDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// ReverseIterator implements dbm.DB.
+func (db *readOnlyDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+// newIterator returns an iterator over the keys in [start, end).
+func (db *readOnlyDB) newIterator(
+	start, end []byte, reverse bool,
+) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, ErrKeyEmpty
+	}
+	source, err := db.db.NewIter(
+		&pebble.IterOptions{LowerBound: start, UpperBound: end},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		source.Last()
+	} else {
+		source.First()
+	}
+	return &readOnlyIterator{
+		source: source, start: start, end: end, reverse: reverse,
+	}, nil
+}
+
+// NewBatch implements dbm.DB. Writing the batch fails.
+func (db *readOnlyDB) NewBatch() dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// NewBatchWithSize implements dbm.DB. Writing the batch fails.
+func (db *readOnlyDB) NewBatchWithSize(int) dbm.Batch {
+	return readOnlyBatch{}
+}
+
+// Print implements dbm.DB.
+func (db *readOnlyDB) Print() error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		//nolint:forbidigo // dbm.DB prints to stdout.
+		fmt.Printf("[%X]:\t[%X]\n", iter.Key(), iter.Value())
+	}
+	return iter.Error()
+}
+
+// Stats implements dbm.DB.
+func (db *readOnlyDB) Stats() map[string]string {
+	return map[string]string{}
+}
+
+// Close implements dbm.DB.
+func (db *readOnlyDB) Close() error {
+	return db.db.Close()
+}
+
+// readOnlyIterator is a dbm.Iterator over a range of a readOnlyDB.
+type readOnlyIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	reverse    bool
+	invalid    bool
+}
+
+// Domain implements dbm.Iterator.
+func (it *readOnlyIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements dbm.Iterator. Once invalid, the iterator stays invalid.
+func (it *readOnlyIterator) Valid() bool {
+	if it.invalid {
+		return false
+	}
+	if it.source.Error() != nil || !it.source.Valid() {
+		it.invalid = true
+		return false
+	}
+	key := it.source.Key()
+	if it.reverse && it.start != nil && bytes.Compare(key, it.start) < 0 ||
+		!it.reverse && it.end != nil && bytes.Compare(it.end, key) <= 0 {
+		it.invalid = true
+		return false
+	}
+	return true
+}
+
+// Key implements dbm.Iterator.
+func (it *readOnlyIterator) Key() []byte {
+	it.assertValid()
+	return bytes.Clone(it.source.Key())
+}
+
+// Value implements dbm.Iterator.
+func (it *readOnlyIterator) Value() []byte {
+	it.assertValid()
+	return bytes.Clone(it.source.Value())
+}
+
+// Next implements dbm.Iterator.
+func (it *readOnlyIterator) Next() {
+	it.assertValid()
+	if it.reverse {
+		it.source.Prev()
+	} else {
+		it.source.Next()
+	}
+}
+
+// Error implements dbm.Iterator.
+func (it *readOnlyIterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements dbm.Iterator.
+func (it *readOnlyIterator) Close() error {
+	return it.source.Close()
+}
+
+// assertValid panics if the iterator is exhausted, as dbm.Iterator requires.
+func (it *readOnlyIterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// readOnlyBatch is the batch of a readOnlyDB, whose writes fail.
+type readOnlyBatch struct{}
+
+// Set implements dbm.Batch.
+func (readOnlyBatch) Set([]byte, []byte) error { return ErrReadOnly }
+
+// Delete implements dbm.Batch.
+func (readOnlyBatch) Delete([]byte) error { return ErrReadOnly }
+
+// Write implements dbm.Batch.
+func (readOnlyBatch) Write() error { return ErrReadOnly }
+
+// WriteSync implements dbm.Batch.
+func (readOnlyBatch) WriteSync() error { return ErrReadOnly }
+
+// Close implements dbm.Batch.
+func (readOnlyBatch) Close() error { return nil }
+
+// GetByteSize implements dbm.Batch.
+func (readOnlyBatch) GetByteSize() (int, error) { return 0, nil }
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// latestCheckpointFile holds the name of the latest complete checkpoint of a
+// checkpoint directory.
+const latestCheckpointFile = "LATEST"
+
+// ReplicaDB is a read-only application database serving the latest
+// checkpoint written by the Checkpointer of a primary node. It lets a second
+// process serve queries, such as those of the node API, without loading the
+// primary. Reload switches to a newer checkpoint.
+//
+// The beacon state kept in beacondb is part of the application database, so
+// the replica serves it as of the checkpoint. The block store is an
+// in-memory index built by the primary as it finalizes blocks and has no
+// on-disk state to replicate, so queries served from it are not available
+// on a replica.
+type ReplicaDB struct {
+	// dir is the checkpoint directory of the primary.
+	dir string
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// checkpoint is the name of the checkpoint being served.
+	checkpoint string
+	// db is the database of the checkpoint being served.
+	db dbm.DB
+	// retired is the database of the previous checkpoint. It is closed on
+	// the next reload, leaving in flight reads time to complete.
+	retired dbm.DB
+}
+
+// OpenReplicaDB opens the latest checkpoint in the given checkpoint
+// directory.
+func OpenReplicaDB(dir string) (*ReplicaDB, error) {
+	r := &ReplicaDB{dir: dir}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload opens the latest checkpoint if it is not the one being served, and
+// returns whether it did.
+func (r *ReplicaDB) Reload() (bool, error) {
+	name, err := latestCheckpoint(r.dir)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	current := r.checkpoint
+	r.mu.RUnlock()
+	if name == current {
+		return false, nil
+	}
+
+	db, err := openReadOnlyDB(
+		filepath.Join(r.dir, name, applicationDBName+".db"),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.retired != nil {
+		if err = r.retired.Close(); err != nil {
+			return false, err
+		}
+	}
+	r.checkpoint, r.db, r.retired = name, db, r.db
+	return true, nil
+}
+
+// Checkpoint returns the name of the checkpoint being served.
+func (r *ReplicaDB) Checkpoint() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.checkpoint
+}
+
+// current returns the database of the checkpoint being served.
+func (r *ReplicaDB) current() dbm.DB {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.db
+}
+
+// Get implements dbm.DB.
+func (r *ReplicaDB) Get(key []byte) ([]byte, error) {
+	return r.current().Get(key)
+}
+
+// Has implements dbm.DB.
+func (r *ReplicaDB) Has(key []byte) (bool, error) {
+	return r.current().Has(key)
+}
+
+// Set implements dbm.DB. The checkpoint is opened read-only, so it fails.
+func (r *ReplicaDB) Set(key, value []byte) error {
+	return r.current().Set(key, value)
+}
+
+// SetSync implements dbm.DB. The checkpoint is opened read-only, so it fails.
+func (r *ReplicaDB) SetSync(key, value []byte) error {
+	return r.current().SetSync(key, value)
+}
+
+// Delete implements dbm.DB. The checkpoint is opened read-only, so it fails.
+func (r *ReplicaDB) Delete(key []byte) error {
+	return r.current().Delete(key)
+}
+
+// DeleteSync implements dbm.DB. The checkpoint is opened read-only, so it
+// fails.
+func (r *ReplicaDB) DeleteSync(key []byte) error {
+	return r.current().DeleteSync(key)
+}
+
+// Iterator implements dbm.DB.
+func (r *ReplicaDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return r.current().Iterator(start, end)
+}
+
+// ReverseIterator implements dbm.DB.
+func (r *ReplicaDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return r.current().ReverseIterator(start, end)
+}
+
+// NewBatch implements dbm.DB. Writing the batch fails, as the checkpoint is
+// opened read-only.
+func (r *ReplicaDB) NewBatch() dbm.Batch {
+	return r.current().NewBatch()
+}
+
+// NewBatchWithSize implements dbm.DB. Writing the batch fails, as the
+// checkpoint is opened read-only.
+func (r *ReplicaDB) NewBatchWithSize(size int) dbm.Batch {
+	return r.current().NewBatchWithSize(size)
+}
+
+// Print implements dbm.DB.
+func (r *ReplicaDB) Print() error {
+	return r.current().Print()
+}
+
+// Stats implements dbm.DB.
+func (r *ReplicaDB) Stats() map[string]string {
+	stats := r.current().Stats()
+	stats["replica.checkpoint"] = r.Checkpoint()
+	return stats
+}
+
+// Close implements dbm.DB, closing the databases of both the served and the
+// previous checkpoint.
+func (r *ReplicaDB) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.retired != nil {
+		if err := r.retired.Close(); err != nil {
+			return err
+		}
+		r.retired = nil
+	}
+	return r.db.Close()
+}
+
+// latestCheckpoint returns the name of the latest complete checkpoint in dir.
+func latestCheckpoint(dir string) (string, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, latestCheckpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoCheckpoint
+	} else if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(bz)), nil
+}
@@ -30,13 +30,20 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+	// KeyWatermarkPrefix is kept disjoint from KeyDepositPrefix so that
+	// iterating the deposits never yields the watermark.
+	KeyWatermarkPrefix = "watermark"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// watermark is the index below which all deposits have been pruned.
+	watermark sdkcollections.Item[uint64]
+	mu        sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -52,6 +59,12 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		watermark: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyWatermarkPrefix)),
+			KeyWatermarkPrefix,
+			sdkcollections.Uint64Value,
+		),
 	}
 }
 
@@ -102,16 +115,46 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
 }
 
-// Prune removes the [start, end) deposits from the store.
+// Prune removes the [start, end) deposits from the store. Deposits below the
+// prune watermark are already gone, so the range is clamped to it and the
+// watermark is advanced to end.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	var ctx = context.TODO()
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	watermark, err := kv.getWatermark(ctx)
+	if err != nil {
+		return err
+	}
+	// A range starting past the watermark leaves a gap behind it, in which
+	// case the watermark cannot move.
+	advance := start <= watermark
+	for i := max(start, watermark); i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(ctx, start+i); err != nil {
+		if err = kv.store.Remove(ctx, i); err != nil {
 			return err
 		}
 	}
-	return nil
+	if !advance || end <= watermark {
+		return nil
+	}
+	return kv.watermark.Set(ctx, end)
+}
+
+// PruneWatermark returns the index below which all deposits have been
+// pruned.
+func (kv *KVStore[DepositT]) PruneWatermark() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.getWatermark(context.TODO())
+}
+
+// getWatermark returns the prune watermark, which is zero until the store is
+// first pruned.
+func (kv *KVStore[DepositT]) getWatermark(ctx context.Context) (uint64, error) {
+	watermark, err := kv.watermark.Get(ctx)
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return watermark, err
 }
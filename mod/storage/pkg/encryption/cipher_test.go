@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCipher_SealOpen(t *testing.T) {
+	c, err := encryption.NewCipher(bytes.Repeat([]byte{0x01}, 32))
+	require.NoError(t, err)
+
+	plaintext := []byte("deposit")
+	sealed, err := c.Seal(plaintext)
+	require.NoError(t, err)
+	require.Len(t, sealed, len(plaintext)+c.Overhead())
+	require.NotContains(t, string(sealed), string(plaintext))
+
+	opened, err := c.Open(sealed)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	// Tampering with the ciphertext must be detected.
+	sealed[len(sealed)-1] ^= 0xff
+	_, err = c.Open(sealed)
+	require.Error(t, err)
+
+	_, err = c.Open([]byte{0x00})
+	require.ErrorIs(t, err, encryption.ErrCiphertextTooShort)
+}
+
+func TestNewCipher_InvalidKeyLength(t *testing.T) {
+	_, err := encryption.NewCipher(make([]byte, 16))
+	require.ErrorIs(t, err, encryption.ErrInvalidKeyLength)
+}
+
+func TestPassphraseKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	passphraseFile := filepath.Join(dir, "passphrase")
+	saltFile := filepath.Join(dir, "salt")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("hunter2\n"), 0o600))
+
+	provider := encryption.NewPassphraseKeyProvider(passphraseFile, saltFile)
+	key, err := provider.Key()
+	require.NoError(t, err)
+	require.Len(t, key, encryption.KeyLength)
+
+	// The salt is persisted so the same key is derived on restart.
+	salt, err := os.ReadFile(saltFile)
+	require.NoError(t, err)
+	require.Len(t, salt, encryption.SaltLength)
+	again, err := provider.Key()
+	require.NoError(t, err)
+	require.Equal(t, key, again)
+
+	require.NoError(t, os.WriteFile(passphraseFile, []byte(" \n"), 0o600))
+	_, err = provider.Key()
+	require.ErrorIs(t, err, encryption.ErrEmptyPassphrase)
+}
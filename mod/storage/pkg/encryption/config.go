@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption
+
+// Config is the configuration for encryption at rest.
+type Config struct {
+	// Enabled determines if sensitive stores and the validator key file are
+	// encrypted at rest.
+	Enabled bool `mapstructure:"enabled"`
+	// PassphraseFile is the path to the file holding the passphrase the data
+	// encryption key is derived from.
+	PassphraseFile string `mapstructure:"passphrase-file"`
+	// SaltFile is the path to the file holding the key derivation salt.
+	SaltFile string `mapstructure:"salt-file"`
+}
+
+// DefaultConfig returns the default configuration for encryption at rest.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        false,
+		PassphraseFile: "config/encryption_passphrase",
+		SaltFile:       "config/encryption_salt",
+	}
+}
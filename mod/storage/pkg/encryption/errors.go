@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidKeyLength is returned when the data encryption key is not
+	// 32 bytes long.
+	ErrInvalidKeyLength = errors.New("invalid encryption key length")
+
+	// ErrCiphertextTooShort is returned when a ciphertext is shorter than the
+	// nonce it must be prefixed with.
+	ErrCiphertextTooShort = errors.New("ciphertext too short")
+
+	// ErrEmptyPassphrase is returned when encryption is enabled but the
+	// operator did not supply a passphrase.
+	ErrEmptyPassphrase = errors.New("encryption passphrase is empty")
+
+	// ErrInvalidSaltLength is returned when the persisted salt does not have
+	// the expected length.
+	ErrInvalidSaltLength = errors.New("invalid encryption salt length")
+
+	// ErrDecryptKeyFile is returned when the encrypted validator key file
+	// cannot be decrypted.
+	ErrDecryptKeyFile = errors.New("failed to decrypt validator key file")
+)
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption
+
+import (
+	"encoding/json"
+	"os"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+)
+
+// LoadFilePV loads a CometBFT file validator whose key file is encrypted at
+// rest with the given cipher. The sign state file is left in plaintext as it
+// holds no secrets. If the key file is still in plaintext, it is sealed in
+// place before the validator is loaded.
+//
+// Unlike privval.LoadFilePV, the returned validator must not be saved with
+// FilePV.Save, which would write the key file back in plaintext.
+func LoadFilePV(
+	keyFilePath string,
+	stateFilePath string,
+	cipher *Cipher,
+) (*privval.FilePV, error) {
+	keyBz, err := readKeyFile(keyFilePath, cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	var pvKey privval.FilePVKey
+	if err = cmtjson.Unmarshal(keyBz, &pvKey); err != nil {
+		return nil, err
+	}
+
+	filePV := privval.NewFilePV(pvKey.PrivKey, keyFilePath, stateFilePath)
+	stateBz, err := os.ReadFile(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if err = cmtjson.Unmarshal(stateBz, &filePV.LastSignState); err != nil {
+		return nil, err
+	}
+	return filePV, nil
+}
+
+// readKeyFile reads and decrypts the key file at the given path, sealing it
+// first if it is still stored in plaintext.
+func readKeyFile(path string, cipher *Cipher) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := cipher.Open(bz)
+	switch {
+	case err == nil:
+		return plaintext, nil
+	case !json.Valid(bz):
+		// The key file is neither sealed with our key nor a plaintext key
+		// file, most likely the wrong passphrase was supplied.
+		return nil, ErrDecryptKeyFile
+	}
+
+	sealed, err := cipher.Seal(bz)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	tmpPath := path + ".tmp"
+	if err = os.WriteFile(tmpPath, sealed, info.Mode().Perm()); err != nil {
+		return nil, err
+	}
+	return bz, os.Rename(tmpPath, path)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encryption"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFilePV(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	stateFile := filepath.Join(dir, "priv_validator_state.json")
+	filePV := privval.GenFilePV(keyFile, stateFile)
+	filePV.Save()
+
+	c, err := encryption.NewCipher(bytes.Repeat([]byte{0x01}, 32))
+	require.NoError(t, err)
+
+	// A plaintext key file is sealed in place on first load.
+	loaded, err := encryption.LoadFilePV(keyFile, stateFile, c)
+	require.NoError(t, err)
+	require.Equal(t, filePV.Key.PrivKey, loaded.Key.PrivKey)
+	sealed, err := os.ReadFile(keyFile)
+	require.NoError(t, err)
+	require.False(t, json.Valid(sealed))
+
+	// The sealed key file loads the same validator.
+	loaded, err = encryption.LoadFilePV(keyFile, stateFile, c)
+	require.NoError(t, err)
+	require.Equal(t, filePV.Key.PrivKey, loaded.Key.PrivKey)
+
+	// A key file sealed with another key is rejected.
+	other, err := encryption.NewCipher(bytes.Repeat([]byte{0x02}, 32))
+	require.NoError(t, err)
+	_, err = encryption.LoadFilePV(keyFile, stateFile, other)
+	require.ErrorIs(t, err, encryption.ErrDecryptKeyFile)
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// SaltLength is the length of the salt used for key derivation.
+	SaltLength = 32
+
+	// scrypt parameters, as recommended for interactive logins in 2017.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// KeyProvider supplies the data encryption key used to seal values at rest.
+// Operators that keep their keys in a KMS can provide their own
+// implementation through depinject, which takes precedence over the
+// passphrase based provider.
+type KeyProvider interface {
+	// Key returns the 32 byte data encryption key.
+	Key() ([]byte, error)
+}
+
+// PassphraseKeyProvider derives the data encryption key from an operator
+// supplied passphrase using scrypt.
+type PassphraseKeyProvider struct {
+	passphraseFile string
+	saltFile       string
+}
+
+// NewPassphraseKeyProvider creates a new PassphraseKeyProvider which reads
+// the passphrase from passphraseFile and the salt from saltFile. If the salt
+// file does not exist, a new random salt is generated and persisted to it.
+func NewPassphraseKeyProvider(
+	passphraseFile, saltFile string,
+) *PassphraseKeyProvider {
+	return &PassphraseKeyProvider{
+		passphraseFile: passphraseFile,
+		saltFile:       saltFile,
+	}
+}
+
+// Key derives the data encryption key.
+func (p *PassphraseKeyProvider) Key() ([]byte, error) {
+	passphrase, err := os.ReadFile(p.passphraseFile)
+	if err != nil {
+		return nil, err
+	}
+	passphrase = bytes.TrimSpace(passphrase)
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	salt, err := p.loadOrCreateSalt()
+	if err != nil {
+		return nil, err
+	}
+	return DeriveKey(passphrase, salt)
+}
+
+// loadOrCreateSalt reads the salt from disk, creating it if it is missing.
+func (p *PassphraseKeyProvider) loadOrCreateSalt() ([]byte, error) {
+	salt, err := os.ReadFile(p.saltFile)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		salt = make([]byte, SaltLength)
+		if _, err = rand.Read(salt); err != nil {
+			return nil, err
+		}
+		//#nosec:G306 // the salt is not a secret.
+		return salt, os.WriteFile(p.saltFile, salt, 0o644)
+	case err != nil:
+		return nil, err
+	case len(salt) != SaltLength:
+		return nil, ErrInvalidSaltLength
+	default:
+		return salt, nil
+	}
+}
+
+// DeriveKey derives a data encryption key from the given passphrase and salt.
+func DeriveKey(passphrase, salt []byte) ([]byte, error) {
+	return scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, KeyLength)
+}
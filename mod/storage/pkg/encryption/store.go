@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encryption
+
+import "cosmossdk.io/core/store"
+
+// KVStore wraps a store.KVStoreWithBatch and transparently encrypts values
+// before they are written and decrypts them when they are read. Keys are
+// left in plaintext so that iteration order is preserved.
+type KVStore struct {
+	store.KVStoreWithBatch
+	cipher *Cipher
+}
+
+// NewKVStore creates a new encrypting KVStore on top of the given store.
+func NewKVStore(kvs store.KVStoreWithBatch, cipher *Cipher) *KVStore {
+	return &KVStore{
+		KVStoreWithBatch: kvs,
+		cipher:           cipher,
+	}
+}
+
+// Get returns the decrypted value stored at the given key.
+func (s *KVStore) Get(key []byte) ([]byte, error) {
+	bz, err := s.KVStoreWithBatch.Get(key)
+	if err != nil || bz == nil {
+		return bz, err
+	}
+	return s.cipher.Open(bz)
+}
+
+// Set encrypts the value and stores it at the given key.
+func (s *KVStore) Set(key, value []byte) error {
+	bz, err := s.cipher.Seal(value)
+	if err != nil {
+		return err
+	}
+	return s.KVStoreWithBatch.Set(key, bz)
+}
+
+// Iterator returns an iterator over the domain [start, end) which decrypts
+// values as they are read.
+func (s *KVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	it, err := s.KVStoreWithBatch.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &iterator{Iterator: it, cipher: s.cipher}, nil
+}
+
+// ReverseIterator returns a reverse iterator over the domain [start, end)
+// which decrypts values as they are read.
+func (s *KVStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	it, err := s.KVStoreWithBatch.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &iterator{Iterator: it, cipher: s.cipher}, nil
+}
+
+// NewBatch returns a batch which encrypts values before they are written.
+func (s *KVStore) NewBatch() store.Batch {
+	return &batch{Batch: s.KVStoreWithBatch.NewBatch(), cipher: s.cipher}
+}
+
+// NewBatchWithSize returns a batch with a pre-allocated size which encrypts
+// values before they are written.
+func (s *KVStore) NewBatchWithSize(size int) store.Batch {
+	return &batch{
+		Batch:  s.KVStoreWithBatch.NewBatchWithSize(size),
+		cipher: s.cipher,
+	}
+}
+
+// iterator decrypts the values of the underlying iterator.
+type iterator struct {
+	store.Iterator
+	cipher *Cipher
+	err    error
+}
+
+// Value returns the decrypted value at the current position. If decryption
+// fails, nil is returned and the failure is surfaced through Error.
+func (it *iterator) Value() []byte {
+	bz, err := it.cipher.Open(it.Iterator.Value())
+	if err != nil {
+		it.err = err
+		return nil
+	}
+	return bz
+}
+
+// Error returns the first decryption error encountered, or the error of the
+// underlying iterator.
+func (it *iterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.Iterator.Error()
+}
+
+// batch encrypts values before adding them to the underlying batch.
+type batch struct {
+	store.Batch
+	cipher *Cipher
+}
+
+// Set encrypts the value and adds it to the batch.
+func (b *batch) Set(key, value []byte) error {
+	bz, err := b.cipher.Seal(value)
+	if err != nil {
+		return err
+	}
+	return b.Batch.Set(key, bz)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ephemeral
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/store"
+)
+
+// entry is a key and its write in the overlay.
+type entry struct {
+	key []byte
+	write
+}
+
+// mergeIterator iterates over the entries of a parent iterator merged with
+// a snapshot of the overlay entries in the same range. Overlay entries
+// shadow the parent entries of the same key, and deleted entries are
+// skipped.
+type mergeIterator struct {
+	parent  store.Iterator
+	entries []entry
+	pos     int
+	reverse bool
+}
+
+// newMergeIterator returns an iterator over parent merged with entries,
+// which must be sorted in the iteration order.
+func newMergeIterator(
+	parent store.Iterator,
+	entries []entry,
+	reverse bool,
+) *mergeIterator {
+	it := &mergeIterator{parent: parent, entries: entries, reverse: reverse}
+	it.skip()
+	return it
+}
+
+// Domain returns the range the iterator was created over.
+func (it *mergeIterator) Domain() ([]byte, []byte) {
+	return it.parent.Domain()
+}
+
+// Valid reports whether the iterator is positioned on an entry.
+func (it *mergeIterator) Valid() bool {
+	return it.pos < len(it.entries) || it.parent.Valid()
+}
+
+// Next moves the iterator to the next entry.
+func (it *mergeIterator) Next() {
+	if it.onOverlay() {
+		it.pos++
+	} else {
+		it.parent.Next()
+	}
+	it.skip()
+}
+
+// Key returns the key of the current entry.
+func (it *mergeIterator) Key() []byte {
+	if it.onOverlay() {
+		return it.entries[it.pos].key
+	}
+	return it.parent.Key()
+}
+
+// Value returns the value of the current entry.
+func (it *mergeIterator) Value() []byte {
+	if it.onOverlay() {
+		return it.entries[it.pos].value
+	}
+	return it.parent.Value()
+}
+
+// Error returns the error of the parent iterator.
+func (it *mergeIterator) Error() error {
+	return it.parent.Error()
+}
+
+// Close closes the parent iterator.
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
+
+// onOverlay reports whether the current entry is an overlay entry.
+func (it *mergeIterator) onOverlay() bool {
+	if it.pos >= len(it.entries) {
+		return false
+	}
+	if !it.parent.Valid() {
+		return true
+	}
+	c := bytes.Compare(it.entries[it.pos].key, it.parent.Key())
+	if it.reverse {
+		c = -c
+	}
+	return c <= 0
+}
+
+// skip moves past the parent entries shadowed by the overlay and the
+// deleted overlay entries.
+func (it *mergeIterator) skip() {
+	for {
+		if it.pos < len(it.entries) && it.parent.Valid() &&
+			bytes.Equal(it.entries[it.pos].key, it.parent.Key()) {
+			it.parent.Next()
+			continue
+		}
+		if it.onOverlay() && it.entries[it.pos].deleted {
+			it.pos++
+			continue
+		}
+		return
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ephemeral
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/core/store"
+)
+
+// overlayKey is the context key of the overlay of a context.
+type overlayKey struct{}
+
+// overlay holds the overlay stores opened in a context, one per store
+// service.
+type overlay struct {
+	parent context.Context
+	mu     sync.Mutex
+	stores map[*byte]*Store
+}
+
+// WithOverlay returns a context in which the stores of the services wrapped
+// by KVStoreService are copy-on-write overlays over their stores in ctx.
+// The writes made through the returned context are discarded with it.
+func WithOverlay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, overlayKey{}, &overlay{
+		parent: ctx,
+		stores: make(map[*byte]*Store),
+	})
+}
+
+// KVStoreService wraps a store service so that, in a context returned by
+// WithOverlay, its stores are opened as overlays.
+type KVStoreService struct {
+	store.KVStoreService
+	// id identifies the service in the overlays.
+	id *byte
+}
+
+// NewKVStoreService returns an overlay-aware wrapper of the given store
+// service.
+func NewKVStoreService(kss store.KVStoreService) KVStoreService {
+	return KVStoreService{KVStoreService: kss, id: new(byte)}
+}
+
+// OpenKVStore opens the store of the context. In an overlay context, the
+// same overlay is returned for every call, over the store of the context
+// the overlay was created from.
+func (s KVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	ov, ok := ctx.Value(overlayKey{}).(*overlay)
+	if !ok {
+		return s.KVStoreService.OpenKVStore(ctx)
+	}
+
+	ov.mu.Lock()
+	defer ov.mu.Unlock()
+	st, ok := ov.stores[s.id]
+	if !ok {
+		st = NewStore(s.OpenKVStore(ov.parent))
+		ov.stores[s.id] = st
+	}
+	return st
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package ephemeral provides copy-on-write overlays over the node stores, so
+// that candidate state transitions can be evaluated without touching the
+// underlying store and discarded by dropping the overlay.
+package ephemeral
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/core/store"
+)
+
+// Store is a copy-on-write overlay over a parent store. Reads fall through
+// to the parent until a key is written or deleted in the overlay, and
+// writes are never applied to the parent.
+type Store struct {
+	parent store.KVStore
+
+	mu sync.RWMutex
+	// writes holds the entries written or deleted in the overlay, a deleted
+	// entry shadowing the parent entry of the same key.
+	writes map[string]write
+}
+
+// write is an entry written to the overlay.
+type write struct {
+	value   []byte
+	deleted bool
+}
+
+// NewStore returns an empty overlay over parent.
+func NewStore(parent store.KVStore) *Store {
+	return &Store{parent: parent, writes: make(map[string]write)}
+}
+
+// Get returns the value of key, or nil if it is not set.
+func (s *Store) Get(key []byte) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	w, ok := s.writes[string(key)]
+	s.mu.RUnlock()
+	if ok {
+		return w.value, nil
+	}
+	return s.parent.Get(key)
+}
+
+// Has reports whether key is set.
+func (s *Store) Has(key []byte) (bool, error) {
+	if err := validateKey(key); err != nil {
+		return false, err
+	}
+	s.mu.RLock()
+	w, ok := s.writes[string(key)]
+	s.mu.RUnlock()
+	if ok {
+		return !w.deleted, nil
+	}
+	return s.parent.Has(key)
+}
+
+// Set sets the value of key in the overlay.
+func (s *Store) Set(key, value []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	if value == nil {
+		return ErrNilValue
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes[string(key)] = write{value: bytes.Clone(value)}
+	return nil
+}
+
+// Delete deletes key from the overlay, shadowing its parent entry.
+func (s *Store) Delete(key []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes[string(key)] = write{deleted: true}
+	return nil
+}
+
+// Iterator iterates over the keys in [start, end) in ascending order.
+func (s *Store) Iterator(start, end []byte) (store.Iterator, error) {
+	parent, err := s.parent.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return newMergeIterator(parent, s.snapshot(start, end, false), false), nil
+}
+
+// ReverseIterator iterates over the keys in [start, end) in descending
+// order.
+func (s *Store) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	parent, err := s.parent.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return newMergeIterator(parent, s.snapshot(start, end, true), true), nil
+}
+
+// snapshot returns the overlay entries in [start, end), in iteration order.
+func (s *Store) snapshot(start, end []byte, reverse bool) []entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]entry, 0, len(s.writes))
+	for k, w := range s.writes {
+		if (start == nil || k >= string(start)) &&
+			(end == nil || k < string(end)) {
+			entries = append(entries, entry{key: []byte(k), write: w})
+		}
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		if reverse {
+			return bytes.Compare(b.key, a.key)
+		}
+		return bytes.Compare(a.key, b.key)
+	})
+	return entries
+}
+
+// validateKey returns an error if key cannot be stored.
+func validateKey(key []byte) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ephemeral_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/ephemeral"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func newParent(t *testing.T) *memdb.DB {
+	t.Helper()
+	db := memdb.New()
+	for _, k := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, db.Set([]byte(k), []byte("parent-"+k)))
+	}
+	return db
+}
+
+// collect returns the keys and values of it, closing it.
+func collect(t *testing.T, it store.Iterator) []string {
+	t.Helper()
+	defer func() { require.NoError(t, it.Close()) }()
+	var kvs []string
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+	}
+	require.NoError(t, it.Error())
+	return kvs
+}
+
+func TestStore_ReadsFallThrough(t *testing.T) {
+	st := ephemeral.NewStore(newParent(t))
+
+	value, err := st.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("parent-a"), value)
+
+	has, err := st.Has([]byte("z"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestStore_WritesStayInOverlay(t *testing.T) {
+	parent := newParent(t)
+	st := ephemeral.NewStore(parent)
+
+	require.NoError(t, st.Set([]byte("a"), []byte("overlay-a")))
+	require.NoError(t, st.Set([]byte("e"), []byte("overlay-e")))
+	require.NoError(t, st.Delete([]byte("b")))
+
+	value, err := st.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("overlay-a"), value)
+	value, err = st.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+	has, err := st.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The parent is untouched.
+	value, err = parent.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("parent-a"), value)
+	has, err = parent.Has([]byte("b"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = parent.Has([]byte("e"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestStore_RejectsInvalidWrites(t *testing.T) {
+	st := ephemeral.NewStore(memdb.New())
+	require.ErrorIs(t, st.Set(nil, []byte("v")), ephemeral.ErrEmptyKey)
+	require.ErrorIs(t, st.Set([]byte("k"), nil), ephemeral.ErrNilValue)
+	require.ErrorIs(t, st.Delete(nil), ephemeral.ErrEmptyKey)
+}
+
+func TestStore_IteratorsMergeOverlay(t *testing.T) {
+	st := ephemeral.NewStore(newParent(t))
+	require.NoError(t, st.Set([]byte("b"), []byte("overlay-b")))
+	require.NoError(t, st.Set([]byte("bb"), []byte("overlay-bb")))
+	require.NoError(t, st.Delete([]byte("c")))
+	require.NoError(t, st.Delete([]byte("d")))
+	require.NoError(t, st.Set([]byte("e"), []byte("overlay-e")))
+
+	it, err := st.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"a=parent-a", "b=overlay-b", "bb=overlay-bb", "e=overlay-e",
+	}, collect(t, it))
+
+	it, err = st.ReverseIterator(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"e=overlay-e", "bb=overlay-bb", "b=overlay-b", "a=parent-a",
+	}, collect(t, it))
+
+	it, err = st.Iterator([]byte("b"), []byte("d"))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"b=overlay-b", "bb=overlay-bb",
+	}, collect(t, it))
+}
+
+// service is a store service over a single store.
+type service struct {
+	store.KVStore
+}
+
+func (s service) OpenKVStore(context.Context) store.KVStore {
+	return s.KVStore
+}
+
+func TestKVStoreService_Overlays(t *testing.T) {
+	parent := newParent(t)
+	kss := ephemeral.NewKVStoreService(service{KVStore: parent})
+
+	// Without an overlay, the store is opened as is.
+	ctx := context.Background()
+	require.NoError(t, kss.OpenKVStore(ctx).Set([]byte("x"), []byte("base")))
+
+	// Every store opened in an overlay context is the same overlay.
+	ovCtx := ephemeral.WithOverlay(ctx)
+	require.NoError(t, kss.OpenKVStore(ovCtx).Set([]byte("y"), []byte("1")))
+	value, err := kss.OpenKVStore(ovCtx).Get([]byte("y"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	// Overlays nest, reading the writes of their parent overlay.
+	nestedCtx := ephemeral.WithOverlay(ovCtx)
+	value, err = kss.OpenKVStore(nestedCtx).Get([]byte("y"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	require.NoError(t, kss.OpenKVStore(nestedCtx).Delete([]byte("y")))
+	value, err = kss.OpenKVStore(ovCtx).Get([]byte("y"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+
+	// Dropping the overlays discards their writes.
+	has, err := kss.OpenKVStore(ctx).Has([]byte("y"))
+	require.NoError(t, err)
+	require.False(t, has)
+	value, err = parent.Get([]byte("x"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("base"), value)
+}
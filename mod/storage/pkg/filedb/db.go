@@ -21,11 +21,13 @@
 package filedb
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
 	"github.com/spf13/afero"
 )
 
@@ -38,6 +40,9 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	// compressor compresses the values written, if set. Values are decoded
+	// whatever codec they were written with.
+	compressor *compression.Compressor
 }
 
 // NewDB creates a new instance of the DB.
@@ -55,7 +60,7 @@ func NewDB(opts ...Option) *DB {
 
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	return db.read(db.pathForKey(key))
 }
 
 // Has returns true if the key exists in the database.
@@ -87,7 +92,7 @@ func (db *DB) Set(key []byte, value []byte) error {
 	}
 	defer file.Close()
 
-	n, err := file.Write(value)
+	n, err := file.Write(db.encode(value))
 	if err != nil {
 		return errors.Wrap(err, "failed to write to file")
 	}
@@ -101,6 +106,121 @@ func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
 }
 
+// Size returns the number of values of the database and the number of bytes
+// they take up on disk.
+func (db *DB) Size() (uint64, uint64, error) {
+	var entries, bytes uint64
+	err := afero.Walk(
+		db.fs, ".", func(path string, info os.FileInfo, err error) error {
+			if os.IsNotExist(err) {
+				// The value was deleted while walking the database.
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != "."+db.extension {
+				return nil
+			}
+			entries++
+			//#nosec:G115 // file sizes are never negative.
+			bytes += uint64(info.Size())
+			return nil
+		},
+	)
+	return entries, bytes, err
+}
+
+// getAll returns the values of every key under the given directory, ordered
+// by key. A directory that does not exist holds no keys.
+func (db *DB) getAll(dir string) ([][]byte, error) {
+	entries, err := afero.ReadDir(db.fs, dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != "."+db.extension {
+			continue
+		}
+		value, err := db.read(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Recompress rewrites every value that is not encoded with the codec of the
+// compressor of the database, and returns the number of values rewritten.
+// Each value is written to a temporary file that is renamed over the
+// original, so readers never observe a partially written value and the
+// database may be recompressed while it is in use.
+func (db *DB) Recompress(ctx context.Context) (int, error) {
+	if db.compressor == nil {
+		return 0, ErrNoCompressor
+	}
+
+	var rewritten int
+	err := afero.Walk(
+		db.fs, ".", func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			} else if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if info.IsDir() || filepath.Ext(path) != "."+db.extension {
+				return nil
+			}
+
+			bz, err := afero.ReadFile(db.fs, path)
+			if err != nil {
+				return err
+			}
+			if compression.FormatOf(bz) == db.compressor.Codec() {
+				return nil
+			}
+			value, err := db.compressor.Decode(bz)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decode %s", path)
+			}
+
+			tmp := path + ".tmp"
+			if err = afero.WriteFile(
+				db.fs, tmp, db.compressor.Encode(value), info.Mode(),
+			); err != nil {
+				return err
+			}
+			if err = db.fs.Rename(tmp, path); err != nil {
+				return err
+			}
+			rewritten++
+			return nil
+		},
+	)
+	return rewritten, err
+}
+
+// read returns the decoded value stored at the given path.
+func (db *DB) read(path string) ([]byte, error) {
+	bz, err := afero.ReadFile(db.fs, path)
+	if err != nil || db.compressor == nil {
+		return bz, err
+	}
+	return db.compressor.Decode(bz)
+}
+
+// encode returns the value as it is written to disk.
+func (db *DB) encode(value []byte) []byte {
+	if db.compressor == nil {
+		return value
+	}
+	return db.compressor.Encode(value)
+}
+
 // pathForKey returns the path for a key.
 // TODO: for efficient storage we should expand this path
 func (db *DB) pathForKey(key []byte) string {
@@ -24,6 +24,7 @@ import (
 	"os"
 
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
 	"github.com/spf13/afero"
 )
 
@@ -38,6 +39,14 @@ func WithAferoFS(fs afero.Fs) Option {
 	}
 }
 
+// WithCompressor sets the compressor values are written with.
+func WithCompressor(compressor *compression.Compressor) Option {
+	return func(db *DB) error {
+		db.compressor = compressor
+		return nil
+	}
+}
+
 // WithDirectoryPermissions sets the permissions for the directory.
 func WithDirectoryPermissions(permissions os.FileMode) Option {
 	return func(db *DB) error {
@@ -21,10 +21,15 @@
 package filedb_test
 
 import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/compression"
 	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -293,3 +298,66 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_Compression(t *testing.T) {
+	rootDir := t.TempDir()
+	newDB := func(codec compression.Codec) *file.DB {
+		compressor, err := compression.NewCompressor(codec)
+		require.NoError(t, err)
+		return file.NewDB(
+			file.WithRootDirectory(rootDir),
+			file.WithFileExtension("ssz"),
+			file.WithDirectoryPermissions(0700),
+			file.WithLogger(log.NewNopLogger()),
+			file.WithCompressor(compressor),
+		)
+	}
+	value := bytes.Repeat([]byte("sidecar"), 256)
+	onDisk := func(key string) []byte {
+		bz, err := os.ReadFile(filepath.Join(rootDir, key+".ssz"))
+		require.NoError(t, err)
+		return bz
+	}
+
+	// Values written before compression was enabled stay readable.
+	require.NoError(t, newDB(compression.None).Set([]byte("1/a"), value))
+	require.Equal(t, value, onDisk("1/a"))
+
+	db := newDB(compression.Zstd)
+	require.NoError(t, db.Set([]byte("1/b"), value))
+	require.Equal(t, compression.Zstd, compression.FormatOf(onDisk("1/b")))
+	for _, key := range []string{"1/a", "1/b"} {
+		got, err := db.Get([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, value, got)
+	}
+
+	// Recompression rewrites only the values in another format.
+	n, err := db.Recompress(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, compression.Zstd, compression.FormatOf(onDisk("1/a")))
+
+	n, err = newDB(compression.Snappy).Recompress(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	values, err := file.NewRangeDB(db).GetByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{value, value}, values)
+}
+
+func TestDB_Size(t *testing.T) {
+	db := file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("ssz"),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	require.NoError(t, db.Set([]byte("a"), []byte("value")))
+	require.NoError(t, db.Set([]byte("b"), []byte("values")))
+
+	entries, bytes, err := db.Size()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), entries)
+	require.Equal(t, uint64(11), bytes)
+}
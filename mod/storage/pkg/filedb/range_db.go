@@ -23,12 +23,15 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"slices"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -37,6 +40,11 @@ const two = 2
 // Compile-time assertion of prunable interface.
 var _ pruner.Prunable = (*RangeDB)(nil)
 
+// wrapper is a database wrapping another one, e.g. to meter it.
+type wrapper interface {
+	Unwrap() db.DB
+}
+
 // RangeDB is a database that stores versioned data.
 // It prefixes keys with an index.
 // Invariant: No index below firstNonNilIndex should be populated.
@@ -60,6 +68,48 @@ func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
 	return db.DB.Get(db.prefix(index, key))
 }
 
+// GetByIndex retrieves every value stored at the given index, ordered by
+// key.
+func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
+	f, ok := db.file()
+	if !ok {
+		return nil, errors.New(
+			"rangedb: get by index not supported for this db",
+		)
+	}
+	return f.getAll(strconv.FormatUint(index, 10))
+}
+
+// Indexes returns every index holding values, in ascending order.
+func (db *RangeDB) Indexes() ([]uint64, error) {
+	f, ok := db.file()
+	if !ok {
+		return nil, errors.New("rangedb: indexes not supported for this db")
+	}
+	entries, err := afero.ReadDir(f.fs, "")
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		// Directories not named after an index are not written by the
+		// range db and are skipped.
+		index, pErr := strconv.ParseUint(entry.Name(), 10, 64)
+		if pErr != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
 // Has checks if the given index and key exist in the database.
 // It prefixes the key with the index and a slash before querying the underlying
 // database.
@@ -89,7 +139,7 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
 func (db *RangeDB) DeleteRange(from, to uint64) error {
-	f, ok := db.DB.(*DB)
+	f, ok := db.file()
 	if !ok {
 		return errors.New("rangedb: delete range not supported for this db")
 	}
@@ -117,6 +167,22 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return nil
 }
 
+// file returns the file database backing the range db, looking through
+// the databases wrapping it.
+func (db *RangeDB) file() (*DB, bool) {
+	inner := db.DB
+	for {
+		switch d := inner.(type) {
+		case *DB:
+			return d, true
+		case wrapper:
+			inner = d.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
 // prefix prefixes the given key with the index and a slash.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
 	return []byte(fmt.Sprintf("%d/%s", index, hex.EncodeBytes(key)))
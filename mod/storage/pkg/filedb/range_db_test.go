@@ -54,6 +54,31 @@ func TestRangeDB(t *testing.T) {
 				require.Equal(t, []byte("testValue"), gotValue)
 			},
 		},
+		{
+			name: "GetByIndex",
+			setupFunc: func(rdb *file.RangeDB) error {
+				for _, key := range []string{"b", "a"} {
+					if err := rdb.Set(
+						10, []byte(key), []byte("value-"+key),
+					); err != nil {
+						return err
+					}
+				}
+				return rdb.Set(11, []byte("c"), []byte("value-c"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				values, err := rdb.GetByIndex(10)
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{
+					[]byte("value-a"), []byte("value-b"),
+				}, values)
+
+				values, err = rdb.GetByIndex(12)
+				require.NoError(t, err)
+				require.Empty(t, values)
+			},
+		},
 		{
 			name: "Has",
 			setupFunc: func(rdb *file.RangeDB) error {
@@ -277,6 +302,26 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_Indexes(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	indexes, err := rdb.Indexes()
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	for _, index := range []uint64{12, 3, 100} {
+		require.NoError(t, rdb.Set(index, []byte("key"), []byte("value")))
+	}
+	indexes, err = rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 12, 100}, indexes)
+
+	require.NoError(t, rdb.Prune(0, 13))
+	indexes, err = rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{100}, indexes)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hotkeys
+
+// Config is the configuration for the tracing of hot keys on the read path of
+// the storage.
+type Config struct {
+	// Enabled turns on the sampling of the keys read from the beacon state
+	// and the block store.
+	Enabled bool `mapstructure:"enabled"`
+	// SampleRate is the fraction of reads that are sampled, between 0 and 1.
+	SampleRate float64 `mapstructure:"sample-rate"`
+	// TopKeys is the number of keys included in the ranked view.
+	TopKeys int `mapstructure:"top-keys"`
+}
+
+// DefaultConfig returns the default hot keys configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:    false,
+		SampleRate: 0.01,
+		TopKeys:    20,
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hotkeys
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+)
+
+// KVStoreService wraps a store service so that the reads made on its stores
+// are sampled by a Tracer.
+type KVStoreService struct {
+	store.KVStoreService
+	tracer *Tracer
+	// name returns the name of the collection stored under a key prefix, or
+	// an empty string if the prefix is unknown.
+	name func(prefix byte) string
+}
+
+// NewKVStoreService returns a tracing wrapper of the given store service. The
+// collection a key belongs to is named by its first byte.
+func NewKVStoreService(
+	kss store.KVStoreService,
+	tracer *Tracer,
+	name func(prefix byte) string,
+) KVStoreService {
+	return KVStoreService{KVStoreService: kss, tracer: tracer, name: name}
+}
+
+// OpenKVStore opens the store of the context, tracing the reads made on it.
+func (s KVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	kvs := s.KVStoreService.OpenKVStore(ctx)
+	if s.tracer == nil {
+		return kvs
+	}
+	return tracingStore{KVStore: kvs, service: s}
+}
+
+// record samples a read of key.
+func (s KVStoreService) record(key []byte) {
+	if !s.tracer.sample() {
+		return
+	}
+	var collection string
+	if len(key) > 0 {
+		collection = s.name(key[0])
+		if collection == "" {
+			collection = fmt.Sprintf("0x%02x", key[0])
+		}
+	}
+	s.tracer.count(collection, key)
+}
+
+// tracingStore is a store whose reads are sampled by a Tracer.
+type tracingStore struct {
+	store.KVStore
+	service KVStoreService
+}
+
+// Get returns the value of key.
+func (s tracingStore) Get(key []byte) ([]byte, error) {
+	s.service.record(key)
+	return s.KVStore.Get(key)
+}
+
+// Has reports whether key exists.
+func (s tracingStore) Has(key []byte) (bool, error) {
+	s.service.record(key)
+	return s.KVStore.Has(key)
+}
+
+// Iterator iterates over a domain of keys in ascending order.
+func (s tracingStore) Iterator(start, end []byte) (store.Iterator, error) {
+	it, err := s.KVStore.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return tracingIterator{Iterator: it, service: s.service}, nil
+}
+
+// ReverseIterator iterates over a domain of keys in descending order.
+func (s tracingStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	it, err := s.KVStore.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return tracingIterator{Iterator: it, service: s.service}, nil
+}
+
+// tracingIterator samples the entries whose values are read.
+type tracingIterator struct {
+	store.Iterator
+	service KVStoreService
+}
+
+// Value returns the value of the current entry.
+func (it tracingIterator) Value() []byte {
+	it.service.record(it.Iterator.Key())
+	return it.Iterator.Value()
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hotkeys
+
+import (
+	"cmp"
+	"encoding/hex"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// windowLength is the length of the windows reads are counted over.
+	windowLength = time.Minute
+	// maxTrackedKeys bounds the number of distinct keys counted in a window.
+	// Samples of further keys are only counted against their collection.
+	maxTrackedKeys = 4096
+)
+
+// Tracer samples the reads made on the storage and counts them, per key and
+// per collection, over windows of a minute. A nil Tracer records nothing.
+type Tracer struct {
+	// sampleRate is the fraction of reads that are sampled.
+	sampleRate float64
+	// topKeys is the number of keys included in a Report.
+	topKeys int
+	// now returns the current time.
+	now func() time.Time
+
+	// mu protects the windows below.
+	mu sync.Mutex
+	// current is the window reads are currently counted in.
+	current *window
+	// last is the last complete window.
+	last *window
+}
+
+// window holds the samples taken over a minute.
+type window struct {
+	start       time.Time
+	samples     uint64
+	collections map[string]uint64
+	keys        map[keyID]uint64
+}
+
+// keyID identifies a key of a collection.
+type keyID struct {
+	collection string
+	key        string
+}
+
+// NewTracer returns a Tracer configured by cfg, or nil if the tracing is
+// disabled.
+func NewTracer(cfg Config) *Tracer {
+	if !cfg.Enabled || cfg.SampleRate <= 0 {
+		return nil
+	}
+	return &Tracer{
+		sampleRate: min(cfg.SampleRate, 1),
+		topKeys:    cfg.TopKeys,
+		now:        time.Now,
+	}
+}
+
+// Record samples a read of key from collection.
+func (t *Tracer) Record(collection string, key []byte) {
+	if t.sample() {
+		t.count(collection, key)
+	}
+}
+
+// sample reports whether a read is to be sampled.
+func (t *Tracer) sample() bool {
+	return t != nil && rand.Float64() < t.sampleRate
+}
+
+// count counts a sampled read of key from collection.
+func (t *Tracer) count(collection string, key []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w := t.roll()
+	w.samples++
+	w.collections[collection]++
+	id := keyID{collection: collection, key: string(key)}
+	if _, ok := w.keys[id]; ok || len(w.keys) < maxTrackedKeys {
+		w.keys[id]++
+	}
+}
+
+// Report returns the ranked view of the reads sampled over the last complete
+// minute.
+func (t *Tracer) Report() Report {
+	if t == nil {
+		return Report{}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.roll()
+	r := Report{
+		Enabled:    true,
+		SampleRate: t.sampleRate,
+	}
+	if t.last == nil {
+		return r
+	}
+	r.Start = t.last.start
+	r.Samples = t.last.samples
+	r.Collections = make([]Entry, 0, len(t.last.collections))
+	for collection, n := range t.last.collections {
+		r.Collections = append(r.Collections, Entry{
+			Collection: collection,
+			Samples:    n,
+		})
+	}
+	r.Keys = make([]Entry, 0, len(t.last.keys))
+	for id, n := range t.last.keys {
+		r.Keys = append(r.Keys, Entry{
+			Collection: id.collection,
+			Key:        hex.EncodeToString([]byte(id.key)),
+			Samples:    n,
+		})
+	}
+	slices.SortFunc(r.Collections, compareEntries)
+	slices.SortFunc(r.Keys, compareEntries)
+	if len(r.Keys) > t.topKeys {
+		r.Keys = r.Keys[:t.topKeys]
+	}
+	return r
+}
+
+// roll moves on to the window of the current minute, and returns it. It must
+// be called with mu held.
+func (t *Tracer) roll() *window {
+	start := t.now().Truncate(windowLength)
+	if t.current != nil && t.current.start.Equal(start) {
+		return t.current
+	}
+	switch {
+	case t.current != nil && t.current.start.Add(windowLength).Equal(start):
+		t.last = t.current
+	default:
+		// Nothing was sampled over the previous minute.
+		t.last = newWindow(start.Add(-windowLength))
+	}
+	t.current = newWindow(start)
+	return t.current
+}
+
+// newWindow returns an empty window starting at start.
+func newWindow(start time.Time) *window {
+	return &window{
+		start:       start,
+		collections: make(map[string]uint64),
+		keys:        make(map[keyID]uint64),
+	}
+}
+
+// compareEntries orders entries by decreasing number of samples, and then by
+// collection and key so that the order is stable.
+func compareEntries(a, b Entry) int {
+	return cmp.Or(
+		cmp.Compare(b.Samples, a.Samples),
+		cmp.Compare(a.Collection, b.Collection),
+		cmp.Compare(a.Key, b.Key),
+	)
+}
+
+// Report is the ranked view of the reads sampled over a minute.
+type Report struct {
+	// Enabled reports whether the tracing is enabled.
+	Enabled bool
+	// Start is the start of the minute the reads were sampled over.
+	Start time.Time
+	// SampleRate is the fraction of reads that were sampled.
+	SampleRate float64
+	// Samples is the number of reads sampled.
+	Samples uint64
+	// Collections are the collections read from, most read first.
+	Collections []Entry
+	// Keys are the most read keys, most read first.
+	Keys []Entry
+}
+
+// Entry is the number of sampled reads of a collection, or of a key of it.
+type Entry struct {
+	// Collection is the name of the collection.
+	Collection string
+	// Key is the hex encoded key, empty for an entry of a whole collection.
+	Key string
+	// Samples is the number of sampled reads.
+	Samples uint64
+}
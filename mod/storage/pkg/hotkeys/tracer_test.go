@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hotkeys
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTracer returns a Tracer sampling every read, whose clock is the
+// returned pointer.
+func newTestTracer(topKeys int) (*Tracer, *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t := NewTracer(Config{Enabled: true, SampleRate: 1, TopKeys: topKeys})
+	t.now = func() time.Time { return now }
+	return t, &now
+}
+
+func TestTracerDisabled(t *testing.T) {
+	tracer := NewTracer(DefaultConfig())
+	require.Nil(t, tracer)
+	tracer.Record("Slot", []byte{1})
+	require.False(t, tracer.Report().Enabled)
+}
+
+func TestTracerReportsLastMinute(t *testing.T) {
+	tracer, now := newTestTracer(2)
+
+	tracer.Record("Balances", []byte{0x0d, 1})
+	tracer.Record("Balances", []byte{0x0d, 1})
+	tracer.Record("Balances", []byte{0x0d, 2})
+	tracer.Record("Slot", []byte{0x0c})
+	tracer.Record("Balances", []byte{0x0d, 3})
+	tracer.Record("Slot", []byte{0x0c})
+	tracer.Record("Slot", []byte{0x0c})
+
+	// The current minute is not reported until it is complete.
+	report := tracer.Report()
+	require.True(t, report.Enabled)
+	require.Zero(t, report.Samples)
+	require.Empty(t, report.Keys)
+
+	*now = now.Add(time.Minute)
+	report = tracer.Report()
+	require.Equal(t, now.Add(-time.Minute), report.Start)
+	require.Equal(t, uint64(7), report.Samples)
+	require.Equal(t, []Entry{
+		{Collection: "Balances", Samples: 4},
+		{Collection: "Slot", Samples: 3},
+	}, report.Collections)
+	require.Equal(t, []Entry{
+		{Collection: "Slot", Key: "0c", Samples: 3},
+		{Collection: "Balances", Key: "0d01", Samples: 2},
+	}, report.Keys)
+
+	// A minute without reads empties the view.
+	*now = now.Add(2 * time.Minute)
+	report = tracer.Report()
+	require.Zero(t, report.Samples)
+	require.Empty(t, report.Collections)
+}
+
+func TestTracerBoundsTrackedKeys(t *testing.T) {
+	tracer, now := newTestTracer(1)
+	for i := range maxTrackedKeys + 10 {
+		tracer.Record("Balances", []byte{byte(i >> 8), byte(i)})
+	}
+	require.Len(t, tracer.current.keys, maxTrackedKeys)
+
+	*now = now.Add(time.Minute)
+	report := tracer.Report()
+	require.Equal(t, uint64(maxTrackedKeys+10), report.Collections[0].Samples)
+	require.Len(t, report.Keys, 1)
+}
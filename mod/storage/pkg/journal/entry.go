@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import "encoding/json"
+
+// Entry is a single event recorded in the journal.
+type Entry struct {
+	// Topic is the topic the event was published under.
+	Topic string `json:"topic"`
+	// Data is the JSON encoded payload of the event.
+	Data json.RawMessage `json:"data"`
+}
+
+// entryCodec encodes journal entries as JSON.
+type entryCodec struct{}
+
+// Encode marshals the entry into its JSON encoding.
+func (entryCodec) Encode(value Entry) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+// Decode unmarshals the entry from its JSON encoding.
+func (entryCodec) Decode(bz []byte) (Entry, error) {
+	var v Entry
+	if err := json.Unmarshal(bz, &v); err != nil {
+		return Entry{}, err
+	}
+	return v, nil
+}
+
+// EncodeJSON marshals the entry into its JSON encoding.
+func (c entryCodec) EncodeJSON(value Entry) ([]byte, error) {
+	return c.Encode(value)
+}
+
+// DecodeJSON unmarshals the entry from its JSON encoding.
+func (c entryCodec) DecodeJSON(bz []byte) (Entry, error) {
+	return c.Decode(bz)
+}
+
+// Stringify returns the string representation of the entry.
+func (entryCodec) Stringify(value Entry) string {
+	return value.Topic + ": " + string(value.Data)
+}
+
+// ValueType returns the name of the type this codec is intended for.
+func (entryCodec) ValueType() string {
+	return "journal.Entry"
+}
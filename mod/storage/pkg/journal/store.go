@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package journal
+
+import (
+	"context"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+)
+
+const (
+	keyEntriesPrefix  = "entries"
+	keySequencePrefix = "sequence"
+)
+
+// key orders the journal by slot, then by publication order.
+type key = sdkcollections.Pair[uint64, uint64]
+
+// Store is a persistent, slot ordered log of the events published by the
+// node. Every entry is keyed by the slot it belongs to and a sequence number
+// that increases monotonically across restarts.
+type Store struct {
+	entries  sdkcollections.Map[key, Entry]
+	sequence sdkcollections.Sequence
+	mu       sync.RWMutex
+}
+
+// NewStore creates a new journal store.
+func NewStore(kvsp store.KVStoreService) *Store {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &Store{
+		entries: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(keyEntriesPrefix)),
+			keyEntriesPrefix,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+			),
+			entryCodec{},
+		),
+		sequence: sdkcollections.NewSequence(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(keySequencePrefix)),
+			keySequencePrefix,
+		),
+	}
+}
+
+// Append records the JSON encoded data of an event published under topic
+// at the given slot.
+func (s *Store) Append(slot uint64, topic string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seq, err := s.sequence.Next(context.TODO())
+	if err != nil {
+		return err
+	}
+	return s.entries.Set(
+		context.TODO(),
+		sdkcollections.Join(slot, seq),
+		Entry{Topic: topic, Data: data},
+	)
+}
+
+// Walk calls fn for every entry at or after the given slot and sequence
+// number, in order, until fn returns false or the journal is exhausted.
+func (s *Store) Walk(
+	fromSlot, fromSeq uint64,
+	fn func(slot, seq uint64, topic string, data []byte) bool,
+) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	iter, err := s.entries.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[key]).StartInclusive(
+			sdkcollections.Join(fromSlot, fromSeq),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		if !fn(kv.Key.K1(), kv.Key.K2(), kv.Value.Topic, kv.Value.Data) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Prune removes the entries of the slots in [start, end).
+func (s *Store) Prune(start, end uint64) error {
+	if start >= end {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	iter, err := s.entries.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[key]).
+			StartInclusive(sdkcollections.Join(start, uint64(0))).
+			EndExclusive(sdkcollections.Join(end, uint64(0))),
+	)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err = s.entries.Remove(context.TODO(), k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Activity is a set of latency sensitive activities of the node, during
+// which pruning is paused to keep the disk free for them.
+type Activity uint8
+
+const (
+	// ActivitySyncing is set while the node catches up with the chain.
+	ActivitySyncing Activity = 1 << iota
+	// ActivityProposing is set from the moment the node is asked for a
+	// proposal until the block of that slot is finalized.
+	ActivityProposing
+)
+
+// String returns the names of the activities in the set.
+func (a Activity) String() string {
+	switch a {
+	case 0:
+		return "idle"
+	case ActivitySyncing:
+		return "syncing"
+	case ActivityProposing:
+		return "proposing"
+	default:
+		return "syncing,proposing"
+	}
+}
+
+// ActivitySource reports the activities of the node.
+type ActivitySource interface {
+	// Track calls update with the activities of the node whenever they
+	// change, until ctx is done.
+	Track(ctx context.Context, update func(Activity))
+}
+
+// Compile-time check that the tracker implements ActivitySource.
+var _ ActivitySource = (*ActivityTracker[BeaconBlock, SlotData])(nil)
+
+// ActivityTracker derives the activities of the node from consensus events.
+// The node proposes from the new slot event of its proposal until the block
+// of that slot is finalized. It is syncing while it finalizes blocks it has
+// not seen proposed, as is the case when blocks are replayed or fetched
+// from peers rather than agreed upon.
+type ActivityTracker[
+	BeaconBlockT BeaconBlock,
+	SlotDataT SlotData,
+] struct {
+	subNewSlot              chan async.Event[SlotDataT]
+	subBeaconBlockReceived  chan async.Event[BeaconBlockT]
+	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
+	// proposalSlot is the slot of the pending proposal of the node, if any.
+	proposalSlot *math.Slot
+	// lastSeenSlot is the slot of the last block seen in consensus.
+	lastSeenSlot *math.Slot
+	// activity is the last reported set of activities.
+	activity Activity
+}
+
+// NewActivityTracker returns an ActivityTracker following the given new slot,
+// block received and block finalized event subscriptions.
+func NewActivityTracker[
+	BeaconBlockT BeaconBlock,
+	SlotDataT SlotData,
+](
+	subNewSlot chan async.Event[SlotDataT],
+	subBeaconBlockReceived chan async.Event[BeaconBlockT],
+	subBeaconBlockFinalized chan async.Event[BeaconBlockT],
+) *ActivityTracker[BeaconBlockT, SlotDataT] {
+	return &ActivityTracker[BeaconBlockT, SlotDataT]{
+		subNewSlot:              subNewSlot,
+		subBeaconBlockReceived:  subBeaconBlockReceived,
+		subBeaconBlockFinalized: subBeaconBlockFinalized,
+	}
+}
+
+// Track implements ActivitySource.
+func (t *ActivityTracker[_, _]) Track(
+	ctx context.Context,
+	update func(Activity),
+) {
+	for {
+		activity := t.activity
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-t.subNewSlot:
+			slot := event.Data().GetSlot()
+			t.proposalSlot, t.lastSeenSlot = &slot, &slot
+			activity |= ActivityProposing
+		case event := <-t.subBeaconBlockReceived:
+			slot := event.Data().GetSlot()
+			t.lastSeenSlot = &slot
+		case event := <-t.subBeaconBlockFinalized:
+			activity = t.onFinalized(event.Data().GetSlot())
+		}
+		if activity != t.activity {
+			t.activity = activity
+			update(activity)
+		}
+	}
+}
+
+// onFinalized returns the activities of the node once the block of the
+// given slot is finalized.
+func (t *ActivityTracker[_, _]) onFinalized(slot math.Slot) Activity {
+	var activity Activity
+	if t.proposalSlot != nil {
+		if *t.proposalSlot > slot {
+			activity |= ActivityProposing
+		} else {
+			t.proposalSlot = nil
+		}
+	}
+	if t.lastSeenSlot == nil || *t.lastSeenSlot != slot {
+		activity |= ActivitySyncing
+	}
+	return activity
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+type slotData math.Slot
+
+func (s slotData) GetSlot() math.Slot {
+	return math.Slot(s)
+}
+
+func blockEvent(
+	id async.EventID,
+	slot math.Slot,
+) async.Event[manager.BeaconBlock] {
+	block := new(mocks.BeaconBlock)
+	block.On("GetSlot").Return(slot)
+	return async.NewEvent[manager.BeaconBlock](
+		context.Background(), id, block,
+	)
+}
+
+func TestActivityTracker_Track(t *testing.T) {
+	var (
+		subNewSlot   = make(chan async.Event[slotData])
+		subReceived  = make(chan async.Event[manager.BeaconBlock])
+		subFinalized = make(chan async.Event[manager.BeaconBlock])
+		updates      = make(chan manager.Activity, 8)
+	)
+	tracker := manager.NewActivityTracker(
+		subNewSlot, subReceived, subFinalized,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tracker.Track(ctx, func(a manager.Activity) { updates <- a })
+
+	expect := func(want manager.Activity) {
+		t.Helper()
+		select {
+		case got := <-updates:
+			require.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("no update, want %s", want)
+		}
+	}
+
+	// Blocks finalized without being proposed are synced.
+	subFinalized <- blockEvent(async.BeaconBlockFinalized, 1)
+	expect(manager.ActivitySyncing)
+	subFinalized <- blockEvent(async.BeaconBlockFinalized, 2)
+
+	// A block agreed upon in consensus ends the sync.
+	subReceived <- blockEvent(async.BeaconBlockReceived, 3)
+	subFinalized <- blockEvent(async.BeaconBlockFinalized, 3)
+	expect(0)
+
+	// A proposal lasts until the block of its slot is finalized.
+	subNewSlot <- async.NewEvent(
+		context.Background(), async.NewSlot, slotData(4),
+	)
+	expect(manager.ActivityProposing)
+	subFinalized <- blockEvent(async.BeaconBlockFinalized, 4)
+	expect(0)
+	require.Empty(t, updates)
+}
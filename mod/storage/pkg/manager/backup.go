@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// backupFilePermissions restricts store backups to the node operator.
+	backupFilePermissions = 0o600
+	// maxBackupChunkLength bounds the length of a single key or value read
+	// back from a backup, so a corrupted length cannot exhaust memory.
+	maxBackupChunkLength = 64 << 20
+)
+
+// backupStore writes every key and value of db to the file at path. Entries
+// are written in key order, each as a uvarint length prefixed key followed
+// by a uvarint length prefixed value.
+func backupStore(db store.KVStore, path string) error {
+	f, err := os.OpenFile(
+		path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, backupFilePermissions,
+	)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err = writeEntries(db, w); err == nil {
+		if err = w.Flush(); err == nil {
+			err = f.Sync()
+		}
+	}
+	return errors.Join(err, f.Close())
+}
+
+// writeEntries streams the entries of db to w.
+func writeEntries(db store.KVStore, w io.Writer) error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err = writeChunk(w, iter.Key()); err != nil {
+			return err
+		}
+		if err = writeChunk(w, iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// restoreStore replaces the contents of db with the entries of the backup at
+// path, in a single batch.
+func restoreStore(db store.KVStoreWithBatch, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	if err = deleteAll(db, batch); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	for {
+		var key, value []byte
+		key, err = readChunk(r)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if value, err = readChunk(r); err != nil {
+			return errors.Wrap(ErrMalformedBackup, err.Error())
+		}
+		if err = batch.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// deleteAll adds the deletion of every key of db to batch.
+func deleteAll(db store.KVStore, batch store.Batch) error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if err = batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// writeChunk writes bz to w prefixed with its uvarint encoded length.
+func writeChunk(w io.Writer, bz []byte) error {
+	if _, err := w.Write(
+		binary.AppendUvarint(nil, uint64(len(bz))),
+	); err != nil {
+		return err
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+// readChunk reads a length prefixed chunk written by writeChunk. It returns
+// io.EOF only if r is exhausted before the chunk starts.
+func readChunk(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, errors.Wrap(ErrMalformedBackup, err.Error())
+	}
+	if n > maxBackupChunkLength {
+		return nil, errors.Wrapf(
+			ErrMalformedBackup, "chunk of length %d", n,
+		)
+	}
+	bz := make([]byte, n)
+	if _, err = io.ReadFull(r, bz); err != nil {
+		return nil, errors.Wrap(ErrMalformedBackup, err.Error())
+	}
+	return bz, nil
+}
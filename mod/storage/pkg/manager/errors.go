@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidMigrations is returned when the migrations of a store are
+	// not numbered 1, 2, ... in order.
+	ErrInvalidMigrations = errors.New("migrations are not ordered")
+
+	// ErrSchemaTooNew is returned when a store was written by a newer
+	// release, whose schema this release does not know how to read.
+	ErrSchemaTooNew = errors.New("store schema is newer than supported")
+
+	// ErrUnknownSchemaVersion is returned when a store is asked to migrate
+	// to a version this release has no migration for.
+	ErrUnknownSchemaVersion = errors.New("unknown store schema version")
+
+	// ErrSchemaDowngrade is returned when a store is asked to migrate to a
+	// version older than the one it is at.
+	ErrSchemaDowngrade = errors.New("store schema cannot be downgraded")
+
+	// ErrMalformedSchemaVersion is returned when the schema version recorded
+	// in a store cannot be decoded.
+	ErrMalformedSchemaVersion = errors.New("malformed schema version")
+
+	// ErrMalformedBackup is returned when a store backup is truncated or
+	// otherwise cannot be decoded.
+	ErrMalformedBackup = errors.New("malformed store backup")
+)
@@ -32,19 +32,27 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-// DBManager is a manager for all pruners.
+// DBManager is a manager for all pruners. Pruning is paused while the node
+// syncs or proposes, as reported by its activity source.
 type DBManager struct {
-	pruners []pruner.Pruner[pruner.Prunable]
-	logger  log.Logger
+	pruners    []pruner.Pruner[pruner.Prunable]
+	activities ActivitySource
+	logger     log.Logger
+	// activity is the current set of activities of the node.
+	activity Activity
 }
 
+// NewDBManager returns a DBManager for the given pruners. A nil activity
+// source never pauses pruning.
 func NewDBManager(
 	logger log.Logger,
+	activities ActivitySource,
 	pruners ...pruner.Pruner[pruner.Prunable],
 ) (*DBManager, error) {
 	return &DBManager{
-		logger:  logger,
-		pruners: pruners,
+		logger:     logger,
+		activities: activities,
+		pruners:    pruners,
 	}, nil
 }
 
@@ -58,5 +66,26 @@ func (m *DBManager) Start(ctx context.Context) error {
 	for _, pruner := range m.pruners {
 		pruner.Start(ctx)
 	}
+	if m.activities != nil {
+		go m.activities.Track(ctx, m.onActivity)
+	}
 	return nil
 }
+
+// onActivity pauses the pruners when the node becomes busy and resumes them
+// once it is idle again.
+func (m *DBManager) onActivity(activity Activity) {
+	switch {
+	case m.activity == 0 && activity != 0:
+		m.logger.Info("Pausing pruning", "activity", activity)
+		for _, p := range m.pruners {
+			p.Pause()
+		}
+	case m.activity != 0 && activity == 0:
+		m.logger.Info("Resuming pruning")
+		for _, p := range m.pruners {
+			p.Resume()
+		}
+	}
+	m.activity = activity
+}
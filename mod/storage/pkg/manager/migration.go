@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+// schemaVersionKey is the key under which a store records the version of its
+// schema. The leading zero byte keeps it clear of the collection prefixes
+// the stores are built on.
+//
+//nolint:gochecknoglobals // read-only.
+var schemaVersionKey = []byte("\x00schema_version")
+
+// schemaVersionLength is the length of the big endian schema version.
+const schemaVersionLength = 8
+
+// Migration upgrades a store to the next version of its schema.
+type Migration struct {
+	// Version is the schema version of the store once the migration has
+	// been applied.
+	Version uint64
+	// Description is a short summary of the change, used in logs.
+	Description string
+	// Apply rewrites the store, e.g. moving keys to a new layout or
+	// re-encoding values.
+	Apply func(db store.KVStoreWithBatch) error
+	// Verify optionally checks that the store is consistent with the schema
+	// once the migration has been applied, e.g. that every value decodes.
+	Verify func(db store.KVStore) error
+}
+
+// Migrator brings a store up to the latest version of its schema on startup.
+// The store is backed up before any migration runs and restored from the
+// backup if one of them fails, so a failed upgrade leaves the data as the
+// previous release wrote it.
+type Migrator struct {
+	logger     log.Logger
+	name       string
+	backupDir  string
+	migrations []Migration
+}
+
+// NewMigrator returns a migrator for the store called name. Backups are
+// written to backupDir. The migrations must be numbered 1, 2, ... in order.
+func NewMigrator(
+	logger log.Logger,
+	name string,
+	backupDir string,
+	migrations ...Migration,
+) (*Migrator, error) {
+	for i, m := range migrations {
+		if m.Version != uint64(i)+1 {
+			return nil, errors.Wrapf(
+				ErrInvalidMigrations,
+				"store %s: migration %d has version %d", name, i, m.Version,
+			)
+		}
+	}
+	return &Migrator{
+		logger:     logger,
+		name:       name,
+		backupDir:  backupDir,
+		migrations: migrations,
+	}, nil
+}
+
+// LatestVersion returns the schema version the migrator upgrades stores to.
+func (m *Migrator) LatestVersion() uint64 {
+	return uint64(len(m.migrations))
+}
+
+// Migrate applies the pending migrations to db. A store without a recorded
+// version is stamped with the latest version if it is empty, and treated as
+// version zero otherwise.
+func (m *Migrator) Migrate(db store.KVStoreWithBatch) error {
+	return m.MigrateTo(db, m.LatestVersion())
+}
+
+// MigrateTo applies the migrations of db up to and including the one to
+// target. The version is recorded after every migration, so a run that is
+// interrupted resumes after the last migration that completed. Stores are
+// never downgraded.
+func (m *Migrator) MigrateTo(db store.KVStoreWithBatch, target uint64) error {
+	latest := m.LatestVersion()
+	if target > latest {
+		return errors.Wrapf(
+			ErrUnknownSchemaVersion,
+			"store %s: target version %d, latest known is %d",
+			m.name, target, latest,
+		)
+	}
+
+	version, found, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	if !found {
+		var empty bool
+		if empty, err = isEmpty(db); err != nil {
+			return err
+		}
+		if empty {
+			return setSchemaVersion(db, target)
+		}
+	}
+
+	switch {
+	case version == target:
+		return nil
+	case version > latest:
+		return errors.Wrapf(
+			ErrSchemaTooNew,
+			"store %s is at version %d, latest known is %d",
+			m.name, version, latest,
+		)
+	case version > target:
+		return errors.Wrapf(
+			ErrSchemaDowngrade,
+			"store %s is at version %d, target is %d",
+			m.name, version, target,
+		)
+	}
+
+	backup := filepath.Join(
+		m.backupDir, fmt.Sprintf("%s.v%d.backup", m.name, version),
+	)
+	m.logger.Info(
+		"Backing up store before migrating",
+		"store", m.name, "from", version, "to", target, "backup", backup,
+	)
+	if err = backupStore(db, backup); err != nil {
+		return errors.Wrapf(err, "backing up store %s", m.name)
+	}
+
+	pending := m.migrations[version:target]
+	for i, migration := range pending {
+		m.logger.Info(
+			"Applying store migration",
+			"store", m.name,
+			"step", fmt.Sprintf("%d/%d", i+1, len(pending)),
+			"version", migration.Version,
+			"description", migration.Description,
+		)
+		if err = migration.Apply(db); err == nil && migration.Verify != nil {
+			err = migration.Verify(db)
+		}
+		if err == nil {
+			err = setSchemaVersion(db, migration.Version)
+		}
+		if err != nil {
+			return m.rollback(db, backup, migration.Version, err)
+		}
+	}
+
+	m.logger.Info("Store migrated", "store", m.name, "version", target)
+	return os.Remove(backup)
+}
+
+// Verify runs the checks of the migration that brought db to its recorded
+// version. Stores at version zero, or whose migration has no checks, are
+// considered consistent.
+func (m *Migrator) Verify(db store.KVStore) error {
+	version, _, err := SchemaVersion(db)
+	switch {
+	case err != nil:
+		return err
+	case version > m.LatestVersion():
+		return errors.Wrapf(
+			ErrSchemaTooNew,
+			"store %s is at version %d, latest known is %d",
+			m.name, version, m.LatestVersion(),
+		)
+	case version == 0 || m.migrations[version-1].Verify == nil:
+		return nil
+	}
+	if err = m.migrations[version-1].Verify(db); err != nil {
+		return errors.Wrapf(
+			err, "verifying store %s at version %d", m.name, version,
+		)
+	}
+	return nil
+}
+
+// rollback restores db from the backup taken before migrating after the
+// migration to version failed with cause.
+func (m *Migrator) rollback(
+	db store.KVStoreWithBatch,
+	backup string,
+	version uint64,
+	cause error,
+) error {
+	m.logger.Error(
+		"Store migration failed, rolling back",
+		"store", m.name, "version", version, "error", cause,
+	)
+	err := errors.Wrapf(
+		cause, "migrating store %s to version %d", m.name, version,
+	)
+	if rerr := restoreStore(db, backup); rerr != nil {
+		return errors.Join(err, errors.Wrapf(
+			rerr, "restoring store %s, backup kept at %s", m.name, backup,
+		))
+	}
+	return errors.Join(err, os.Remove(backup))
+}
+
+// SchemaVersion returns the schema version recorded in db and whether one
+// was found.
+func SchemaVersion(db store.KVStore) (uint64, bool, error) {
+	bz, err := db.Get(schemaVersionKey)
+	if err != nil || bz == nil {
+		return 0, false, err
+	}
+	if len(bz) != schemaVersionLength {
+		return 0, false, errors.Wrapf(
+			ErrMalformedSchemaVersion, "length %d", len(bz),
+		)
+	}
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+// setSchemaVersion records version as the schema version of db.
+func setSchemaVersion(db store.KVStore, version uint64) error {
+	return db.Set(schemaVersionKey, binary.BigEndian.AppendUint64(nil, version))
+}
+
+// isEmpty reports whether db holds no keys at all.
+func isEmpty(db store.KVStore) (bool, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return !iter.Valid(), iter.Error()
+}
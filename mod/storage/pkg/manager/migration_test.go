@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package manager_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/memdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMigrator_RejectsUnorderedMigrations(t *testing.T) {
+	_, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 2},
+	)
+	require.ErrorIs(t, err, manager.ErrInvalidMigrations)
+}
+
+func TestMigrator_StampsEmptyStore(t *testing.T) {
+	db := memdb.New()
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 1, Apply: failingMigration},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Migrate(db))
+
+	version, found, err := manager.SchemaVersion(db)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(1), version)
+}
+
+func TestMigrator_AppliesPendingMigrations(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Set([]byte("old/a"), []byte("1")))
+
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 1, Apply: renamePrefix},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Migrate(db))
+
+	bz, err := db.Get([]byte("new/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), bz)
+	has, err := db.Has([]byte("old/a"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	version, _, err := manager.SchemaVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
+
+func TestMigrator_RollsBackFailedMigration(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Set([]byte("old/a"), []byte("1")))
+
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 1, Apply: renamePrefix},
+		manager.Migration{Version: 2, Apply: failingMigration},
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, m.Migrate(db), errMigrationFailed)
+
+	// The store is back to the state the previous release left it in.
+	bz, err := db.Get([]byte("old/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), bz)
+	has, err := db.Has([]byte("new/a"))
+	require.NoError(t, err)
+	require.False(t, has)
+	_, found, err := manager.SchemaVersion(db)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestMigrator_RejectsNewerSchema(t *testing.T) {
+	db := memdb.New()
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 1, Apply: renamePrefix},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Migrate(db))
+
+	older, err := manager.NewMigrator(log.NewNopLogger(), "test", t.TempDir())
+	require.NoError(t, err)
+	require.ErrorIs(t, older.Migrate(db), manager.ErrSchemaTooNew)
+}
+
+func TestMigrator_MigratesToTarget(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Set([]byte("old/a"), []byte("1")))
+
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{Version: 1, Apply: renamePrefix},
+		manager.Migration{Version: 2, Apply: failingMigration},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.MigrateTo(db, 1))
+
+	version, _, err := manager.SchemaVersion(db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+	require.ErrorIs(t, m.MigrateTo(db, 3), manager.ErrUnknownSchemaVersion)
+	require.ErrorIs(t, m.MigrateTo(db, 0), manager.ErrSchemaDowngrade)
+}
+
+func TestMigrator_RollsBackFailedVerification(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Set([]byte("old/a"), []byte("1")))
+
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{
+			Version: 1,
+			Apply:   renamePrefix,
+			Verify:  func(store.KVStore) error { return errMigrationFailed },
+		},
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, m.Migrate(db), errMigrationFailed)
+
+	has, err := db.Has([]byte("old/a"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestMigrator_VerifiesRecordedVersion(t *testing.T) {
+	db := memdb.New()
+	require.NoError(t, db.Set([]byte("old/a"), []byte("1")))
+
+	m, err := manager.NewMigrator(
+		log.NewNopLogger(), "test", t.TempDir(),
+		manager.Migration{
+			Version: 1,
+			Apply:   renamePrefix,
+			Verify:  noOldKeys,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Migrate(db))
+	require.NoError(t, m.Verify(db))
+
+	require.NoError(t, db.Set([]byte("old/b"), []byte("2")))
+	require.ErrorIs(t, m.Verify(db), errMigrationFailed)
+}
+
+var errMigrationFailed = errors.New("migration failed")
+
+func failingMigration(store.KVStoreWithBatch) error {
+	return errMigrationFailed
+}
+
+// renamePrefix moves every key under "old/" to "new/".
+func renamePrefix(db store.KVStoreWithBatch) error {
+	iter, err := db.Iterator([]byte("old/"), []byte("old0"))
+	if err != nil {
+		return err
+	}
+	batch := db.NewBatch()
+	defer batch.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := append([]byte("new/"), iter.Key()[len("old/"):]...)
+		if err = batch.Set(key, iter.Value()); err != nil {
+			return err
+		}
+		if err = batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	if err = iter.Close(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// noOldKeys fails if a key is left under "old/".
+func noOldKeys(db store.KVStore) error {
+	iter, err := db.Iterator([]byte("old/"), []byte("old0"))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		return errMigrationFailed
+	}
+	return nil
+}
@@ -37,6 +37,11 @@ type BeaconBlock interface {
 	GetSlot() math.U64
 }
 
+// SlotData is an interface for the data of new slot events.
+type SlotData interface {
+	GetSlot() math.Slot
+}
+
 // BlockEvent is an interface for block events.
 type BlockEvent[BeaconBlockT BeaconBlock] interface {
 	Is(async.EventID) bool
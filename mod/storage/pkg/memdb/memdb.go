@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package memdb provides an in-memory store.KVStoreWithBatch, for tests of
+// the code built on top of the node stores.
+package memdb
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/core/store"
+)
+
+// DB is an in-memory store.KVStoreWithBatch. Iterators range over a
+// snapshot of the keys taken when they are created.
+type DB struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// New returns an empty DB.
+func New() *DB {
+	return &DB{data: make(map[string][]byte)}
+}
+
+// Get returns the value of key, or nil if it is not set.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.data[string(key)], nil
+}
+
+// Has reports whether key is set.
+func (db *DB) Has(key []byte) (bool, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	_, ok := db.data[string(key)]
+	return ok, nil
+}
+
+// Set sets the value of key.
+func (db *DB) Set(key, value []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.data[string(key)] = bytes.Clone(value)
+	return nil
+}
+
+// Delete deletes key.
+func (db *DB) Delete(key []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.data, string(key))
+	return nil
+}
+
+// Iterator iterates over the keys in [start, end) in ascending order.
+func (db *DB) Iterator(start, end []byte) (store.Iterator, error) {
+	return db.newIterator(start, end, false), nil
+}
+
+// ReverseIterator iterates over the keys in [start, end) in descending
+// order.
+func (db *DB) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return db.newIterator(start, end, true), nil
+}
+
+// NewBatch returns a batch buffering writes until it is written.
+func (db *DB) NewBatch() store.Batch {
+	return &batch{db: db}
+}
+
+// NewBatchWithSize returns a batch buffering writes until it is written.
+func (db *DB) NewBatchWithSize(int) store.Batch {
+	return db.NewBatch()
+}
+
+// Close implements store.KVStoreWithBatch. The data is kept, so the DB may
+// still be read after it is closed.
+func (db *DB) Close() error {
+	return nil
+}
+
+// newIterator returns an iterator over a snapshot of the keys in
+// [start, end).
+func (db *DB) newIterator(start, end []byte, reverse bool) *iterator {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	it := &iterator{start: start, end: end}
+	for k, v := range db.data {
+		if (start == nil || k >= string(start)) &&
+			(end == nil || k < string(end)) {
+			it.entries = append(it.entries, entry{key: []byte(k), value: v})
+		}
+	}
+	slices.SortFunc(it.entries, func(a, b entry) int {
+		if reverse {
+			return bytes.Compare(b.key, a.key)
+		}
+		return bytes.Compare(a.key, b.key)
+	})
+	return it
+}
+
+// entry is a key and its value.
+type entry struct {
+	key, value []byte
+}
+
+// iterator iterates over a snapshot of the entries of a DB.
+type iterator struct {
+	start, end []byte
+	entries    []entry
+	pos        int
+}
+
+// Domain returns the range the iterator was created over.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid reports whether the iterator is positioned on an entry.
+func (it *iterator) Valid() bool {
+	return it.pos < len(it.entries)
+}
+
+// Next moves the iterator to the next entry.
+func (it *iterator) Next() {
+	it.pos++
+}
+
+// Key returns the key of the current entry.
+func (it *iterator) Key() []byte {
+	return it.entries[it.pos].key
+}
+
+// Value returns the value of the current entry.
+func (it *iterator) Value() []byte {
+	return it.entries[it.pos].value
+}
+
+// Error returns nil, iterating over a snapshot cannot fail.
+func (it *iterator) Error() error {
+	return nil
+}
+
+// Close implements store.Iterator.
+func (it *iterator) Close() error {
+	return nil
+}
+
+// batch buffers writes to a DB until it is written.
+type batch struct {
+	db   *DB
+	ops  []func(map[string][]byte)
+	size int
+}
+
+// Set buffers setting the value of key.
+func (b *batch) Set(key, value []byte) error {
+	k, v := string(key), bytes.Clone(value)
+	b.ops = append(b.ops, func(data map[string][]byte) { data[k] = v })
+	b.size += len(k) + len(v)
+	return nil
+}
+
+// Delete buffers deleting key.
+func (b *batch) Delete(key []byte) error {
+	k := string(key)
+	b.ops = append(b.ops, func(data map[string][]byte) { delete(data, k) })
+	b.size += len(k)
+	return nil
+}
+
+// Write applies the buffered writes to the DB at once.
+func (b *batch) Write() error {
+	b.db.mu.Lock()
+	defer b.db.mu.Unlock()
+	for _, op := range b.ops {
+		op(b.db.data)
+	}
+	b.ops, b.size = nil, 0
+	return nil
+}
+
+// WriteSync applies the buffered writes to the DB at once.
+func (b *batch) WriteSync() error {
+	return b.Write()
+}
+
+// Close discards the buffered writes.
+func (b *batch) Close() error {
+	b.ops, b.size = nil, 0
+	return nil
+}
+
+// GetByteSize returns the size of the buffered keys and values.
+func (b *batch) GetByteSize() (int, error) {
+	return b.size, nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metered
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
+)
+
+// DB wraps an interfaces.DB and exports the latency of its operations,
+// labeled by the name of the store. The number of entries and bytes of the
+// store are exported too if the wrapped database is able to measure them.
+type DB struct {
+	interfaces.DB
+	metrics *metrics
+}
+
+// NewDB creates a new metered DB on top of the given database.
+func NewDB(db interfaces.DB, name string, sink TelemetrySink) *DB {
+	return &DB{DB: db, metrics: newMetrics(sink, name, sizeOf(db))}
+}
+
+// Get returns the value stored at the given key.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	defer db.metrics.done("get", time.Now())
+	return db.DB.Get(key)
+}
+
+// Has reports whether a value is stored at the given key.
+func (db *DB) Has(key []byte) (bool, error) {
+	defer db.metrics.done("has", time.Now())
+	return db.DB.Has(key)
+}
+
+// Set stores the value at the given key.
+func (db *DB) Set(key []byte, value []byte) error {
+	defer db.metrics.done("set", time.Now())
+	return db.DB.Set(key, value)
+}
+
+// Delete removes the value stored at the given key.
+func (db *DB) Delete(key []byte) error {
+	defer db.metrics.done("delete", time.Now())
+	return db.DB.Delete(key)
+}
+
+// Unwrap returns the wrapped database, so that callers depending on its
+// concrete type can reach it.
+func (db *DB) Unwrap() interfaces.DB {
+	return db.DB
+}
+
+// sizeOf returns the function measuring the first database able to, looking
+// through the databases wrapping it, or nil if none is.
+func sizeOf(db interfaces.DB) func() (uint64, uint64, error) {
+	for {
+		switch d := db.(type) {
+		case sizer:
+			return d.Size
+		case wrapper:
+			db = d.Unwrap()
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metered_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/metered"
+	"github.com/stretchr/testify/require"
+)
+
+// sink records the metrics it is sent.
+type sink struct {
+	mu        sync.Mutex
+	gauges    map[string]int64
+	latencies map[string]int
+}
+
+func newSink() *sink {
+	return &sink{
+		gauges:    make(map[string]int64),
+		latencies: make(map[string]int),
+	}
+}
+
+func (s *sink) SetGauge(key string, value int64, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gauges[key+"/"+strings.Join(args, "/")] = value
+}
+
+func (s *sink) MeasureSince(key string, _ time.Time, args ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latencies[key+"/"+strings.Join(args, "/")]++
+}
+
+func (s *sink) gauge(key string) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.gauges[key]
+	return v, ok
+}
+
+func (s *sink) measured(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latencies[key]
+}
+
+func TestDB(t *testing.T) {
+	s := newSink()
+	db := metered.NewDB(
+		filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithLogger(log.NewNopLogger()),
+		),
+		"blobs",
+		s,
+	)
+	rdb := filedb.NewRangeDB(db)
+
+	require.NoError(t, rdb.Set(1, []byte("a"), []byte("value")))
+	require.NoError(t, rdb.Set(2, []byte("b"), []byte("other")))
+	_, err := rdb.Get(1, []byte("a"))
+	require.NoError(t, err)
+
+	latency := "beacon_kit.storage.latency/store/blobs/op/"
+	require.Equal(t, 2, s.measured(latency+"set"))
+	require.Equal(t, 1, s.measured(latency+"get"))
+
+	// The store is measured in the background after the first operation.
+	require.Eventually(t, func() bool {
+		_, ok := s.gauge("beacon_kit.storage.entries/store/blobs")
+		return ok
+	}, time.Second, time.Millisecond)
+	bytes, ok := s.gauge("beacon_kit.storage.bytes/store/blobs")
+	require.True(t, ok)
+	require.Positive(t, bytes)
+
+	// The range db reaches the file db through the metered db.
+	indexes, err := rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, indexes)
+}
+
+func TestDB_NilSink(t *testing.T) {
+	db := metered.NewDB(
+		filedb.NewDB(
+			filedb.WithRootDirectory(t.TempDir()),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithLogger(log.NewNopLogger()),
+		),
+		"blobs",
+		nil,
+	)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
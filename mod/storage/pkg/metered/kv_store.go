@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metered
+
+import (
+	"time"
+
+	"cosmossdk.io/core/store"
+)
+
+// KVStore wraps a store.KVStoreWithBatch and exports the latency of its
+// operations, along with its number of entries and bytes, labeled by the
+// name of the store.
+type KVStore struct {
+	store.KVStoreWithBatch
+	metrics *metrics
+}
+
+// NewKVStore creates a new metered KVStore on top of the given store. The
+// store is measured by iterating over all of its entries.
+func NewKVStore(
+	kvs store.KVStoreWithBatch,
+	name string,
+	sink TelemetrySink,
+) *KVStore {
+	s := &KVStore{KVStoreWithBatch: kvs}
+	s.metrics = newMetrics(sink, name, s.Size)
+	return s
+}
+
+// Get returns the value stored at the given key.
+func (s *KVStore) Get(key []byte) ([]byte, error) {
+	defer s.metrics.done("get", time.Now())
+	return s.KVStoreWithBatch.Get(key)
+}
+
+// Has reports whether a value is stored at the given key.
+func (s *KVStore) Has(key []byte) (bool, error) {
+	defer s.metrics.done("has", time.Now())
+	return s.KVStoreWithBatch.Has(key)
+}
+
+// Set stores the value at the given key.
+func (s *KVStore) Set(key, value []byte) error {
+	defer s.metrics.done("set", time.Now())
+	return s.KVStoreWithBatch.Set(key, value)
+}
+
+// Delete removes the value stored at the given key.
+func (s *KVStore) Delete(key []byte) error {
+	defer s.metrics.done("delete", time.Now())
+	return s.KVStoreWithBatch.Delete(key)
+}
+
+// Iterator returns an iterator over the domain [start, end).
+func (s *KVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	defer s.metrics.done("iterator", time.Now())
+	return s.KVStoreWithBatch.Iterator(start, end)
+}
+
+// ReverseIterator returns a reverse iterator over the domain [start, end).
+func (s *KVStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	defer s.metrics.done("iterator", time.Now())
+	return s.KVStoreWithBatch.ReverseIterator(start, end)
+}
+
+// NewBatch returns a batch whose writes are metered.
+func (s *KVStore) NewBatch() store.Batch {
+	return &batch{Batch: s.KVStoreWithBatch.NewBatch(), metrics: s.metrics}
+}
+
+// NewBatchWithSize returns a batch with a pre-allocated size whose writes
+// are metered.
+func (s *KVStore) NewBatchWithSize(size int) store.Batch {
+	return &batch{
+		Batch:   s.KVStoreWithBatch.NewBatchWithSize(size),
+		metrics: s.metrics,
+	}
+}
+
+// Size returns the number of entries of the store and the number of bytes
+// taken up by their keys and values.
+func (s *KVStore) Size() (uint64, uint64, error) {
+	it, err := s.KVStoreWithBatch.Iterator(nil, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer it.Close()
+
+	var entries, bytes uint64
+	for ; it.Valid(); it.Next() {
+		entries++
+		bytes += uint64(len(it.Key()) + len(it.Value()))
+	}
+	return entries, bytes, it.Error()
+}
+
+// batch meters the writes of the underlying batch.
+type batch struct {
+	store.Batch
+	metrics *metrics
+}
+
+// Write writes the batch to the store.
+func (b *batch) Write() error {
+	defer b.metrics.done("batch_write", time.Now())
+	return b.Batch.Write()
+}
+
+// WriteSync writes the batch to the store and syncs it to disk.
+func (b *batch) WriteSync() error {
+	defer b.metrics.done("batch_write", time.Now())
+	return b.Batch.WriteSync()
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metered
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// sizeInterval is the minimum time between two measurements of the size of
+// a store. Measuring walks the whole store, so it is not done on every write.
+const sizeInterval = time.Minute
+
+// metrics is a struct that contains metrics for a single store.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+	// store is the name the metrics of the store are labeled with.
+	store string
+	// size measures the store, nil if its size is unknown.
+	size func() (entries, bytes uint64, err error)
+	// sizedAt is the time, in unix nanoseconds, the store was last measured.
+	sizedAt atomic.Int64
+	// sizing is set while the store is being measured.
+	sizing atomic.Bool
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(
+	sink TelemetrySink,
+	store string,
+	size func() (entries, bytes uint64, err error),
+) *metrics {
+	return &metrics{
+		sink:  sink,
+		store: store,
+		size:  size,
+	}
+}
+
+// done records the latency of an operation started at the given time, and
+// measures the store in the background if it was not measured recently.
+func (m *metrics) done(op string, start time.Time) {
+	if m.sink == nil {
+		return
+	}
+	m.sink.MeasureSince(
+		"beacon_kit.storage.latency", start, "store", m.store, "op", op,
+	)
+
+	now := time.Now().UnixNano()
+	if m.size == nil || now-m.sizedAt.Load() < int64(sizeInterval) ||
+		!m.sizing.CompareAndSwap(false, true) {
+		return
+	}
+	m.sizedAt.Store(now)
+	go func() {
+		defer m.sizing.Store(false)
+		m.setSize()
+	}()
+}
+
+// setSize sets the gauges for the number of entries and bytes of the store.
+// A store that fails to be measured keeps its previous gauges.
+func (m *metrics) setSize() {
+	entries, bytes, err := m.size()
+	if err != nil {
+		return
+	}
+	//#nosec:G115 // store sizes fit in an int64.
+	m.sink.SetGauge(
+		"beacon_kit.storage.entries", int64(entries), "store", m.store,
+	)
+	//#nosec:G115 // store sizes fit in an int64.
+	m.sink.SetGauge("beacon_kit.storage.bytes", int64(bytes), "store", m.store)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metered
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/store"
+)
+
+// KVStoreService wraps a store service so that the operations made on its
+// stores are metered. The stores it opens are views of a context, so their
+// size is not exported.
+type KVStoreService struct {
+	store.KVStoreService
+	metrics *metrics
+}
+
+// NewKVStoreService returns a metered wrapper of the given store service.
+func NewKVStoreService(
+	kss store.KVStoreService,
+	name string,
+	sink TelemetrySink,
+) KVStoreService {
+	return KVStoreService{
+		KVStoreService: kss,
+		metrics:        newMetrics(sink, name, nil),
+	}
+}
+
+// OpenKVStore opens the store of the context, metering the operations made
+// on it.
+func (s KVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	kvs := s.KVStoreService.OpenKVStore(ctx)
+	if s.metrics.sink == nil {
+		return kvs
+	}
+	return meteredStore{KVStore: kvs, metrics: s.metrics}
+}
+
+// meteredStore is a store whose operations are metered.
+type meteredStore struct {
+	store.KVStore
+	metrics *metrics
+}
+
+// Get returns the value of key.
+func (s meteredStore) Get(key []byte) ([]byte, error) {
+	defer s.metrics.done("get", time.Now())
+	return s.KVStore.Get(key)
+}
+
+// Has reports whether key exists.
+func (s meteredStore) Has(key []byte) (bool, error) {
+	defer s.metrics.done("has", time.Now())
+	return s.KVStore.Has(key)
+}
+
+// Set sets the value of key.
+func (s meteredStore) Set(key, value []byte) error {
+	defer s.metrics.done("set", time.Now())
+	return s.KVStore.Set(key, value)
+}
+
+// Delete deletes key.
+func (s meteredStore) Delete(key []byte) error {
+	defer s.metrics.done("delete", time.Now())
+	return s.KVStore.Delete(key)
+}
+
+// Iterator iterates over a domain of keys in ascending order.
+func (s meteredStore) Iterator(start, end []byte) (store.Iterator, error) {
+	defer s.metrics.done("iterator", time.Now())
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator iterates over a domain of keys in descending order.
+func (s meteredStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	defer s.metrics.done("iterator", time.Now())
+	return s.KVStore.ReverseIterator(start, end)
+}
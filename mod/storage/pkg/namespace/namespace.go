@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package namespace prefixes the keys of the stores of a node with the name
+// of its chain, so that several chains can share a host database, or be run
+// side by side in a single process.
+package namespace
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+const (
+	// separator terminates the prefix of a namespace. As it is not allowed
+	// in names, no namespace prefix is a prefix of another.
+	separator = '/'
+	// maxLength is the length of the longest namespace name.
+	maxLength = 32
+)
+
+// Validate returns an error if name is not a valid namespace name. The empty
+// name, which leaves keys unprefixed, is valid.
+func Validate(name string) error {
+	if len(name) > maxLength {
+		return errors.Wrapf(
+			ErrInvalidNamespace, "%q is longer than %d bytes", name, maxLength,
+		)
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') &&
+			c != '-' && c != '_' {
+			return errors.Wrapf(
+				ErrInvalidNamespace, "%q contains %q", name, c,
+			)
+		}
+	}
+	return nil
+}
+
+// Prefix returns the prefix of the keys of the namespace, or nil for the
+// empty name.
+func Prefix(name string) []byte {
+	if name == "" {
+		return nil
+	}
+	return append([]byte(name), separator)
+}
+
+// NewDB returns a view of db holding the keys of the namespace only. db is
+// returned as is for the empty name.
+func NewDB(db dbm.DB, name string) (dbm.DB, error) {
+	if err := Validate(name); err != nil {
+		return nil, err
+	} else if name == "" {
+		return db, nil
+	}
+	return dbm.NewPrefixDB(db, Prefix(name)), nil
+}
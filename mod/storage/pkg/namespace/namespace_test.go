@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package namespace_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/memdb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/namespace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, namespace.Validate(""))
+	require.NoError(t, namespace.Validate("bartio-devnet_1"))
+	require.ErrorIs(
+		t, namespace.Validate("Devnet"), namespace.ErrInvalidNamespace,
+	)
+	require.ErrorIs(
+		t, namespace.Validate("dev/net"), namespace.ErrInvalidNamespace,
+	)
+	require.ErrorIs(
+		t,
+		namespace.Validate(string(bytes.Repeat([]byte("a"), 33))),
+		namespace.ErrInvalidNamespace,
+	)
+}
+
+func TestKVStore_Isolation(t *testing.T) {
+	host := memdb.New()
+	unprefixed, err := namespace.NewKVStore(host, "")
+	require.NoError(t, err)
+	require.Same(t, host, unprefixed)
+
+	devnet, err := namespace.NewKVStore(host, "devnet")
+	require.NoError(t, err)
+	devnet2, err := namespace.NewKVStore(host, "devnet2")
+	require.NoError(t, err)
+
+	require.NoError(t, devnet.Set([]byte("a"), []byte("1")))
+	require.NoError(t, devnet.Set([]byte("b"), []byte("2")))
+	require.NoError(t, devnet2.Set([]byte("a"), []byte("3")))
+
+	bz, err := devnet.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), bz)
+	bz, err = devnet2.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), bz)
+	has, err := devnet2.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The keys of a namespace are stored under its prefix.
+	bz, err = host.Get([]byte("devnet/b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), bz)
+
+	// Batches write to the namespace.
+	batch := devnet2.NewBatch()
+	require.NoError(t, batch.Set([]byte("c"), []byte("4")))
+	require.NoError(t, batch.Write())
+
+	// Iteration is bounded by the namespace and strips its prefix.
+	require.Equal(t, []string{"a", "b"}, keys(t, devnet))
+	require.Equal(t, []string{"a", "c"}, keys(t, devnet2))
+
+	require.NoError(t, devnet.Delete([]byte("a")))
+	require.Equal(t, []string{"b"}, keys(t, devnet))
+	require.Equal(t, []string{"a", "c"}, keys(t, devnet2))
+}
+
+func TestNewKVStore_InvalidName(t *testing.T) {
+	_, err := namespace.NewKVStore(memdb.New(), "dev net")
+	require.ErrorIs(t, err, namespace.ErrInvalidNamespace)
+}
+
+// keys returns the keys of the store in iteration order.
+func keys(t *testing.T, kvs store.KVStore) []string {
+	t.Helper()
+	it, err := kvs.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	var out []string
+	for ; it.Valid(); it.Next() {
+		out = append(out, string(it.Key()))
+	}
+	return out
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package namespace
+
+import (
+	"bytes"
+
+	"cosmossdk.io/core/store"
+)
+
+// KVStore wraps a store.KVStoreWithBatch and prefixes every key with the
+// prefix of a namespace. Keys are returned by iterators without the prefix.
+type KVStore struct {
+	store.KVStoreWithBatch
+	prefix []byte
+}
+
+// NewKVStore returns a view of kvs holding the keys of the namespace only.
+// kvs is returned as is for the empty name.
+func NewKVStore(
+	kvs store.KVStoreWithBatch, name string,
+) (store.KVStoreWithBatch, error) {
+	if err := Validate(name); err != nil {
+		return nil, err
+	} else if name == "" {
+		return kvs, nil
+	}
+	return &KVStore{KVStoreWithBatch: kvs, prefix: Prefix(name)}, nil
+}
+
+// Get returns the value stored at the given key of the namespace.
+func (s *KVStore) Get(key []byte) ([]byte, error) {
+	return s.KVStoreWithBatch.Get(s.key(key))
+}
+
+// Has reports whether the given key of the namespace is set.
+func (s *KVStore) Has(key []byte) (bool, error) {
+	return s.KVStoreWithBatch.Has(s.key(key))
+}
+
+// Set stores the value at the given key of the namespace.
+func (s *KVStore) Set(key, value []byte) error {
+	return s.KVStoreWithBatch.Set(s.key(key), value)
+}
+
+// Delete deletes the given key of the namespace.
+func (s *KVStore) Delete(key []byte) error {
+	return s.KVStoreWithBatch.Delete(s.key(key))
+}
+
+// Iterator returns an iterator over the domain [start, end) of the
+// namespace.
+func (s *KVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	from, to := s.domain(start, end)
+	it, err := s.KVStoreWithBatch.Iterator(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.iterator(it, start, end), nil
+}
+
+// ReverseIterator returns a reverse iterator over the domain [start, end) of
+// the namespace.
+func (s *KVStore) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	from, to := s.domain(start, end)
+	it, err := s.KVStoreWithBatch.ReverseIterator(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.iterator(it, start, end), nil
+}
+
+// NewBatch returns a batch writing to the namespace.
+func (s *KVStore) NewBatch() store.Batch {
+	return &batch{Batch: s.KVStoreWithBatch.NewBatch(), store: s}
+}
+
+// NewBatchWithSize returns a batch with a pre-allocated size writing to the
+// namespace.
+func (s *KVStore) NewBatchWithSize(size int) store.Batch {
+	return &batch{
+		Batch: s.KVStoreWithBatch.NewBatchWithSize(size),
+		store: s,
+	}
+}
+
+// key returns the key of the underlying store for the given key of the
+// namespace.
+func (s *KVStore) key(key []byte) []byte {
+	bz := make([]byte, 0, len(s.prefix)+len(key))
+	return append(append(bz, s.prefix...), key...)
+}
+
+// domain returns the domain of the underlying store for the given domain of
+// the namespace. A nil end is bounded by the end of the namespace.
+func (s *KVStore) domain(start, end []byte) ([]byte, []byte) {
+	if end != nil {
+		return s.key(start), s.key(end)
+	}
+	// The prefix ends with the separator, so it can be incremented in place.
+	to := bytes.Clone(s.prefix)
+	to[len(to)-1]++
+	return s.key(start), to
+}
+
+// iterator wraps an iterator of the underlying store over the given domain
+// of the namespace.
+func (s *KVStore) iterator(
+	it store.Iterator, start, end []byte,
+) store.Iterator {
+	return &iterator{Iterator: it, prefix: s.prefix, start: start, end: end}
+}
+
+// iterator strips the namespace prefix from the keys of the underlying
+// iterator.
+type iterator struct {
+	store.Iterator
+	prefix     []byte
+	start, end []byte
+}
+
+// Domain returns the domain of the iterator within the namespace.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Key returns the key at the current position, without the prefix.
+func (it *iterator) Key() []byte {
+	return it.Iterator.Key()[len(it.prefix):]
+}
+
+// batch prefixes the keys written to the underlying batch.
+type batch struct {
+	store.Batch
+	store *KVStore
+}
+
+// Set adds the value at the given key of the namespace to the batch.
+func (b *batch) Set(key, value []byte) error {
+	return b.Batch.Set(b.store.key(key), value)
+}
+
+// Delete adds the deletion of the given key of the namespace to the batch.
+func (b *batch) Delete(key []byte) error {
+	return b.Batch.Delete(b.store.key(key))
+}
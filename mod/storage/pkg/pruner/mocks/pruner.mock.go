@@ -67,6 +67,70 @@ func (_c *Pruner_Name_Call[PrunableT]) RunAndReturn(run func() string) *Pruner_N
 	return _c
 }
 
+// Pause provides a mock function with no fields
+func (_m *Pruner[PrunableT]) Pause() {
+	_m.Called()
+}
+
+// Pruner_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type Pruner_Pause_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Pause() *Pruner_Pause_Call[PrunableT] {
+	return &Pruner_Pause_Call[PrunableT]{Call: _e.mock.On("Pause")}
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) Run(run func()) *Pruner_Pause_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) Return() *Pruner_Pause_Call[PrunableT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) RunAndReturn(run func()) *Pruner_Pause_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with no fields
+func (_m *Pruner[PrunableT]) Resume() {
+	_m.Called()
+}
+
+// Pruner_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type Pruner_Resume_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Resume() *Pruner_Resume_Call[PrunableT] {
+	return &Pruner_Resume_Call[PrunableT]{Call: _e.mock.On("Resume")}
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) Run(run func()) *Pruner_Resume_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) Return() *Pruner_Resume_Call[PrunableT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) RunAndReturn(run func()) *Pruner_Resume_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Pruner[PrunableT]) Start(ctx context.Context) {
 	_m.Called(ctx)
@@ -28,6 +28,7 @@ package pruner
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
@@ -47,6 +48,18 @@ type pruner[
 	name                    string
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
 	pruneRangeFn            func(async.Event[BeaconBlockT]) (uint64, uint64)
+	// paused is true while pruning is deferred.
+	paused atomic.Bool
+	// resumed wakes the listener up to prune the deferred ranges.
+	resumed chan struct{}
+	// deferred are the ranges received while paused, in order. It is only
+	// accessed by the listener.
+	deferred []pruneRange
+}
+
+// pruneRange is a [start, end) range of indexes to prune.
+type pruneRange struct {
+	start, end uint64
 }
 
 // NewPruner creates a new Pruner.
@@ -66,6 +79,7 @@ func NewPruner[
 		name:                    name,
 		pruneRangeFn:            pruneRangeFn,
 		subBeaconBlockFinalized: subBeaconBlockFinalized,
+		resumed:                 make(chan struct{}, 1),
 	}
 }
 
@@ -83,6 +97,8 @@ func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
 			return
 		case event := <-p.subBeaconBlockFinalized:
 			p.onFinalizeBlock(event)
+		case <-p.resumed:
+			p.pruneDeferred()
 		}
 	}
 }
@@ -93,11 +109,58 @@ func (p *pruner[BeaconBlockT, PrunableT]) onFinalizeBlock(
 	event async.Event[BeaconBlockT],
 ) {
 	start, end := p.pruneRangeFn(event)
+	if !p.paused.Load() {
+		p.prune(start, end)
+		return
+	}
+
+	// Nothing to do for an empty range, and a range sharing the start of
+	// the last deferred one covers it.
+	switch n := len(p.deferred); {
+	case start == end:
+	case n > 0 && p.deferred[n-1].start == start:
+		p.deferred[n-1].end = max(p.deferred[n-1].end, end)
+	default:
+		p.deferred = append(p.deferred, pruneRange{start, end})
+	}
+}
+
+// pruneDeferred prunes the ranges received while paused, unless pruning has
+// been paused again in the meantime.
+func (p *pruner[_, _]) pruneDeferred() {
+	for len(p.deferred) > 0 && !p.paused.Load() {
+		p.prune(p.deferred[0].start, p.deferred[0].end)
+		p.deferred = p.deferred[1:]
+	}
+	if len(p.deferred) == 0 {
+		p.deferred = nil
+	}
+}
+
+// prune prunes the [start, end) range of the prunable store.
+func (p *pruner[_, _]) prune(start, end uint64) {
 	if err := p.prunable.Prune(start, end); err != nil {
 		p.logger.Error("‼️ error pruning index ‼️", "error", err)
 	}
 }
 
+// Pause defers pruning until Resume is called. Ranges received in the
+// meantime are pruned once resumed.
+func (p *pruner[_, _]) Pause() {
+	p.paused.Store(true)
+}
+
+// Resume resumes pruning, starting with the ranges deferred while paused.
+func (p *pruner[_, _]) Resume() {
+	if !p.paused.Swap(false) {
+		return
+	}
+	select {
+	case p.resumed <- struct{}{}:
+	default:
+	}
+}
+
 // Name returns the name of the Pruner.
 func (p *pruner[_, _]) Name() string {
 	return p.name
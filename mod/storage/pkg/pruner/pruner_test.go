@@ -122,3 +122,42 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+func TestPruner_PauseResume(t *testing.T) {
+	logger := log.NewNopLogger()
+	ch := make(chan async.Event[pruner.BeaconBlock])
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).Return(nil)
+
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.Prunable,
+	](logger, mockPrunable, "TestPruner", ch, func(
+		event async.Event[pruner.BeaconBlock],
+	) (uint64, uint64) {
+		return 0, event.Data().GetSlot().Unwrap()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	testPruner.Start(ctx)
+	testPruner.Pause()
+
+	// ranges sharing a start are coalesced while paused
+	for _, index := range []uint64{1, 2, 3} {
+		block := mocks.BeaconBlock{}
+		block.On("GetSlot").Return(math.U64(index))
+		ch <- async.NewEvent[pruner.BeaconBlock](
+			context.Background(),
+			async.BeaconBlockFinalized,
+			&block,
+		)
+	}
+	time.Sleep(100 * time.Millisecond)
+	mockPrunable.AssertNotCalled(t, "Prune", mock.Anything, mock.Anything)
+
+	testPruner.Resume()
+	time.Sleep(100 * time.Millisecond)
+	mockPrunable.AssertNumberOfCalls(t, "Prune", 1)
+	mockPrunable.AssertCalled(t, "Prune", uint64(0), uint64(3))
+}
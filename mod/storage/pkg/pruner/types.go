@@ -55,4 +55,8 @@ type Prunable interface {
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Pause defers pruning until Resume is called.
+	Pause()
+	// Resume resumes pruning, including what was deferred while paused.
+	Resume()
 }
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// Tree is a beacon state that can be merkleized.
+type Tree interface {
+	// GetTree returns the Merkle tree of the beacon state.
+	GetTree() (*fastssz.Node, error)
+}
+
+// Exporter writes the witness of every block it is started for to a
+// directory, as witness-<slot>.json.
+type Exporter[
+	BeaconStateT interface {
+		GetMarshallable() (BeaconStateMarshallableT, error)
+	},
+	BeaconStateMarshallableT Tree,
+] struct {
+	dir string
+}
+
+// NewExporter returns an Exporter writing to dir, which is created if it
+// does not exist.
+func NewExporter[
+	BeaconStateT interface {
+		GetMarshallable() (BeaconStateMarshallableT, error)
+	},
+	BeaconStateMarshallableT Tree,
+](dir string) (*Exporter[BeaconStateT, BeaconStateMarshallableT], error) {
+	//#nosec:G301 // witnesses are not sensitive.
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Exporter[BeaconStateT, BeaconStateMarshallableT]{dir: dir}, nil
+}
+
+// Start merkleizes the pre-state of the block at slot and returns a context
+// recording the accesses to the beacon store made through it, along with a
+// function writing the witness once the transition has succeeded.
+func (e *Exporter[BeaconStateT, _]) Start(
+	ctx context.Context,
+	preState BeaconStateT,
+	slot math.Slot,
+) (context.Context, func() error, error) {
+	marshallable, err := preState.GetMarshallable()
+	if err != nil {
+		return ctx, nil, err
+	}
+	tree, err := marshallable.GetTree()
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	ctx, recorder := WithRecorder(ctx)
+	export := func() error {
+		recorder.Close()
+		return e.export(slot, tree, recorder)
+	}
+	return ctx, export, nil
+}
+
+// export writes the witness recorded by recorder.
+func (e *Exporter[_, _]) export(
+	slot math.Slot,
+	tree *fastssz.Node,
+	recorder *Recorder,
+) error {
+	reads := recorder.Reads()
+	writes := recorder.Writes()
+
+	accessed := make([][]byte, 0, len(reads)+len(writes))
+	for _, entry := range reads {
+		accessed = append(accessed, entry.Key)
+	}
+	accessed = append(accessed, writes...)
+	fields, err := proveFields(tree, accessed)
+	if err != nil {
+		return err
+	}
+
+	w := &Witness{
+		Slot:      slot,
+		StateRoot: common.NewRootFromBytes(tree.Hash()),
+		Reads:     reads,
+		Writes:    make([]bytes.Bytes, len(writes)),
+		Fields:    fields,
+	}
+	for i, key := range writes {
+		w.Writes[i] = key
+	}
+
+	bz, err := json.Marshal(w)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(e.dir, fmt.Sprintf("witness-%d.json", slot))
+	//#nosec:G306 // witnesses are not sensitive.
+	return os.WriteFile(path, bz, 0o644)
+}
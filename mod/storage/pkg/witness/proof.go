@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// stateFieldsGIndexOffset is the generalized index of the first field of the
+// beacon state, which has 16 fields in the Deneb fork.
+const stateFieldsGIndexOffset = 16
+
+// stateField is a field of the beacon state.
+type stateField struct {
+	name  string
+	index uint64
+}
+
+// stateFields maps the prefix of every beacon store collection to the field
+// of the beacon state it holds. Collections without a field, such as the
+// tombstones, are not part of the state root and cannot be proven.
+//
+//nolint:gochecknoglobals,lll // read-only lookup table.
+var stateFields = map[byte]stateField{
+	keys.GenesisValidatorsRootPrefix:            {"genesis_validators_root", 0},
+	keys.SlotPrefix:                             {"slot", 1},
+	keys.ForkPrefix:                             {"fork", 2},
+	keys.LatestBeaconBlockHeaderPrefix:          {"latest_block_header", 3},
+	keys.BlockRootsPrefix:                       {"block_roots", 4},
+	keys.StateRootsPrefix:                       {"state_roots", 5},
+	keys.Eth1DataPrefix:                         {"eth1_data", 6},
+	keys.Eth1BlockHashPrefix:                    {"eth1_data", 6},
+	keys.Eth1DepositIndexPrefix:                 {"eth1_deposit_index", 7},
+	keys.LatestExecutionPayloadHeaderPrefix:     {"latest_execution_payload_header", 8},
+	keys.LatestExecutionPayloadVersionPrefix:    {"latest_execution_payload_header", 8},
+	keys.ValidatorIndexPrefix:                   {"validators", 9},
+	keys.ValidatorByIndexPrefix:                 {"validators", 9},
+	keys.ValidatorPubkeyToIndexPrefix:           {"validators", 9},
+	keys.ValidatorConsAddrToIndexPrefix:         {"validators", 9},
+	keys.ValidatorEffectiveBalanceToIndexPrefix: {"validators", 9},
+	keys.BalancesPrefix:                         {"balances", 10},
+	keys.RandaoMixPrefix:                        {"randao_mixes", 11},
+	keys.NextWithdrawalIndexPrefix:              {"next_withdrawal_index", 12},
+	keys.NextWithdrawalValidatorIndexPrefix:     {"next_withdrawal_validator_index", 13},
+	keys.SlashingsPrefix:                        {"slashings", 14},
+	keys.TotalSlashingPrefix:                    {"total_slashing", 15},
+}
+
+// proveFields proves, against the tree of the pre-state, every field of the
+// beacon state that one of the given store keys belongs to.
+func proveFields(
+	tree *fastssz.Node,
+	storeKeys [][]byte,
+) ([]FieldProof, error) {
+	touched := make(map[uint64]string)
+	for _, key := range storeKeys {
+		if len(key) == 0 {
+			continue
+		}
+		if field, ok := stateFields[key[0]]; ok {
+			touched[field.index] = field.name
+		}
+	}
+
+	proofs := make([]FieldProof, 0, len(touched))
+	for index, name := range touched {
+		gIndex := stateFieldsGIndexOffset + index
+		//#nosec:G115 // the generalized index is small.
+		proof, err := tree.Prove(int(gIndex))
+		if err != nil {
+			return nil, err
+		}
+
+		branch := make([]common.Root, len(proof.Hashes))
+		for i, hash := range proof.Hashes {
+			branch[i] = common.NewRootFromBytes(hash)
+		}
+		proofs = append(proofs, FieldProof{
+			Name:             name,
+			GeneralizedIndex: gIndex,
+			Leaf:             common.NewRootFromBytes(proof.Leaf),
+			Proof:            branch,
+		})
+	}
+	sort.Slice(proofs, func(i, j int) bool {
+		return proofs[i].GeneralizedIndex < proofs[j].GeneralizedIndex
+	})
+	return proofs, nil
+}
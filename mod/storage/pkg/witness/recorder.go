@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+)
+
+// recorderKey is the context key of the Recorder.
+type recorderKey struct{}
+
+// WithRecorder returns a context through which every access to the beacon
+// store is recorded by the returned Recorder.
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{
+		reads:  make(map[string][]byte),
+		writes: make(map[string]struct{}),
+	}
+	return context.WithValue(ctx, recorderKey{}, r), r
+}
+
+// recorderFromContext returns the Recorder of the context, if any.
+func recorderFromContext(ctx context.Context) *Recorder {
+	r, _ := ctx.Value(recorderKey{}).(*Recorder)
+	return r
+}
+
+// Recorder records the keys read and written in the beacon store. Reads of
+// keys that were written first are not recorded, since their values do not
+// come from the pre-state.
+type Recorder struct {
+	mu     sync.Mutex
+	closed bool
+	reads  map[string][]byte
+	writes map[string]struct{}
+}
+
+// Close stops the recording.
+func (r *Recorder) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+}
+
+// Reads returns the keys read from the pre-state and their values, sorted
+// by key. A nil value means the key was absent.
+func (r *Recorder) Reads() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entries := make([]Entry, 0, len(r.reads))
+	for key, value := range r.reads {
+		entries = append(entries, Entry{Key: []byte(key), Value: value})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].Key, entries[j].Key) < 0
+	})
+	return entries
+}
+
+// Writes returns the keys written or deleted, sorted.
+func (r *Recorder) Writes() [][]byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	keys := make([][]byte, 0, len(r.writes))
+	for key := range r.writes {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	return keys
+}
+
+// recordRead records a read of key that returned value.
+func (r *Recorder) recordRead(key, value []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	if _, ok := r.writes[string(key)]; ok {
+		return
+	}
+	if _, ok := r.reads[string(key)]; !ok {
+		r.reads[string(key)] = bytes.Clone(value)
+	}
+}
+
+// recordWrite records a write or deletion of key.
+func (r *Recorder) recordWrite(key []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		r.writes[string(key)] = struct{}{}
+	}
+}
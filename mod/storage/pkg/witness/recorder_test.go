@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/witness"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	kvs := memStore{"a": []byte("1"), "b": []byte("2")}
+	kss := witness.NewKVStoreService(memService{kvs})
+
+	// Accesses through a context without a recorder are not recorded.
+	require.Equal(t, kvs, kss.OpenKVStore(context.Background()))
+
+	ctx, recorder := witness.WithRecorder(context.Background())
+	st := kss.OpenKVStore(ctx)
+
+	_, err := st.Get([]byte("a"))
+	require.NoError(t, err)
+	has, err := st.Has([]byte("missing"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// A key written before it is read does not come from the pre-state.
+	require.NoError(t, st.Set([]byte("c"), []byte("3")))
+	_, err = st.Get([]byte("c"))
+	require.NoError(t, err)
+
+	// The first read of a key is the one from the pre-state.
+	require.NoError(t, st.Set([]byte("b"), []byte("4")))
+	require.NoError(t, st.Delete([]byte("a")))
+
+	require.Equal(t, []witness.Entry{
+		{Key: bytes.Bytes("a"), Value: bytes.Bytes("1")},
+		{Key: bytes.Bytes("missing"), Value: nil},
+	}, recorder.Reads())
+	require.Equal(t, [][]byte{
+		[]byte("a"), []byte("b"), []byte("c"),
+	}, recorder.Writes())
+
+	// Nothing is recorded once the recorder is closed.
+	recorder.Close()
+	require.NoError(t, st.Set([]byte("d"), []byte("5")))
+	require.Len(t, recorder.Writes(), 3)
+}
+
+type memService struct {
+	kvs memStore
+}
+
+func (s memService) OpenKVStore(context.Context) store.KVStore {
+	return s.kvs
+}
+
+type memStore map[string][]byte
+
+func (m memStore) Get(key []byte) ([]byte, error) {
+	return m[string(key)], nil
+}
+
+func (m memStore) Has(key []byte) (bool, error) {
+	_, ok := m[string(key)]
+	return ok, nil
+}
+
+func (m memStore) Set(key, value []byte) error {
+	m[string(key)] = value
+	return nil
+}
+
+func (m memStore) Delete(key []byte) error {
+	delete(m, string(key))
+	return nil
+}
+
+func (m memStore) Iterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
+
+func (m memStore) ReverseIterator(_, _ []byte) (store.Iterator, error) {
+	panic("not implemented")
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+)
+
+// KVStoreService wraps a store service so that the accesses made through a
+// context carrying a Recorder are recorded.
+type KVStoreService struct {
+	store.KVStoreService
+}
+
+// NewKVStoreService returns a recording wrapper of the given store service.
+func NewKVStoreService(kss store.KVStoreService) KVStoreService {
+	return KVStoreService{KVStoreService: kss}
+}
+
+// OpenKVStore opens the store of the context, recording accesses to it if
+// the context carries a Recorder.
+func (s KVStoreService) OpenKVStore(ctx context.Context) store.KVStore {
+	kvs := s.KVStoreService.OpenKVStore(ctx)
+	if r := recorderFromContext(ctx); r != nil {
+		return recordingStore{KVStore: kvs, recorder: r}
+	}
+	return kvs
+}
+
+// recordingStore is a store that reports its accesses to a Recorder.
+type recordingStore struct {
+	store.KVStore
+	recorder *Recorder
+}
+
+// Get returns the value of key.
+func (s recordingStore) Get(key []byte) ([]byte, error) {
+	value, err := s.KVStore.Get(key)
+	if err == nil {
+		s.recorder.recordRead(key, value)
+	}
+	return value, err
+}
+
+// Has reports whether key exists.
+func (s recordingStore) Has(key []byte) (bool, error) {
+	value, err := s.KVStore.Get(key)
+	if err != nil {
+		return false, err
+	}
+	s.recorder.recordRead(key, value)
+	return value != nil, nil
+}
+
+// Set sets the value of key.
+func (s recordingStore) Set(key, value []byte) error {
+	s.recorder.recordWrite(key)
+	return s.KVStore.Set(key, value)
+}
+
+// Delete deletes key.
+func (s recordingStore) Delete(key []byte) error {
+	s.recorder.recordWrite(key)
+	return s.KVStore.Delete(key)
+}
+
+// Iterator iterates over a domain of keys in ascending order.
+func (s recordingStore) Iterator(start, end []byte) (store.Iterator, error) {
+	it, err := s.KVStore.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return recordingIterator{Iterator: it, recorder: s.recorder}, nil
+}
+
+// ReverseIterator iterates over a domain of keys in descending order.
+func (s recordingStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	it, err := s.KVStore.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return recordingIterator{Iterator: it, recorder: s.recorder}, nil
+}
+
+// recordingIterator records the entries whose values are read.
+type recordingIterator struct {
+	store.Iterator
+	recorder *Recorder
+}
+
+// Value returns the value of the current entry.
+func (it recordingIterator) Value() []byte {
+	value := it.Iterator.Value()
+	it.recorder.recordRead(it.Iterator.Key(), value)
+	return value
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package witness
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Witness is the part of the pre-state a block's transition accessed,
+// together with Merkle proofs of the beacon state fields it touched.
+type Witness struct {
+	// Slot is the slot of the block.
+	Slot math.Slot `json:"slot"`
+	// StateRoot is the hash tree root of the pre-state.
+	StateRoot common.Root `json:"state_root"`
+	// Reads are the store entries read from the pre-state.
+	Reads []Entry `json:"reads"`
+	// Writes are the store keys written or deleted by the transition.
+	Writes []bytes.Bytes `json:"writes"`
+	// Fields are the proofs of the pre-state fields the accessed keys
+	// belong to, against StateRoot.
+	Fields []FieldProof `json:"fields"`
+}
+
+// Entry is a store entry read from the pre-state.
+type Entry struct {
+	// Key is the store key.
+	Key bytes.Bytes `json:"key"`
+	// Value is the value read, or null if the key was absent.
+	Value bytes.Bytes `json:"value"`
+}
+
+// FieldProof is a Merkle proof of a field of the beacon state.
+type FieldProof struct {
+	// Name is the name of the field.
+	Name string `json:"name"`
+	// GeneralizedIndex is the generalized index of the field in the state.
+	GeneralizedIndex uint64 `json:"generalized_index"`
+	// Leaf is the hash tree root of the field.
+	Leaf common.Root `json:"leaf"`
+	// Proof is the Merkle branch from the leaf to the state root.
+	Proof []common.Root `json:"proof"`
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package byzantine_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/testing/byzantine"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidBlock = errors.New("invalid block")
+
+const (
+	// height is the height at which proposals are built.
+	height = 10
+	// numBlobs is the number of blobs carried by each proposal.
+	numBlobs = 2
+	// runsPerFault is how often each fault is replayed on a harness to check
+	// that the verdict is deterministic.
+	runsPerFault = 5
+	// harnessesPerFault is the number of fresh harnesses each fault is
+	// replayed on.
+	harnessesPerFault = 2
+	// trustedSetupPath is the path of the KZG trusted setup.
+	trustedSetupPath = "../files/kzg-trusted-setup.json"
+)
+
+func newProofVerifier(t *testing.T) kzg.BlobProofVerifier {
+	t.Helper()
+	bz, err := os.ReadFile(trustedSetupPath)
+	require.NoError(t, err)
+	ts := new(gokzg4844.JSONTrustedSetup)
+	require.NoError(t, json.Unmarshal(bz, ts))
+	verifier, err := kzg.NewBlobProofVerifier(gokzg.Implementation, ts)
+	require.NoError(t, err)
+	return verifier
+}
+
+func newHarness(
+	t *testing.T, opts ...byzantine.Option,
+) (*byzantine.Harness, context.Context) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	h, err := byzantine.NewHarness(
+		spec.TestnetChainSpec(), newProofVerifier(t), opts...,
+	)
+	require.NoError(t, err)
+	require.NoError(t, h.Start(ctx))
+	return h, ctx
+}
+
+func newProposal(t *testing.T) *byzantine.Proposal {
+	t.Helper()
+	proposal, err := byzantine.NewProposal(
+		spec.TestnetChainSpec(), height, numBlobs,
+	)
+	require.NoError(t, err)
+	return proposal
+}
+
+func TestProcessProposalAcceptsHonestProposal(t *testing.T) {
+	h, ctx := newHarness(t)
+	for range runsPerFault {
+		require.Equal(
+			t,
+			cmtabci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+			h.ProcessProposal(ctx, newProposal(t)),
+		)
+	}
+}
+
+// TestProcessProposalVerdicts replays each fault on fresh harnesses, and
+// checks that the same verdict is reached every time and that the proposal
+// is rejected. Faults the middleware or the verifiers do not catch yet are
+// listed with the follow-up that fixes them, and fail once they are caught so
+// that they are moved out of the known failures.
+func TestProcessProposalVerdicts(t *testing.T) {
+	faults := []struct {
+		fault byzantine.Fault
+		// knownFailure is the follow-up needed to reject the fault, if it
+		// is not rejected yet.
+		knownFailure string
+	}{
+		{
+			fault:        byzantine.DuplicateTx(0),
+			knownFailure: "reject proposals carrying unexpected txs",
+		},
+		{
+			fault:        byzantine.DuplicateTx(1),
+			knownFailure: "reject proposals carrying unexpected txs",
+		},
+		{
+			fault:        byzantine.ReorderSidecars(),
+			knownFailure: "require sidecars to be ordered by blob index",
+		},
+		{
+			fault:        byzantine.TruncateSSZ(0),
+			knownFailure: "reject proposals whose block fails to decode",
+		},
+		{
+			fault:        byzantine.TruncateSSZ(1),
+			knownFailure: "reject proposals whose sidecars fail to decode",
+		},
+		{fault: byzantine.FutureSlot(1)},
+		{fault: byzantine.FutureSlot(1 << 32)},
+	}
+	for _, tc := range faults {
+		t.Run(tc.fault.Name, func(t *testing.T) {
+			verdict := replayFault(t, tc.fault)
+			switch {
+			case tc.knownFailure == "":
+				require.Equal(
+					t, cmtabci.PROCESS_PROPOSAL_STATUS_REJECT, verdict,
+				)
+			case verdict == cmtabci.PROCESS_PROPOSAL_STATUS_REJECT:
+				t.Fatalf(
+					"fault is now rejected, drop the known failure: %s",
+					tc.knownFailure,
+				)
+			default:
+				t.Skipf("known failure, to fix: %s", tc.knownFailure)
+			}
+		})
+	}
+}
+
+// replayFault replays the fault on fresh harnesses and returns the verdict,
+// which must be the same on every run.
+func replayFault(
+	t *testing.T, fault byzantine.Fault,
+) cmtabci.ProcessProposalStatus {
+	t.Helper()
+	verdicts := make(map[cmtabci.ProcessProposalStatus]int)
+	for range harnessesPerFault {
+		h, ctx := newHarness(t)
+		for range runsPerFault {
+			proposal := newProposal(t)
+			require.NoError(t, fault.Apply(proposal))
+			verdicts[h.ProcessProposal(ctx, proposal)]++
+		}
+	}
+	require.Len(t, verdicts, 1, "verdicts are not deterministic")
+	for verdict := range verdicts {
+		return verdict
+	}
+	return cmtabci.PROCESS_PROPOSAL_STATUS_UNKNOWN
+}
+
+func TestProcessProposalRejectsUnverifiedBlock(t *testing.T) {
+	h, ctx := newHarness(t, byzantine.WithBlockVerifier(
+		func(context.Context, *types.BeaconBlock) error {
+			return errInvalidBlock
+		},
+	))
+	for range runsPerFault {
+		require.Equal(
+			t,
+			cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
+			h.ProcessProposal(ctx, newProposal(t)),
+		)
+	}
+}
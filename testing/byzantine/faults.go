@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package byzantine
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ErrNotEnoughSidecars is returned when a fault needs more sidecars than the
+// proposal carries.
+var ErrNotEnoughSidecars = errors.New("not enough sidecars to apply fault")
+
+// Fault turns a well-formed proposal into a byzantine one.
+type Fault struct {
+	// Name is a human readable name of the fault.
+	Name string
+	// Apply mutates the given proposal in place.
+	Apply func(*Proposal) error
+}
+
+// DuplicateTx appends a copy of the tx at the given index to the proposal.
+func DuplicateTx(index int) Fault {
+	return Fault{
+		Name: "duplicate tx",
+		Apply: func(p *Proposal) error {
+			p.Txs = append(p.Txs, slices.Clone(p.Txs[index]))
+			return nil
+		},
+	}
+}
+
+// TruncateSSZ drops the trailing half of the tx at the given index.
+func TruncateSSZ(index int) Fault {
+	return Fault{
+		Name: "truncated ssz",
+		Apply: func(p *Proposal) error {
+			p.Txs[index] = p.Txs[index][:len(p.Txs[index])/2]
+			return nil
+		},
+	}
+}
+
+// ReorderSidecars reverses the order of the blob sidecars in the proposal.
+func ReorderSidecars() Fault {
+	return Fault{
+		Name: "reordered blob sidecars",
+		Apply: func(p *Proposal) error {
+			sidecars := new(datypes.BlobSidecars)
+			if err := sidecars.UnmarshalSSZ(
+				p.Txs[middlewareSidecarsIndex],
+			); err != nil {
+				return err
+			}
+			if sidecars.Len() < 2 { //nolint:mnd // need two to reorder.
+				return ErrNotEnoughSidecars
+			}
+			slices.Reverse(sidecars.Sidecars)
+
+			bz, err := sidecars.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			p.Txs[middlewareSidecarsIndex] = bz
+			return nil
+		},
+	}
+}
+
+// FutureSlot moves the beacon block in the proposal the given number of
+// slots past the height of the proposal.
+func FutureSlot(slots uint64) Fault {
+	return Fault{
+		Name: "future slot block",
+		Apply: func(p *Proposal) error {
+			blk, err := new(types.BeaconBlock).NewFromSSZ(
+				p.Txs[middlewareBlockIndex], version.Deneb,
+			)
+			if err != nil {
+				return err
+			}
+			blk.Slot += math.Slot(slots)
+
+			bz, err := blk.MarshalSSZ()
+			if err != nil {
+				return err
+			}
+			p.Txs[middlewareBlockIndex] = bz
+			return nil
+		},
+	}
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package byzantine provides a harness that drives the ABCI middleware with
+// adversarial proposals and records the verdicts it reaches.
+package byzantine
+
+import (
+	"context"
+	"encoding/json"
+
+	dp "github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+)
+
+type (
+	// BlockVerifier stands in for the blockchain service when verifying an
+	// incoming beacon block.
+	BlockVerifier func(ctx context.Context, blk *types.BeaconBlock) error
+
+	// SidecarsVerifier stands in for the DA service when verifying incoming
+	// blob sidecars.
+	SidecarsVerifier func(
+		ctx context.Context, sidecars *datypes.BlobSidecars,
+	) error
+
+	// Middleware is the ABCI middleware under test.
+	Middleware = middleware.ABCIMiddleware[
+		*types.BeaconBlock, *datypes.BlobSidecars, *genesis, *slotData,
+	]
+)
+
+// ErrSlotMismatch is returned by the default block verifier when the slot of
+// the beacon block is not the height of the proposal carrying it.
+var ErrSlotMismatch = errors.New("block slot does not match proposal height")
+
+// Harness wires the ABCI middleware to an in-process dispatcher, replacing the
+// services downstream of it with pluggable verifiers.
+type Harness struct {
+	chainSpec      common.ChainSpec
+	dispatcher     *dp.Dispatcher
+	middleware     *Middleware
+	verifyBlock    BlockVerifier
+	verifySidecars SidecarsVerifier
+}
+
+// NewHarness creates a new harness for the given chain spec. By default
+// sidecars are verified by the blob processor of the DA service, with the
+// given KZG proof verifier, and blocks are held to the slot of the proposal.
+// The state transition the blockchain service verifies blocks with needs a
+// chain state and an execution client, which the harness does not run.
+func NewHarness(
+	chainSpec common.ChainSpec,
+	proofVerifier kzg.BlobProofVerifier,
+	opts ...Option,
+) (*Harness, error) {
+	logger := noop.NewLogger[any]()
+	dispatcher, err := dp.New(
+		logger,
+		dp.WithEvent[async.Event[*genesis]](async.GenesisDataReceived),
+		dp.WithEvent[async.Event[transition.ValidatorUpdates]](
+			async.GenesisDataProcessed,
+		),
+		dp.WithEvent[async.Event[*slotData]](async.NewSlot),
+		dp.WithEvent[blockEvent](async.BuiltBeaconBlock),
+		dp.WithEvent[sidecarsEvent](async.BuiltSidecars),
+		dp.WithEvent[blockEvent](async.BeaconBlockReceived),
+		dp.WithEvent[sidecarsEvent](async.SidecarsReceived),
+		dp.WithEvent[blockEvent](async.BeaconBlockVerified),
+		dp.WithEvent[sidecarsEvent](async.SidecarsVerified),
+		dp.WithEvent[async.Event[transition.ValidatorUpdates]](
+			async.FinalValidatorUpdatesProcessed,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{
+		chainSpec:  chainSpec,
+		dispatcher: dispatcher,
+		middleware: middleware.NewABCIMiddleware[
+			*types.BeaconBlock, *datypes.BlobSidecars, *genesis, *slotData,
+		](chainSpec, dispatcher, logger, noopSink{}),
+		verifyBlock: verifyBlockSlot,
+		verifySidecars: newBlobProcessorVerifier(
+			chainSpec, proofVerifier,
+		),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
+}
+
+// Start starts the dispatcher and the middleware, and begins answering
+// verification requests until the context is cancelled.
+func (h *Harness) Start(ctx context.Context) error {
+	subBlocks := make(chan blockEvent)
+	subSidecars := make(chan sidecarsEvent)
+	if err := h.dispatcher.Subscribe(
+		async.BeaconBlockReceived, subBlocks,
+	); err != nil {
+		return err
+	}
+	if err := h.dispatcher.Subscribe(
+		async.SidecarsReceived, subSidecars,
+	); err != nil {
+		return err
+	}
+	if err := h.dispatcher.Start(ctx); err != nil {
+		return err
+	}
+	if err := h.middleware.Start(ctx); err != nil {
+		return err
+	}
+
+	go h.verifyLoop(ctx, subBlocks, subSidecars)
+	return nil
+}
+
+// ProcessProposal runs the given proposal through the middleware and returns
+// the verdict that would be handed back to CometBFT.
+func (h *Harness) ProcessProposal(
+	ctx context.Context, proposal *Proposal,
+) cmtabci.ProcessProposalStatus {
+	resp, err := h.middleware.ProcessProposal(
+		context.WithValue(ctx, heightKey{}, proposal.Height),
+		&cmtabci.ProcessProposalRequest{
+			Height: proposal.Height,
+			Txs:    proposal.Txs,
+		},
+	)
+	// The consensus service rejects the proposal on any middleware error.
+	if err != nil || resp == nil {
+		return cmtabci.PROCESS_PROPOSAL_STATUS_REJECT
+	}
+	return resp.Status
+}
+
+// verifyLoop answers the verification requests published by the middleware.
+func (h *Harness) verifyLoop(
+	ctx context.Context,
+	subBlocks chan blockEvent,
+	subSidecars chan sidecarsEvent,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-subBlocks:
+			if !ok {
+				return
+			}
+			_ = h.dispatcher.Publish(async.NewEvent(
+				event.Context(), async.BeaconBlockVerified, event.Data(),
+				h.verifyBlock(event.Context(), event.Data()),
+			))
+		case event, ok := <-subSidecars:
+			if !ok {
+				return
+			}
+			_ = h.dispatcher.Publish(async.NewEvent(
+				event.Context(), async.SidecarsVerified, event.Data(),
+				h.verifySidecars(event.Context(), event.Data()),
+			))
+		}
+	}
+}
+
+// heightKey is the context key of the height of the proposal being
+// processed.
+type heightKey struct{}
+
+// verifyBlockSlot ensures the slot of the block is the height of the
+// proposal carrying it.
+func verifyBlockSlot(ctx context.Context, blk *types.BeaconBlock) error {
+	height, ok := ctx.Value(heightKey{}).(int64)
+	//#nosec:G115 // height is always positive.
+	if !ok || blk.GetSlot() != math.Slot(height) {
+		return errors.Wrapf(
+			ErrSlotMismatch, "slot: %d, height: %d", blk.GetSlot(), height,
+		)
+	}
+	return nil
+}
+
+// newBlobProcessorVerifier returns a verifier that checks sidecars with the
+// blob processor of the DA service.
+func newBlobProcessorVerifier(
+	chainSpec common.ChainSpec, proofVerifier kzg.BlobProofVerifier,
+) SidecarsVerifier {
+	processor := dablob.NewProcessor[
+		dablob.AvailabilityStore[*types.BeaconBlockBody, *datypes.BlobSidecars],
+		*types.BeaconBlockBody, *types.BeaconBlockHeader,
+		*datypes.BlobSidecar, *datypes.BlobSidecars,
+	](
+		noop.NewLogger[any](),
+		chainSpec,
+		dablob.NewVerifier[
+			*types.BeaconBlockHeader, *datypes.BlobSidecar,
+			*datypes.BlobSidecars,
+		](proofVerifier, noopSink{}),
+		types.BlockBodyKZGOffset,
+		noopSink{},
+	)
+	return func(_ context.Context, sidecars *datypes.BlobSidecars) error {
+		return processor.VerifySidecars(sidecars)
+	}
+}
+
+type (
+	blockEvent    = async.Event[*types.BeaconBlock]
+	sidecarsEvent = async.Event[*datypes.BlobSidecars]
+)
+
+// genesis is a placeholder genesis, the harness never initializes a chain.
+type genesis struct{}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (*genesis) UnmarshalJSON([]byte) error { return nil }
+
+var _ json.Unmarshaler = (*genesis)(nil)
+
+// slotData is a placeholder for the data of a new slot.
+type slotData struct{}
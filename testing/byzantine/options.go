@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package byzantine
+
+import "time"
+
+// Option is a functional option for the harness.
+type Option func(*Harness)
+
+// WithBlockVerifier sets the verifier standing in for the blockchain service.
+func WithBlockVerifier(verifier BlockVerifier) Option {
+	return func(h *Harness) {
+		h.verifyBlock = verifier
+	}
+}
+
+// WithSidecarsVerifier sets the verifier standing in for the DA service.
+func WithSidecarsVerifier(verifier SidecarsVerifier) Option {
+	return func(h *Harness) {
+		h.verifySidecars = verifier
+	}
+}
+
+// noopSink is a telemetry sink that discards all metrics.
+type noopSink struct{}
+
+// IncrementCounter implements middleware.TelemetrySink.
+func (noopSink) IncrementCounter(string, ...string) {}
+
+// MeasureSince implements middleware.TelemetrySink.
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// AddSample implements middleware.TelemetrySink.
+func (noopSink) AddSample(string, float32, ...string) {}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package byzantine
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// middlewareBlockIndex is the index of the beacon block tx.
+	middlewareBlockIndex = int(middleware.BeaconBlockTxIndex)
+	// middlewareSidecarsIndex is the index of the blob sidecars tx.
+	middlewareSidecarsIndex = int(middleware.BlobSidecarsTxIndex)
+)
+
+// Proposal is the raw content of a ProcessProposal request.
+type Proposal struct {
+	// Height is the height of the proposal, which is also the slot.
+	Height int64
+	// Txs holds the SSZ encoded beacon block followed by its sidecars.
+	Txs [][]byte
+}
+
+// pointAtInfinity is the compressed G1 point at infinity, which is both the
+// KZG commitment and the KZG proof of an all zero blob.
+//
+//nolint:gochecknoglobals // constant.
+var pointAtInfinity = [48]byte{0xc0}
+
+// NewProposal builds a well-formed proposal for the given height, carrying
+// a beacon block with the given number of all zero blobs. The sidecars are
+// built by the blob sidecar factory, so that they pass the DA checks.
+func NewProposal(
+	chainSpec common.ChainSpec, height int64, numBlobs int,
+) (*Proposal, error) {
+	bundle := &engineprimitives.BlobsBundleV1[
+		eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+	]{
+		Commitments: make([]eip4844.KZGCommitment, numBlobs),
+		Proofs:      make([]eip4844.KZGProof, numBlobs),
+		Blobs:       make([]*eip4844.Blob, numBlobs),
+	}
+	for i := range numBlobs {
+		bundle.Commitments[i] = pointAtInfinity
+		bundle.Proofs[i] = pointAtInfinity
+		bundle.Blobs[i] = &eip4844.Blob{}
+	}
+
+	//#nosec:G115 // height is always positive.
+	blk := &types.BeaconBlock{
+		Slot:          math.Slot(height),
+		ProposerIndex: 0,
+		ParentRoot:    common.Root{0x01},
+		StateRoot:     common.Root{0x02},
+		Body: &types.BeaconBlockBody{
+			Eth1Data: &types.Eth1Data{},
+			ExecutionPayload: &types.ExecutionPayload{
+				Number:        math.U64(height),
+				ExtraData:     []byte{},
+				Transactions:  [][]byte{},
+				Withdrawals:   []*engineprimitives.Withdrawal{},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Deposits:           []*types.Deposit{},
+			BlobKzgCommitments: bundle.Commitments,
+		},
+	}
+
+	sidecars, err := dablob.NewBlobSidecarFactory[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	](chainSpec, types.KZGPositionDeneb, 1, noopSink{}).BuildSidecars(
+		blk, bundle,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newProposalFrom(height, blk, sidecars)
+}
+
+// newProposalFrom encodes the given block and sidecars into a proposal.
+func newProposalFrom(
+	height int64,
+	blk *types.BeaconBlock,
+	sidecars *datypes.BlobSidecars,
+) (*Proposal, error) {
+	blkBz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	sidecarsBz, err := sidecars.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return &Proposal{
+		Height: height,
+		Txs:    [][]byte{blkBz, sidecarsBz},
+	}, nil
+}